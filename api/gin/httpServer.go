@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/multiversx/mx-chain-es-indexer-go/config"
 	logger "github.com/multiversx/mx-chain-logger-go"
 )
 
@@ -14,25 +15,33 @@ var log = logger.GetOrCreate("api/gin")
 // ErrNilHttpServer signals that a nil http server has been provided
 var ErrNilHttpServer = errors.New("nil http server")
 
+// ErrMissingTLSFiles signals that TLS was enabled without providing the certificate or the key file
+var ErrMissingTLSFiles = errors.New("missing TLS certificate or key file")
+
 type httpServer struct {
-	server server
+	server    server
+	tlsConfig config.TLSConfig
 }
 
 // NewHttpServer returns a new instance of httpServer
-func NewHttpServer(server server) (*httpServer, error) {
+func NewHttpServer(server server, tlsConfig config.TLSConfig) (*httpServer, error) {
 	if server == nil {
 		return nil, ErrNilHttpServer
 	}
+	if tlsConfig.Enabled && (tlsConfig.CertificateFile == "" || tlsConfig.KeyFile == "") {
+		return nil, ErrMissingTLSFiles
+	}
 
 	return &httpServer{
-		server: server,
+		server:    server,
+		tlsConfig: tlsConfig,
 	}, nil
 }
 
 // Start will handle the starting of the gin web server. This call is blocking, and it should be
 // called on a go routine (different from the main one)
 func (h *httpServer) Start() {
-	err := h.server.ListenAndServe()
+	err := h.listenAndServe()
 	if err == nil {
 		return
 	}
@@ -45,6 +54,14 @@ func (h *httpServer) Start() {
 	log.Error("could not start webserver", "error", err.Error())
 }
 
+func (h *httpServer) listenAndServe() error {
+	if h.tlsConfig.Enabled {
+		return h.server.ListenAndServeTLS(h.tlsConfig.CertificateFile, h.tlsConfig.KeyFile)
+	}
+
+	return h.server.ListenAndServe()
+}
+
 // Close will handle the stopping of the gin web server
 func (h *httpServer) Close() error {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
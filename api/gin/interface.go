@@ -4,5 +4,6 @@ import "context"
 
 type server interface {
 	ListenAndServe() error
+	ListenAndServeTLS(certFile string, keyFile string) error
 	Shutdown(ctx context.Context) error
 }
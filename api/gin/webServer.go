@@ -4,38 +4,53 @@ import (
 	"fmt"
 	"net/http"
 	"sync"
+	"time"
 
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-es-indexer-go/api/groups"
+	"github.com/multiversx/mx-chain-es-indexer-go/api/middleware"
 	"github.com/multiversx/mx-chain-es-indexer-go/api/shared"
 	"github.com/multiversx/mx-chain-es-indexer-go/config"
 )
 
 const (
 	webServerOffString = "off"
+	statusGroupName    = "status"
+	adminGroupName     = "admin"
+	streamGroupName    = "stream"
+	readGroupName      = "read"
 )
 
 // ArgsWebServer holds the arguments needed for a webServer
 type ArgsWebServer struct {
-	Facade    shared.FacadeHandler
-	ApiConfig config.ApiRoutesConfig
+	Facade       shared.FacadeHandler
+	AdminFacade  shared.AdminFacadeHandler
+	StreamFacade shared.StreamFacadeHandler
+	ReadFacade   shared.ReadFacadeHandler
+	ApiConfig    config.ApiRoutesConfig
 }
 
 type webServer struct {
 	sync.RWMutex
-	facade     shared.FacadeHandler
-	apiConfig  config.ApiRoutesConfig
-	groups     map[string]shared.GroupHandler
-	httpServer shared.HttpServerCloser
+	facade       shared.FacadeHandler
+	adminFacade  shared.AdminFacadeHandler
+	streamFacade shared.StreamFacadeHandler
+	readFacade   shared.ReadFacadeHandler
+	apiConfig    config.ApiRoutesConfig
+	groups       map[string]shared.GroupHandler
+	httpServer   shared.HttpServerCloser
 }
 
 // NewWebServer will create a new instance of the webServer
 func NewWebServer(args ArgsWebServer) (*webServer, error) {
 	return &webServer{
-		facade:    args.Facade,
-		apiConfig: args.ApiConfig,
+		facade:       args.Facade,
+		adminFacade:  args.AdminFacade,
+		streamFacade: args.StreamFacade,
+		readFacade:   args.ReadFacade,
+		apiConfig:    args.ApiConfig,
 	}, nil
 }
 
@@ -57,21 +72,35 @@ func (ws *webServer) StartHttpServer() error {
 	gin.SetMode(gin.ReleaseMode)
 
 	engine = gin.Default()
-	cfg := cors.DefaultConfig()
-	cfg.AllowAllOrigins = true
-	cfg.AddAllowHeaders("Authorization")
-	engine.Use(cors.New(cfg))
+	// the client IP, used by the rate limiter and the audit log, is read from the forwarding headers only when the
+	// request comes from one of the trusted proxies. With no trusted proxies, the remote address of the request is used
+	err := engine.SetTrustedProxies(ws.apiConfig.TrustedProxies)
+	if err != nil {
+		return err
+	}
+	engine.Use(cors.New(createCORSConfig(ws.apiConfig.CORS)))
+
+	if ws.apiConfig.RateLimit.Enabled {
+		rateLimiter, err := middleware.NewRateLimiter(ws.apiConfig.RateLimit)
+		if err != nil {
+			return err
+		}
+		engine.Use(rateLimiter.MiddlewareHandlerFunc())
+	}
 
-	err := ws.createGroups()
+	err = ws.createGroups()
 	if err != nil {
 		return err
 	}
 
-	ws.registerRoutes(engine)
+	err = ws.registerRoutes(engine)
+	if err != nil {
+		return err
+	}
 
 	s := &http.Server{Addr: apiInterface, Handler: engine}
-	log.Debug("creating gin web sever", "interface", apiInterface)
-	ws.httpServer, err = NewHttpServer(s)
+	log.Debug("creating gin web sever", "interface", apiInterface, "tls", ws.apiConfig.TLS.Enabled)
+	ws.httpServer, err = NewHttpServer(s, ws.apiConfig.TLS)
 	if err != nil {
 		return err
 	}
@@ -89,19 +118,94 @@ func (ws *webServer) createGroups() error {
 	if err != nil {
 		return err
 	}
-	groupsMap["status"] = statusGroup
+	groupsMap[statusGroupName] = statusGroup
+
+	streamGroup, err := groups.NewStreamGroup(ws.streamFacade)
+	if err != nil {
+		return err
+	}
+	groupsMap[streamGroupName] = streamGroup
+
+	readGroup, err := groups.NewReadGroup(ws.readFacade)
+	if err != nil {
+		return err
+	}
+	groupsMap[readGroupName] = readGroup
+
+	if !isAuthEnabled(ws.apiConfig.APIPackages[adminGroupName].Auth) {
+		log.Warn("admin API group is disabled because no authentication is configured for it")
+		ws.groups = groupsMap
+		return nil
+	}
+
+	adminGroup, err := groups.NewAdminGroup(ws.adminFacade)
+	if err != nil {
+		return err
+	}
+	groupsMap[adminGroupName] = adminGroup
 
 	ws.groups = groupsMap
 
 	return nil
 }
 
-func (ws *webServer) registerRoutes(ginRouter *gin.Engine) {
+func (ws *webServer) registerRoutes(ginRouter *gin.Engine) error {
 	for groupName, groupHandler := range ws.groups {
-		log.Debug("registering gin API group", "group name", groupName)
+		authMiddleware, err := middleware.NewAuthMiddleware(ws.apiConfig.APIPackages[groupName].Auth)
+		if err != nil {
+			return fmt.Errorf("%w for API group %s", err, groupName)
+		}
+
+		rateLimitConfig := ws.apiConfig.APIPackages[groupName].RateLimit
+		log.Debug("registering gin API group", "group name", groupName, "authenticated", authMiddleware != nil, "rate limited", rateLimitConfig.Enabled)
 		ginGroup := ginRouter.Group(fmt.Sprintf("/%s", groupName))
+		// the group rate limiter applies on top of the global one, so that the expensive groups can be limited stricter
+		if rateLimitConfig.Enabled {
+			rateLimiter, err := middleware.NewRateLimiter(rateLimitConfig)
+			if err != nil {
+				return fmt.Errorf("%w for API group %s", err, groupName)
+			}
+			ginGroup.Use(rateLimiter.MiddlewareHandlerFunc())
+		}
+		if authMiddleware != nil {
+			ginGroup.Use(authMiddleware)
+		}
 		groupHandler.RegisterRoutes(ginGroup, ws.apiConfig)
 	}
+
+	return nil
+}
+
+func isAuthEnabled(authConfig config.AuthConfig) bool {
+	return authConfig.Type != "" && authConfig.Type != middleware.AuthTypeNone
+}
+
+func createCORSConfig(corsConfig config.CORSConfig) cors.Config {
+	cfg := cors.DefaultConfig()
+	cfg.AddAllowHeaders("Authorization")
+
+	allowAllOrigins := len(corsConfig.AllowedOrigins) == 0
+	for _, origin := range corsConfig.AllowedOrigins {
+		if origin == "*" {
+			allowAllOrigins = true
+		}
+	}
+	if allowAllOrigins {
+		cfg.AllowAllOrigins = true
+	} else {
+		cfg.AllowOrigins = corsConfig.AllowedOrigins
+	}
+
+	if len(corsConfig.AllowedMethods) > 0 {
+		cfg.AllowMethods = corsConfig.AllowedMethods
+	}
+	cfg.AddAllowHeaders(corsConfig.AllowedHeaders...)
+	cfg.AllowCredentials = corsConfig.AllowCredentials
+	if corsConfig.MaxAgeInSec > 0 {
+		cfg.MaxAge = time.Duration(corsConfig.MaxAgeInSec) * time.Second
+	}
+
+	return cfg
 }
 
 // Close will handle the closing of inner components
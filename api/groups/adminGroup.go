@@ -0,0 +1,155 @@
+package groups
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-es-indexer-go/api/shared"
+	"github.com/multiversx/mx-chain-es-indexer-go/core"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+const (
+	pausePath        = "/pause"
+	resumePath       = "/resume"
+	enableIndexPath  = "/indices/:index/enable"
+	disableIndexPath = "/indices/:index/disable"
+	configPath       = "/config"
+	tokensSyncPath   = "/tokens/sync"
+
+	indexParam = "index"
+)
+
+var auditLog = logger.GetOrCreate("api/audit")
+
+type adminGroup struct {
+	*baseGroup
+	facade shared.AdminFacadeHandler
+}
+
+// NewAdminGroup returns a new instance of admin group
+func NewAdminGroup(facade shared.AdminFacadeHandler) (*adminGroup, error) {
+	if check.IfNil(facade) {
+		return nil, fmt.Errorf("%w for admin group", core.ErrNilFacadeHandler)
+	}
+
+	ag := &adminGroup{
+		facade:    facade,
+		baseGroup: &baseGroup{},
+	}
+
+	endpoints := []*shared.EndpointHandlerData{
+		{
+			Path:    pausePath,
+			Handler: ag.pause,
+			Method:  http.MethodPost,
+		},
+		{
+			Path:    resumePath,
+			Handler: ag.resume,
+			Method:  http.MethodPost,
+		},
+		{
+			Path:    enableIndexPath,
+			Handler: ag.enableIndex,
+			Method:  http.MethodPost,
+		},
+		{
+			Path:    disableIndexPath,
+			Handler: ag.disableIndex,
+			Method:  http.MethodPost,
+		},
+		{
+			Path:    configPath,
+			Handler: ag.getConfig,
+			Method:  http.MethodGet,
+		},
+		{
+			Path:    tokensSyncPath,
+			Handler: ag.syncTokens,
+			Method:  http.MethodPost,
+		},
+		{
+			Path:    tokensSyncPath,
+			Handler: ag.getTokensSyncReport,
+			Method:  http.MethodGet,
+		},
+	}
+	ag.endpoints = endpoints
+
+	return ag, nil
+}
+
+// pause will stop the indexing of the data received from the observer
+func (ag *adminGroup) pause(c *gin.Context) {
+	ag.facade.Pause()
+	auditLog.Info("indexing paused", "client", c.ClientIP())
+
+	returnStatus(c, gin.H{"paused": true}, http.StatusOK, "", "successful")
+}
+
+// resume will continue the indexing of the data received from the observer
+func (ag *adminGroup) resume(c *gin.Context) {
+	ag.facade.Resume()
+	auditLog.Info("indexing resumed", "client", c.ClientIP())
+
+	returnStatus(c, gin.H{"paused": false}, http.StatusOK, "", "successful")
+}
+
+// enableIndex will start writing data in the provided index
+func (ag *adminGroup) enableIndex(c *gin.Context) {
+	index := c.Param(indexParam)
+	err := ag.facade.EnableIndex(index)
+	if err != nil {
+		auditLog.Warn("cannot enable index", "client", c.ClientIP(), "index", index, "error", err)
+		returnStatus(c, nil, http.StatusBadRequest, err.Error(), "bad_request")
+		return
+	}
+
+	auditLog.Info("index enabled", "client", c.ClientIP(), "index", index)
+	returnStatus(c, gin.H{"index": index, "enabled": true}, http.StatusOK, "", "successful")
+}
+
+// disableIndex will stop writing data in the provided index
+func (ag *adminGroup) disableIndex(c *gin.Context) {
+	index := c.Param(indexParam)
+	err := ag.facade.DisableIndex(index)
+	if err != nil {
+		auditLog.Warn("cannot disable index", "client", c.ClientIP(), "index", index, "error", err)
+		returnStatus(c, nil, http.StatusBadRequest, err.Error(), "bad_request")
+		return
+	}
+
+	auditLog.Info("index disabled", "client", c.ClientIP(), "index", index)
+	returnStatus(c, gin.H{"index": index, "enabled": false}, http.StatusOK, "", "successful")
+}
+
+// getConfig will expose the current configuration of the indexer
+func (ag *adminGroup) getConfig(c *gin.Context) {
+	returnStatus(c, gin.H{"config": ag.facade.GetConfig()}, http.StatusOK, "", "successful")
+}
+
+// syncTokens will refresh the cross-chain tokens from the main chain
+func (ag *adminGroup) syncTokens(c *gin.Context) {
+	report, err := ag.facade.SyncMainChainTokens()
+	if err != nil {
+		auditLog.Warn("cannot synchronize the cross-chain tokens", "client", c.ClientIP(), "error", err)
+		returnStatus(c, nil, http.StatusInternalServerError, err.Error(), "internal_issue")
+		return
+	}
+
+	auditLog.Info("cross-chain tokens synchronized", "client", c.ClientIP(), "updated", report.UpdatedTokens)
+	returnStatus(c, gin.H{"report": report}, http.StatusOK, "", "successful")
+}
+
+// getTokensSyncReport will return the report of the last synchronization of the cross-chain tokens
+func (ag *adminGroup) getTokensSyncReport(c *gin.Context) {
+	returnStatus(c, gin.H{"report": ag.facade.GetMainChainTokensSyncReport()}, http.StatusOK, "", "successful")
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ag *adminGroup) IsInterfaceNil() bool {
+	return ag == nil
+}
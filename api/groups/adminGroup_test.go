@@ -0,0 +1,207 @@
+package groups
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-es-indexer-go/config"
+	"github.com/multiversx/mx-chain-es-indexer-go/core"
+	"github.com/multiversx/mx-chain-es-indexer-go/core/request"
+	"github.com/multiversx/mx-chain-es-indexer-go/data"
+	"github.com/multiversx/mx-chain-es-indexer-go/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type adminResponse struct {
+	Data  map[string]json.RawMessage `json:"data"`
+	Error string                     `json:"error"`
+	Code  string                     `json:"code"`
+}
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+func createAdminApiConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"admin": {
+				Routes: []config.RouteConfig{
+					{Name: pausePath, Open: true},
+					{Name: resumePath, Open: true},
+					{Name: enableIndexPath, Open: true},
+					{Name: disableIndexPath, Open: true},
+					{Name: configPath, Open: true},
+					{Name: tokensSyncPath, Open: true},
+				},
+			},
+		},
+	}
+}
+
+func startAdminGroup(t *testing.T, facade *mock.AdminFacadeStub) *gin.Engine {
+	ws := gin.New()
+
+	ag, err := NewAdminGroup(facade)
+	require.Nil(t, err)
+	ag.RegisterRoutes(ws.Group("/admin"), createAdminApiConfig())
+
+	return ws
+}
+
+func doAdminRequest(ws *gin.Engine, method string, path string) (int, *adminResponse) {
+	req, _ := http.NewRequest(method, path, nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &adminResponse{}
+	_ = json.Unmarshal(resp.Body.Bytes(), response)
+
+	return resp.Code, response
+}
+
+func TestNewAdminGroup(t *testing.T) {
+	t.Parallel()
+
+	ag, err := NewAdminGroup(nil)
+	require.Nil(t, ag)
+	require.True(t, errors.Is(err, core.ErrNilFacadeHandler))
+
+	ag, err = NewAdminGroup(&mock.AdminFacadeStub{})
+	require.Nil(t, err)
+	require.False(t, ag.IsInterfaceNil())
+	require.Len(t, ag.endpoints, 7)
+}
+
+func TestAdminGroup_PauseAndResume(t *testing.T) {
+	t.Parallel()
+
+	paused := false
+	ws := startAdminGroup(t, &mock.AdminFacadeStub{
+		PauseCalled: func() {
+			paused = true
+		},
+		ResumeCalled: func() {
+			paused = false
+		},
+	})
+
+	code, response := doAdminRequest(ws, http.MethodPost, "/admin/pause")
+	require.Equal(t, http.StatusOK, code)
+	require.True(t, paused)
+	require.Equal(t, json.RawMessage(`true`), response.Data["paused"])
+
+	code, response = doAdminRequest(ws, http.MethodPost, "/admin/resume")
+	require.Equal(t, http.StatusOK, code)
+	require.False(t, paused)
+	require.Equal(t, json.RawMessage(`false`), response.Data["paused"])
+
+	code, _ = doAdminRequest(ws, http.MethodGet, "/admin/pause")
+	require.Equal(t, http.StatusNotFound, code)
+}
+
+func TestAdminGroup_EnableAndDisableIndex(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("unknown index")
+	ws := startAdminGroup(t, &mock.AdminFacadeStub{
+		EnableIndexCalled: func(index string) error {
+			require.Equal(t, "tokens", index)
+			return nil
+		},
+		DisableIndexCalled: func(index string) error {
+			return expectedErr
+		},
+	})
+
+	code, response := doAdminRequest(ws, http.MethodPost, "/admin/indices/tokens/enable")
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, json.RawMessage(`"tokens"`), response.Data["index"])
+	require.Equal(t, json.RawMessage(`true`), response.Data["enabled"])
+
+	code, response = doAdminRequest(ws, http.MethodPost, "/admin/indices/unknown/disable")
+	require.Equal(t, http.StatusBadRequest, code)
+	require.Equal(t, expectedErr.Error(), response.Error)
+	require.Equal(t, "bad_request", response.Code)
+}
+
+func TestAdminGroup_GetConfig(t *testing.T) {
+	t.Parallel()
+
+	ws := startAdminGroup(t, &mock.AdminFacadeStub{
+		GetConfigCalled: func() request.ConfigResponse {
+			return request.ConfigResponse{Paused: true, EnabledIndices: []string{"blocks"}}
+		},
+	})
+
+	code, response := doAdminRequest(ws, http.MethodGet, "/admin/config")
+	require.Equal(t, http.StatusOK, code)
+
+	configResponse := request.ConfigResponse{}
+	require.Nil(t, json.Unmarshal(response.Data["config"], &configResponse))
+	require.True(t, configResponse.Paused)
+	require.Equal(t, []string{"blocks"}, configResponse.EnabledIndices)
+}
+
+func TestAdminGroup_TokensSync(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("sync error")
+	shouldFail := false
+	ws := startAdminGroup(t, &mock.AdminFacadeStub{
+		SyncMainChainTokensCalled: func() (*data.TokensSyncReport, error) {
+			if shouldFail {
+				return nil, expectedErr
+			}
+			return &data.TokensSyncReport{CheckedTokens: 3, UpdatedTokens: 1}, nil
+		},
+		GetMainChainTokensSyncReportCalled: func() *data.TokensSyncReport {
+			return &data.TokensSyncReport{CheckedTokens: 3}
+		},
+	})
+
+	code, response := doAdminRequest(ws, http.MethodPost, "/admin/tokens/sync")
+	require.Equal(t, http.StatusOK, code)
+	report := &data.TokensSyncReport{}
+	require.Nil(t, json.Unmarshal(response.Data["report"], report))
+	require.Equal(t, 1, report.UpdatedTokens)
+
+	code, response = doAdminRequest(ws, http.MethodGet, "/admin/tokens/sync")
+	require.Equal(t, http.StatusOK, code)
+	report = &data.TokensSyncReport{}
+	require.Nil(t, json.Unmarshal(response.Data["report"], report))
+	require.Equal(t, 3, report.CheckedTokens)
+
+	shouldFail = true
+	code, response = doAdminRequest(ws, http.MethodPost, "/admin/tokens/sync")
+	require.Equal(t, http.StatusInternalServerError, code)
+	require.Equal(t, expectedErr.Error(), response.Error)
+}
+
+func TestAdminGroup_ClosedRoutesShouldNotBeRegistered(t *testing.T) {
+	t.Parallel()
+
+	ws := gin.New()
+
+	ag, _ := NewAdminGroup(&mock.AdminFacadeStub{})
+	apiConfig := config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"admin": {
+				Routes: []config.RouteConfig{
+					{Name: pausePath, Open: false},
+					{Name: configPath, Open: true},
+				},
+			},
+		},
+	}
+	ag.RegisterRoutes(ws.Group("/admin"), apiConfig)
+
+	code, _ := doAdminRequest(ws, http.MethodPost, "/admin/pause")
+	require.Equal(t, http.StatusNotFound, code)
+	code, _ = doAdminRequest(ws, http.MethodGet, "/admin/config")
+	require.Equal(t, http.StatusOK, code)
+}
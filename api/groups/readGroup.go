@@ -0,0 +1,104 @@
+package groups
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-es-indexer-go/api/shared"
+	"github.com/multiversx/mx-chain-es-indexer-go/core"
+)
+
+const (
+	stuckTxsPath   = "/transactions/stuck"
+	tokenRolesPath = "/tokens/:token/roles"
+
+	tokenParam     = "token"
+	maxAgeInSecKey = "max-age-in-sec"
+	timestampKey   = "timestamp"
+)
+
+type readGroup struct {
+	*baseGroup
+	facade shared.ReadFacadeHandler
+}
+
+// NewReadGroup returns a new instance of read group
+func NewReadGroup(facade shared.ReadFacadeHandler) (*readGroup, error) {
+	if check.IfNil(facade) {
+		return nil, fmt.Errorf("%w for read group", core.ErrNilFacadeHandler)
+	}
+
+	rg := &readGroup{
+		facade:    facade,
+		baseGroup: &baseGroup{},
+	}
+
+	endpoints := []*shared.EndpointHandlerData{
+		{
+			Path:    stuckTxsPath,
+			Handler: rg.getStuckTransactions,
+			Method:  http.MethodGet,
+		},
+		{
+			Path:    tokenRolesPath,
+			Handler: rg.getTokenRoles,
+			Method:  http.MethodGet,
+		},
+	}
+	rg.endpoints = endpoints
+
+	return rg, nil
+}
+
+// getStuckTransactions will return the cross-shard transactions that are pending for too long
+func (rg *readGroup) getStuckTransactions(c *gin.Context) {
+	maxAgeInSec := rg.facade.GetStuckPendingAgeInSec()
+	maxAgeStr := c.Query(maxAgeInSecKey)
+	if maxAgeStr != "" {
+		var err error
+		maxAgeInSec, err = strconv.ParseUint(maxAgeStr, 10, 64)
+		if err != nil {
+			returnStatus(c, nil, http.StatusBadRequest, fmt.Sprintf("invalid %s: %s", maxAgeInSecKey, err.Error()), "bad_request")
+			return
+		}
+	}
+
+	stuckTxs, err := rg.facade.GetStuckTransactions(maxAgeInSec)
+	if err != nil {
+		returnStatus(c, nil, http.StatusInternalServerError, err.Error(), "internal_issue")
+		return
+	}
+
+	returnStatus(c, gin.H{"transactions": stuckTxs, "count": len(stuckTxs)}, http.StatusOK, "", "successful")
+}
+
+// getTokenRoles will return the addresses that had each role of a token at the provided timestamp, or the current ones
+func (rg *readGroup) getTokenRoles(c *gin.Context) {
+	token := c.Param(tokenParam)
+	timestamp := uint64(0)
+	timestampStr := c.Query(timestampKey)
+	if timestampStr != "" {
+		var err error
+		timestamp, err = strconv.ParseUint(timestampStr, 10, 64)
+		if err != nil {
+			returnStatus(c, nil, http.StatusBadRequest, fmt.Sprintf("invalid %s: %s", timestampKey, err.Error()), "bad_request")
+			return
+		}
+	}
+
+	roles, err := rg.facade.GetTokenRolesAtTimestamp(token, timestamp)
+	if err != nil {
+		returnStatus(c, nil, http.StatusInternalServerError, err.Error(), "internal_issue")
+		return
+	}
+
+	returnStatus(c, gin.H{"token": token, "timestamp": timestamp, "roles": roles}, http.StatusOK, "", "successful")
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rg *readGroup) IsInterfaceNil() bool {
+	return rg == nil
+}
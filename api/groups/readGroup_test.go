@@ -0,0 +1,107 @@
+package groups
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-es-indexer-go/config"
+	"github.com/multiversx/mx-chain-es-indexer-go/core"
+	"github.com/multiversx/mx-chain-es-indexer-go/data"
+	"github.com/multiversx/mx-chain-es-indexer-go/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func startReadGroup(t *testing.T, facade *mock.ReadFacadeStub) *gin.Engine {
+	ws := gin.New()
+
+	rg, err := NewReadGroup(facade)
+	require.Nil(t, err)
+	rg.RegisterRoutes(ws.Group("/read"), config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"read": {
+				Routes: []config.RouteConfig{
+					{Name: stuckTxsPath, Open: true},
+					{Name: tokenRolesPath, Open: true},
+				},
+			},
+		},
+	})
+
+	return ws
+}
+
+func TestNewReadGroup(t *testing.T) {
+	t.Parallel()
+
+	rg, err := NewReadGroup(nil)
+	require.Nil(t, rg)
+	require.True(t, errors.Is(err, core.ErrNilFacadeHandler))
+
+	rg, err = NewReadGroup(&mock.ReadFacadeStub{})
+	require.Nil(t, err)
+	require.False(t, rg.IsInterfaceNil())
+	require.Len(t, rg.endpoints, 2)
+}
+
+func TestReadGroup_GetStuckTransactions(t *testing.T) {
+	t.Parallel()
+
+	requestedMaxAge := uint64(0)
+	ws := startReadGroup(t, &mock.ReadFacadeStub{
+		GetStuckPendingAgeInSecCalled: func() uint64 {
+			return 600
+		},
+		GetStuckTransactionsCalled: func(maxAgeInSec uint64) ([]*data.StuckTransaction, error) {
+			requestedMaxAge = maxAgeInSec
+			return []*data.StuckTransaction{{Hash: "h1"}}, nil
+		},
+	})
+
+	code, response := doAdminRequest(ws, http.MethodGet, "/read/transactions/stuck?max-age-in-sec=60")
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, uint64(60), requestedMaxAge)
+	require.Equal(t, json.RawMessage(`1`), response.Data["count"])
+
+	code, _ = doAdminRequest(ws, http.MethodGet, "/read/transactions/stuck")
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, uint64(600), requestedMaxAge)
+
+	code, _ = doAdminRequest(ws, http.MethodGet, "/read/transactions/stuck?max-age-in-sec=0")
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, uint64(0), requestedMaxAge)
+
+	code, response = doAdminRequest(ws, http.MethodGet, "/read/transactions/stuck?max-age-in-sec=abc")
+	require.Equal(t, http.StatusBadRequest, code)
+	require.Contains(t, response.Error, maxAgeInSecKey)
+}
+
+func TestReadGroup_GetTokenRoles(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("local error")
+	ws := startReadGroup(t, &mock.ReadFacadeStub{
+		GetTokenRolesAtTimestampCalled: func(token string, timestamp uint64) (map[string][]string, error) {
+			if token == "ERR-abcdef" {
+				return nil, expectedErr
+			}
+
+			require.Equal(t, "TKN-abcdef", token)
+			require.Equal(t, uint64(5040), timestamp)
+			return map[string][]string{"ESDTRoleLocalMint": {"erd1a"}}, nil
+		},
+	})
+
+	code, response := doAdminRequest(ws, http.MethodGet, "/read/tokens/TKN-abcdef/roles?timestamp=5040")
+	require.Equal(t, http.StatusOK, code)
+	require.JSONEq(t, `{"ESDTRoleLocalMint":["erd1a"]}`, string(response.Data["roles"]))
+
+	code, response = doAdminRequest(ws, http.MethodGet, "/read/tokens/ERR-abcdef/roles")
+	require.Equal(t, http.StatusInternalServerError, code)
+	require.Equal(t, expectedErr.Error(), response.Error)
+
+	code, _ = doAdminRequest(ws, http.MethodGet, "/read/tokens/TKN-abcdef/roles?timestamp=-1")
+	require.Equal(t, http.StatusBadRequest, code)
+}
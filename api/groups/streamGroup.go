@@ -0,0 +1,135 @@
+package groups
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-es-indexer-go/api/shared"
+	"github.com/multiversx/mx-chain-es-indexer-go/core"
+	"github.com/multiversx/mx-chain-es-indexer-go/stream"
+)
+
+const (
+	subscribePath = "/subscribe"
+
+	typeQueryParam       = "type"
+	shardQueryParam      = "shard"
+	addressQueryParam    = "address"
+	tokenQueryParam      = "token"
+	identifierQueryParam = "identifier"
+
+	keepAliveInterval = 15 * time.Second
+)
+
+type streamGroup struct {
+	*baseGroup
+	facade shared.StreamFacadeHandler
+}
+
+// NewStreamGroup returns a new instance of stream group
+func NewStreamGroup(facade shared.StreamFacadeHandler) (*streamGroup, error) {
+	if check.IfNil(facade) {
+		return nil, fmt.Errorf("%w for stream group", core.ErrNilFacadeHandler)
+	}
+
+	sg := &streamGroup{
+		facade:    facade,
+		baseGroup: &baseGroup{},
+	}
+
+	endpoints := []*shared.EndpointHandlerData{
+		{
+			Path:    subscribePath,
+			Handler: sg.subscribe,
+			Method:  http.MethodGet,
+		},
+	}
+	sg.endpoints = endpoints
+
+	return sg, nil
+}
+
+// subscribe will push the newly indexed entities that match the query filters as server-sent events
+func (sg *streamGroup) subscribe(c *gin.Context) {
+	filter, err := parseStreamFilter(c)
+	if err != nil {
+		returnStatus(c, nil, http.StatusBadRequest, err.Error(), "bad_request")
+		return
+	}
+
+	subscription, err := sg.facade.Subscribe(filter)
+	if err != nil {
+		returnStatus(c, nil, http.StatusServiceUnavailable, err.Error(), "unavailable")
+		return
+	}
+	defer sg.facade.Unsubscribe(subscription.ID)
+
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no")
+
+	keepAlive := time.NewTicker(keepAliveInterval)
+	defer keepAlive.Stop()
+
+	c.Stream(func(w io.Writer) bool {
+		select {
+		case event, ok := <-subscription.Events:
+			if !ok {
+				return false
+			}
+			c.SSEvent(event.Type, event)
+			return true
+		case <-keepAlive.C:
+			_, err = io.WriteString(w, ": keep-alive\n\n")
+			return err == nil
+		case <-c.Request.Context().Done():
+			return false
+		}
+	})
+}
+
+// parseStreamFilter reads the filter from the query parameters. Every parameter can be repeated or can hold
+// comma separated values
+func parseStreamFilter(c *gin.Context) (stream.Filter, error) {
+	filter := stream.Filter{
+		Types:       getQueryValues(c, typeQueryParam),
+		Addresses:   getQueryValues(c, addressQueryParam),
+		Tokens:      getQueryValues(c, tokenQueryParam),
+		Identifiers: getQueryValues(c, identifierQueryParam),
+	}
+
+	for _, shard := range getQueryValues(c, shardQueryParam) {
+		shardID, err := strconv.ParseUint(shard, 10, 32)
+		if err != nil {
+			return stream.Filter{}, fmt.Errorf("invalid shard %s", shard)
+		}
+		filter.ShardIDs = append(filter.ShardIDs, uint32(shardID))
+	}
+
+	return filter, nil
+}
+
+func getQueryValues(c *gin.Context, param string) []string {
+	values := make([]string, 0)
+	for _, value := range c.QueryArray(param) {
+		for _, splitValue := range strings.Split(value, ",") {
+			splitValue = strings.TrimSpace(splitValue)
+			if len(splitValue) > 0 {
+				values = append(values, splitValue)
+			}
+		}
+	}
+
+	return values
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sg *streamGroup) IsInterfaceNil() bool {
+	return sg == nil
+}
@@ -0,0 +1,103 @@
+package groups
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-es-indexer-go/config"
+	"github.com/multiversx/mx-chain-es-indexer-go/core"
+	"github.com/multiversx/mx-chain-es-indexer-go/data"
+	"github.com/multiversx/mx-chain-es-indexer-go/mock"
+	"github.com/multiversx/mx-chain-es-indexer-go/stream"
+	"github.com/stretchr/testify/require"
+)
+
+func startStreamGroup(t *testing.T, facade *mock.StreamFacadeStub) *httptest.Server {
+	ws := gin.New()
+
+	sg, err := NewStreamGroup(facade)
+	require.Nil(t, err)
+	sg.RegisterRoutes(ws.Group("/stream"), config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"stream": {
+				Routes: []config.RouteConfig{{Name: subscribePath, Open: true}},
+			},
+		},
+	})
+
+	return httptest.NewServer(ws)
+}
+
+func TestNewStreamGroup(t *testing.T) {
+	t.Parallel()
+
+	sg, err := NewStreamGroup(nil)
+	require.Nil(t, sg)
+	require.True(t, errors.Is(err, core.ErrNilFacadeHandler))
+
+	sg, err = NewStreamGroup(&mock.StreamFacadeStub{})
+	require.Nil(t, err)
+	require.False(t, sg.IsInterfaceNil())
+}
+
+func TestStreamGroup_SubscribeShouldPushTheEvents(t *testing.T) {
+	t.Parallel()
+
+	events := make(chan *data.StreamEvent, 1)
+	events <- &data.StreamEvent{Type: data.StreamEventBlock, ShardID: 1, Hash: "h1"}
+	close(events)
+
+	unsubscribedID := uint64(0)
+	server := startStreamGroup(t, &mock.StreamFacadeStub{
+		SubscribeCalled: func(filter stream.Filter) (*stream.Subscription, error) {
+			require.Equal(t, []string{data.StreamEventBlock, data.StreamEventTransaction}, filter.Types)
+			require.Equal(t, []uint32{0, 1}, filter.ShardIDs)
+			require.Equal(t, []string{"erd1a", "erd1b"}, filter.Addresses)
+			require.Equal(t, []string{}, filter.Tokens)
+			return &stream.Subscription{ID: 7, Events: events}, nil
+		},
+		UnsubscribeCalled: func(id uint64) {
+			unsubscribedID = id
+		},
+	})
+	defer server.Close()
+
+	resp, err := http.Get(server.URL + "/stream/subscribe?type=block,transaction&shard=0&shard=1&address=erd1a,%20erd1b")
+	require.Nil(t, err)
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	body, err := io.ReadAll(resp.Body)
+	require.Nil(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+	require.Contains(t, string(body), "event:block\n")
+	require.Contains(t, string(body), `"hash":"h1"`)
+	require.Equal(t, uint64(7), unsubscribedID)
+}
+
+func TestStreamGroup_SubscribeErrors(t *testing.T) {
+	t.Parallel()
+
+	server := startStreamGroup(t, &mock.StreamFacadeStub{
+		SubscribeCalled: func(filter stream.Filter) (*stream.Subscription, error) {
+			return nil, stream.ErrTooManySubscribers
+		},
+	})
+	defer server.Close()
+
+	resp, err := http.Get(server.URL + "/stream/subscribe?shard=abc")
+	require.Nil(t, err)
+	_ = resp.Body.Close()
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	resp, err = http.Get(server.URL + "/stream/subscribe")
+	require.Nil(t, err)
+	_ = resp.Body.Close()
+	require.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+}
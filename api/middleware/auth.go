@@ -0,0 +1,52 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-es-indexer-go/api/shared"
+	"github.com/multiversx/mx-chain-es-indexer-go/config"
+)
+
+const (
+	// AuthTypeNone disables the authentication of an API group
+	AuthTypeNone = "none"
+	// AuthTypeBearer requires a static bearer token in the Authorization header
+	AuthTypeBearer = "bearer"
+	// AuthTypeBasic requires HTTP basic auth credentials
+	AuthTypeBasic = "basic"
+
+	unauthorizedCode = "unauthorized"
+)
+
+// NewAuthMiddleware returns the authentication middleware described by the provided config. A nil middleware
+// is returned if the authentication is disabled
+func NewAuthMiddleware(cfg config.AuthConfig) (gin.HandlerFunc, error) {
+	switch cfg.Type {
+	case "", AuthTypeNone:
+		return nil, nil
+	case AuthTypeBearer:
+		if len(cfg.Tokens) == 0 {
+			return nil, fmt.Errorf("%w for %s authentication", ErrMissingCredentials, cfg.Type)
+		}
+		return NewBearerAuth(cfg.Tokens), nil
+	case AuthTypeBasic:
+		if len(cfg.Users) == 0 {
+			return nil, fmt.Errorf("%w for %s authentication", ErrMissingCredentials, cfg.Type)
+		}
+		return NewBasicAuth(cfg.Users), nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrInvalidAuthType, cfg.Type)
+	}
+}
+
+func abortUnauthorized(c *gin.Context, reason string) {
+	c.AbortWithStatusJSON(
+		http.StatusUnauthorized,
+		shared.GenericAPIResponse{
+			Error: reason,
+			Code:  unauthorizedCode,
+		},
+	)
+}
@@ -0,0 +1,41 @@
+package middleware
+
+import (
+	"crypto/subtle"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-es-indexer-go/config"
+)
+
+const (
+	authenticateHeader = "WWW-Authenticate"
+	basicRealm         = `Basic realm="elasticindexer"`
+)
+
+// NewBasicAuth returns a middleware that rejects the requests not carrying the basic auth credentials
+// of one of the provided users
+func NewBasicAuth(users []config.BasicAuthUser) gin.HandlerFunc {
+	credentials := make(map[string][]byte, len(users))
+	for _, user := range users {
+		credentials[user.Username] = []byte(user.Password)
+	}
+
+	return func(c *gin.Context) {
+		username, password, ok := c.Request.BasicAuth()
+		if !ok {
+			c.Header(authenticateHeader, basicRealm)
+			abortUnauthorized(c, "missing basic auth credentials")
+			return
+		}
+
+		expectedPassword, userExists := credentials[username]
+		isPasswordValid := subtle.ConstantTimeCompare(expectedPassword, []byte(password)) == 1
+		if !userExists || !isPasswordValid {
+			c.Header(authenticateHeader, basicRealm)
+			abortUnauthorized(c, "invalid basic auth credentials")
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -0,0 +1,51 @@
+package middleware
+
+import (
+	"crypto/subtle"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	authorizationHeader = "Authorization"
+	bearerPrefix        = "Bearer "
+)
+
+// NewBearerAuth returns a middleware that rejects the requests not carrying one of the provided tokens
+// in the Authorization header
+func NewBearerAuth(tokens []string) gin.HandlerFunc {
+	expectedTokens := make([][]byte, 0, len(tokens))
+	for _, token := range tokens {
+		if len(token) == 0 {
+			continue
+		}
+		expectedTokens = append(expectedTokens, []byte(token))
+	}
+
+	return func(c *gin.Context) {
+		header := c.GetHeader(authorizationHeader)
+		if !strings.HasPrefix(header, bearerPrefix) {
+			abortUnauthorized(c, "missing bearer token")
+			return
+		}
+
+		receivedToken := []byte(strings.TrimPrefix(header, bearerPrefix))
+		if !containsSecret(expectedTokens, receivedToken) {
+			abortUnauthorized(c, "invalid bearer token")
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// containsSecret compares the received value with all the expected ones, in constant time
+func containsSecret(expected [][]byte, received []byte) bool {
+	found := 0
+	for _, value := range expected {
+		found |= subtle.ConstantTimeCompare(value, received)
+	}
+
+	return found == 1
+}
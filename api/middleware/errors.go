@@ -0,0 +1,12 @@
+package middleware
+
+import "errors"
+
+// ErrInvalidAuthType signals that an invalid authentication type has been provided
+var ErrInvalidAuthType = errors.New("invalid authentication type")
+
+// ErrMissingCredentials signals that an authentication type was configured without any credentials
+var ErrMissingCredentials = errors.New("missing credentials")
+
+// ErrInvalidRateLimit signals that an invalid rate limit configuration has been provided
+var ErrInvalidRateLimit = errors.New("invalid rate limit")
@@ -0,0 +1,126 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-es-indexer-go/config"
+	"github.com/stretchr/testify/require"
+)
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+func createEngine(handlers ...gin.HandlerFunc) *gin.Engine {
+	engine := gin.New()
+	engine.Use(handlers...)
+	engine.GET("/test", func(c *gin.Context) {
+		c.String(http.StatusOK, "ok")
+	})
+
+	return engine
+}
+
+func doRequest(engine *gin.Engine, setHeaders func(req *http.Request)) int {
+	req := httptest.NewRequest(http.MethodGet, "/test", nil)
+	if setHeaders != nil {
+		setHeaders(req)
+	}
+	resp := httptest.NewRecorder()
+	engine.ServeHTTP(resp, req)
+
+	return resp.Code
+}
+
+func TestNewAuthMiddleware(t *testing.T) {
+	t.Parallel()
+
+	handler, err := NewAuthMiddleware(config.AuthConfig{Type: AuthTypeNone})
+	require.Nil(t, err)
+	require.Nil(t, handler)
+
+	handler, err = NewAuthMiddleware(config.AuthConfig{Type: AuthTypeBearer})
+	require.True(t, errors.Is(err, ErrMissingCredentials))
+	require.Nil(t, handler)
+
+	handler, err = NewAuthMiddleware(config.AuthConfig{Type: AuthTypeBasic})
+	require.True(t, errors.Is(err, ErrMissingCredentials))
+	require.Nil(t, handler)
+
+	handler, err = NewAuthMiddleware(config.AuthConfig{Type: "digest"})
+	require.True(t, errors.Is(err, ErrInvalidAuthType))
+	require.Nil(t, handler)
+
+	handler, err = NewAuthMiddleware(config.AuthConfig{Type: AuthTypeBearer, Tokens: []string{"token"}})
+	require.Nil(t, err)
+	require.NotNil(t, handler)
+}
+
+func TestBearerAuth(t *testing.T) {
+	t.Parallel()
+
+	engine := createEngine(NewBearerAuth([]string{"first", "second"}))
+
+	require.Equal(t, http.StatusUnauthorized, doRequest(engine, nil))
+	require.Equal(t, http.StatusUnauthorized, doRequest(engine, func(req *http.Request) {
+		req.Header.Set(authorizationHeader, "Bearer third")
+	}))
+	require.Equal(t, http.StatusUnauthorized, doRequest(engine, func(req *http.Request) {
+		req.Header.Set(authorizationHeader, "first")
+	}))
+	require.Equal(t, http.StatusOK, doRequest(engine, func(req *http.Request) {
+		req.Header.Set(authorizationHeader, "Bearer first")
+	}))
+	require.Equal(t, http.StatusOK, doRequest(engine, func(req *http.Request) {
+		req.Header.Set(authorizationHeader, "Bearer second")
+	}))
+}
+
+func TestBasicAuth(t *testing.T) {
+	t.Parallel()
+
+	engine := createEngine(NewBasicAuth([]config.BasicAuthUser{{Username: "user", Password: "pass"}}))
+
+	require.Equal(t, http.StatusUnauthorized, doRequest(engine, nil))
+	require.Equal(t, http.StatusUnauthorized, doRequest(engine, func(req *http.Request) {
+		req.SetBasicAuth("user", "wrong")
+	}))
+	require.Equal(t, http.StatusUnauthorized, doRequest(engine, func(req *http.Request) {
+		req.SetBasicAuth("other", "pass")
+	}))
+	require.Equal(t, http.StatusOK, doRequest(engine, func(req *http.Request) {
+		req.SetBasicAuth("user", "pass")
+	}))
+}
+
+func TestRateLimiter(t *testing.T) {
+	t.Parallel()
+
+	rateLimiter, err := NewRateLimiter(config.RateLimitConfig{RequestsPerSecond: 0})
+	require.True(t, errors.Is(err, ErrInvalidRateLimit))
+	require.Nil(t, rateLimiter)
+
+	rateLimiter, err = NewRateLimiter(config.RateLimitConfig{RequestsPerSecond: 1, Burst: 0})
+	require.True(t, errors.Is(err, ErrInvalidRateLimit))
+	require.Nil(t, rateLimiter)
+
+	rateLimiter, err = NewRateLimiter(config.RateLimitConfig{RequestsPerSecond: 0.001, Burst: 2})
+	require.Nil(t, err)
+
+	engine := createEngine(rateLimiter.MiddlewareHandlerFunc())
+	fromFirstClient := func(req *http.Request) {
+		req.RemoteAddr = "10.0.0.1:1000"
+	}
+	fromSecondClient := func(req *http.Request) {
+		req.RemoteAddr = "10.0.0.2:1000"
+	}
+
+	require.Equal(t, http.StatusOK, doRequest(engine, fromFirstClient))
+	require.Equal(t, http.StatusOK, doRequest(engine, fromFirstClient))
+	require.Equal(t, http.StatusTooManyRequests, doRequest(engine, fromFirstClient))
+	require.Equal(t, http.StatusOK, doRequest(engine, fromSecondClient))
+}
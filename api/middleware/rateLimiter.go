@@ -0,0 +1,99 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-es-indexer-go/api/shared"
+	"github.com/multiversx/mx-chain-es-indexer-go/config"
+	"golang.org/x/time/rate"
+)
+
+const (
+	tooManyRequestsCode = "too_many_requests"
+	clientsCleanupDelay = time.Minute
+)
+
+type clientLimiter struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+type rateLimiter struct {
+	mut               sync.Mutex
+	clients           map[string]*clientLimiter
+	requestsPerSecond rate.Limit
+	burst             int
+	lastCleanup       time.Time
+}
+
+// NewRateLimiter returns a new instance of rateLimiter that limits the requests of every client, identified by its IP
+func NewRateLimiter(cfg config.RateLimitConfig) (*rateLimiter, error) {
+	if cfg.RequestsPerSecond <= 0 {
+		return nil, fmt.Errorf("%w: requests per second should be positive", ErrInvalidRateLimit)
+	}
+	if cfg.Burst < 1 {
+		return nil, fmt.Errorf("%w: burst should be at least 1", ErrInvalidRateLimit)
+	}
+
+	return &rateLimiter{
+		clients:           make(map[string]*clientLimiter),
+		requestsPerSecond: rate.Limit(cfg.RequestsPerSecond),
+		burst:             cfg.Burst,
+		lastCleanup:       time.Now(),
+	}, nil
+}
+
+// MiddlewareHandlerFunc returns the handler func to be used as a gin middleware
+func (rl *rateLimiter) MiddlewareHandlerFunc() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !rl.allow(c.ClientIP()) {
+			c.AbortWithStatusJSON(
+				http.StatusTooManyRequests,
+				shared.GenericAPIResponse{
+					Error: "too many requests",
+					Code:  tooManyRequestsCode,
+				},
+			)
+			return
+		}
+
+		c.Next()
+	}
+}
+
+func (rl *rateLimiter) allow(clientIP string) bool {
+	rl.mut.Lock()
+	defer rl.mut.Unlock()
+
+	now := time.Now()
+	rl.cleanupUnprotected(now)
+
+	client, found := rl.clients[clientIP]
+	if !found {
+		client = &clientLimiter{
+			limiter: rate.NewLimiter(rl.requestsPerSecond, rl.burst),
+		}
+		rl.clients[clientIP] = client
+	}
+	client.lastSeen = now
+
+	return client.limiter.AllowN(now, 1)
+}
+
+// cleanupUnprotected removes the clients that did not make any request lately, so the map does not grow indefinitely
+func (rl *rateLimiter) cleanupUnprotected(now time.Time) {
+	if now.Sub(rl.lastCleanup) < clientsCleanupDelay {
+		return
+	}
+
+	for clientIP, client := range rl.clients {
+		if now.Sub(client.lastSeen) >= clientsCleanupDelay {
+			delete(rl.clients, clientIP)
+		}
+	}
+	rl.lastCleanup = now
+}
@@ -4,6 +4,8 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/multiversx/mx-chain-es-indexer-go/config"
 	"github.com/multiversx/mx-chain-es-indexer-go/core/request"
+	"github.com/multiversx/mx-chain-es-indexer-go/data"
+	"github.com/multiversx/mx-chain-es-indexer-go/stream"
 )
 
 // GroupHandler defines the actions needed to be performed by a gin API group
@@ -22,6 +24,33 @@ type FacadeHandler interface {
 	IsInterfaceNil() bool
 }
 
+// AdminFacadeHandler defines all the methods that an admin facade should implement
+type AdminFacadeHandler interface {
+	Pause()
+	Resume()
+	EnableIndex(index string) error
+	DisableIndex(index string) error
+	GetConfig() request.ConfigResponse
+	SyncMainChainTokens() (*data.TokensSyncReport, error)
+	GetMainChainTokensSyncReport() *data.TokensSyncReport
+	IsInterfaceNil() bool
+}
+
+// ReadFacadeHandler defines all the methods that a facade of the read-only endpoints should implement
+type ReadFacadeHandler interface {
+	GetStuckPendingAgeInSec() uint64
+	GetStuckTransactions(maxAgeInSec uint64) ([]*data.StuckTransaction, error)
+	GetTokenRolesAtTimestamp(token string, timestamp uint64) (map[string][]string, error)
+	IsInterfaceNil() bool
+}
+
+// StreamFacadeHandler defines all the methods that a live stream facade should implement
+type StreamFacadeHandler interface {
+	Subscribe(filter stream.Filter) (*stream.Subscription, error)
+	Unsubscribe(id uint64)
+	IsInterfaceNil() bool
+}
+
 // HttpServerCloser defines the basic actions of starting and closing that a web server should be able to do
 type HttpServerCloser interface {
 	Start()
@@ -37,6 +37,11 @@ func (ec *elasticClient) DoCountRequest(_ context.Context, _ string, _ []byte) (
 	return 0, nil
 }
 
+// DoSearchRequest -
+func (ec *elasticClient) DoSearchRequest(_ context.Context, _ string, _ []byte, _ interface{}) error {
+	return nil
+}
+
 // UpdateByQuery -
 func (ec *elasticClient) UpdateByQuery(_ context.Context, _ string, _ *bytes.Buffer) error {
 	return nil
@@ -21,6 +21,7 @@ func TestDisabledElasticClient_MethodsShouldNotPanic(t *testing.T) {
 		_ = ec.DoMultiGet(context.Background(), make([]string, 0), "", true, nil)
 		_ = ec.DoScrollRequest(context.Background(), "", []byte(""), true, nil)
 		_, _ = ec.DoCountRequest(context.Background(), "", []byte(""))
+		_ = ec.DoSearchRequest(context.Background(), "", []byte(""), nil)
 		_ = ec.UpdateByQuery(context.Background(), "", new(bytes.Buffer))
 		_ = ec.PutMappings("", new(bytes.Buffer))
 		_ = ec.CheckAndCreateIndex("")
@@ -34,6 +34,26 @@ func (ec *elasticClient) DoCountRequest(ctx context.Context, index string, body
 	return countRes.Uint(), nil
 }
 
+// DoSearchRequest will perform a search request and load the response in the provided result. The index is refreshed
+// before, so that the documents written by the previous bulk requests are found
+func (ec *elasticClient) DoSearchRequest(ctx context.Context, index string, body []byte, res interface{}) error {
+	err := ec.doRefresh(index)
+	if err != nil {
+		log.Warn("elasticClient.doRefresh", "cannot do refresh", err)
+	}
+
+	response, err := ec.client.Search(
+		ec.client.Search.WithIndex(index),
+		ec.client.Search.WithBody(bytes.NewBuffer(body)),
+		ec.client.Search.WithContext(ctx),
+	)
+	if err != nil {
+		return err
+	}
+
+	return parseResponse(response, res, elasticDefaultErrorResponseHandler)
+}
+
 // DoScrollRequest will perform a documents request using scroll api
 func (ec *elasticClient) DoScrollRequest(
 	ctx context.Context,
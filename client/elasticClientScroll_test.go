@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/elastic/go-elasticsearch/v7"
@@ -37,3 +38,32 @@ func TestElasticClient_DoCountRequest(t *testing.T) {
 	require.Nil(t, err)
 	require.Equal(t, uint64(112671), count)
 }
+
+func TestElasticClient_DoSearchRequest(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "_refresh") {
+			_, _ = w.Write([]byte(`{}`))
+			return
+		}
+
+		require.True(t, strings.HasSuffix(r.URL.Path, "/stats/_search"))
+		_, _ = w.Write([]byte(`{"hits":{"total":{"value":3}}}`))
+	}))
+	defer ts.Close()
+
+	esClient, _ := NewElasticClient(elasticsearch.Config{
+		Addresses: []string{ts.URL},
+		Logger:    &logging.CustomLogger{},
+	})
+
+	response := &struct {
+		Hits struct {
+			Total struct {
+				Value uint64 `json:"value"`
+			} `json:"total"`
+		} `json:"hits"`
+	}{}
+	err := esClient.DoSearchRequest(context.Background(), "stats", []byte(`{}`), response)
+	require.Nil(t, err)
+	require.Equal(t, uint64(3), response.Hits.Total.Value)
+}
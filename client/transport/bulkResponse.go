@@ -0,0 +1,70 @@
+package transport
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"unicode"
+)
+
+type bulkResponseItem struct {
+	Index  string `json:"_index"`
+	Status int    `json:"status"`
+}
+
+type bulkResponse struct {
+	Items []map[string]bulkResponseItem `json:"items"`
+}
+
+// countWrittenDocumentsPerIndex will read the body of a bulk response and will count the successfully written
+// documents for every index. The body of the response is restored so the client can read it afterward.
+func countWrittenDocumentsPerIndex(resp *http.Response) (map[string]uint64, error) {
+	bodyBytes, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(bodyBytes))
+	if err != nil {
+		return nil, err
+	}
+
+	response := bulkResponse{}
+	err = json.Unmarshal(bodyBytes, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	documentsPerIndex := make(map[string]uint64)
+	for _, item := range response.Items {
+		for _, actionResult := range item {
+			isSuccessful := actionResult.Status >= http.StatusOK && actionResult.Status < http.StatusMultipleChoices
+			if !isSuccessful {
+				continue
+			}
+
+			documentsPerIndex[trimIndexSuffix(actionResult.Index)]++
+		}
+	}
+
+	return documentsPerIndex, nil
+}
+
+// trimIndexSuffix will remove the numeric suffix of an index name, so "transactions-000001" becomes "transactions"
+func trimIndexSuffix(index string) string {
+	separatorPosition := strings.LastIndex(index, "-")
+	if separatorPosition < 0 {
+		return index
+	}
+
+	suffix := index[separatorPosition+1:]
+	if len(suffix) == 0 {
+		return index
+	}
+	for _, r := range suffix {
+		if !unicode.IsDigit(r) {
+			return index
+		}
+	}
+
+	return index[:separatorPosition]
+}
@@ -4,15 +4,20 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-es-indexer-go/core"
 	"github.com/multiversx/mx-chain-es-indexer-go/core/request"
 	"github.com/multiversx/mx-chain-es-indexer-go/metrics"
+	logger "github.com/multiversx/mx-chain-logger-go"
 )
 
-var errNilRequest = errors.New("nil request")
+var (
+	log           = logger.GetOrCreate("client/transport")
+	errNilRequest = errors.New("nil request")
+)
 
 type metricsTransport struct {
 	statusMetrics core.StatusMetricsHandler
@@ -55,6 +60,12 @@ func (m *metricsTransport) RoundTrip(req *http.Request) (*http.Response, error)
 	}
 	topic := fmt.Sprintf("%s", valueFromCtx)
 
+	isSuccessfulBulk := err == nil && resp.Body != nil && resp.StatusCode < http.StatusMultipleChoices &&
+		strings.HasPrefix(topic, request.BulkTopic)
+	if isSuccessfulBulk {
+		m.addWrittenDocuments(resp)
+	}
+
 	m.statusMetrics.AddIndexingData(metrics.ArgsAddIndexingData{
 		StatusCode: statusCode,
 		GotError:   err != nil,
@@ -65,3 +76,15 @@ func (m *metricsTransport) RoundTrip(req *http.Request) (*http.Response, error)
 
 	return resp, err
 }
+
+func (m *metricsTransport) addWrittenDocuments(resp *http.Response) {
+	documentsPerIndex, err := countWrittenDocumentsPerIndex(resp)
+	if err != nil {
+		log.Debug("metricsTransport.addWrittenDocuments: cannot parse bulk response", "error", err)
+		return
+	}
+
+	for index, count := range documentsPerIndex {
+		m.statusMetrics.AddIndexedDocuments(index, count)
+	}
+}
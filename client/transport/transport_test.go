@@ -4,7 +4,9 @@ import (
 	"bytes"
 	"context"
 	"errors"
+	"io"
 	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/multiversx/mx-chain-es-indexer-go/core"
@@ -103,3 +105,45 @@ func TestMetricsTransport_RoundTripNoValueInContextShouldNotAddMetrics(t *testin
 	metricsMap := metricsHandler.GetMetrics()
 	require.Len(t, metricsMap, 0)
 }
+
+func TestMetricsTransport_RoundTripBulkShouldCountWrittenDocuments(t *testing.T) {
+	t.Parallel()
+
+	metricsHandler := metrics.NewStatusMetrics()
+	transportHandler, _ := NewMetricsTransport(metricsHandler)
+
+	responseBody := `{"errors":true,"items":[
+{"index":{"_index":"transactions-000001","_id":"a","status":201}},
+{"update":{"_index":"transactions-000001","_id":"b","status":200}},
+{"update":{"_index":"accounts-000001","_id":"c","status":200}},
+{"delete":{"_index":"logs-000001","_id":"d","status":404}}]}`
+	transportHandler.transport = &mock.TransportMock{
+		Response: &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(responseBody)),
+		},
+	}
+
+	contextWithValue := context.WithValue(context.Background(), request.ContextKey, request.ExtendTopicWithShardID(request.BulkTopic, 0))
+	req, _ := http.NewRequestWithContext(contextWithValue, http.MethodPost, "dummy", bytes.NewBuffer([]byte("test")))
+
+	resp, err := transportHandler.RoundTrip(req)
+	require.Nil(t, err)
+
+	bodyBytes, _ := io.ReadAll(resp.Body)
+	require.Equal(t, responseBody, string(bodyBytes))
+
+	prometheusMetrics := metricsHandler.GetMetricsForPrometheus()
+	require.Contains(t, prometheusMetrics, `indexer_documents_written_total{index="transactions"} 2`)
+	require.Contains(t, prometheusMetrics, `indexer_documents_written_total{index="accounts"} 1`)
+	require.NotContains(t, prometheusMetrics, `indexer_documents_written_total{index="logs"}`)
+}
+
+func TestTrimIndexSuffix(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, "transactions", trimIndexSuffix("transactions-000001"))
+	require.Equal(t, "accountsesdt", trimIndexSuffix("accountsesdt"))
+	require.Equal(t, "some-index", trimIndexSuffix("some-index"))
+	require.Equal(t, "index-", trimIndexSuffix("index-"))
+}
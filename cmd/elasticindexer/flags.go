@@ -47,6 +47,11 @@ var (
 		Name:  "disable-ansi-color",
 		Usage: "Boolean option for disabling ANSI colors in the logging system.",
 	}
+	// checkConnectivity defines a flag of the validate-config command that enables the connectivity and privileges checks
+	checkConnectivity = cli.BoolFlag{
+		Name:  "check-connectivity",
+		Usage: "If set, the configured Elasticsearch clusters are contacted to check the connectivity and the privileges of the configured credentials",
+	}
 	// sovereign defines a flag that specifies if the es instance should run for a sovereign chain
 	sovereign = cli.BoolFlag{
 		Name:  "sovereign",
@@ -19,6 +19,7 @@ import (
 	"github.com/multiversx/mx-chain-es-indexer-go/factory"
 	"github.com/multiversx/mx-chain-es-indexer-go/metrics"
 	"github.com/multiversx/mx-chain-es-indexer-go/process/wsindexer"
+	"github.com/multiversx/mx-chain-es-indexer-go/stream"
 )
 
 var (
@@ -31,6 +32,9 @@ USAGE:
 AUTHOR:
    {{range .Authors}}{{ . }}{{end}}
    {{end}}{{if .Commands}}
+COMMANDS:
+   {{range .VisibleCommands}}{{join .Names ", "}}{{ "\t" }}{{.Usage}}
+   {{end}}
 GLOBAL OPTIONS:
    {{range .VisibleFlags}}{{.}}
    {{end}}
@@ -75,6 +79,14 @@ func main() {
 
 	app.Version = version
 	app.Action = startIndexer
+	app.Commands = []cli.Command{
+		{
+			Name:   "validate-config",
+			Usage:  "Validates the configuration files, including the environment overrides, without starting the indexer",
+			Flags:  []cli.Flag{checkConnectivity},
+			Action: validateConfig,
+		},
+	}
 
 	err := app.Run(os.Args)
 	if err != nil {
@@ -100,10 +112,9 @@ func startIndexer(ctx *cli.Context) error {
 		return fmt.Errorf("%w while initializing the logger", err)
 	}
 
-	statusMetrics := metrics.NewStatusMetrics()
-	wsHost, err := factory.CreateWsIndexer(cfg, clusterCfg, statusMetrics, ctx.App.Version)
+	err = applyLogLevel(clusterCfg.Config.LogLevel, ctx.GlobalString(logLevel.Name))
 	if err != nil {
-		return fmt.Errorf("%w while creating the indexer", err)
+		return fmt.Errorf("%w while setting the log level from the preferences config file", err)
 	}
 
 	apiConfig, err := loadApiConfig(ctx.GlobalString(configurationApiFile.Name))
@@ -111,7 +122,28 @@ func startIndexer(ctx *cli.Context) error {
 		return fmt.Errorf("%w while loading the api config file", err)
 	}
 
-	webServer, err := factory.CreateWebServer(apiConfig, statusMetrics)
+	streamBroadcaster, err := stream.NewBroadcaster(stream.ArgsBroadcaster{
+		MaxSubscribers:       apiConfig.Stream.MaxSubscribers,
+		SubscriberBufferSize: apiConfig.Stream.SubscriberBufferSize,
+	})
+	if err != nil {
+		return fmt.Errorf("%w while creating the stream broadcaster", err)
+	}
+
+	statusMetrics := metrics.NewStatusMetrics()
+	wsHost, indexerAdmin, err := factory.CreateWsIndexer(cfg, clusterCfg, statusMetrics, streamBroadcaster, ctx.App.Version)
+	if err != nil {
+		return fmt.Errorf("%w while creating the indexer", err)
+	}
+
+	webServer, err := factory.CreateWebServer(factory.ArgsWebServer{
+		ApiConfig:            apiConfig,
+		Config:               cfg,
+		ClusterConfig:        clusterCfg,
+		StatusMetricsHandler: statusMetrics,
+		IndexerAdminHandler:  indexerAdmin,
+		StreamBroadcaster:    streamBroadcaster,
+	})
 	if err != nil {
 		return fmt.Errorf("%w while creating the web server", err)
 	}
@@ -124,10 +156,21 @@ func startIndexer(ctx *cli.Context) error {
 	interrupt := make(chan os.Signal, 1)
 	signal.Notify(interrupt, syscall.SIGINT, syscall.SIGTERM)
 
+	reload := make(chan os.Signal, 1)
+	signal.Notify(reload, syscall.SIGHUP)
+
+	reloader := &preferencesReloader{
+		filePath:        ctx.GlobalString(configurationPreferencesFile.Name),
+		defaultLogLevel: ctx.GlobalString(logLevel.Name),
+		cfg:             cfg,
+		clusterCfg:      clusterCfg,
+		indexerAdmin:    indexerAdmin,
+	}
+
 	retryDuration := time.Duration(clusterCfg.Config.WebSocket.RetryDurationInSec) * time.Second
 	closed := requestSettings(wsHost, retryDuration, interrupt)
 	if !closed {
-		<-interrupt
+		waitForInterrupt(interrupt, reload, reloader)
 	}
 
 	log.Info("closing app at user's signal")
@@ -136,6 +179,7 @@ func startIndexer(ctx *cli.Context) error {
 		log.Error("cannot close ws indexer", "error", err)
 	}
 
+	streamBroadcaster.Close()
 	err = webServer.Close()
 	if err != nil {
 		log.Error("cannot close web server", "error", err)
@@ -148,6 +192,17 @@ func startIndexer(ctx *cli.Context) error {
 	return nil
 }
 
+func waitForInterrupt(interrupt chan os.Signal, reload chan os.Signal, reloader *preferencesReloader) {
+	for {
+		select {
+		case <-reload:
+			reloader.reload()
+		case <-interrupt:
+			return
+		}
+	}
+}
+
 func requestSettings(host wsindexer.WSClient, retryDuration time.Duration, close chan os.Signal) bool {
 	timer := time.NewTimer(0)
 	defer timer.Stop()
@@ -172,6 +227,11 @@ func requestSettings(host wsindexer.WSClient, retryDuration time.Duration, close
 func loadMainConfig(filepath string) (config.Config, error) {
 	cfg := config.Config{}
 	err := core.LoadTomlFile(&cfg, filepath)
+	if err != nil {
+		return config.Config{}, err
+	}
+
+	err = applyEnvOverrides(&cfg, config.EnvPrefixMainConfig)
 
 	return cfg, err
 }
@@ -179,6 +239,11 @@ func loadMainConfig(filepath string) (config.Config, error) {
 func loadClusterConfig(filepath string) (config.ClusterConfig, error) {
 	cfg := config.ClusterConfig{}
 	err := core.LoadTomlFile(&cfg, filepath)
+	if err != nil {
+		return config.ClusterConfig{}, err
+	}
+
+	err = applyEnvOverrides(&cfg, config.EnvPrefixPrefsConfig)
 
 	return cfg, err
 }
@@ -191,9 +256,58 @@ func loadApiConfig(filepath string) (config.ApiRoutesConfig, error) {
 		return config.ApiRoutesConfig{}, err
 	}
 
+	err = applyEnvOverrides(&cfg, config.EnvPrefixApiConfig)
+	if err != nil {
+		return config.ApiRoutesConfig{}, err
+	}
+
 	return cfg, nil
 }
 
+func applyEnvOverrides(cfg interface{}, prefix string) error {
+	applied, err := config.ApplyEnvOverrides(cfg, prefix)
+	if err != nil {
+		return err
+	}
+
+	// only the names are logged as the values usually are credentials
+	for _, envName := range applied {
+		log.Info("configuration value overridden from environment", "variable", envName)
+	}
+
+	return nil
+}
+
+func validateConfig(ctx *cli.Context) error {
+	cfg, err := loadMainConfig(ctx.GlobalString(configurationFile.Name))
+	if err != nil {
+		return fmt.Errorf("%w while loading the config file", err)
+	}
+	cfg.Sovereign = ctx.GlobalBool(sovereign.Name)
+
+	clusterCfg, err := loadClusterConfig(ctx.GlobalString(configurationPreferencesFile.Name))
+	if err != nil {
+		return fmt.Errorf("%w while loading the preferences config file", err)
+	}
+
+	_, err = loadApiConfig(ctx.GlobalString(configurationApiFile.Name))
+	if err != nil {
+		return fmt.Errorf("%w while loading the api config file", err)
+	}
+
+	err = factory.ValidateConfig(cfg, clusterCfg)
+	if err != nil {
+		return fmt.Errorf("invalid configuration: %w", err)
+	}
+	log.Info("configuration files are valid")
+
+	if !ctx.Bool(checkConnectivity.Name) {
+		return nil
+	}
+
+	return factory.CheckClustersAccess(cfg, clusterCfg)
+}
+
 func initializeLogger(ctx *cli.Context, cfg config.Config) (closing.Closer, error) {
 	logLevelFlagValue := ctx.GlobalString(logLevel.Name)
 	err := logger.SetLogLevel(logLevelFlagValue)
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"strings"
+
+	logger "github.com/multiversx/mx-chain-logger-go"
+
+	"github.com/multiversx/mx-chain-es-indexer-go/config"
+	"github.com/multiversx/mx-chain-es-indexer-go/core"
+	"github.com/multiversx/mx-chain-es-indexer-go/factory"
+)
+
+const (
+	disabledIndicesKey    = "config.disabled-indices"
+	logLevelKey           = "config.log-level"
+	bulkRequestMaxSizeKey = "config.elastic-cluster.bulk-request-max-size-in-bytes"
+	webSocketKeysPrefix   = "config.web-socket."
+)
+
+// reloadablePreferences holds the keys of the preferences file that can be changed without restarting the indexer
+var reloadablePreferences = map[string]struct{}{
+	disabledIndicesKey:    {},
+	logLevelKey:           {},
+	bulkRequestMaxSizeKey: {},
+}
+
+type preferencesReloader struct {
+	filePath        string
+	defaultLogLevel string
+	cfg             config.Config
+	clusterCfg      config.ClusterConfig
+	indexerAdmin    core.IndexerAdminHandler
+}
+
+// reload will read again the preferences file and apply the settings that can be changed at runtime.
+// The other changes are rejected and the values loaded at startup are kept
+func (pr *preferencesReloader) reload() {
+	log.Info("reloading the preferences file", "path", pr.filePath)
+
+	newClusterCfg, err := loadClusterConfig(pr.filePath)
+	if err != nil {
+		log.Error("cannot reload the preferences file, keeping the current settings", "error", err)
+		return
+	}
+
+	changedKeys := config.ChangedKeys(pr.clusterCfg, newClusterCfg)
+	if len(changedKeys) == 0 {
+		log.Info("preferences file did not change")
+		return
+	}
+
+	reloadedCfg := pr.clusterCfg
+	reloadedCfg.Config.DisabledIndices = newClusterCfg.Config.DisabledIndices
+	reloadedCfg.Config.LogLevel = newClusterCfg.Config.LogLevel
+	reloadedCfg.Config.ElasticCluster.BulkRequestMaxSizeInBytes = newClusterCfg.Config.ElasticCluster.BulkRequestMaxSizeInBytes
+
+	for _, key := range changedKeys {
+		_, isReloadable := reloadablePreferences[key]
+		if isReloadable {
+			continue
+		}
+		// the web socket settings, including the retry duration, are used by the connection to the observer, which is
+		// kept open while reloading
+		if strings.HasPrefix(key, webSocketKeysPrefix) {
+			log.Warn("web socket setting cannot be changed without reconnecting to the observer, a restart is required to apply it", "setting", key)
+			continue
+		}
+		log.Warn("setting cannot be changed at runtime, a restart is required to apply it", "setting", key)
+	}
+
+	settings, err := factory.CreateRuntimeSettings(pr.cfg, reloadedCfg)
+	if err != nil {
+		log.Error("invalid preferences, keeping the current settings", "error", err)
+		return
+	}
+	if settings.LogLevel == "" {
+		settings.LogLevel = pr.defaultLogLevel
+	}
+
+	pr.indexerAdmin.UpdateSettings(settings)
+	pr.clusterCfg = reloadedCfg
+}
+
+// applyLogLevel sets the log level from the preferences file, falling back to the one provided in the command line
+func applyLogLevel(logLevel string, defaultLogLevel string) error {
+	if logLevel == "" {
+		logLevel = defaultLogLevel
+	}
+
+	return logger.SetLogLevel(logLevel)
+}
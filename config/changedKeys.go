@@ -0,0 +1,44 @@
+package config
+
+import (
+	"reflect"
+	"strings"
+)
+
+const keySeparator = "."
+
+// ChangedKeys returns the TOML paths of the values that differ between the two provided configurations.
+// Both configurations should have the same type
+func ChangedKeys(oldCfg interface{}, newCfg interface{}) []string {
+	changed := make([]string, 0)
+	collectChangedKeys(reflect.ValueOf(oldCfg), reflect.ValueOf(newCfg), "", &changed)
+
+	return changed
+}
+
+func collectChangedKeys(oldValue reflect.Value, newValue reflect.Value, path string, changed *[]string) {
+	if oldValue.Kind() == reflect.Ptr {
+		oldValue, newValue = oldValue.Elem(), newValue.Elem()
+	}
+
+	if oldValue.Kind() != reflect.Struct {
+		if !reflect.DeepEqual(oldValue.Interface(), newValue.Interface()) {
+			*changed = append(*changed, path)
+		}
+		return
+	}
+
+	for i := 0; i < oldValue.NumField(); i++ {
+		field := oldValue.Type().Field(i)
+		tag := strings.Split(field.Tag.Get(tomlTagName), ",")[0]
+		if tag == "" || tag == "-" || !field.IsExported() {
+			continue
+		}
+
+		fieldPath := tag
+		if path != "" {
+			fieldPath = path + keySeparator + tag
+		}
+		collectChangedKeys(oldValue.Field(i), newValue.Field(i), fieldPath, changed)
+	}
+}
@@ -0,0 +1,27 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestChangedKeys(t *testing.T) {
+	t.Parallel()
+
+	oldCfg := ClusterConfig{}
+	oldCfg.Config.DisabledIndices = []string{"logs"}
+	oldCfg.Config.ElasticCluster.URL = "http://localhost:9200"
+
+	newCfg := oldCfg
+	require.Empty(t, ChangedKeys(oldCfg, newCfg))
+
+	newCfg.Config.DisabledIndices = []string{"logs", "events"}
+	newCfg.Config.ElasticCluster.URL = "http://localhost:9201"
+	newCfg.Config.WebSocket.RetryDurationInSec = 10
+	require.Equal(t, []string{
+		"config.disabled-indices",
+		"config.web-socket.retry-duration-in-seconds",
+		"config.elastic-cluster.url",
+	}, ChangedKeys(&oldCfg, &newCfg))
+}
@@ -6,9 +6,10 @@ type Config struct {
 		AvailableIndices []string `toml:"available-indices"`
 		ESDTPrefix       string   `toml:"esdt-prefix"`
 		AddressConverter struct {
-			Length int    `toml:"length"`
-			Type   string `toml:"type"`
-			Prefix string `toml:"prefix"`
+			Length      int    `toml:"length"`
+			Type        string `toml:"type"`
+			Prefix      string `toml:"prefix"`
+			StoreRawHex bool   `toml:"store-raw-hex"`
 		} `toml:"address-converter"`
 		ValidatorKeysConverter struct {
 			Length int    `toml:"length"`
@@ -21,8 +22,30 @@ type Config struct {
 			Type string `toml:"type"`
 		} `toml:"marshaller"`
 		Economics struct {
-			Denomination int `toml:"denomination"`
+			Denomination         int    `toml:"denomination"`
+			UnBondPeriodInEpochs uint32 `toml:"unbond-period-in-epochs"`
 		} `toml:"economics"`
+		CrossShard struct {
+			StuckPendingAgeInSec uint64 `toml:"stuck-pending-age-in-sec"`
+		} `toml:"cross-shard"`
+		NFTAttributes struct {
+			MaxFields          int `toml:"max-fields"`
+			MaxKeyLength       int `toml:"max-key-length"`
+			MaxValueLength     int `toml:"max-value-length"`
+			CollectionDecoders []struct {
+				Collection string `toml:"collection"`
+				Decoder    string `toml:"decoder"`
+			} `toml:"collection-decoders"`
+		} `toml:"nft-attributes"`
+		ABI struct {
+			Directory string `toml:"directory"`
+			Contracts []struct {
+				Address        string `toml:"address"`
+				CodeHash       string `toml:"code-hash"`
+				ABI            string `toml:"abi"`
+				DecodeCallArgs bool   `toml:"decode-call-args"`
+			} `toml:"contracts"`
+		} `toml:"abi"`
 		Logs struct {
 			LogFileLifeSpanInMB  int    `toml:"log-file-life-span-in-mb"`
 			LogFileLifeSpanInSec int    `toml:"log-file-life-span-in-sec"`
@@ -37,6 +60,7 @@ type Config struct {
 type ClusterConfig struct {
 	Config struct {
 		DisabledIndices []string `toml:"disabled-indices"`
+		LogLevel        string   `toml:"log-level"`
 		WebSocket       struct {
 			URL                string `toml:"url"`
 			Mode               string `toml:"mode"`
@@ -59,18 +83,79 @@ type ClusterConfig struct {
 			UserName string `toml:"username"`
 			Password string `toml:"password"`
 		} `toml:"main-chain-elastic-cluster"`
+		MainChainTokensSource struct {
+			Type                    string `toml:"type"`
+			ProxyURL                string `toml:"proxy-url"`
+			AddressPrefix           string `toml:"address-prefix"`
+			RequestTimeoutInSec     uint64 `toml:"request-timeout-in-seconds"`
+			CacheExpiryInSec        uint64 `toml:"cache-expiry-in-seconds"`
+			CacheMaxSize            int    `toml:"cache-max-size"`
+			TokensSyncIntervalInSec uint64 `toml:"tokens-sync-interval-in-seconds"`
+		} `toml:"main-chain-tokens-source"`
+		ContractStats struct {
+			CallersRetentionInDays uint64 `toml:"callers-retention-in-days"`
+		} `toml:"contract-stats"`
 	} `toml:"config"`
 }
 
 // ApiRoutesConfig holds the configuration related to Rest API routes
 type ApiRoutesConfig struct {
 	RestApiInterface string                      `toml:"rest-api-interface"`
+	TrustedProxies   []string                    `toml:"trusted-proxies"`
+	TLS              TLSConfig                   `toml:"tls"`
+	CORS             CORSConfig                  `toml:"cors"`
+	RateLimit        RateLimitConfig             `toml:"rate-limit"`
+	Stream           StreamConfig                `toml:"stream"`
 	APIPackages      map[string]APIPackageConfig `toml:"api-packages"`
 }
 
+// TLSConfig holds the configuration for serving the Rest API over HTTPS
+type TLSConfig struct {
+	Enabled         bool   `toml:"enabled"`
+	CertificateFile string `toml:"certificate-file"`
+	KeyFile         string `toml:"key-file"`
+}
+
+// CORSConfig holds the cross-origin resource sharing settings of the Rest API
+type CORSConfig struct {
+	AllowedOrigins   []string `toml:"allowed-origins"`
+	AllowedMethods   []string `toml:"allowed-methods"`
+	AllowedHeaders   []string `toml:"allowed-headers"`
+	AllowCredentials bool     `toml:"allow-credentials"`
+	MaxAgeInSec      uint32   `toml:"max-age-in-seconds"`
+}
+
+// RateLimitConfig holds the per client rate limiting settings of the Rest API
+type RateLimitConfig struct {
+	Enabled           bool    `toml:"enabled"`
+	RequestsPerSecond float64 `toml:"requests-per-second"`
+	Burst             int     `toml:"burst"`
+}
+
+// StreamConfig holds the settings of the live stream of indexed entities
+type StreamConfig struct {
+	MaxSubscribers       int `toml:"max-subscribers"`
+	SubscriberBufferSize int `toml:"subscriber-buffer-size"`
+}
+
 // APIPackageConfig holds the configuration for the routes of each package
 type APIPackageConfig struct {
-	Routes []RouteConfig `toml:"routes"`
+	Routes    []RouteConfig   `toml:"routes"`
+	Auth      AuthConfig      `toml:"auth"`
+	RateLimit RateLimitConfig `toml:"rate-limit"`
+}
+
+// AuthConfig holds the authentication settings of an API package
+type AuthConfig struct {
+	Type   string          `toml:"type"`
+	Tokens []string        `toml:"tokens"`
+	Users  []BasicAuthUser `toml:"users"`
+}
+
+// BasicAuthUser holds the credentials of a basic auth user
+type BasicAuthUser struct {
+	Username string `toml:"username"`
+	Password string `toml:"password"`
 }
 
 // RouteConfig holds the configuration for a single route
@@ -0,0 +1,186 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+const (
+	// EnvPrefixMainConfig is the prefix of the environment variables that override the main configuration file
+	EnvPrefixMainConfig = "ELASTICINDEXER_CONFIG"
+	// EnvPrefixPrefsConfig is the prefix of the environment variables that override the preferences configuration file
+	EnvPrefixPrefsConfig = "ELASTICINDEXER_PREFS"
+	// EnvPrefixApiConfig is the prefix of the environment variables that override the api configuration file
+	EnvPrefixApiConfig = "ELASTICINDEXER_API"
+
+	envSeparator   = "_"
+	tomlTagName    = "toml"
+	sliceSeparator = ","
+)
+
+type lookupEnvFunc func(key string) (string, bool)
+
+// ApplyEnvOverrides will override the fields of the provided configuration with the values of the matching
+// environment variables. The name of the variable is built from the prefix followed by the path of the TOML key,
+// upper-cased, with dashes and dots replaced by underscores. For example, the "password" key of the
+// [config.elastic-cluster] section of the preferences file is overridden by ELASTICINDEXER_PREFS_CONFIG_ELASTIC_CLUSTER_PASSWORD.
+// Map entries and elements of slices of tables are reachable through their key or position
+// (ELASTICINDEXER_API_API_PACKAGES_ADMIN_AUTH_USERS_0_PASSWORD), but only if they are already defined in the file.
+// Slices of values are provided as comma separated lists. It returns the names of the applied variables.
+func ApplyEnvOverrides(cfg interface{}, prefix string) ([]string, error) {
+	return applyEnvOverrides(cfg, prefix, os.LookupEnv)
+}
+
+func applyEnvOverrides(cfg interface{}, prefix string, lookupEnv lookupEnvFunc) ([]string, error) {
+	value := reflect.ValueOf(cfg)
+	if value.Kind() != reflect.Ptr || value.IsNil() || value.Elem().Kind() != reflect.Struct {
+		return nil, fmt.Errorf("cannot apply environment overrides on %T, a pointer to a struct is required", cfg)
+	}
+
+	applied := make([]string, 0)
+	err := overrideValue(value.Elem(), prefix, lookupEnv, &applied)
+
+	return applied, err
+}
+
+func overrideValue(value reflect.Value, envName string, lookupEnv lookupEnvFunc, applied *[]string) error {
+	switch value.Kind() {
+	case reflect.Struct:
+		return overrideStruct(value, envName, lookupEnv, applied)
+	case reflect.Map:
+		return overrideMap(value, envName, lookupEnv, applied)
+	case reflect.Slice:
+		if value.Type().Elem().Kind() == reflect.Struct {
+			return overrideSliceOfStructs(value, envName, lookupEnv, applied)
+		}
+	}
+
+	envValue, found := lookupEnv(envName)
+	if !found {
+		return nil
+	}
+
+	err := setValueFromString(value, envValue)
+	if err != nil {
+		return fmt.Errorf("%w while parsing the environment variable %s", err, envName)
+	}
+
+	*applied = append(*applied, envName)
+	return nil
+}
+
+func overrideStruct(value reflect.Value, envName string, lookupEnv lookupEnvFunc, applied *[]string) error {
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Type().Field(i)
+		tag := strings.Split(field.Tag.Get(tomlTagName), ",")[0]
+		if tag == "" || tag == "-" || !field.IsExported() {
+			continue
+		}
+
+		err := overrideValue(value.Field(i), joinEnvName(envName, tag), lookupEnv, applied)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func overrideMap(value reflect.Value, envName string, lookupEnv lookupEnvFunc, applied *[]string) error {
+	if value.Type().Key().Kind() != reflect.String {
+		return nil
+	}
+
+	for _, key := range value.MapKeys() {
+		// map entries are not addressable, so the entry is copied, updated and stored back
+		entry := reflect.New(value.Type().Elem()).Elem()
+		entry.Set(value.MapIndex(key))
+
+		err := overrideValue(entry, joinEnvName(envName, key.String()), lookupEnv, applied)
+		if err != nil {
+			return err
+		}
+
+		value.SetMapIndex(key, entry)
+	}
+
+	return nil
+}
+
+func overrideSliceOfStructs(value reflect.Value, envName string, lookupEnv lookupEnvFunc, applied *[]string) error {
+	for i := 0; i < value.Len(); i++ {
+		err := overrideValue(value.Index(i), joinEnvName(envName, strconv.Itoa(i)), lookupEnv, applied)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func setValueFromString(value reflect.Value, str string) error {
+	switch value.Kind() {
+	case reflect.String:
+		value.SetString(str)
+	case reflect.Bool:
+		parsed, err := strconv.ParseBool(str)
+		if err != nil {
+			return err
+		}
+		value.SetBool(parsed)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		parsed, err := strconv.ParseInt(str, 10, value.Type().Bits())
+		if err != nil {
+			return err
+		}
+		value.SetInt(parsed)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		parsed, err := strconv.ParseUint(str, 10, value.Type().Bits())
+		if err != nil {
+			return err
+		}
+		value.SetUint(parsed)
+	case reflect.Float32, reflect.Float64:
+		parsed, err := strconv.ParseFloat(str, value.Type().Bits())
+		if err != nil {
+			return err
+		}
+		value.SetFloat(parsed)
+	case reflect.Slice:
+		return setSliceFromString(value, str)
+	default:
+		return fmt.Errorf("unsupported type %s", value.Type())
+	}
+
+	return nil
+}
+
+func setSliceFromString(value reflect.Value, str string) error {
+	items := make([]string, 0)
+	for _, item := range strings.Split(str, sliceSeparator) {
+		item = strings.TrimSpace(item)
+		if item != "" {
+			items = append(items, item)
+		}
+	}
+
+	slice := reflect.MakeSlice(value.Type(), len(items), len(items))
+	for i, item := range items {
+		err := setValueFromString(slice.Index(i), item)
+		if err != nil {
+			return err
+		}
+	}
+	value.Set(slice)
+
+	return nil
+}
+
+func joinEnvName(envName string, key string) string {
+	replacer := strings.NewReplacer("-", envSeparator, ".", envSeparator, "/", envSeparator)
+
+	return envName + envSeparator + strings.ToUpper(replacer.Replace(key))
+}
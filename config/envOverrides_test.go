@@ -0,0 +1,85 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func createLookupEnv(values map[string]string) lookupEnvFunc {
+	return func(key string) (string, bool) {
+		value, found := values[key]
+		return value, found
+	}
+}
+
+func TestApplyEnvOverrides_InvalidConfig(t *testing.T) {
+	t.Parallel()
+
+	cfg := ClusterConfig{}
+	_, err := ApplyEnvOverrides(cfg, EnvPrefixPrefsConfig)
+	require.Error(t, err)
+}
+
+func TestApplyEnvOverrides_NestedFields(t *testing.T) {
+	t.Parallel()
+
+	cfg := &ClusterConfig{}
+	cfg.Config.ElasticCluster.URL = "http://localhost:9200"
+	cfg.Config.ElasticCluster.Password = "from-file"
+
+	applied, err := applyEnvOverrides(cfg, EnvPrefixPrefsConfig, createLookupEnv(map[string]string{
+		"ELASTICINDEXER_PREFS_CONFIG_ELASTIC_CLUSTER_PASSWORD":                       "secret",
+		"ELASTICINDEXER_PREFS_CONFIG_ELASTIC_CLUSTER_USE_KIBANA":                     "true",
+		"ELASTICINDEXER_PREFS_CONFIG_ELASTIC_CLUSTER_BULK_REQUEST_MAX_SIZE_IN_BYTES": "1024",
+		"ELASTICINDEXER_PREFS_CONFIG_WEB_SOCKET_RETRY_DURATION_IN_SECONDS":           "7",
+		"ELASTICINDEXER_PREFS_CONFIG_DISABLED_INDICES":                               "logs, events,",
+		"ELASTICINDEXER_CONFIG_CONFIG_ESDT_PREFIX":                                   "ignored",
+	}))
+	require.Nil(t, err)
+	require.Len(t, applied, 5)
+	require.Equal(t, "http://localhost:9200", cfg.Config.ElasticCluster.URL)
+	require.Equal(t, "secret", cfg.Config.ElasticCluster.Password)
+	require.True(t, cfg.Config.ElasticCluster.UseKibana)
+	require.Equal(t, 1024, cfg.Config.ElasticCluster.BulkRequestMaxSizeInBytes)
+	require.Equal(t, uint32(7), cfg.Config.WebSocket.RetryDurationInSec)
+	require.Equal(t, []string{"logs", "events"}, cfg.Config.DisabledIndices)
+}
+
+func TestApplyEnvOverrides_MapsAndSlicesOfTables(t *testing.T) {
+	t.Parallel()
+
+	cfg := &ApiRoutesConfig{
+		APIPackages: map[string]APIPackageConfig{
+			"admin": {
+				Auth: AuthConfig{
+					Type:  "basic",
+					Users: []BasicAuthUser{{Username: "admin", Password: "from-file"}},
+				},
+			},
+		},
+	}
+
+	applied, err := applyEnvOverrides(cfg, EnvPrefixApiConfig, createLookupEnv(map[string]string{
+		"ELASTICINDEXER_API_API_PACKAGES_ADMIN_AUTH_USERS_0_PASSWORD": "secret",
+		"ELASTICINDEXER_API_API_PACKAGES_ADMIN_AUTH_USERS_1_PASSWORD": "not defined in file",
+		"ELASTICINDEXER_API_RATE_LIMIT_REQUESTS_PER_SECOND":           "2.5",
+	}))
+	require.Nil(t, err)
+	require.Equal(t, []string{
+		"ELASTICINDEXER_API_RATE_LIMIT_REQUESTS_PER_SECOND",
+		"ELASTICINDEXER_API_API_PACKAGES_ADMIN_AUTH_USERS_0_PASSWORD",
+	}, applied)
+	require.Equal(t, 2.5, cfg.RateLimit.RequestsPerSecond)
+	require.Equal(t, []BasicAuthUser{{Username: "admin", Password: "secret"}}, cfg.APIPackages["admin"].Auth.Users)
+}
+
+func TestApplyEnvOverrides_InvalidValue(t *testing.T) {
+	t.Parallel()
+
+	cfg := &ClusterConfig{}
+	_, err := applyEnvOverrides(cfg, EnvPrefixPrefsConfig, createLookupEnv(map[string]string{
+		"ELASTICINDEXER_PREFS_CONFIG_WEB_SOCKET_WITH_ACKNOWLEDGE": "maybe",
+	}))
+	require.ErrorContains(t, err, "ELASTICINDEXER_PREFS_CONFIG_WEB_SOCKET_WITH_ACKNOWLEDGE")
+}
@@ -7,3 +7,9 @@ var ErrNilMetricsHandler = errors.New("nil metrics handler")
 
 // ErrNilFacadeHandler signal that a nil facade handler has been provided
 var ErrNilFacadeHandler = errors.New("nil facade handler")
+
+// ErrNilStreamBroadcaster signals that a nil stream broadcaster has been provided
+var ErrNilStreamBroadcaster = errors.New("nil stream broadcaster")
+
+// ErrNilIndexerAdminHandler signals that a nil indexer admin handler has been provided
+var ErrNilIndexerAdminHandler = errors.New("nil indexer admin handler")
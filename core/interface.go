@@ -2,12 +2,17 @@ package core
 
 import (
 	"github.com/multiversx/mx-chain-es-indexer-go/core/request"
+	"github.com/multiversx/mx-chain-es-indexer-go/data"
 	"github.com/multiversx/mx-chain-es-indexer-go/metrics"
+	"github.com/multiversx/mx-chain-es-indexer-go/stream"
 )
 
 // StatusMetricsHandler defines the behavior of a component that handles status metrics
 type StatusMetricsHandler interface {
 	AddIndexingData(args metrics.ArgsAddIndexingData)
+	AddIndexedDocuments(index string, count uint64)
+	SetLastIndexedBlock(args metrics.ArgsLastIndexedBlock)
+	AddABIDecodingFailures(kind string, count uint64)
 	GetMetrics() map[string]*request.MetricsResponse
 	GetMetricsForPrometheus() string
 	IsInterfaceNil() bool
@@ -18,3 +23,28 @@ type WebServerHandler interface {
 	StartHttpServer() error
 	Close() error
 }
+
+// StreamBroadcasterHandler defines the behavior of a component that pushes the indexed entities to the live stream subscribers
+type StreamBroadcasterHandler interface {
+	Publish(events []*data.StreamEvent)
+	Subscribe(filter stream.Filter) (*stream.Subscription, error)
+	Unsubscribe(id uint64)
+	Close()
+	IsInterfaceNil() bool
+}
+
+// IndexerAdminHandler defines the behavior of a component that allows runtime administration of the indexer
+type IndexerAdminHandler interface {
+	Pause()
+	Resume()
+	IsPaused() bool
+	EnableIndex(index string) error
+	DisableIndex(index string) error
+	GetEnabledIndexes() []string
+	UpdateSettings(settings data.RuntimeSettings)
+	GetStuckTransactions(maxAgeInSec uint64) ([]*data.StuckTransaction, error)
+	GetTokenRolesAtTimestamp(token string, timestamp uint64) (map[string][]string, error)
+	SyncMainChainTokens() (*data.TokensSyncReport, error)
+	GetMainChainTokensSyncReport() *data.TokensSyncReport
+	IsInterfaceNil() bool
+}
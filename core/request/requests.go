@@ -36,6 +36,22 @@ type MetricsResponse struct {
 	TotalIndexingTime time.Duration  `json:"total_time"`
 }
 
+// ConfigResponse defines the response for the admin config endpoint
+type ConfigResponse struct {
+	Paused             bool     `json:"paused"`
+	EnabledIndices     []string `json:"enabled_indices"`
+	AvailableIndices   []string `json:"available_indices"`
+	Sovereign          bool     `json:"sovereign"`
+	ElasticURL         string   `json:"elastic_url"`
+	UseKibana          bool     `json:"use_kibana"`
+	BulkRequestMaxSize int      `json:"bulk_request_max_size"`
+	WebSocketURL       string   `json:"web_socket_url"`
+	WebSocketMode      string   `json:"web_socket_mode"`
+	MainChainEnabled   bool     `json:"main_chain_enabled"`
+	MainChainURL       string   `json:"main_chain_url,omitempty"`
+	TokensSource       string   `json:"tokens_source,omitempty"`
+}
+
 // ExtendTopicWithShardID will concatenate topic with shardID
 func ExtendTopicWithShardID(topic string, shardID uint32) string {
 	return topic + separator + fmt.Sprintf("%d", shardID)
@@ -8,30 +8,32 @@ import (
 
 // AccountInfo holds (serializable) data about an account
 type AccountInfo struct {
-	Address             string         `json:"address,omitempty"`
-	Nonce               uint64         `json:"nonce,omitempty"`
-	Balance             string         `json:"balance"`
-	BalanceNum          float64        `json:"balanceNum"`
-	TokenName           string         `json:"token,omitempty"`
-	TokenIdentifier     string         `json:"identifier,omitempty"`
-	TokenNonce          uint64         `json:"tokenNonce,omitempty"`
-	Properties          string         `json:"properties,omitempty"`
-	Frozen              bool           `json:"frozen,omitempty"`
-	Owner               string         `json:"owner,omitempty"`
-	UserName            string         `json:"userName,omitempty"`
-	DeveloperRewards    string         `json:"developerRewards,omitempty"`
-	DeveloperRewardsNum float64        `json:"developerRewardsNum,omitempty"`
-	Data                *TokenMetaData `json:"data,omitempty"`
-	Timestamp           time.Duration  `json:"timestamp,omitempty"`
-	Type                string         `json:"type,omitempty"`
-	CurrentOwner        string         `json:"currentOwner,omitempty"`
-	ShardID             uint32         `json:"shardID"`
-	RootHash            []byte         `json:"rootHash,omitempty"`
-	CodeHash            []byte         `json:"codeHash,omitempty"`
-	CodeMetadata        []byte         `json:"codeMetadata,omitempty"`
-	IsSender            bool           `json:"-"`
-	IsSmartContract     bool           `json:"-"`
-	IsNFTCreate         bool           `json:"-"`
+	Address             string          `json:"address,omitempty"`
+	AddressHex          string          `json:"addressHex,omitempty"`
+	Nonce               uint64          `json:"nonce,omitempty"`
+	Balance             string          `json:"balance"`
+	BalanceNum          float64         `json:"balanceNum"`
+	TokenName           string          `json:"token,omitempty"`
+	TokenIdentifier     string          `json:"identifier,omitempty"`
+	TokenNonce          uint64          `json:"tokenNonce,omitempty"`
+	Properties          string          `json:"properties,omitempty"`
+	Frozen              bool            `json:"frozen,omitempty"`
+	Owner               string          `json:"owner,omitempty"`
+	UserName            string          `json:"userName,omitempty"`
+	DeveloperRewards    string          `json:"developerRewards,omitempty"`
+	DeveloperRewardsNum float64         `json:"developerRewardsNum,omitempty"`
+	Data                *TokenMetaData  `json:"data,omitempty"`
+	Attributes          []*NFTAttribute `json:"attributes,omitempty"`
+	Timestamp           time.Duration   `json:"timestamp,omitempty"`
+	Type                string          `json:"type,omitempty"`
+	CurrentOwner        string          `json:"currentOwner,omitempty"`
+	ShardID             uint32          `json:"shardID"`
+	RootHash            []byte          `json:"rootHash,omitempty"`
+	CodeHash            []byte          `json:"codeHash,omitempty"`
+	CodeMetadata        []byte          `json:"codeMetadata,omitempty"`
+	IsSender            bool            `json:"-"`
+	IsSmartContract     bool            `json:"-"`
+	IsNFTCreate         bool            `json:"-"`
 }
 
 // TokenMetaData holds data about a token metadata
@@ -76,3 +78,17 @@ type AccountESDT struct {
 	IsNFTOperation  bool
 	IsNFTCreate     bool
 }
+
+// ResponseAccountsCodeHashes is the structure for the accounts code hashes response
+type ResponseAccountsCodeHashes struct {
+	Docs []ResponseAccountCodeHashDB `json:"docs"`
+}
+
+// ResponseAccountCodeHashDB is the structure for the account code hash response
+type ResponseAccountCodeHashDB struct {
+	Found  bool   `json:"found"`
+	ID     string `json:"_id"`
+	Source struct {
+		CodeHash []byte `json:"codeHash"`
+	} `json:"_source"`
+}
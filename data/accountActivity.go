@@ -0,0 +1,54 @@
+package data
+
+// AccountActivity holds the changes of the activity counters of an address in a block. It is also the document of the
+// accounts activity index, which keeps the changes of the blocks in order to subtract them in case of revert
+type AccountActivity struct {
+	Address     string `json:"address"`
+	TxsSent     uint64 `json:"txsSent"`
+	TxsReceived uint64 `json:"txsReceived"`
+	ScrsCount   uint64 `json:"scrsCount"`
+	TokensCount int64  `json:"tokensCount"`
+	ShardID     uint32 `json:"shardID"`
+	Epoch       uint32 `json:"epoch"`
+	Timestamp   uint64 `json:"timestamp"`
+	// Tokens holds, for every token changed in the block, whether the address still holds it. The tokens count is
+	// computed against the ESDT accounts documents as they were before the block
+	Tokens map[string]bool `json:"-"`
+}
+
+// ResponseAccountsActivity is the structure for the accounts activity response
+type ResponseAccountsActivity struct {
+	Docs []ResponseAccountActivityDB `json:"docs"`
+}
+
+// ResponseAccountActivityDB is the structure for the account activity response
+type ResponseAccountActivityDB struct {
+	Found  bool            `json:"found"`
+	ID     string          `json:"_id"`
+	Source AccountActivity `json:"_source"`
+}
+
+// ResponseAccountsESDT is the structure for the ESDT accounts response
+type ResponseAccountsESDT struct {
+	Docs []ResponseAccountESDTDB `json:"docs"`
+}
+
+// ResponseAccountESDTDB is the structure for the ESDT account response
+type ResponseAccountESDTDB struct {
+	Found bool   `json:"found"`
+	ID    string `json:"_id"`
+}
+
+// ResponseLastActive is the structure for the response of the aggregation of the last activity of the accounts
+type ResponseLastActive struct {
+	Aggregations struct {
+		Accounts struct {
+			Buckets []struct {
+				Key        string `json:"key"`
+				LastActive struct {
+					Value float64 `json:"value"`
+				} `json:"lastActive"`
+			} `json:"buckets"`
+		} `json:"accounts"`
+	} `json:"aggregations"`
+}
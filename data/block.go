@@ -47,6 +47,23 @@ type Block struct {
 	SoftwareVersion       string                 `json:"softwareVersion,omitempty"`
 	ReceiptsHash          string                 `json:"receiptsHash,omitempty"`
 	Reserved              []byte                 `json:"reserved,omitempty"`
+	NotarizedByMetaHash   string                 `json:"notarizedByMetaHash,omitempty"`
+	NotarizedAtRound      uint64                 `json:"notarizedAtRound,omitempty"`
+	Notarized             bool                   `json:"notarized,omitempty"`
+	Finalized             bool                   `json:"finalized,omitempty"`
+	CrossShardTxsHashes   []string               `json:"crossShardTxsHashes,omitempty"`
+}
+
+// ResponseBlocks is the structure for the blocks response
+type ResponseBlocks struct {
+	Docs []ResponseBlockDB `json:"docs"`
+}
+
+// ResponseBlockDB is the structure for the block response
+type ResponseBlockDB struct {
+	Found  bool   `json:"found"`
+	ID     string `json:"_id"`
+	Source Block  `json:"_source"`
 }
 
 // MiniBlocksDetails is a structure that hold information about mini-blocks execution details
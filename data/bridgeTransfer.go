@@ -0,0 +1,35 @@
+package data
+
+import "time"
+
+const (
+	// BridgeIncomingDirection is the direction of a transfer deposited on the main chain and received by the sovereign chain
+	BridgeIncomingDirection = "incoming"
+	// BridgeOutgoingDirection is the direction of a transfer withdrawn from the sovereign chain to the main chain
+	BridgeOutgoingDirection = "outgoing"
+
+	// BridgeStatusPending is the status of a withdrawal that was not yet found executed on the main chain
+	BridgeStatusPending = "pending"
+	// BridgeStatusExecuted is the status of a bridge transfer that reached the receiver
+	BridgeStatusExecuted = "executed"
+	// BridgeStatusFailed is the status of a deposit whose execution failed on the sovereign chain
+	BridgeStatusFailed = "failed"
+)
+
+// BridgeTransfer is a structure that is needed to store a cross-chain transfer between a sovereign chain and the main chain
+type BridgeTransfer struct {
+	ID              string        `json:"-"`
+	Direction       string        `json:"direction"`
+	Nonce           uint64        `json:"nonce"`
+	OpHash          string        `json:"opHash,omitempty"`
+	Sender          string        `json:"sender,omitempty"`
+	Receiver        string        `json:"receiver"`
+	Tokens          []string      `json:"tokens,omitempty"`
+	ESDTValues      []string      `json:"esdtValues,omitempty"`
+	SovereignTxHash string        `json:"sovereignTxHash"`
+	MainChainTxHash string        `json:"mainChainTxHash,omitempty"`
+	Status          string        `json:"status"`
+	Matched         bool          `json:"matched"`
+	ShardID         uint32        `json:"shardID"`
+	Timestamp       time.Duration `json:"timestamp"`
+}
@@ -0,0 +1,54 @@
+package data
+
+// ContractStats holds the identity fields of the daily usage statistics document of a smart contract
+type ContractStats struct {
+	Contract string  `json:"contract"`
+	Day      string  `json:"day"`
+	ShardID  uint32  `json:"shardID"`
+	Value    string  `json:"value"`
+	ValueNum float64 `json:"valueNum"`
+}
+
+// ContractCaller holds an address that called a smart contract in a day
+type ContractCaller struct {
+	Contract  string `json:"contract"`
+	Day       string `json:"day"`
+	Caller    string `json:"caller"`
+	ShardID   uint32 `json:"shardID"`
+	Timestamp uint64 `json:"timestamp"`
+}
+
+// ContractStatsChange holds the changes of the daily usage statistics of a smart contract in a block
+type ContractStatsChange struct {
+	ID            string           `json:"-"`
+	Contract      string           `json:"-"`
+	Day           string           `json:"-"`
+	Callers       []string         `json:"-"`
+	CallsCount    uint64           `json:"callsCount"`
+	FailedCalls   uint64           `json:"failedCalls"`
+	UniqueCallers uint64           `json:"uniqueCallers"`
+	GasUsed       uint64           `json:"gasUsed"`
+	Value         string           `json:"value"`
+	ValueNum      float64          `json:"valueNum"`
+	Functions     []*FunctionCalls `json:"functions"`
+	ShardID       uint32           `json:"shardID"`
+	Timestamp     uint64           `json:"timestamp"`
+}
+
+// FunctionCalls holds the number of calls of a smart contract function
+type FunctionCalls struct {
+	Function string `json:"function"`
+	Calls    uint64 `json:"calls"`
+}
+
+// ResponseContractCallers is the structure for the contract callers response
+type ResponseContractCallers struct {
+	Docs []ResponseContractCallerDB `json:"docs"`
+}
+
+// ResponseContractCallerDB is the structure for the contract caller response
+type ResponseContractCallerDB struct {
+	Found  bool           `json:"found"`
+	ID     string         `json:"_id"`
+	Source ContractCaller `json:"_source"`
+}
@@ -4,18 +4,29 @@ import "time"
 
 // LogEvent is the dto for the log event structure
 type LogEvent struct {
-	UUID           string        `json:"uuid"`
-	ID             string        `json:"-"`
-	TxHash         string        `json:"txHash"`
-	OriginalTxHash string        `json:"originalTxHash,omitempty"`
-	LogAddress     string        `json:"logAddress"`
-	Address        string        `json:"address"`
-	Identifier     string        `json:"identifier"`
-	Data           string        `json:"data,omitempty"`
-	AdditionalData []string      `json:"additionalData,omitempty"`
-	Topics         []string      `json:"topics"`
-	Order          int           `json:"order"`
-	TxOrder        int           `json:"txOrder"`
-	ShardID        uint32        `json:"shardID"`
-	Timestamp      time.Duration `json:"timestamp,omitempty"`
+	UUID           string               `json:"uuid"`
+	ID             string               `json:"-"`
+	TxHash         string               `json:"txHash"`
+	OriginalTxHash string               `json:"originalTxHash,omitempty"`
+	LogAddress     string               `json:"logAddress"`
+	Address        string               `json:"address"`
+	Identifier     string               `json:"identifier"`
+	Data           string               `json:"data,omitempty"`
+	AdditionalData []string             `json:"additionalData,omitempty"`
+	Topics         []string             `json:"topics"`
+	Decoded        []*DecodedEventField `json:"decoded,omitempty"`
+	Order          int                  `json:"order"`
+	TxOrder        int                  `json:"txOrder"`
+	ShardID        uint32               `json:"shardID"`
+	Timestamp      time.Duration        `json:"timestamp,omitempty"`
+}
+
+// DecodedEventField holds a field of an event, decoded based on the contract ABI. The numeric and the boolean values are
+// also kept typed, so that they can be filtered by range or by value
+type DecodedEventField struct {
+	Name      string   `json:"name"`
+	Type      string   `json:"type"`
+	Value     string   `json:"value"`
+	ValueNum  *float64 `json:"valueNum,omitempty"`
+	ValueBool *bool    `json:"valueBool,omitempty"`
 }
@@ -19,25 +19,31 @@ type Logs struct {
 
 // Event holds all the fields needed for an event structure
 type Event struct {
-	Address        string   `json:"address"`
-	Identifier     string   `json:"identifier"`
-	Topics         [][]byte `json:"topics"`
-	Data           []byte   `json:"data"`
-	AdditionalData [][]byte `json:"additionalData,omitempty"`
-	Order          int      `json:"order"`
+	Address        string               `json:"address"`
+	Identifier     string               `json:"identifier"`
+	Topics         [][]byte             `json:"topics"`
+	Data           []byte               `json:"data"`
+	AdditionalData [][]byte             `json:"additionalData,omitempty"`
+	Order          int                  `json:"order"`
+	Decoded        []*DecodedEventField `json:"decoded,omitempty"`
 }
 
 // PreparedLogsResults is the DTO that holds all the results after processing
 type PreparedLogsResults struct {
 	Tokens                  TokensHandler
 	TokensSupply            TokensHandler
+	TokensSupplyChanges     []*TokenSupplyChange
 	ScDeploys               map[string]*ScDeployInfo
 	ChangeOwnerOperations   map[string]*OwnerData
 	Delegators              map[string]*Delegator
+	DelegatorsOperations    []*Delegator
+	ProvidersChanges        []*ProviderChange
+	StakedKeys              map[string]*StakedKey
 	TxHashStatusInfo        map[string]*outport.StatusInfo
 	TokensInfo              []*TokenInfo
 	NFTsDataUpdates         []*NFTDataUpdate
 	TokenRolesAndProperties *tokeninfo.TokenRolesAndProperties
+	TokenRolesHistory       []*TokenRole
 	DBLogs                  []*Logs
 	DBEvents                []*LogEvent
 }
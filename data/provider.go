@@ -0,0 +1,42 @@
+package data
+
+import "time"
+
+// Provider holds the fields that are set when the summary document of a delegation contract is created
+type Provider struct {
+	Contract          string  `json:"contract"`
+	NumDelegators     uint64  `json:"numDelegators"`
+	RewardsClaimed    string  `json:"rewardsClaimed"`
+	RewardsClaimedNum float64 `json:"rewardsClaimedNum"`
+	ClaimsCount       uint64  `json:"claimsCount"`
+}
+
+// ProviderChange holds the changes of a delegation contract summary in a block
+type ProviderChange struct {
+	Contract             string                 `json:"-"`
+	HasStakeInfo         bool                   `json:"hasStakeInfo"`
+	TotalActiveStake     string                 `json:"totalActiveStake"`
+	TotalActiveStakeNum  float64                `json:"totalActiveStakeNum"`
+	NumDelegators        uint64                 `json:"numDelegators"`
+	DelegatorsDeleted    uint64                 `json:"delegatorsDeleted"`
+	RewardsClaimed       string                 `json:"rewardsClaimed"`
+	RewardsClaimedNum    float64                `json:"rewardsClaimedNum"`
+	ClaimsCount          uint64                 `json:"claimsCount"`
+	ServiceFeeChanges    []*ServiceFeeChange    `json:"serviceFeeChanges"`
+	DelegationCapChanges []*DelegationCapChange `json:"delegationCapChanges"`
+	ShardID              uint32                 `json:"shardID"`
+	Timestamp            time.Duration          `json:"timestamp"`
+}
+
+// ServiceFeeChange holds a change of the service fee of a delegation contract
+type ServiceFeeChange struct {
+	ServiceFee uint64        `json:"serviceFee"`
+	Timestamp  time.Duration `json:"timestamp"`
+}
+
+// DelegationCapChange holds a change of the maximum delegation cap of a delegation contract
+type DelegationCapChange struct {
+	MaxDelegationCap    string        `json:"maxDelegationCap"`
+	MaxDelegationCapNum float64       `json:"maxDelegationCapNum"`
+	Timestamp           time.Duration `json:"timestamp"`
+}
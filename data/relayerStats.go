@@ -0,0 +1,47 @@
+package data
+
+// RelayerStats holds the identity fields of the daily statistics document of a relayer
+type RelayerStats struct {
+	Relayer string  `json:"relayer"`
+	Day     string  `json:"day"`
+	ShardID uint32  `json:"shardID"`
+	Fee     string  `json:"fee"`
+	FeeNum  float64 `json:"feeNum"`
+}
+
+// RelayerUser holds an address whose transactions were relayed by a relayer in a day
+type RelayerUser struct {
+	Relayer   string `json:"relayer"`
+	Day       string `json:"day"`
+	User      string `json:"user"`
+	ShardID   uint32 `json:"shardID"`
+	Timestamp uint64 `json:"timestamp"`
+}
+
+// RelayerStatsChange holds the changes of the daily statistics of a relayer in a block
+type RelayerStatsChange struct {
+	ID                 string   `json:"-"`
+	Relayer            string   `json:"-"`
+	Day                string   `json:"-"`
+	Users              []string `json:"-"`
+	TransactionsCount  uint64   `json:"transactionsCount"`
+	FailedTransactions uint64   `json:"failedTransactions"`
+	UniqueUsers        uint64   `json:"uniqueUsers"`
+	GasUsed            uint64   `json:"gasUsed"`
+	Fee                string   `json:"fee"`
+	FeeNum             float64  `json:"feeNum"`
+	ShardID            uint32   `json:"shardID"`
+	Timestamp          uint64   `json:"timestamp"`
+}
+
+// ResponseRelayerUsers is the structure for the relayer users response
+type ResponseRelayerUsers struct {
+	Docs []ResponseRelayerUserDB `json:"docs"`
+}
+
+// ResponseRelayerUserDB is the structure for the relayer user response
+type ResponseRelayerUserDB struct {
+	Found  bool        `json:"found"`
+	ID     string      `json:"_id"`
+	Source RelayerUser `json:"_source"`
+}
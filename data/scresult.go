@@ -14,6 +14,8 @@ type ScResult struct {
 	ValueNum           float64       `json:"valueNum"`
 	Sender             string        `json:"sender"`
 	Receiver           string        `json:"receiver"`
+	SenderHex          string        `json:"senderHex,omitempty"`
+	ReceiverHex        string        `json:"receiverHex,omitempty"`
 	SenderShard        uint32        `json:"senderShard"`
 	ReceiverShard      uint32        `json:"receiverShard"`
 	RelayerAddr        string        `json:"relayerAddr,omitempty"`
@@ -36,6 +38,7 @@ type ScResult struct {
 	ReceiversShardIDs  []uint32      `json:"receiversShardIDs,omitempty"`
 	Operation          string        `json:"operation,omitempty"`
 	Function           string        `json:"function,omitempty"`
+	DecodedArgs        []*DecodedArg `json:"decodedArgs,omitempty"`
 	IsRelayed          bool          `json:"isRelayed,omitempty"`
 	CanBeIgnored       bool          `json:"canBeIgnored,omitempty"`
 	OriginalSender     string        `json:"originalSender,omitempty"`
@@ -0,0 +1,8 @@
+package data
+
+// RuntimeSettings holds the indexer settings that can be changed without restarting the process
+type RuntimeSettings struct {
+	EnabledIndexes     []string
+	BulkRequestMaxSize int
+	LogLevel           string
+}
@@ -0,0 +1,16 @@
+package data
+
+import "time"
+
+// StakedKey is a structure that is needed to store the ownership and the staking state of a validator BLS key
+type StakedKey struct {
+	BLSKey        string        `json:"blsKey"`
+	Owner         string        `json:"owner,omitempty"`
+	Status        string        `json:"status,omitempty"`
+	TopUp         string        `json:"topUp,omitempty"`
+	TopUpNum      float64       `json:"topUpNum,omitempty"`
+	Delegation    string        `json:"delegation,omitempty"`
+	RewardAddress string        `json:"rewardAddress,omitempty"`
+	ShardID       uint32        `json:"shardID"`
+	Timestamp     time.Duration `json:"timestamp"`
+}
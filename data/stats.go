@@ -0,0 +1,84 @@
+package data
+
+// Stats holds the identity fields of a statistics document of a shard for a day or for an epoch. For a day document
+// the epoch is the one of the first block indexed in that day
+type Stats struct {
+	Type           string  `json:"type"`
+	Day            string  `json:"day,omitempty"`
+	Epoch          uint32  `json:"epoch"`
+	ShardID        uint32  `json:"shardID"`
+	Fees           string  `json:"fees"`
+	FeesNum        float64 `json:"feesNum"`
+	StartTimestamp uint64  `json:"startTimestamp,omitempty"`
+}
+
+// StatsChange holds the changes of the counters of a statistics document in a block
+type StatsChange struct {
+	ID                 string  `json:"-"`
+	Type               string  `json:"-"`
+	Day                string  `json:"-"`
+	Epoch              uint32  `json:"-"`
+	TxsCount           uint64  `json:"txsCount"`
+	ScrsCount          uint64  `json:"scrsCount"`
+	Fees               string  `json:"fees"`
+	FeesNum            float64 `json:"feesNum"`
+	GasUsed            uint64  `json:"gasUsed"`
+	ESDTTransfersCount uint64  `json:"esdtTransfersCount"`
+	ShardID            uint32  `json:"shardID"`
+	Timestamp          uint64  `json:"timestamp"`
+}
+
+// StatsAccountsCounters holds the accounts counters of a statistics document, they are computed when its day or epoch ends
+type StatsAccountsCounters struct {
+	DistinctSenders   uint64 `json:"distinctSenders"`
+	DistinctReceivers uint64 `json:"distinctReceivers"`
+	NewAccounts       uint64 `json:"newAccounts"`
+}
+
+// ResponseStats is the structure for the statistics documents response
+type ResponseStats struct {
+	Docs []ResponseStatsDB `json:"docs"`
+}
+
+// ResponseStatsDB is the structure for the statistics document response
+type ResponseStatsDB struct {
+	Found  bool   `json:"found"`
+	ID     string `json:"_id"`
+	Source Stats  `json:"_source"`
+}
+
+// ResponseDistinctAddresses is the structure for the response of the distinct senders and receivers aggregations
+type ResponseDistinctAddresses struct {
+	Aggregations DistinctAddressesAggregations `json:"aggregations"`
+}
+
+// DistinctAddressesAggregations holds the distinct senders and receivers aggregations
+type DistinctAddressesAggregations struct {
+	Senders   AddressesAggregation `json:"senders"`
+	Receivers AddressesAggregation `json:"receivers"`
+}
+
+// AddressesAggregation holds the number of distinct addresses of a filter aggregation
+type AddressesAggregation struct {
+	Addresses CardinalityAggregation `json:"addresses"`
+}
+
+// CardinalityAggregation holds the value of a cardinality aggregation
+type CardinalityAggregation struct {
+	Value uint64 `json:"value"`
+}
+
+// ResponseHitsCount is the structure for the response of a search request that only counts the matching documents
+type ResponseHitsCount struct {
+	Hits HitsCount `json:"hits"`
+}
+
+// HitsCount holds the total number of the matching documents
+type HitsCount struct {
+	Total TotalHits `json:"total"`
+}
+
+// TotalHits holds the value of the total hits
+type TotalHits struct {
+	Value uint64 `json:"value"`
+}
@@ -0,0 +1,35 @@
+package data
+
+import "time"
+
+const (
+	// StreamEventBlock is the type of the event pushed when a block was indexed
+	StreamEventBlock = "block"
+	// StreamEventTransaction is the type of the event pushed when a transaction was indexed
+	StreamEventTransaction = "transaction"
+	// StreamEventScResult is the type of the event pushed when a smart contract result was indexed
+	StreamEventScResult = "scresult"
+	// StreamEventLogEvent is the type of the event pushed when a log event was indexed
+	StreamEventLogEvent = "event"
+	// StreamEventRevert is the type of the event pushed when a block was reverted
+	StreamEventRevert = "revert"
+)
+
+// StreamEvent is the structure of the events pushed to the live stream subscribers
+type StreamEvent struct {
+	Type        string        `json:"type"`
+	ShardID     uint32        `json:"shardID"`
+	Hash        string        `json:"hash"`
+	Timestamp   time.Duration `json:"timestamp"`
+	Addresses   []string      `json:"addresses,omitempty"`
+	Tokens      []string      `json:"tokens,omitempty"`
+	Identifiers []string      `json:"identifiers,omitempty"`
+	Data        interface{}   `json:"data,omitempty"`
+}
+
+// StreamRevertedBlock holds the details of a reverted block that are pushed to the live stream subscribers
+type StreamRevertedBlock struct {
+	Nonce uint64 `json:"nonce"`
+	Round uint64 `json:"round"`
+	Epoch uint32 `json:"epoch"`
+}
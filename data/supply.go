@@ -0,0 +1,22 @@
+package data
+
+import "time"
+
+// TokenSupplyChange holds the supply changes of a token, or of a token nonce, that were produced in a block. The change is also
+// kept in the token document so that it can be subtracted in case of revert
+type TokenSupplyChange struct {
+	Token            string        `json:"-"`
+	Identifier       string        `json:"-"`
+	Nonce            uint64        `json:"-"`
+	InitialSupply    string        `json:"initialSupply"`
+	InitialSupplyNum float64       `json:"initialSupplyNum"`
+	Minted           string        `json:"minted"`
+	MintedNum        float64       `json:"mintedNum"`
+	Burned           string        `json:"burned"`
+	BurnedNum        float64       `json:"burnedNum"`
+	Wiped            string        `json:"wiped"`
+	WipedNum         float64       `json:"wipedNum"`
+	ShardID          uint32        `json:"shardID"`
+	Timestamp        time.Duration `json:"timestamp"`
+	HasQuantity      bool          `json:"-"`
+}
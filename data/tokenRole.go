@@ -0,0 +1,23 @@
+package data
+
+import "time"
+
+const (
+	// RoleSetAction is the action of a role assigned to an address
+	RoleSetAction = "set"
+	// RoleUnsetAction is the action of a role removed from an address
+	RoleUnsetAction = "unset"
+)
+
+// TokenRole is a structure that is needed to store a role set or unset for an address on a token
+type TokenRole struct {
+	ID        string        `json:"-"`
+	Token     string        `json:"token"`
+	Address   string        `json:"address"`
+	Role      string        `json:"role"`
+	Action    string        `json:"action"`
+	TxHash    string        `json:"txHash"`
+	ShardID   uint32        `json:"shardID"`
+	Order     int           `json:"order"`
+	Timestamp time.Duration `json:"timestamp"`
+}
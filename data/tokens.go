@@ -1,6 +1,7 @@
 package data
 
 import (
+	"encoding/json"
 	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
@@ -25,6 +26,15 @@ type NFTDataUpdate struct {
 	SetURIs       bool
 	NewRoyalties  core.OptionalUint32
 	NewMetaData   *TokenMetaData
+
+	NewDecodedAttributes []*NFTAttribute
+}
+
+// NFTAttribute is a trait decoded from the attributes of an NFT
+type NFTAttribute struct {
+	Key      string   `json:"key"`
+	Value    string   `json:"value"`
+	ValueNum *float64 `json:"valueNum,omitempty"`
 }
 
 // ResponseTokens is the structure for the tokens response
@@ -70,6 +80,7 @@ type TokenInfo struct {
 	Nonce             uint64           `json:"nonce,omitempty"`
 	Timestamp         time.Duration    `json:"timestamp,omitempty"`
 	Data              *TokenMetaData   `json:"data,omitempty"`
+	Attributes        []*NFTAttribute  `json:"attributes,omitempty"`
 	OwnersHistory     []*OwnerData     `json:"ownersHistory,omitempty"`
 	TransferOwnership bool             `json:"-"`
 	ChangeToDynamic   bool             `json:"-"`
@@ -219,3 +230,33 @@ func (ti *tokensInfo) Len() int {
 func (ti *tokensInfo) IsInterfaceNil() bool {
 	return ti == nil
 }
+
+// ResponseRawDocs is the structure for a multi get response whose documents are kept as they are stored
+type ResponseRawDocs struct {
+	Docs []ResponseRawDocDB `json:"docs"`
+}
+
+// ResponseRawDocDB is the structure for a document of a multi get response kept as it is stored
+type ResponseRawDocDB struct {
+	Found  bool                       `json:"found"`
+	ID     string                     `json:"_id"`
+	Source map[string]json.RawMessage `json:"_source"`
+}
+
+// TokenDivergence holds the fields of a cross-chain token that were different from the main chain
+type TokenDivergence struct {
+	Token  string   `json:"token"`
+	Fields []string `json:"fields"`
+}
+
+// TokensSyncReport is a structure containing the result of a synchronization of the cross-chain tokens with the main chain
+type TokensSyncReport struct {
+	StartTimestamp     int64              `json:"startTimestamp"`
+	EndTimestamp       int64              `json:"endTimestamp"`
+	CheckedTokens      int                `json:"checkedTokens"`
+	UpdatedTokens      int                `json:"updatedTokens"`
+	MissingTokens      []string           `json:"missingTokens,omitempty"`
+	Divergences        []*TokenDivergence `json:"divergences,omitempty"`
+	Checkpoint         string             `json:"checkpoint"`
+	FullCycleCompleted bool               `json:"fullCycleCompleted"`
+}
@@ -16,6 +16,8 @@ type Transaction struct {
 	ValueNum             float64       `json:"valueNum"`
 	Receiver             string        `json:"receiver"`
 	Sender               string        `json:"sender"`
+	ReceiverHex          string        `json:"receiverHex,omitempty"`
+	SenderHex            string        `json:"senderHex,omitempty"`
 	ReceiverShard        uint32        `json:"receiverShard"`
 	SenderShard          uint32        `json:"senderShard"`
 	GasPrice             uint64        `json:"gasPrice"`
@@ -43,6 +45,7 @@ type Transaction struct {
 	Type                 string        `json:"type,omitempty"`
 	Operation            string        `json:"operation,omitempty"`
 	Function             string        `json:"function,omitempty"`
+	DecodedArgs          []*DecodedArg `json:"decodedArgs,omitempty"`
 	IsRelayed            bool          `json:"isRelayed,omitempty"`
 	Version              uint32        `json:"version,omitempty"`
 	GuardianAddress      string        `json:"guardian,omitempty"`
@@ -51,14 +54,48 @@ type Transaction struct {
 	CompletedEvent       bool          `json:"completedEvent,omitempty"`
 	RelayedAddr          string        `json:"relayer,omitempty"`
 	RelayedSignature     string        `json:"relayerSignature,omitempty"`
+	RelayerShard         *uint32       `json:"relayerShard,omitempty"`
+	RelayerFee           string        `json:"relayerFee,omitempty"`
+	RelayerFeeNum        float64       `json:"relayerFeeNum,omitempty"`
 	HadRefund            bool          `json:"hadRefund,omitempty"`
 	Epoch                uint32        `json:"epoch"`
+	Lifecycle            *TxLifecycle  `json:"lifecycle,omitempty"`
 	ExecutionOrder       int           `json:"-"`
 	SmartContractResults []*ScResult   `json:"-"`
 	Hash                 string        `json:"-"`
 	BlockHash            string        `json:"-"`
 }
 
+// DecodedArg holds an argument of a smart contract call, decoded based on the contract ABI
+type DecodedArg struct {
+	Name  string `json:"name"`
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+// TxLifecycle holds the execution details of both legs of a cross-shard transaction
+type TxLifecycle struct {
+	SourceBlockHash               string        `json:"sourceBlockHash,omitempty"`
+	SourceTimestamp               time.Duration `json:"sourceTimestamp,omitempty"`
+	SourceNotarizedBlockHash      string        `json:"sourceNotarizedBlockHash,omitempty"`
+	DestinationBlockHash          string        `json:"destinationBlockHash,omitempty"`
+	DestinationTimestamp          time.Duration `json:"destinationTimestamp,omitempty"`
+	DestinationNotarizedBlockHash string        `json:"destinationNotarizedBlockHash,omitempty"`
+	CompletionLatency             uint64        `json:"completionLatency,omitempty"`
+}
+
+// StuckTransaction is a structure containing the details of a cross-shard transaction that is pending for too long
+type StuckTransaction struct {
+	Hash          string        `json:"hash"`
+	Sender        string        `json:"sender"`
+	Receiver      string        `json:"receiver"`
+	SenderShard   uint32        `json:"senderShard"`
+	ReceiverShard uint32        `json:"receiverShard"`
+	Timestamp     time.Duration `json:"timestamp"`
+	PendingFor    uint64        `json:"pendingFor"`
+	Lifecycle     *TxLifecycle  `json:"lifecycle,omitempty"`
+}
+
 // Receipt is a structure containing all the fields that need to be safe for a Receipt
 type Receipt struct {
 	Hash      string        `json:"-"`
@@ -0,0 +1,26 @@
+package data
+
+import "time"
+
+// Transfer holds all the fields needed for a value transfer leg structure
+type Transfer struct {
+	UUID               string        `json:"uuid"`
+	ID                 string        `json:"-"`
+	TxHash             string        `json:"txHash"`
+	ScrHash            string        `json:"scrHash,omitempty"`
+	Sender             string        `json:"sender"`
+	Receiver           string        `json:"receiver"`
+	SenderShard        uint32        `json:"senderShard"`
+	ReceiverShard      uint32        `json:"receiverShard"`
+	Token              string        `json:"token"`
+	Identifier         string        `json:"identifier,omitempty"`
+	Nonce              uint64        `json:"nonce,omitempty"`
+	Amount             string        `json:"amount"`
+	AmountNum          float64       `json:"amountNum"`
+	Direction          string        `json:"direction"`
+	Status             string        `json:"status"`
+	ShardID            uint32        `json:"shardID"`
+	Timestamp          time.Duration `json:"timestamp"`
+	CompletedTimestamp time.Duration `json:"completedTimestamp,omitempty"`
+	IsIncoming         bool          `json:"-"`
+}
@@ -0,0 +1,17 @@
+package data
+
+import "time"
+
+// Undelegation is a structure that is needed to store an unDelegate fund of a delegator until it is withdrawn
+type Undelegation struct {
+	ID          string        `json:"-"`
+	Delegator   string        `json:"delegator"`
+	Contract    string        `json:"contract"`
+	FundID      string        `json:"fundID"`
+	Value       string        `json:"value"`
+	ValueNum    float64       `json:"valueNum"`
+	Status      string        `json:"status"`
+	Epoch       uint32        `json:"epoch"`
+	UnlockEpoch uint32        `json:"unlockEpoch"`
+	Timestamp   time.Duration `json:"timestamp"`
+}
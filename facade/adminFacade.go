@@ -0,0 +1,109 @@
+package facade
+
+import (
+	"net/url"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-es-indexer-go/config"
+	"github.com/multiversx/mx-chain-es-indexer-go/core"
+	"github.com/multiversx/mx-chain-es-indexer-go/core/request"
+	"github.com/multiversx/mx-chain-es-indexer-go/data"
+)
+
+// ArgsAdminFacade holds the arguments needed for creating a new admin facade
+type ArgsAdminFacade struct {
+	Indexer       core.IndexerAdminHandler
+	Config        config.Config
+	ClusterConfig config.ClusterConfig
+}
+
+type adminFacade struct {
+	indexer       core.IndexerAdminHandler
+	config        config.Config
+	clusterConfig config.ClusterConfig
+}
+
+// NewAdminFacade will create a new instance of adminFacade
+func NewAdminFacade(args ArgsAdminFacade) (*adminFacade, error) {
+	if check.IfNil(args.Indexer) {
+		return nil, core.ErrNilIndexerAdminHandler
+	}
+
+	return &adminFacade{
+		indexer:       args.Indexer,
+		config:        args.Config,
+		clusterConfig: args.ClusterConfig,
+	}, nil
+}
+
+// Pause will pause the indexing of the received data
+func (af *adminFacade) Pause() {
+	af.indexer.Pause()
+}
+
+// Resume will resume the indexing of the received data
+func (af *adminFacade) Resume() {
+	af.indexer.Resume()
+}
+
+// EnableIndex will enable writing in the provided index
+func (af *adminFacade) EnableIndex(index string) error {
+	return af.indexer.EnableIndex(index)
+}
+
+// DisableIndex will disable writing in the provided index
+func (af *adminFacade) DisableIndex(index string) error {
+	return af.indexer.DisableIndex(index)
+}
+
+// GetConfig returns the current configuration of the indexer, without any credentials
+func (af *adminFacade) GetConfig() request.ConfigResponse {
+	clusterCfg := af.clusterConfig.Config
+	response := request.ConfigResponse{
+		Paused:             af.indexer.IsPaused(),
+		EnabledIndices:     af.indexer.GetEnabledIndexes(),
+		AvailableIndices:   af.config.Config.AvailableIndices,
+		Sovereign:          af.config.Sovereign,
+		ElasticURL:         redactURL(clusterCfg.ElasticCluster.URL),
+		UseKibana:          clusterCfg.ElasticCluster.UseKibana,
+		BulkRequestMaxSize: clusterCfg.ElasticCluster.BulkRequestMaxSizeInBytes,
+		WebSocketURL:       clusterCfg.WebSocket.URL,
+		WebSocketMode:      clusterCfg.WebSocket.Mode,
+		MainChainEnabled:   clusterCfg.MainChainCluster.Enabled,
+	}
+	if response.MainChainEnabled {
+		response.MainChainURL = redactURL(clusterCfg.MainChainCluster.URL)
+	}
+	if af.config.Sovereign {
+		response.TokensSource = clusterCfg.MainChainTokensSource.Type
+	}
+
+	return response
+}
+
+// SyncMainChainTokens will refresh the cross-chain tokens from the main chain and returns the synchronization report
+func (af *adminFacade) SyncMainChainTokens() (*data.TokensSyncReport, error) {
+	return af.indexer.SyncMainChainTokens()
+}
+
+// GetMainChainTokensSyncReport returns the report of the last synchronization of the cross-chain tokens
+func (af *adminFacade) GetMainChainTokensSyncReport() *data.TokensSyncReport {
+	return af.indexer.GetMainChainTokensSyncReport()
+}
+
+// redactURL removes the user info that might be embedded in the provided URL
+func redactURL(rawURL string) string {
+	parsedURL, err := url.Parse(rawURL)
+	if err != nil {
+		return ""
+	}
+
+	parsedURL.User = nil
+
+	return parsedURL.String()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (af *adminFacade) IsInterfaceNil() bool {
+	return af == nil
+}
@@ -0,0 +1,58 @@
+package facade
+
+import (
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-es-indexer-go/config"
+	"github.com/multiversx/mx-chain-es-indexer-go/core"
+	"github.com/multiversx/mx-chain-es-indexer-go/data"
+)
+
+// ArgsReadFacade holds the arguments needed for creating a new read facade
+type ArgsReadFacade struct {
+	Indexer core.IndexerAdminHandler
+	Config  config.Config
+}
+
+type readFacade struct {
+	indexer core.IndexerAdminHandler
+	config  config.Config
+}
+
+// NewReadFacade will create a new instance of readFacade
+func NewReadFacade(args ArgsReadFacade) (*readFacade, error) {
+	if check.IfNil(args.Indexer) {
+		return nil, core.ErrNilIndexerAdminHandler
+	}
+
+	return &readFacade{
+		indexer: args.Indexer,
+		config:  args.Config,
+	}, nil
+}
+
+// GetStuckPendingAgeInSec returns the configured age after which a pending cross-shard transaction is reported as stuck
+func (rf *readFacade) GetStuckPendingAgeInSec() uint64 {
+	return rf.config.Config.CrossShard.StuckPendingAgeInSec
+}
+
+// GetStuckTransactions returns the cross-shard transactions that are pending for more than the provided age
+func (rf *readFacade) GetStuckTransactions(maxAgeInSec uint64) ([]*data.StuckTransaction, error) {
+	return rf.indexer.GetStuckTransactions(maxAgeInSec)
+}
+
+// GetTokenRolesAtTimestamp returns the addresses that had each role of the provided token at the provided timestamp.
+// When no timestamp is provided, the current roles are returned
+func (rf *readFacade) GetTokenRolesAtTimestamp(token string, timestamp uint64) (map[string][]string, error) {
+	if timestamp == 0 {
+		timestamp = uint64(time.Now().Unix())
+	}
+
+	return rf.indexer.GetTokenRolesAtTimestamp(token, timestamp)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rf *readFacade) IsInterfaceNil() bool {
+	return rf == nil
+}
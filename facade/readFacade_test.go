@@ -0,0 +1,80 @@
+package facade
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-es-indexer-go/core"
+	"github.com/multiversx/mx-chain-es-indexer-go/data"
+	"github.com/multiversx/mx-chain-es-indexer-go/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func createArgsReadFacade() ArgsReadFacade {
+	args := ArgsReadFacade{
+		Indexer: &mock.IndexerAdminHandlerStub{},
+	}
+	args.Config.Config.CrossShard.StuckPendingAgeInSec = 600
+
+	return args
+}
+
+func TestNewReadFacade(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsReadFacade()
+	args.Indexer = nil
+	rf, err := NewReadFacade(args)
+	require.Nil(t, rf)
+	require.Equal(t, core.ErrNilIndexerAdminHandler, err)
+
+	rf, err = NewReadFacade(createArgsReadFacade())
+	require.Nil(t, err)
+	require.False(t, rf.IsInterfaceNil())
+	require.Equal(t, uint64(600), rf.GetStuckPendingAgeInSec())
+}
+
+func TestReadFacade_GetStuckTransactions(t *testing.T) {
+	t.Parallel()
+
+	requestedMaxAge := uint64(1)
+	args := createArgsReadFacade()
+	args.Indexer = &mock.IndexerAdminHandlerStub{
+		GetStuckTransactionsCalled: func(maxAgeInSec uint64) ([]*data.StuckTransaction, error) {
+			requestedMaxAge = maxAgeInSec
+			return nil, nil
+		},
+	}
+	rf, _ := NewReadFacade(args)
+
+	_, err := rf.GetStuckTransactions(60)
+	require.Nil(t, err)
+	require.Equal(t, uint64(60), requestedMaxAge)
+
+	_, err = rf.GetStuckTransactions(0)
+	require.Nil(t, err)
+	require.Equal(t, uint64(0), requestedMaxAge)
+}
+
+func TestReadFacade_GetTokenRolesAtTimestamp(t *testing.T) {
+	t.Parallel()
+
+	requestedTimestamp := uint64(0)
+	args := createArgsReadFacade()
+	args.Indexer = &mock.IndexerAdminHandlerStub{
+		GetTokenRolesAtTimestampCalled: func(token string, timestamp uint64) (map[string][]string, error) {
+			require.Equal(t, "TKN-abcdef", token)
+			requestedTimestamp = timestamp
+			return nil, nil
+		},
+	}
+	rf, _ := NewReadFacade(args)
+
+	_, err := rf.GetTokenRolesAtTimestamp("TKN-abcdef", 5040)
+	require.Nil(t, err)
+	require.Equal(t, uint64(5040), requestedTimestamp)
+
+	_, err = rf.GetTokenRolesAtTimestamp("TKN-abcdef", 0)
+	require.Nil(t, err)
+	require.NotZero(t, requestedTimestamp)
+	require.NotEqual(t, uint64(5040), requestedTimestamp)
+}
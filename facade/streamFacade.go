@@ -0,0 +1,37 @@
+package facade
+
+import (
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-es-indexer-go/core"
+	"github.com/multiversx/mx-chain-es-indexer-go/stream"
+)
+
+type streamFacade struct {
+	broadcaster core.StreamBroadcasterHandler
+}
+
+// NewStreamFacade will create a new instance of streamFacade
+func NewStreamFacade(broadcaster core.StreamBroadcasterHandler) (*streamFacade, error) {
+	if check.IfNil(broadcaster) {
+		return nil, core.ErrNilStreamBroadcaster
+	}
+
+	return &streamFacade{
+		broadcaster: broadcaster,
+	}, nil
+}
+
+// Subscribe will register a new live stream subscriber
+func (sf *streamFacade) Subscribe(filter stream.Filter) (*stream.Subscription, error) {
+	return sf.broadcaster.Subscribe(filter)
+}
+
+// Unsubscribe will remove the live stream subscriber with the provided ID
+func (sf *streamFacade) Unsubscribe(id uint64) {
+	sf.broadcaster.Unsubscribe(id)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sf *streamFacade) IsInterfaceNil() bool {
+	return sf == nil
+}
@@ -0,0 +1,41 @@
+package facade
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-es-indexer-go/core"
+	"github.com/multiversx/mx-chain-es-indexer-go/data"
+	"github.com/multiversx/mx-chain-es-indexer-go/stream"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewStreamFacade(t *testing.T) {
+	t.Parallel()
+
+	sf, err := NewStreamFacade(nil)
+	require.Nil(t, sf)
+	require.Equal(t, core.ErrNilStreamBroadcaster, err)
+
+	broadcaster, _ := stream.NewBroadcaster(stream.ArgsBroadcaster{SubscriberBufferSize: 1})
+	sf, err = NewStreamFacade(broadcaster)
+	require.Nil(t, err)
+	require.False(t, sf.IsInterfaceNil())
+}
+
+func TestStreamFacade_SubscribeAndUnsubscribe(t *testing.T) {
+	t.Parallel()
+
+	broadcaster, _ := stream.NewBroadcaster(stream.ArgsBroadcaster{SubscriberBufferSize: 1})
+	sf, _ := NewStreamFacade(broadcaster)
+
+	subscription, err := sf.Subscribe(stream.Filter{Types: []string{data.StreamEventBlock}})
+	require.Nil(t, err)
+
+	broadcaster.Publish([]*data.StreamEvent{{Type: data.StreamEventTransaction}, {Type: data.StreamEventBlock, Hash: "h1"}})
+	event := <-subscription.Events
+	require.Equal(t, "h1", event.Hash)
+
+	sf.Unsubscribe(subscription.ID)
+	_, ok := <-subscription.Events
+	require.False(t, ok)
+}
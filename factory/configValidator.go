@@ -0,0 +1,266 @@
+package factory
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/elastic/go-elasticsearch/v7"
+	"github.com/multiversx/mx-chain-core-go/core"
+	factoryHasher "github.com/multiversx/mx-chain-core-go/hashing/factory"
+	factoryMarshaller "github.com/multiversx/mx-chain-core-go/marshal/factory"
+
+	"github.com/multiversx/mx-chain-es-indexer-go/client/logging"
+	"github.com/multiversx/mx-chain-es-indexer-go/config"
+	"github.com/multiversx/mx-chain-es-indexer-go/factory/pubkeyConverters"
+	"github.com/multiversx/mx-chain-es-indexer-go/process/dataindexer"
+	"github.com/multiversx/mx-chain-es-indexer-go/process/elasticproc"
+	"github.com/multiversx/mx-chain-es-indexer-go/process/elasticproc/tokens"
+)
+
+var (
+	requiredClusterPrivileges        = []string{"monitor", "manage_index_templates"}
+	requiredKibanaClusterPrivileges  = []string{"manage_ilm"}
+	requiredIndexPrivileges          = []string{"create_index", "manage", "write", "read"}
+	requiredMainChainIndexPrivileges = []string{"read"}
+)
+
+type privilegesRequest struct {
+	Cluster []string                 `json:"cluster,omitempty"`
+	Index   []indexPrivilegesRequest `json:"index"`
+}
+
+type indexPrivilegesRequest struct {
+	Names      []string `json:"names"`
+	Privileges []string `json:"privileges"`
+}
+
+type privilegesResponse struct {
+	HasAllRequested bool                       `json:"has_all_requested"`
+	Cluster         map[string]bool            `json:"cluster"`
+	Index           map[string]map[string]bool `json:"index"`
+}
+
+// ValidateConfig checks the settings of the main and preferences configuration files without starting the indexer.
+// All the problems found are returned joined in a single error
+func ValidateConfig(cfg config.Config, clusterCfg config.ClusterConfig) error {
+	errs := make([]error, 0)
+	errs = append(errs, checkIndices(cfg.Config.AvailableIndices, "available-indices")...)
+	errs = append(errs, checkIndices(clusterCfg.Config.DisabledIndices, "disabled-indices")...)
+	if len(prepareIndices(cfg.Config.AvailableIndices, clusterCfg.Config.DisabledIndices)) == 0 {
+		errs = append(errs, dataindexer.ErrEmptyEnabledIndexes)
+	}
+
+	_, err := factoryMarshaller.NewMarshalizer(cfg.Config.Marshaller.Type)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("%w for marshaller type %q", err, cfg.Config.Marshaller.Type))
+	}
+	_, err = factoryMarshaller.NewMarshalizer(clusterCfg.Config.WebSocket.DataMarshallerType)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("%w for web socket data marshaller type %q", err, clusterCfg.Config.WebSocket.DataMarshallerType))
+	}
+	_, err = factoryHasher.NewHasher(cfg.Config.Hasher.Type)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("%w for hasher type %q", err, cfg.Config.Hasher.Type))
+	}
+	_, _, err = createPubkeyConverters(cfg)
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	if clusterCfg.Config.ElasticCluster.URL == "" {
+		errs = append(errs, dataindexer.ErrNoElasticUrlProvided)
+	}
+	if cfg.Sovereign && clusterCfg.Config.MainChainCluster.Enabled && clusterCfg.Config.MainChainCluster.URL == "" {
+		errs = append(errs, fmt.Errorf("%w for the main chain cluster", dataindexer.ErrNoElasticUrlProvided))
+	}
+	if cfg.Sovereign {
+		errs = append(errs, checkMainChainTokensSource(clusterCfg)...)
+	}
+
+	return errors.Join(errs...)
+}
+
+func checkMainChainTokensSource(clusterCfg config.ClusterConfig) []error {
+	tokensSource := clusterCfg.Config.MainChainTokensSource
+	switch tokensSource.Type {
+	case tokens.ElasticTokensSourceType, "":
+		return nil
+	case tokens.ProxyTokensSourceType:
+		if tokensSource.ProxyURL == "" {
+			return []error{fmt.Errorf("%w for the main chain tokens source", dataindexer.ErrNoProxyUrlProvided)}
+		}
+		return nil
+	default:
+		return []error{fmt.Errorf("%w %q", dataindexer.ErrUnknownMainChainTokensSource, tokensSource.Type)}
+	}
+}
+
+func checkIndices(indices []string, settingName string) []error {
+	knownIndices := make(map[string]struct{})
+	for _, index := range elasticproc.GetKnownIndexes() {
+		knownIndices[index] = struct{}{}
+	}
+
+	errs := make([]error, 0)
+	for _, index := range indices {
+		_, found := knownIndices[index]
+		if !found {
+			errs = append(errs, fmt.Errorf("%w %q in %s", dataindexer.ErrUnknownIndex, index, settingName))
+		}
+	}
+
+	return errs
+}
+
+func createPubkeyConverters(cfg config.Config) (core.PubkeyConverter, core.PubkeyConverter, error) {
+	addressPubkeyConverter, err := pubkeyConverters.NewPubkeyConverter(pubkeyConverters.ArgsPubkeyConverter{
+		Type:   cfg.Config.AddressConverter.Type,
+		Length: cfg.Config.AddressConverter.Length,
+		Prefix: cfg.Config.AddressConverter.Prefix,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w for the address converter", err)
+	}
+
+	validatorPubkeyConverter, err := pubkeyConverters.NewPubkeyConverter(pubkeyConverters.ArgsPubkeyConverter{
+		Type:   cfg.Config.ValidatorKeysConverter.Type,
+		Length: cfg.Config.ValidatorKeysConverter.Length,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w for the validator keys converter", err)
+	}
+
+	return addressPubkeyConverter, validatorPubkeyConverter, nil
+}
+
+// CheckClustersAccess will connect to the configured Elasticsearch clusters and check that the provided credentials
+// grant all the privileges needed by the enabled indices. Clusters without the security features enabled only
+// get the connectivity check
+func CheckClustersAccess(cfg config.Config, clusterCfg config.ClusterConfig) error {
+	enabledIndices := prepareIndices(cfg.Config.AvailableIndices, clusterCfg.Config.DisabledIndices)
+	clusterPrivileges := append([]string{}, requiredClusterPrivileges...)
+	if clusterCfg.Config.ElasticCluster.UseKibana {
+		clusterPrivileges = append(clusterPrivileges, requiredKibanaClusterPrivileges...)
+	}
+
+	elasticCluster := clusterCfg.Config.ElasticCluster
+	err := checkClusterAccess(elasticCluster.URL, elasticCluster.UserName, elasticCluster.Password, privilegesRequest{
+		Cluster: clusterPrivileges,
+		Index: []indexPrivilegesRequest{{
+			Names:      indicesPatterns(enabledIndices),
+			Privileges: requiredIndexPrivileges,
+		}},
+	})
+	if err != nil {
+		return fmt.Errorf("%w for cluster %s", err, elasticCluster.URL)
+	}
+
+	mainChainCluster := clusterCfg.Config.MainChainCluster
+	if !cfg.Sovereign || !mainChainCluster.Enabled {
+		return nil
+	}
+
+	err = checkClusterAccess(mainChainCluster.URL, mainChainCluster.UserName, mainChainCluster.Password, privilegesRequest{
+		Index: []indexPrivilegesRequest{{
+			Names:      indicesPatterns([]string{dataindexer.TokensIndex}),
+			Privileges: requiredMainChainIndexPrivileges,
+		}},
+	})
+	if err != nil {
+		return fmt.Errorf("%w for main chain cluster %s", err, mainChainCluster.URL)
+	}
+
+	return nil
+}
+
+func checkClusterAccess(url string, username string, password string, privileges privilegesRequest) error {
+	esClient, err := elasticsearch.NewClient(elasticsearch.Config{
+		Addresses: []string{url},
+		Username:  username,
+		Password:  password,
+		Logger:    &logging.CustomLogger{},
+	})
+	if err != nil {
+		return err
+	}
+
+	infoResponse, err := esClient.Info()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = infoResponse.Body.Close()
+	}()
+	if infoResponse.IsError() {
+		return fmt.Errorf("cannot connect, status: %s", infoResponse.Status())
+	}
+	log.Info("connected to cluster", "url", url)
+
+	body, err := json.Marshal(privileges)
+	if err != nil {
+		return err
+	}
+
+	privilegesResp, err := esClient.Security.HasPrivileges(bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = privilegesResp.Body.Close()
+	}()
+	if privilegesResp.StatusCode == http.StatusUnauthorized {
+		return fmt.Errorf("cannot check privileges, status: %s", privilegesResp.Status())
+	}
+	if privilegesResp.IsError() {
+		log.Warn("privileges check skipped, the security features might be disabled on the cluster", "url", url, "status", privilegesResp.Status())
+		return nil
+	}
+
+	response := &privilegesResponse{}
+	err = json.NewDecoder(privilegesResp.Body).Decode(response)
+	if err != nil {
+		return err
+	}
+
+	missing := getMissingPrivileges(response)
+	if len(missing) > 0 {
+		return fmt.Errorf("%w: %s", dataindexer.ErrMissingPrivileges, strings.Join(missing, ", "))
+	}
+
+	log.Info("all required privileges are granted", "url", url)
+	return nil
+}
+
+func getMissingPrivileges(response *privilegesResponse) []string {
+	missing := make([]string, 0)
+	for privilege, granted := range response.Cluster {
+		if !granted {
+			missing = append(missing, "cluster:"+privilege)
+		}
+	}
+	for index, privileges := range response.Index {
+		for privilege, granted := range privileges {
+			if !granted {
+				missing = append(missing, index+":"+privilege)
+			}
+		}
+	}
+	sort.Strings(missing)
+
+	return missing
+}
+
+func indicesPatterns(indices []string) []string {
+	patterns := make([]string, 0, len(indices))
+	for _, index := range indices {
+		// the indexer writes in aliases backed by suffixed indices, so the privileges are checked on both
+		patterns = append(patterns, index+"*")
+	}
+
+	return patterns
+}
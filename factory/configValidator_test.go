@@ -0,0 +1,148 @@
+package factory
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/multiversx/mx-chain-es-indexer-go/config"
+	"github.com/multiversx/mx-chain-es-indexer-go/process/dataindexer"
+)
+
+func createValidConfigs(url string) (config.Config, config.ClusterConfig) {
+	cfg := config.Config{}
+	cfg.Config.AvailableIndices = []string{dataindexer.TransactionsIndex, dataindexer.BlockIndex}
+	cfg.Config.AddressConverter.Type = "bech32"
+	cfg.Config.AddressConverter.Length = 32
+	cfg.Config.AddressConverter.Prefix = "erd"
+	cfg.Config.ValidatorKeysConverter.Type = "hex"
+	cfg.Config.ValidatorKeysConverter.Length = 96
+	cfg.Config.Hasher.Type = "blake2b"
+	cfg.Config.Marshaller.Type = "gogo protobuf"
+
+	clusterCfg := config.ClusterConfig{}
+	clusterCfg.Config.DisabledIndices = []string{dataindexer.BlockIndex}
+	clusterCfg.Config.WebSocket.DataMarshallerType = "json"
+	clusterCfg.Config.ElasticCluster.URL = url
+
+	return cfg, clusterCfg
+}
+
+func createClusterServer(t *testing.T, privilegesStatus int, privileges *privilegesResponse) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/":
+			_, _ = w.Write([]byte(`{"version":{"number":"7.16.2"}}`))
+		case "/_security/user/_has_privileges":
+			w.WriteHeader(privilegesStatus)
+			if privileges != nil {
+				require.Nil(t, json.NewEncoder(w).Encode(privileges))
+			}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func TestValidateConfig(t *testing.T) {
+	t.Parallel()
+
+	t.Run("valid config should work", func(t *testing.T) {
+		t.Parallel()
+
+		cfg, clusterCfg := createValidConfigs("http://localhost:9200")
+		require.Nil(t, ValidateConfig(cfg, clusterCfg))
+	})
+	t.Run("invalid settings should report all problems", func(t *testing.T) {
+		t.Parallel()
+
+		cfg, clusterCfg := createValidConfigs("")
+		cfg.Config.AvailableIndices = append(cfg.Config.AvailableIndices, "unknown")
+		cfg.Config.Marshaller.Type = "xml"
+		cfg.Config.Hasher.Type = "md5"
+		cfg.Config.AddressConverter.Type = "base58"
+
+		err := ValidateConfig(cfg, clusterCfg)
+		require.ErrorIs(t, err, dataindexer.ErrUnknownIndex)
+		require.ErrorIs(t, err, dataindexer.ErrUnsupportedPubkeyConverterType)
+		require.ErrorIs(t, err, dataindexer.ErrNoElasticUrlProvided)
+		require.ErrorContains(t, err, "marshaller type \"xml\"")
+		require.ErrorContains(t, err, "hasher type \"md5\"")
+	})
+	t.Run("all indices disabled should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg, clusterCfg := createValidConfigs("http://localhost:9200")
+		clusterCfg.Config.DisabledIndices = cfg.Config.AvailableIndices
+
+		require.ErrorIs(t, ValidateConfig(cfg, clusterCfg), dataindexer.ErrEmptyEnabledIndexes)
+	})
+	t.Run("invalid main chain tokens source should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg, clusterCfg := createValidConfigs("http://localhost:9200")
+		cfg.Sovereign = true
+		clusterCfg.Config.MainChainTokensSource.Type = "proxy"
+		require.ErrorIs(t, ValidateConfig(cfg, clusterCfg), dataindexer.ErrNoProxyUrlProvided)
+
+		clusterCfg.Config.MainChainTokensSource.ProxyURL = "http://localhost:8079"
+		require.Nil(t, ValidateConfig(cfg, clusterCfg))
+
+		clusterCfg.Config.MainChainTokensSource.Type = "api"
+		require.ErrorIs(t, ValidateConfig(cfg, clusterCfg), dataindexer.ErrUnknownMainChainTokensSource)
+	})
+}
+
+func TestCheckClustersAccess(t *testing.T) {
+	t.Parallel()
+
+	t.Run("all privileges granted should work", func(t *testing.T) {
+		t.Parallel()
+
+		server := createClusterServer(t, http.StatusOK, &privilegesResponse{
+			HasAllRequested: true,
+			Cluster:         map[string]bool{"monitor": true},
+			Index:           map[string]map[string]bool{"transactions*": {"write": true}},
+		})
+		defer server.Close()
+
+		cfg, clusterCfg := createValidConfigs(server.URL)
+		require.Nil(t, CheckClustersAccess(cfg, clusterCfg))
+	})
+	t.Run("missing privileges should error", func(t *testing.T) {
+		t.Parallel()
+
+		server := createClusterServer(t, http.StatusOK, &privilegesResponse{
+			Cluster: map[string]bool{"monitor": true, "manage_index_templates": false},
+			Index:   map[string]map[string]bool{"transactions*": {"write": false, "read": true}},
+		})
+		defer server.Close()
+
+		cfg, clusterCfg := createValidConfigs(server.URL)
+		err := CheckClustersAccess(cfg, clusterCfg)
+		require.ErrorIs(t, err, dataindexer.ErrMissingPrivileges)
+		require.ErrorContains(t, err, "cluster:manage_index_templates, transactions*:write")
+	})
+	t.Run("security disabled should only check connectivity", func(t *testing.T) {
+		t.Parallel()
+
+		server := createClusterServer(t, http.StatusInternalServerError, nil)
+		defer server.Close()
+
+		cfg, clusterCfg := createValidConfigs(server.URL)
+		require.Nil(t, CheckClustersAccess(cfg, clusterCfg))
+	})
+	t.Run("unreachable cluster should error", func(t *testing.T) {
+		t.Parallel()
+
+		server := createClusterServer(t, http.StatusOK, nil)
+		cfg, clusterCfg := createValidConfigs(server.URL)
+		server.Close()
+
+		require.Error(t, CheckClustersAccess(cfg, clusterCfg))
+	})
+}
@@ -0,0 +1,71 @@
+package pubkeyConverters
+
+import (
+	"encoding/base64"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/pubkeyConverter"
+)
+
+// base64PubkeyConverter encodes or decodes provided public key as/from standard base64
+type base64PubkeyConverter struct {
+	len int
+}
+
+// NewBase64PubkeyConverter returns a base64PubkeyConverter instance
+func NewBase64PubkeyConverter(addressLen int) (*base64PubkeyConverter, error) {
+	if addressLen < 1 {
+		return nil, fmt.Errorf("%w when creating base64 address converter, addressLen should have been greater than 0",
+			pubkeyConverter.ErrInvalidAddressLength)
+	}
+
+	return &base64PubkeyConverter{
+		len: addressLen,
+	}, nil
+}
+
+// Decode converts the provided public key string as base64 decoded bytes
+func (bpc *base64PubkeyConverter) Decode(humanReadable string) ([]byte, error) {
+	buff, err := base64.StdEncoding.DecodeString(humanReadable)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(buff) != bpc.len {
+		return nil, fmt.Errorf("%w when converting to address, expected length %d, received %d",
+			pubkeyConverter.ErrWrongSize, bpc.len, len(buff))
+	}
+
+	return buff, nil
+}
+
+// Encode converts the provided bytes in a form that this converter can decode. In this case it will encode to base64
+func (bpc *base64PubkeyConverter) Encode(pkBytes []byte) (string, error) {
+	return base64.StdEncoding.EncodeToString(pkBytes), nil
+}
+
+// EncodeSlice converts the provided bytes slice in a form that this converter can decode. In this case it will encode to base64
+func (bpc *base64PubkeyConverter) EncodeSlice(pkBytesSlice [][]byte) ([]string, error) {
+	encodedSlice := make([]string, 0, len(pkBytesSlice))
+	for _, item := range pkBytesSlice {
+		encodedSlice = append(encodedSlice, base64.StdEncoding.EncodeToString(item))
+	}
+
+	return encodedSlice, nil
+}
+
+// SilentEncode converts the provided bytes in a form that this converter can decode. In this case it will encode to base64
+func (bpc *base64PubkeyConverter) SilentEncode(pkBytes []byte, _ core.Logger) string {
+	return base64.StdEncoding.EncodeToString(pkBytes)
+}
+
+// Len returns the decoded address length
+func (bpc *base64PubkeyConverter) Len() int {
+	return bpc.len
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (bpc *base64PubkeyConverter) IsInterfaceNil() bool {
+	return bpc == nil
+}
@@ -0,0 +1,52 @@
+package pubkeyConverters
+
+import (
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/pubkeyConverter"
+
+	"github.com/multiversx/mx-chain-es-indexer-go/process/dataindexer"
+)
+
+const (
+	// Bech32Type defines the type of the bech32 public key converter, usable with any human readable prefix
+	Bech32Type = "bech32"
+	// HexType defines the type of the hex public key converter
+	HexType = "hex"
+	// Base64Type defines the type of the base64 public key converter
+	Base64Type = "base64"
+)
+
+// ArgsPubkeyConverter holds the settings needed to create a public key converter
+type ArgsPubkeyConverter struct {
+	Type   string
+	Length int
+	Prefix string
+}
+
+// NewPubkeyConverter creates a public key converter based on the provided type
+func NewPubkeyConverter(args ArgsPubkeyConverter) (core.PubkeyConverter, error) {
+	switch args.Type {
+	case Bech32Type:
+		converter, err := pubkeyConverter.NewBech32PubkeyConverter(args.Length, args.Prefix)
+		if err != nil {
+			return nil, err
+		}
+		return converter, nil
+	case HexType:
+		converter, err := pubkeyConverter.NewHexPubkeyConverter(args.Length)
+		if err != nil {
+			return nil, err
+		}
+		return converter, nil
+	case Base64Type:
+		converter, err := NewBase64PubkeyConverter(args.Length)
+		if err != nil {
+			return nil, err
+		}
+		return converter, nil
+	default:
+		return nil, fmt.Errorf("%w %q", dataindexer.ErrUnsupportedPubkeyConverterType, args.Type)
+	}
+}
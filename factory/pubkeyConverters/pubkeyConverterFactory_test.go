@@ -0,0 +1,90 @@
+package pubkeyConverters
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/pubkeyConverter"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multiversx/mx-chain-es-indexer-go/process/dataindexer"
+)
+
+func TestNewPubkeyConverter(t *testing.T) {
+	t.Parallel()
+
+	addressBytes := bytes.Repeat([]byte{1}, 32)
+
+	t.Run("bech32 with custom prefix should work", func(t *testing.T) {
+		t.Parallel()
+
+		converter, err := NewPubkeyConverter(ArgsPubkeyConverter{Type: Bech32Type, Length: 32, Prefix: "sov"})
+		require.Nil(t, err)
+
+		encoded, err := converter.Encode(addressBytes)
+		require.Nil(t, err)
+		require.True(t, strings.HasPrefix(encoded, "sov1"))
+
+		decoded, err := converter.Decode(encoded)
+		require.Nil(t, err)
+		require.Equal(t, addressBytes, decoded)
+	})
+	t.Run("hex should work", func(t *testing.T) {
+		t.Parallel()
+
+		converter, err := NewPubkeyConverter(ArgsPubkeyConverter{Type: HexType, Length: 32})
+		require.Nil(t, err)
+		require.Equal(t, "0101010101010101010101010101010101010101010101010101010101010101", converter.SilentEncode(addressBytes, nil))
+	})
+	t.Run("base64 should work", func(t *testing.T) {
+		t.Parallel()
+
+		converter, err := NewPubkeyConverter(ArgsPubkeyConverter{Type: Base64Type, Length: 32})
+		require.Nil(t, err)
+		require.Equal(t, base64.StdEncoding.EncodeToString(addressBytes), converter.SilentEncode(addressBytes, nil))
+	})
+	t.Run("invalid settings should error", func(t *testing.T) {
+		t.Parallel()
+
+		converter, err := NewPubkeyConverter(ArgsPubkeyConverter{Type: Bech32Type, Length: 32, Prefix: ""})
+		require.True(t, errors.Is(err, pubkeyConverter.ErrInvalidHrpPrefix))
+		require.Nil(t, converter)
+
+		converter, err = NewPubkeyConverter(ArgsPubkeyConverter{Type: Base64Type, Length: 0})
+		require.True(t, errors.Is(err, pubkeyConverter.ErrInvalidAddressLength))
+		require.Nil(t, converter)
+	})
+	t.Run("unknown type should error", func(t *testing.T) {
+		t.Parallel()
+
+		converter, err := NewPubkeyConverter(ArgsPubkeyConverter{Type: "base58", Length: 32})
+		require.True(t, errors.Is(err, dataindexer.ErrUnsupportedPubkeyConverterType))
+		require.Nil(t, converter)
+	})
+}
+
+func TestBase64PubkeyConverter_EncodeDecode(t *testing.T) {
+	t.Parallel()
+
+	converter, _ := NewBase64PubkeyConverter(4)
+	require.False(t, converter.IsInterfaceNil())
+	require.Equal(t, 4, converter.Len())
+
+	encoded, err := converter.Encode([]byte("addr"))
+	require.Nil(t, err)
+	require.Equal(t, "YWRkcg==", encoded)
+
+	decoded, err := converter.Decode(encoded)
+	require.Nil(t, err)
+	require.Equal(t, []byte("addr"), decoded)
+
+	_, err = converter.Decode("YWRkcmVzcw==")
+	require.True(t, errors.Is(err, pubkeyConverter.ErrWrongSize))
+
+	encodedSlice, err := converter.EncodeSlice([][]byte{[]byte("addr")})
+	require.Nil(t, err)
+	require.Equal(t, []string{"YWRkcg=="}, encodedSlice)
+}
@@ -30,6 +30,7 @@ type RunTypeComponentsHolder interface {
 	TxHashExtractorCreator() transactions.TxHashExtractor
 	RewardTxDataCreator() transactions.RewardTxDataHandler
 	IndexTokensHandlerCreator() elasticproc.IndexTokensHandler
+	BridgeTransfersHandlerCreator() elasticproc.BridgeTransfersHandler
 	Create() error
 	Close() error
 	CheckSubcomponents() error
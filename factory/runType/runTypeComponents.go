@@ -6,9 +6,10 @@ import (
 )
 
 type runTypeComponents struct {
-	txHashExtractor    transactions.TxHashExtractor
-	rewardTxData       transactions.RewardTxDataHandler
-	indexTokensHandler elasticproc.IndexTokensHandler
+	txHashExtractor        transactions.TxHashExtractor
+	rewardTxData           transactions.RewardTxDataHandler
+	indexTokensHandler     elasticproc.IndexTokensHandler
+	bridgeTransfersHandler elasticproc.BridgeTransfersHandler
 }
 
 // Close does nothing
@@ -1,6 +1,7 @@
 package runType
 
 import (
+	"github.com/multiversx/mx-chain-es-indexer-go/process/elasticproc/bridge"
 	"github.com/multiversx/mx-chain-es-indexer-go/process/elasticproc/tokens"
 	"github.com/multiversx/mx-chain-es-indexer-go/process/elasticproc/transactions"
 )
@@ -15,9 +16,10 @@ func NewRunTypeComponentsFactory() *runTypeComponentsFactory {
 // Create will create the run type components
 func (rtcf *runTypeComponentsFactory) Create() (*runTypeComponents, error) {
 	return &runTypeComponents{
-		txHashExtractor:    transactions.NewTxHashExtractor(),
-		rewardTxData:       transactions.NewRewardTxData(),
-		indexTokensHandler: tokens.NewDisabledIndexTokensHandler(),
+		txHashExtractor:        transactions.NewTxHashExtractor(),
+		rewardTxData:           transactions.NewRewardTxData(),
+		indexTokensHandler:     tokens.NewDisabledIndexTokensHandler(),
+		bridgeTransfersHandler: bridge.NewDisabledBridgeTransfersHandler(),
 	}, nil
 }
 
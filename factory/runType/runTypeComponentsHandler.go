@@ -83,6 +83,9 @@ func (mrtc *managedRunTypeComponents) CheckSubcomponents() error {
 	if check.IfNil(mrtc.indexTokensHandler) {
 		return elasticIndexer.ErrNilIndexTokensHandler
 	}
+	if check.IfNil(mrtc.bridgeTransfersHandler) {
+		return elasticIndexer.ErrNilBridgeTransfersHandler
+	}
 	return nil
 }
 
@@ -122,6 +125,18 @@ func (mrtc *managedRunTypeComponents) IndexTokensHandlerCreator() elasticproc.In
 	return mrtc.runTypeComponents.indexTokensHandler
 }
 
+// BridgeTransfersHandlerCreator returns the bridge transfers handler
+func (mrtc *managedRunTypeComponents) BridgeTransfersHandlerCreator() elasticproc.BridgeTransfersHandler {
+	mrtc.mutRunTypeCoreComponents.Lock()
+	defer mrtc.mutRunTypeCoreComponents.Unlock()
+
+	if check.IfNil(mrtc.runTypeComponents) {
+		return nil
+	}
+
+	return mrtc.runTypeComponents.bridgeTransfersHandler
+}
+
 // IsInterfaceNil returns true if the interface is nil
 func (mrtc *managedRunTypeComponents) IsInterfaceNil() bool {
 	return mrtc == nil
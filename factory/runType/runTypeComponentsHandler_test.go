@@ -44,6 +44,8 @@ func TestManagedRunTypeComponents_Create(t *testing.T) {
 
 		require.NotNil(t, managedRunTypeComponents.TxHashExtractorCreator())
 		require.NotNil(t, managedRunTypeComponents.RewardTxDataCreator())
+		require.NotNil(t, managedRunTypeComponents.IndexTokensHandlerCreator())
+		require.NotNil(t, managedRunTypeComponents.BridgeTransfersHandlerCreator())
 
 		require.Equal(t, runTypeComponentsName, managedRunTypeComponents.String())
 		require.NoError(t, managedRunTypeComponents.Close())
@@ -1,29 +1,46 @@
 package runType
 
 import (
+	"fmt"
 	"net/http"
 
 	"github.com/elastic/go-elasticsearch/v7"
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/pubkeyConverter"
 
 	"github.com/multiversx/mx-chain-es-indexer-go/client"
 	"github.com/multiversx/mx-chain-es-indexer-go/client/disabled"
 	"github.com/multiversx/mx-chain-es-indexer-go/client/logging"
+	"github.com/multiversx/mx-chain-es-indexer-go/process/dataindexer"
 	"github.com/multiversx/mx-chain-es-indexer-go/process/elasticproc"
+	"github.com/multiversx/mx-chain-es-indexer-go/process/elasticproc/bridge"
 	"github.com/multiversx/mx-chain-es-indexer-go/process/elasticproc/factory"
 	"github.com/multiversx/mx-chain-es-indexer-go/process/elasticproc/tokens"
 	"github.com/multiversx/mx-chain-es-indexer-go/process/elasticproc/transactions"
 )
 
+// mainChainAddressLen is the length of the main chain addresses
+const mainChainAddressLen = 32
+
 type sovereignRunTypeComponentsFactory struct {
-	mainChainElastic factory.ElasticConfig
-	esdtPrefix       string
+	mainChainElastic      factory.ElasticConfig
+	mainChainTokensSource factory.MainChainTokensSourceConfig
+	esdtPrefix            string
+	pubKeyConverter       core.PubkeyConverter
 }
 
 // NewSovereignRunTypeComponentsFactory will return a new instance of sovereign run type components factory
-func NewSovereignRunTypeComponentsFactory(mainChainElastic factory.ElasticConfig, esdtPrefix string) *sovereignRunTypeComponentsFactory {
+func NewSovereignRunTypeComponentsFactory(
+	mainChainElastic factory.ElasticConfig,
+	mainChainTokensSource factory.MainChainTokensSourceConfig,
+	esdtPrefix string,
+	pubKeyConverter core.PubkeyConverter,
+) *sovereignRunTypeComponentsFactory {
 	return &sovereignRunTypeComponentsFactory{
-		mainChainElastic: mainChainElastic,
-		esdtPrefix:       esdtPrefix,
+		mainChainElastic:      mainChainElastic,
+		mainChainTokensSource: mainChainTokensSource,
+		esdtPrefix:            esdtPrefix,
+		pubKeyConverter:       pubKeyConverter,
 	}
 }
 
@@ -34,15 +51,26 @@ func (srtcf *sovereignRunTypeComponentsFactory) Create() (*runTypeComponents, er
 		return nil, err
 	}
 
-	sovIndexTokensHandler, err := tokens.NewSovereignIndexTokensHandler(mainChainElasticClient, srtcf.esdtPrefix)
+	mainChainTokensSource, err := createMainChainTokensSource(srtcf.mainChainTokensSource, mainChainElasticClient)
+	if err != nil {
+		return nil, err
+	}
+
+	sovIndexTokensHandler, err := tokens.NewSovereignIndexTokensHandler(mainChainTokensSource, srtcf.esdtPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	sovBridgeTransfersHandler, err := bridge.NewSovereignBridgeTransfersHandler(mainChainElasticClient, srtcf.pubKeyConverter)
 	if err != nil {
 		return nil, err
 	}
 
 	return &runTypeComponents{
-		txHashExtractor:    transactions.NewSovereignTxHashExtractor(),
-		rewardTxData:       transactions.NewSovereignRewardTxData(),
-		indexTokensHandler: sovIndexTokensHandler,
+		txHashExtractor:        transactions.NewSovereignTxHashExtractor(),
+		rewardTxData:           transactions.NewSovereignRewardTxData(),
+		indexTokensHandler:     sovIndexTokensHandler,
+		bridgeTransfersHandler: sovBridgeTransfersHandler,
 	}, nil
 }
 
@@ -67,6 +95,31 @@ func createMainChainElasticClient(mainChainElastic factory.ElasticConfig) (elast
 	}
 }
 
+func createMainChainTokensSource(
+	tokensSourceConfig factory.MainChainTokensSourceConfig,
+	mainChainElasticClient elasticproc.MainChainDatabaseClientHandler,
+) (elasticproc.MainChainTokensSource, error) {
+	switch tokensSourceConfig.Type {
+	case tokens.ElasticTokensSourceType, "":
+		return tokens.NewElasticMainChainTokensSource(mainChainElasticClient)
+	case tokens.ProxyTokensSourceType:
+		mainChainPubKeyConverter, err := pubkeyConverter.NewBech32PubkeyConverter(mainChainAddressLen, tokensSourceConfig.AddressPrefix)
+		if err != nil {
+			return nil, err
+		}
+
+		return tokens.NewProxyMainChainTokensSource(tokens.ArgsProxyMainChainTokensSource{
+			ProxyURL:        tokensSourceConfig.ProxyURL,
+			PubKeyConverter: mainChainPubKeyConverter,
+			RequestTimeout:  tokensSourceConfig.RequestTimeout,
+			CacheExpiry:     tokensSourceConfig.CacheExpiry,
+			CacheMaxSize:    tokensSourceConfig.CacheMaxSize,
+		})
+	default:
+		return nil, fmt.Errorf("%w %q", dataindexer.ErrUnknownMainChainTokensSource, tokensSourceConfig.Type)
+	}
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (srtcf *sovereignRunTypeComponentsFactory) IsInterfaceNil() bool {
 	return srtcf == nil
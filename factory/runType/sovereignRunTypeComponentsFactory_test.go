@@ -1,17 +1,21 @@
 package runType
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/multiversx/mx-chain-es-indexer-go/mock"
+	"github.com/multiversx/mx-chain-es-indexer-go/process/dataindexer"
 	"github.com/multiversx/mx-chain-es-indexer-go/process/elasticproc/factory"
+	"github.com/multiversx/mx-chain-es-indexer-go/process/elasticproc/tokens"
 )
 
 func TestSovereignRunTypeComponentsFactory_CreateAndClose(t *testing.T) {
 	t.Parallel()
 
-	srtcf := NewSovereignRunTypeComponentsFactory(factory.ElasticConfig{}, "sov")
+	srtcf := NewSovereignRunTypeComponentsFactory(factory.ElasticConfig{}, factory.MainChainTokensSourceConfig{}, "sov", &mock.PubkeyConverterMock{})
 	require.False(t, srtcf.IsInterfaceNil())
 
 	srtc, err := srtcf.Create()
@@ -20,3 +24,47 @@ func TestSovereignRunTypeComponentsFactory_CreateAndClose(t *testing.T) {
 
 	require.NoError(t, srtc.Close())
 }
+
+func TestSovereignRunTypeComponentsFactory_CreateWithProxyTokensSource(t *testing.T) {
+	t.Parallel()
+
+	t.Run("proxy tokens source, should work", func(t *testing.T) {
+		t.Parallel()
+
+		tokensSource := factory.MainChainTokensSourceConfig{
+			Type:          tokens.ProxyTokensSourceType,
+			ProxyURL:      "http://localhost:8079",
+			AddressPrefix: "erd",
+		}
+		srtcf := NewSovereignRunTypeComponentsFactory(factory.ElasticConfig{}, tokensSource, "sov", &mock.PubkeyConverterMock{})
+
+		srtc, err := srtcf.Create()
+		require.NoError(t, err)
+		require.NotNil(t, srtc)
+	})
+	t.Run("proxy tokens source without url, should error", func(t *testing.T) {
+		t.Parallel()
+
+		tokensSource := factory.MainChainTokensSourceConfig{
+			Type:          tokens.ProxyTokensSourceType,
+			AddressPrefix: "erd",
+		}
+		srtcf := NewSovereignRunTypeComponentsFactory(factory.ElasticConfig{}, tokensSource, "sov", &mock.PubkeyConverterMock{})
+
+		srtc, err := srtcf.Create()
+		require.Nil(t, srtc)
+		require.Equal(t, dataindexer.ErrNoProxyUrlProvided, err)
+	})
+	t.Run("unknown tokens source, should error", func(t *testing.T) {
+		t.Parallel()
+
+		tokensSource := factory.MainChainTokensSourceConfig{
+			Type: "api",
+		}
+		srtcf := NewSovereignRunTypeComponentsFactory(factory.ElasticConfig{}, tokensSource, "sov", &mock.PubkeyConverterMock{})
+
+		srtc, err := srtcf.Create()
+		require.Nil(t, srtc)
+		require.True(t, errors.Is(err, dataindexer.ErrUnknownMainChainTokensSource))
+	})
+}
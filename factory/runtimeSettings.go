@@ -0,0 +1,45 @@
+package factory
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-es-indexer-go/config"
+	"github.com/multiversx/mx-chain-es-indexer-go/data"
+	"github.com/multiversx/mx-chain-es-indexer-go/process/dataindexer"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var errInvalidBulkRequestMaxSize = errors.New("invalid bulk request max size")
+
+// CreateRuntimeSettings will create the settings that can be changed without restarting the indexer from the provided configuration
+func CreateRuntimeSettings(cfg config.Config, clusterCfg config.ClusterConfig) (data.RuntimeSettings, error) {
+	errs := checkIndices(clusterCfg.Config.DisabledIndices, "disabled-indices")
+	if len(errs) > 0 {
+		return data.RuntimeSettings{}, errors.Join(errs...)
+	}
+
+	enabledIndexes := prepareIndices(cfg.Config.AvailableIndices, clusterCfg.Config.DisabledIndices)
+	if len(enabledIndexes) == 0 {
+		return data.RuntimeSettings{}, dataindexer.ErrEmptyEnabledIndexes
+	}
+
+	bulkRequestMaxSize := clusterCfg.Config.ElasticCluster.BulkRequestMaxSizeInBytes
+	if bulkRequestMaxSize <= 0 {
+		return data.RuntimeSettings{}, fmt.Errorf("%w: %d", errInvalidBulkRequestMaxSize, bulkRequestMaxSize)
+	}
+
+	logLevel := clusterCfg.Config.LogLevel
+	if logLevel != "" {
+		_, _, err := logger.ParseLogLevelAndMatchingString(logLevel)
+		if err != nil {
+			return data.RuntimeSettings{}, fmt.Errorf("%w for log-level %s", err, logLevel)
+		}
+	}
+
+	return data.RuntimeSettings{
+		EnabledIndexes:     enabledIndexes,
+		BulkRequestMaxSize: bulkRequestMaxSize,
+		LogLevel:           logLevel,
+	}, nil
+}
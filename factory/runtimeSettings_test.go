@@ -0,0 +1,66 @@
+package factory
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/multiversx/mx-chain-es-indexer-go/process/dataindexer"
+)
+
+func TestCreateRuntimeSettings(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		cfg, clusterCfg := createValidConfigs("http://localhost:9200")
+		clusterCfg.Config.ElasticCluster.BulkRequestMaxSizeInBytes = 1024
+		clusterCfg.Config.LogLevel = "*:INFO,process:DEBUG"
+
+		settings, err := CreateRuntimeSettings(cfg, clusterCfg)
+		require.Nil(t, err)
+		require.Equal(t, []string{dataindexer.TransactionsIndex}, settings.EnabledIndexes)
+		require.Equal(t, 1024, settings.BulkRequestMaxSize)
+		require.Equal(t, "*:INFO,process:DEBUG", settings.LogLevel)
+	})
+	t.Run("unknown disabled index should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg, clusterCfg := createValidConfigs("http://localhost:9200")
+		clusterCfg.Config.ElasticCluster.BulkRequestMaxSizeInBytes = 1024
+		clusterCfg.Config.DisabledIndices = []string{"unknown"}
+
+		_, err := CreateRuntimeSettings(cfg, clusterCfg)
+		require.ErrorIs(t, err, dataindexer.ErrUnknownIndex)
+	})
+	t.Run("all indices disabled should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg, clusterCfg := createValidConfigs("http://localhost:9200")
+		clusterCfg.Config.ElasticCluster.BulkRequestMaxSizeInBytes = 1024
+		clusterCfg.Config.DisabledIndices = cfg.Config.AvailableIndices
+
+		_, err := CreateRuntimeSettings(cfg, clusterCfg)
+		require.ErrorIs(t, err, dataindexer.ErrEmptyEnabledIndexes)
+	})
+	t.Run("invalid log level should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg, clusterCfg := createValidConfigs("http://localhost:9200")
+		clusterCfg.Config.ElasticCluster.BulkRequestMaxSizeInBytes = 1024
+		clusterCfg.Config.LogLevel = "*:UNKNOWN"
+
+		_, err := CreateRuntimeSettings(cfg, clusterCfg)
+		require.NotNil(t, err)
+		require.Contains(t, err.Error(), "log-level")
+	})
+	t.Run("invalid bulk size should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg, clusterCfg := createValidConfigs("http://localhost:9200")
+
+		_, err := CreateRuntimeSettings(cfg, clusterCfg)
+		require.ErrorIs(t, err, errInvalidBulkRequestMaxSize)
+	})
+}
@@ -7,16 +7,51 @@ import (
 	"github.com/multiversx/mx-chain-es-indexer-go/facade"
 )
 
+// ArgsWebServer holds the arguments needed for creating a new web server
+type ArgsWebServer struct {
+	ApiConfig            config.ApiRoutesConfig
+	Config               config.Config
+	ClusterConfig        config.ClusterConfig
+	StatusMetricsHandler core.StatusMetricsHandler
+	IndexerAdminHandler  core.IndexerAdminHandler
+	StreamBroadcaster    core.StreamBroadcasterHandler
+}
+
 // CreateWebServer will create a new instance of core.WebServerHandler
-func CreateWebServer(apiConfig config.ApiRoutesConfig, statusMetricsHandler core.StatusMetricsHandler) (core.WebServerHandler, error) {
-	metricsFacade, err := facade.NewMetricsFacade(statusMetricsHandler)
+func CreateWebServer(args ArgsWebServer) (core.WebServerHandler, error) {
+	metricsFacade, err := facade.NewMetricsFacade(args.StatusMetricsHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	adminFacade, err := facade.NewAdminFacade(facade.ArgsAdminFacade{
+		Indexer:       args.IndexerAdminHandler,
+		Config:        args.Config,
+		ClusterConfig: args.ClusterConfig,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	streamFacade, err := facade.NewStreamFacade(args.StreamBroadcaster)
+	if err != nil {
+		return nil, err
+	}
+
+	readFacade, err := facade.NewReadFacade(facade.ArgsReadFacade{
+		Indexer: args.IndexerAdminHandler,
+		Config:  args.Config,
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	args := gin.ArgsWebServer{
-		Facade:    metricsFacade,
-		ApiConfig: apiConfig,
+	webServerArgs := gin.ArgsWebServer{
+		Facade:       metricsFacade,
+		AdminFacade:  adminFacade,
+		StreamFacade: streamFacade,
+		ReadFacade:   readFacade,
+		ApiConfig:    args.ApiConfig,
 	}
-	return gin.NewWebServer(args)
+	return gin.NewWebServer(webServerArgs)
 }
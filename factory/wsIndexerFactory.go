@@ -1,9 +1,10 @@
 package factory
 
 import (
+	"time"
+
 	"github.com/multiversx/mx-chain-communication-go/websocket/data"
 	factoryHost "github.com/multiversx/mx-chain-communication-go/websocket/factory"
-	"github.com/multiversx/mx-chain-core-go/core/pubkeyConverter"
 	factoryHasher "github.com/multiversx/mx-chain-core-go/hashing/factory"
 	"github.com/multiversx/mx-chain-core-go/marshal"
 	factoryMarshaller "github.com/multiversx/mx-chain-core-go/marshal/factory"
@@ -11,23 +12,31 @@ import (
 
 	"github.com/multiversx/mx-chain-es-indexer-go/config"
 	"github.com/multiversx/mx-chain-es-indexer-go/core"
+	"github.com/multiversx/mx-chain-es-indexer-go/process/elasticproc/abi"
 	esFactory "github.com/multiversx/mx-chain-es-indexer-go/process/elasticproc/factory"
+	"github.com/multiversx/mx-chain-es-indexer-go/process/elasticproc/nftattributes"
 	"github.com/multiversx/mx-chain-es-indexer-go/process/factory"
 	"github.com/multiversx/mx-chain-es-indexer-go/process/wsindexer"
 )
 
 var log = logger.GetOrCreate("elasticindexer")
 
-// CreateWsIndexer will create a new instance of wsindexer.WSClient
-func CreateWsIndexer(cfg config.Config, clusterCfg config.ClusterConfig, statusMetrics core.StatusMetricsHandler, version string) (wsindexer.WSClient, error) {
+// CreateWsIndexer will create a new instance of wsindexer.WSClient together with the handler used to administrate the indexer
+func CreateWsIndexer(
+	cfg config.Config,
+	clusterCfg config.ClusterConfig,
+	statusMetrics core.StatusMetricsHandler,
+	streamBroadcaster core.StreamBroadcasterHandler,
+	version string,
+) (wsindexer.WSClient, core.IndexerAdminHandler, error) {
 	wsMarshaller, err := factoryMarshaller.NewMarshalizer(clusterCfg.Config.WebSocket.DataMarshallerType)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	dataIndexer, err := createDataIndexer(cfg, clusterCfg, wsMarshaller, statusMetrics, version)
+	dataIndexer, err := createDataIndexer(cfg, clusterCfg, wsMarshaller, statusMetrics, streamBroadcaster, version)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	args := wsindexer.ArgsIndexer{
@@ -37,20 +46,20 @@ func CreateWsIndexer(cfg config.Config, clusterCfg config.ClusterConfig, statusM
 	}
 	indexer, err := wsindexer.NewIndexer(args)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	host, err := createWsHost(clusterCfg, wsMarshaller)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	err = host.SetPayloadHandler(indexer)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return host, nil
+	return host, indexer, nil
 }
 
 func createDataIndexer(
@@ -58,6 +67,7 @@ func createDataIndexer(
 	clusterCfg config.ClusterConfig,
 	wsMarshaller marshal.Marshalizer,
 	statusMetrics core.StatusMetricsHandler,
+	streamBroadcaster core.StreamBroadcasterHandler,
 	version string,
 ) (wsindexer.DataIndexer, error) {
 	marshaller, err := factoryMarshaller.NewMarshalizer(cfg.Config.Marshaller.Type)
@@ -68,11 +78,7 @@ func createDataIndexer(
 	if err != nil {
 		return nil, err
 	}
-	addressPubkeyConverter, err := pubkeyConverter.NewBech32PubkeyConverter(cfg.Config.AddressConverter.Length, cfg.Config.AddressConverter.Prefix)
-	if err != nil {
-		return nil, err
-	}
-	validatorPubkeyConverter, err := pubkeyConverter.NewHexPubkeyConverter(cfg.Config.ValidatorKeysConverter.Length)
+	addressPubkeyConverter, validatorPubkeyConverter, err := createPubkeyConverters(cfg)
 	if err != nil {
 		return nil, err
 	}
@@ -84,12 +90,26 @@ func createDataIndexer(
 		Password: clusterCfg.Config.MainChainCluster.Password,
 	}
 
+	tokensSourceCfg := clusterCfg.Config.MainChainTokensSource
+	mainChainTokensSource := esFactory.MainChainTokensSourceConfig{
+		Type:           tokensSourceCfg.Type,
+		ProxyURL:       tokensSourceCfg.ProxyURL,
+		AddressPrefix:  tokensSourceCfg.AddressPrefix,
+		RequestTimeout: time.Duration(tokensSourceCfg.RequestTimeoutInSec) * time.Second,
+		CacheExpiry:    time.Duration(tokensSourceCfg.CacheExpiryInSec) * time.Second,
+		CacheMaxSize:   tokensSourceCfg.CacheMaxSize,
+	}
+
 	return factory.NewIndexer(factory.ArgsIndexerFactory{
 		Sovereign:                cfg.Sovereign,
 		MainChainElastic:         mainChainElastic,
+		MainChainTokensSource:    mainChainTokensSource,
+		TokensSyncIntervalInSec:  tokensSourceCfg.TokensSyncIntervalInSec,
 		UseKibana:                clusterCfg.Config.ElasticCluster.UseKibana,
 		Denomination:             cfg.Config.Economics.Denomination,
 		BulkRequestMaxSize:       clusterCfg.Config.ElasticCluster.BulkRequestMaxSizeInBytes,
+		UnBondPeriodInEpochs:     cfg.Config.Economics.UnBondPeriodInEpochs,
+		StoreRawHexAddresses:     cfg.Config.AddressConverter.StoreRawHex,
 		Url:                      clusterCfg.Config.ElasticCluster.URL,
 		UserName:                 clusterCfg.Config.ElasticCluster.UserName,
 		Password:                 clusterCfg.Config.ElasticCluster.Password,
@@ -100,10 +120,45 @@ func createDataIndexer(
 		ValidatorPubkeyConverter: validatorPubkeyConverter,
 		HeaderMarshaller:         wsMarshaller,
 		StatusMetrics:            statusMetrics,
+		StreamPublisher:          streamBroadcaster,
+		NFTAttributes:            createNFTAttributesDecoderArgs(cfg),
+		ABI:                      createABIDecoderArgs(cfg),
+		CallersRetentionInDays:   clusterCfg.Config.ContractStats.CallersRetentionInDays,
 		Version:                  version,
 	})
 }
 
+func createNFTAttributesDecoderArgs(cfg config.Config) nftattributes.ArgsAttributesDecoder {
+	collectionDecoders := make(map[string]string, len(cfg.Config.NFTAttributes.CollectionDecoders))
+	for _, collectionDecoder := range cfg.Config.NFTAttributes.CollectionDecoders {
+		collectionDecoders[collectionDecoder.Collection] = collectionDecoder.Decoder
+	}
+
+	return nftattributes.ArgsAttributesDecoder{
+		MaxFields:          cfg.Config.NFTAttributes.MaxFields,
+		MaxKeyLength:       cfg.Config.NFTAttributes.MaxKeyLength,
+		MaxValueLength:     cfg.Config.NFTAttributes.MaxValueLength,
+		CollectionDecoders: collectionDecoders,
+	}
+}
+
+func createABIDecoderArgs(cfg config.Config) abi.ArgsABIDecoder {
+	bindings := make([]abi.ContractBinding, 0, len(cfg.Config.ABI.Contracts))
+	for _, contract := range cfg.Config.ABI.Contracts {
+		bindings = append(bindings, abi.ContractBinding{
+			Address:        contract.Address,
+			CodeHash:       contract.CodeHash,
+			ABIFile:        contract.ABI,
+			DecodeCallArgs: contract.DecodeCallArgs,
+		})
+	}
+
+	return abi.ArgsABIDecoder{
+		Directory: cfg.Config.ABI.Directory,
+		Bindings:  bindings,
+	}
+}
+
 func prepareIndices(availableIndices, disabledIndices []string) []string {
 	indices := make([]string, 0)
 
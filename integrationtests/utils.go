@@ -18,9 +18,11 @@ import (
 	"github.com/multiversx/mx-chain-es-indexer-go/mock"
 	"github.com/multiversx/mx-chain-es-indexer-go/process/dataindexer"
 	"github.com/multiversx/mx-chain-es-indexer-go/process/elasticproc"
+	"github.com/multiversx/mx-chain-es-indexer-go/process/elasticproc/bridge"
 	"github.com/multiversx/mx-chain-es-indexer-go/process/elasticproc/factory"
 	"github.com/multiversx/mx-chain-es-indexer-go/process/elasticproc/tokens"
 	"github.com/multiversx/mx-chain-es-indexer-go/process/elasticproc/transactions"
+	"github.com/multiversx/mx-chain-es-indexer-go/stream"
 )
 
 var (
@@ -70,10 +72,12 @@ func CreateElasticProcessor(
 		EnabledIndexes: []string{dataindexer.TransactionsIndex, dataindexer.LogsIndex, dataindexer.AccountsESDTIndex, dataindexer.ScResultsIndex,
 			dataindexer.ReceiptsIndex, dataindexer.BlockIndex, dataindexer.AccountsIndex, dataindexer.TokensIndex, dataindexer.TagsIndex, dataindexer.EventsIndex,
 			dataindexer.OperationsIndex, dataindexer.DelegatorsIndex, dataindexer.ESDTsIndex, dataindexer.SCDeploysIndex, dataindexer.MiniblocksIndex, dataindexer.ValuesIndex},
-		Denomination:       18,
-		TxHashExtractor:    transactions.NewTxHashExtractor(),
-		RewardTxData:       transactions.NewRewardTxData(),
-		IndexTokensHandler: tokens.NewDisabledIndexTokensHandler(),
+		Denomination:           18,
+		TxHashExtractor:        transactions.NewTxHashExtractor(),
+		RewardTxData:           transactions.NewRewardTxData(),
+		IndexTokensHandler:     tokens.NewDisabledIndexTokensHandler(),
+		BridgeTransfersHandler: bridge.NewDisabledBridgeTransfersHandler(),
+		StreamPublisher:        stream.NewDisabledPublisher(),
 	}
 
 	return factory.CreateElasticProcessor(args)
@@ -84,7 +88,9 @@ func CreateSovereignElasticProcessor(
 	esClient elasticproc.DatabaseClientHandler,
 	mainEsClient elasticproc.MainChainDatabaseClientHandler,
 ) (dataindexer.ElasticProcessor, error) {
-	sovIndexTokens, _ := tokens.NewSovereignIndexTokensHandler(mainEsClient, sovEsdtPrefix)
+	mainChainTokensSource, _ := tokens.NewElasticMainChainTokensSource(mainEsClient)
+	sovIndexTokens, _ := tokens.NewSovereignIndexTokensHandler(mainChainTokensSource, sovEsdtPrefix)
+	sovBridgeTransfers, _ := bridge.NewSovereignBridgeTransfersHandler(mainEsClient, pubKeyConverter)
 
 	args := factory.ArgElasticProcessorFactory{
 		Marshalizer:              &mock.MarshalizerMock{},
@@ -95,10 +101,12 @@ func CreateSovereignElasticProcessor(
 		EnabledIndexes: []string{dataindexer.TransactionsIndex, dataindexer.LogsIndex, dataindexer.AccountsESDTIndex, dataindexer.ScResultsIndex,
 			dataindexer.ReceiptsIndex, dataindexer.BlockIndex, dataindexer.AccountsIndex, dataindexer.TokensIndex, dataindexer.TagsIndex, dataindexer.EventsIndex,
 			dataindexer.OperationsIndex, dataindexer.DelegatorsIndex, dataindexer.ESDTsIndex, dataindexer.SCDeploysIndex, dataindexer.MiniblocksIndex, dataindexer.ValuesIndex},
-		Denomination:       18,
-		TxHashExtractor:    transactions.NewSovereignTxHashExtractor(),
-		RewardTxData:       transactions.NewSovereignRewardTxData(),
-		IndexTokensHandler: sovIndexTokens,
+		Denomination:           18,
+		TxHashExtractor:        transactions.NewSovereignTxHashExtractor(),
+		RewardTxData:           transactions.NewSovereignRewardTxData(),
+		IndexTokensHandler:     sovIndexTokens,
+		BridgeTransfersHandler: sovBridgeTransfers,
+		StreamPublisher:        stream.NewDisabledPublisher(),
 	}
 
 	return factory.CreateElasticProcessor(args)
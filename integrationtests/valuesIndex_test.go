@@ -32,6 +32,8 @@ func TestCheckVersionIsIndexer(t *testing.T) {
 		TxHashExtractor:          &mock.TxHashExtractorMock{},
 		RewardTxData:             &mock.RewardTxDataMock{},
 		IndexTokensHandler:       &elasticproc.IndexTokenHandlerMock{},
+		BridgeTransfersHandler:   &elasticproc.BridgeTransfersHandlerMock{},
+		StreamPublisher:          &mock.StreamPublisherStub{},
 	}
 
 	_, err = factory.CreateElasticProcessor(args)
@@ -10,3 +10,11 @@ type ArgsAddIndexingData struct {
 	Topic      string
 	Duration   time.Duration
 }
+
+// ArgsLastIndexedBlock holds the details of the last indexed block of a shard
+type ArgsLastIndexedBlock struct {
+	ShardID   uint32
+	Nonce     uint64
+	Round     uint64
+	Timestamp uint64
+}
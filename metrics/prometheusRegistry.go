@@ -0,0 +1,129 @@
+package metrics
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/multiversx/mx-chain-es-indexer-go/core/request"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/common/expfmt"
+)
+
+var log = logger.GetOrCreate("metrics")
+
+const (
+	namespace = "indexer"
+
+	topicLabel       = "topic"
+	requestTypeLabel = "type"
+	indexLabel       = "index"
+	kindLabel        = "kind"
+
+	requestTopicPrefix = "req_"
+)
+
+type prometheusRegistry struct {
+	registry             *prometheus.Registry
+	topicDuration        *prometheus.HistogramVec
+	requestDuration      *prometheus.HistogramVec
+	bulkRequestSize      *prometheus.HistogramVec
+	documentsWritten     *prometheus.CounterVec
+	lastIndexedNonce     *prometheus.GaugeVec
+	lastIndexedRound     *prometheus.GaugeVec
+	lastIndexedTimestamp *prometheus.GaugeVec
+	abiDecodingFailures  *prometheus.CounterVec
+}
+
+func newPrometheusRegistry() *prometheusRegistry {
+	pr := &prometheusRegistry{
+		registry: prometheus.NewRegistry(),
+		topicDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "topic_duration_seconds",
+			Help:      "Duration of processing a payload received from the observer, by topic",
+			Buckets:   prometheus.ExponentialBuckets(0.005, 2, 14),
+		}, []string{topicLabel, shardIDName}),
+		requestDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "request_duration_seconds",
+			Help:      "Duration of the requests made to Elasticsearch, by request type",
+			Buckets:   prometheus.ExponentialBuckets(0.001, 2, 16),
+		}, []string{requestTypeLabel, shardIDName}),
+		bulkRequestSize: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "bulk_request_size_bytes",
+			Help:      "Size of the bulk requests payloads sent to Elasticsearch",
+			Buckets:   prometheus.ExponentialBuckets(1024, 4, 10),
+		}, []string{shardIDName}),
+		documentsWritten: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "documents_written_total",
+			Help:      "Number of documents successfully written in Elasticsearch, by index",
+		}, []string{indexLabel}),
+		lastIndexedNonce: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "last_indexed_block_nonce",
+			Help:      "Nonce of the last indexed block, by shard",
+		}, []string{shardIDName}),
+		lastIndexedRound: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "last_indexed_block_round",
+			Help:      "Round of the last indexed block, by shard",
+		}, []string{shardIDName}),
+		lastIndexedTimestamp: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "last_indexed_block_timestamp",
+			Help:      "Timestamp of the last indexed block, by shard",
+		}, []string{shardIDName}),
+		abiDecodingFailures: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "abi_decoding_failures_total",
+			Help:      "Number of smart contract events or arguments that could not be decoded based on the ABI, by kind",
+		}, []string{kindLabel}),
+	}
+
+	pr.registry.MustRegister(
+		pr.topicDuration,
+		pr.requestDuration,
+		pr.bulkRequestSize,
+		pr.documentsWritten,
+		pr.lastIndexedNonce,
+		pr.lastIndexedRound,
+		pr.lastIndexedTimestamp,
+		pr.abiDecodingFailures,
+	)
+
+	return pr
+}
+
+func (pr *prometheusRegistry) observeIndexingData(topic string, shardIDStr string, args ArgsAddIndexingData) {
+	if !strings.HasPrefix(topic, requestTopicPrefix) {
+		pr.topicDuration.WithLabelValues(topic, shardIDStr).Observe(args.Duration.Seconds())
+		return
+	}
+
+	pr.requestDuration.WithLabelValues(topic, shardIDStr).Observe(args.Duration.Seconds())
+	if topic == request.BulkTopic {
+		pr.bulkRequestSize.WithLabelValues(shardIDStr).Observe(float64(args.MessageLen))
+	}
+}
+
+func (pr *prometheusRegistry) gatherAsString() string {
+	metricFamilies, err := pr.registry.Gather()
+	if err != nil {
+		log.Warn("prometheusRegistry.gatherAsString: cannot gather metrics", "error", err)
+		return ""
+	}
+
+	out := bytes.NewBuffer(make([]byte, 0))
+	for _, metricFamily := range metricFamilies {
+		_, err = expfmt.MetricFamilyToText(out, metricFamily)
+		if err != nil {
+			log.Warn("prometheusRegistry.gatherAsString: cannot write metric family", "name", metricFamily.GetName(), "error", err)
+			return ""
+		}
+	}
+
+	return out.String()
+}
@@ -3,6 +3,7 @@ package metrics
 import (
 	"bytes"
 	"net/http"
+	"strconv"
 	"strings"
 	"sync"
 	"unicode"
@@ -19,23 +20,28 @@ const (
 )
 
 type statusMetrics struct {
-	metrics map[string]*request.MetricsResponse
-	mut     sync.RWMutex
+	metrics  map[string]*request.MetricsResponse
+	mut      sync.RWMutex
+	registry *prometheusRegistry
 }
 
 // NewStatusMetrics will return an instance of the statusMetrics
 func NewStatusMetrics() *statusMetrics {
 	return &statusMetrics{
-		metrics: make(map[string]*request.MetricsResponse),
+		metrics:  make(map[string]*request.MetricsResponse),
+		registry: newPrometheusRegistry(),
 	}
 }
 
 // AddIndexingData will add the indexing data for the give topic
 func (sm *statusMetrics) AddIndexingData(args ArgsAddIndexingData) {
+	topic := camelToSnake(args.Topic)
+	topicWithoutShard, shardIDStr := request.SplitTopicAndShardID(topic)
+	sm.registry.observeIndexingData(topicWithoutShard, shardIDStr, args)
+
 	sm.mut.Lock()
 	defer sm.mut.Unlock()
 
-	topic := camelToSnake(args.Topic)
 	_, found := sm.metrics[topic]
 	if !found {
 		sm.metrics[topic] = &request.MetricsResponse{
@@ -56,6 +62,24 @@ func (sm *statusMetrics) AddIndexingData(args ArgsAddIndexingData) {
 	}
 }
 
+// AddIndexedDocuments will increase the number of documents written in the provided index
+func (sm *statusMetrics) AddIndexedDocuments(index string, count uint64) {
+	sm.registry.documentsWritten.WithLabelValues(index).Add(float64(count))
+}
+
+// SetLastIndexedBlock will update the details of the last indexed block of a shard
+func (sm *statusMetrics) SetLastIndexedBlock(args ArgsLastIndexedBlock) {
+	shardIDStr := strconv.FormatUint(uint64(args.ShardID), 10)
+	sm.registry.lastIndexedNonce.WithLabelValues(shardIDStr).Set(float64(args.Nonce))
+	sm.registry.lastIndexedRound.WithLabelValues(shardIDStr).Set(float64(args.Round))
+	sm.registry.lastIndexedTimestamp.WithLabelValues(shardIDStr).Set(float64(args.Timestamp))
+}
+
+// AddABIDecodingFailures will increase the number of smart contract data that could not be decoded based on the ABI
+func (sm *statusMetrics) AddABIDecodingFailures(kind string, count uint64) {
+	sm.registry.abiDecodingFailures.WithLabelValues(kind).Add(float64(count))
+}
+
 // GetMetrics returns the metrics map
 func (sm *statusMetrics) GetMetrics() map[string]*request.MetricsResponse {
 	sm.mut.RLock()
@@ -81,6 +105,8 @@ func (sm *statusMetrics) GetMetricsForPrometheus() string {
 		stringBuilder.WriteString(errorsMetric(topic, requestsErrors, shardIDStr, metricsData.ErrorsCount))
 	}
 
+	stringBuilder.WriteString(sm.registry.gatherAsString())
+
 	promMetricsOutput := stringBuilder.String()
 
 	return promMetricsOutput
@@ -2,7 +2,9 @@ package metrics
 
 import (
 	"net/http"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/multiversx/mx-chain-core-go/data/outport"
 	"github.com/multiversx/mx-chain-es-indexer-go/core/request"
@@ -42,7 +44,7 @@ func TestStatusMetrics_AddIndexingDataAndGetMetrics(t *testing.T) {
 	}, metrics[topic1])
 
 	prometheusMetrics := statusMetricsHandler.GetMetricsForPrometheus()
-	require.Equal(t, `# TYPE test1 counter
+	require.True(t, strings.HasPrefix(prometheusMetrics, `# TYPE test1 counter
 test1{operation="total_data",shardID="0"} 322
 
 # TYPE test1 counter
@@ -57,7 +59,46 @@ test1{operation="total_time",shardID="0"} 0
 # TYPE test1 gauge
 test1{operation="requests_errors",shardID="0",errorCode="400"} 1
 
-`, prometheusMetrics)
+`))
+	require.Contains(t, prometheusMetrics, "# TYPE indexer_topic_duration_seconds histogram")
+	require.Contains(t, prometheusMetrics, `indexer_topic_duration_seconds_count{shardID="0",topic="test1"} 2`)
+}
+
+func TestStatusMetrics_PrometheusRegistryMetrics(t *testing.T) {
+	t.Parallel()
+
+	statusMetricsHandler := NewStatusMetrics()
+
+	statusMetricsHandler.AddIndexingData(ArgsAddIndexingData{
+		MessageLen: 2048,
+		Topic:      request.ExtendTopicWithShardID(request.BulkTopic, 1),
+		Duration:   time.Millisecond,
+	})
+	statusMetricsHandler.AddIndexingData(ArgsAddIndexingData{
+		Topic:    request.ExtendTopicWithShardID(request.GetTopic, 1),
+		Duration: time.Millisecond,
+	})
+	statusMetricsHandler.AddIndexedDocuments("transactions", 10)
+	statusMetricsHandler.AddIndexedDocuments("transactions", 5)
+	statusMetricsHandler.SetLastIndexedBlock(ArgsLastIndexedBlock{
+		ShardID:   2,
+		Nonce:     100,
+		Round:     101,
+		Timestamp: 5000,
+	})
+	statusMetricsHandler.AddABIDecodingFailures("events", 2)
+
+	prometheusMetrics := statusMetricsHandler.GetMetricsForPrometheus()
+	require.Contains(t, prometheusMetrics, "# HELP indexer_request_duration_seconds")
+	require.Contains(t, prometheusMetrics, `indexer_request_duration_seconds_count{shardID="1",type="req_bulk"} 1`)
+	require.Contains(t, prometheusMetrics, `indexer_request_duration_seconds_count{shardID="1",type="req_get"} 1`)
+	require.Contains(t, prometheusMetrics, `indexer_bulk_request_size_bytes_sum{shardID="1"} 2048`)
+	require.Contains(t, prometheusMetrics, `indexer_documents_written_total{index="transactions"} 15`)
+	require.Contains(t, prometheusMetrics, `indexer_last_indexed_block_nonce{shardID="2"} 100`)
+	require.Contains(t, prometheusMetrics, `indexer_last_indexed_block_round{shardID="2"} 101`)
+	require.Contains(t, prometheusMetrics, `indexer_last_indexed_block_timestamp{shardID="2"} 5000`)
+	require.Contains(t, prometheusMetrics, `indexer_abi_decoding_failures_total{kind="events"} 2`)
+	require.NotContains(t, prometheusMetrics, "indexer_topic_duration_seconds")
 }
 
 func TestCamelCaseToSnakeCase(t *testing.T) {
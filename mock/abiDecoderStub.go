@@ -0,0 +1,63 @@
+package mock
+
+import (
+	"github.com/multiversx/mx-chain-core-go/data/alteredAccount"
+	"github.com/multiversx/mx-chain-core-go/data/outport"
+
+	"github.com/multiversx/mx-chain-es-indexer-go/data"
+)
+
+// ABIDecoderStub -
+type ABIDecoderStub struct {
+	RegisterContractsCodeHashesCalled func(alteredAccounts map[string]*alteredAccount.AlteredAccount)
+	GetUnresolvedContractsCalled      func(pool *outport.TransactionPool) []string
+	RegisterResolvedCodeHashesCalled  func(addresses []string, codeHashes map[string][]byte)
+	DecodeEventCalled                 func(address string, topics [][]byte, eventData []byte) []*data.DecodedEventField
+	DecodeCallArgumentsCalled         func(sender []byte, receiver []byte, dataField []byte) []*data.DecodedArg
+}
+
+// RegisterContractsCodeHashes -
+func (ads *ABIDecoderStub) RegisterContractsCodeHashes(alteredAccounts map[string]*alteredAccount.AlteredAccount) {
+	if ads.RegisterContractsCodeHashesCalled != nil {
+		ads.RegisterContractsCodeHashesCalled(alteredAccounts)
+	}
+}
+
+// GetUnresolvedContracts -
+func (ads *ABIDecoderStub) GetUnresolvedContracts(pool *outport.TransactionPool) []string {
+	if ads.GetUnresolvedContractsCalled != nil {
+		return ads.GetUnresolvedContractsCalled(pool)
+	}
+
+	return nil
+}
+
+// RegisterResolvedCodeHashes -
+func (ads *ABIDecoderStub) RegisterResolvedCodeHashes(addresses []string, codeHashes map[string][]byte) {
+	if ads.RegisterResolvedCodeHashesCalled != nil {
+		ads.RegisterResolvedCodeHashesCalled(addresses, codeHashes)
+	}
+}
+
+// DecodeEvent -
+func (ads *ABIDecoderStub) DecodeEvent(address string, topics [][]byte, eventData []byte) []*data.DecodedEventField {
+	if ads.DecodeEventCalled != nil {
+		return ads.DecodeEventCalled(address, topics, eventData)
+	}
+
+	return nil
+}
+
+// DecodeCallArguments -
+func (ads *ABIDecoderStub) DecodeCallArguments(sender []byte, receiver []byte, dataField []byte) []*data.DecodedArg {
+	if ads.DecodeCallArgumentsCalled != nil {
+		return ads.DecodeCallArgumentsCalled(sender, receiver, dataField)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (ads *ABIDecoderStub) IsInterfaceNil() bool {
+	return ads == nil
+}
@@ -0,0 +1,76 @@
+package mock
+
+import (
+	"github.com/multiversx/mx-chain-es-indexer-go/core/request"
+	"github.com/multiversx/mx-chain-es-indexer-go/data"
+)
+
+// AdminFacadeStub -
+type AdminFacadeStub struct {
+	PauseCalled                        func()
+	ResumeCalled                       func()
+	EnableIndexCalled                  func(index string) error
+	DisableIndexCalled                 func(index string) error
+	GetConfigCalled                    func() request.ConfigResponse
+	SyncMainChainTokensCalled          func() (*data.TokensSyncReport, error)
+	GetMainChainTokensSyncReportCalled func() *data.TokensSyncReport
+}
+
+// Pause -
+func (afs *AdminFacadeStub) Pause() {
+	if afs.PauseCalled != nil {
+		afs.PauseCalled()
+	}
+}
+
+// Resume -
+func (afs *AdminFacadeStub) Resume() {
+	if afs.ResumeCalled != nil {
+		afs.ResumeCalled()
+	}
+}
+
+// EnableIndex -
+func (afs *AdminFacadeStub) EnableIndex(index string) error {
+	if afs.EnableIndexCalled != nil {
+		return afs.EnableIndexCalled(index)
+	}
+	return nil
+}
+
+// DisableIndex -
+func (afs *AdminFacadeStub) DisableIndex(index string) error {
+	if afs.DisableIndexCalled != nil {
+		return afs.DisableIndexCalled(index)
+	}
+	return nil
+}
+
+// GetConfig -
+func (afs *AdminFacadeStub) GetConfig() request.ConfigResponse {
+	if afs.GetConfigCalled != nil {
+		return afs.GetConfigCalled()
+	}
+	return request.ConfigResponse{}
+}
+
+// SyncMainChainTokens -
+func (afs *AdminFacadeStub) SyncMainChainTokens() (*data.TokensSyncReport, error) {
+	if afs.SyncMainChainTokensCalled != nil {
+		return afs.SyncMainChainTokensCalled()
+	}
+	return &data.TokensSyncReport{}, nil
+}
+
+// GetMainChainTokensSyncReport -
+func (afs *AdminFacadeStub) GetMainChainTokensSyncReport() *data.TokensSyncReport {
+	if afs.GetMainChainTokensSyncReportCalled != nil {
+		return afs.GetMainChainTokensSyncReportCalled()
+	}
+	return nil
+}
+
+// IsInterfaceNil -
+func (afs *AdminFacadeStub) IsInterfaceNil() bool {
+	return afs == nil
+}
@@ -0,0 +1,167 @@
+package mock
+
+import (
+	"github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-es-indexer-go/data"
+)
+
+// DataIndexerStub -
+type DataIndexerStub struct {
+	SaveBlockCalled                    func(outportBlock *outport.OutportBlock) error
+	RevertIndexedBlockCalled           func(blockData *outport.BlockData) error
+	SaveRoundsInfoCalled               func(roundsInfos *outport.RoundsInfo) error
+	SaveValidatorsPubKeysCalled        func(validatorsPubKeys *outport.ValidatorsPubKeys) error
+	SaveValidatorsRatingCalled         func(ratingData *outport.ValidatorsRating) error
+	SaveAccountsCalled                 func(accountsData *outport.Accounts) error
+	FinalizedBlockCalled               func(finalizedBlock *outport.FinalizedBlock) error
+	SetCurrentSettingsCalled           func(settings outport.OutportConfig) error
+	EnableIndexCalled                  func(index string) error
+	DisableIndexCalled                 func(index string) error
+	GetEnabledIndexesCalled            func() []string
+	UpdateSettingsCalled               func(settings data.RuntimeSettings)
+	GetStuckTransactionsCalled         func(maxAgeInSec uint64) ([]*data.StuckTransaction, error)
+	GetTokenRolesAtTimestampCalled     func(token string, timestamp uint64) (map[string][]string, error)
+	SyncMainChainTokensCalled          func() (*data.TokensSyncReport, error)
+	GetMainChainTokensSyncReportCalled func() *data.TokensSyncReport
+	CloseCalled                        func() error
+}
+
+// SaveBlock -
+func (dis *DataIndexerStub) SaveBlock(outportBlock *outport.OutportBlock) error {
+	if dis.SaveBlockCalled != nil {
+		return dis.SaveBlockCalled(outportBlock)
+	}
+	return nil
+}
+
+// RevertIndexedBlock -
+func (dis *DataIndexerStub) RevertIndexedBlock(blockData *outport.BlockData) error {
+	if dis.RevertIndexedBlockCalled != nil {
+		return dis.RevertIndexedBlockCalled(blockData)
+	}
+	return nil
+}
+
+// SaveRoundsInfo -
+func (dis *DataIndexerStub) SaveRoundsInfo(roundsInfos *outport.RoundsInfo) error {
+	if dis.SaveRoundsInfoCalled != nil {
+		return dis.SaveRoundsInfoCalled(roundsInfos)
+	}
+	return nil
+}
+
+// SaveValidatorsPubKeys -
+func (dis *DataIndexerStub) SaveValidatorsPubKeys(validatorsPubKeys *outport.ValidatorsPubKeys) error {
+	if dis.SaveValidatorsPubKeysCalled != nil {
+		return dis.SaveValidatorsPubKeysCalled(validatorsPubKeys)
+	}
+	return nil
+}
+
+// SaveValidatorsRating -
+func (dis *DataIndexerStub) SaveValidatorsRating(ratingData *outport.ValidatorsRating) error {
+	if dis.SaveValidatorsRatingCalled != nil {
+		return dis.SaveValidatorsRatingCalled(ratingData)
+	}
+	return nil
+}
+
+// SaveAccounts -
+func (dis *DataIndexerStub) SaveAccounts(accountsData *outport.Accounts) error {
+	if dis.SaveAccountsCalled != nil {
+		return dis.SaveAccountsCalled(accountsData)
+	}
+	return nil
+}
+
+// FinalizedBlock -
+func (dis *DataIndexerStub) FinalizedBlock(finalizedBlock *outport.FinalizedBlock) error {
+	if dis.FinalizedBlockCalled != nil {
+		return dis.FinalizedBlockCalled(finalizedBlock)
+	}
+	return nil
+}
+
+// SetCurrentSettings -
+func (dis *DataIndexerStub) SetCurrentSettings(settings outport.OutportConfig) error {
+	if dis.SetCurrentSettingsCalled != nil {
+		return dis.SetCurrentSettingsCalled(settings)
+	}
+	return nil
+}
+
+// EnableIndex -
+func (dis *DataIndexerStub) EnableIndex(index string) error {
+	if dis.EnableIndexCalled != nil {
+		return dis.EnableIndexCalled(index)
+	}
+	return nil
+}
+
+// DisableIndex -
+func (dis *DataIndexerStub) DisableIndex(index string) error {
+	if dis.DisableIndexCalled != nil {
+		return dis.DisableIndexCalled(index)
+	}
+	return nil
+}
+
+// GetEnabledIndexes -
+func (dis *DataIndexerStub) GetEnabledIndexes() []string {
+	if dis.GetEnabledIndexesCalled != nil {
+		return dis.GetEnabledIndexesCalled()
+	}
+	return nil
+}
+
+// UpdateSettings -
+func (dis *DataIndexerStub) UpdateSettings(settings data.RuntimeSettings) {
+	if dis.UpdateSettingsCalled != nil {
+		dis.UpdateSettingsCalled(settings)
+	}
+}
+
+// GetStuckTransactions -
+func (dis *DataIndexerStub) GetStuckTransactions(maxAgeInSec uint64) ([]*data.StuckTransaction, error) {
+	if dis.GetStuckTransactionsCalled != nil {
+		return dis.GetStuckTransactionsCalled(maxAgeInSec)
+	}
+	return nil, nil
+}
+
+// GetTokenRolesAtTimestamp -
+func (dis *DataIndexerStub) GetTokenRolesAtTimestamp(token string, timestamp uint64) (map[string][]string, error) {
+	if dis.GetTokenRolesAtTimestampCalled != nil {
+		return dis.GetTokenRolesAtTimestampCalled(token, timestamp)
+	}
+	return nil, nil
+}
+
+// SyncMainChainTokens -
+func (dis *DataIndexerStub) SyncMainChainTokens() (*data.TokensSyncReport, error) {
+	if dis.SyncMainChainTokensCalled != nil {
+		return dis.SyncMainChainTokensCalled()
+	}
+	return nil, nil
+}
+
+// GetMainChainTokensSyncReport -
+func (dis *DataIndexerStub) GetMainChainTokensSyncReport() *data.TokensSyncReport {
+	if dis.GetMainChainTokensSyncReportCalled != nil {
+		return dis.GetMainChainTokensSyncReportCalled()
+	}
+	return nil
+}
+
+// Close -
+func (dis *DataIndexerStub) Close() error {
+	if dis.CloseCalled != nil {
+		return dis.CloseCalled()
+	}
+	return nil
+}
+
+// IsInterfaceNil -
+func (dis *DataIndexerStub) IsInterfaceNil() bool {
+	return dis == nil
+}
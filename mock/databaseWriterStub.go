@@ -12,6 +12,8 @@ type DatabaseWriterStub struct {
 	DoMultiGetCalled          func(ids []string, index string, withSource bool, response interface{}) error
 	CheckAndCreateIndexCalled func(index string) error
 	DoScrollRequestCalled     func(index string, body []byte, withSource bool, handlerFunc func(responseBytes []byte) error) error
+	UpdateByQueryCalled       func(index string, buff *bytes.Buffer) error
+	DoSearchRequestCalled     func(index string, body []byte, response interface{}) error
 }
 
 // PutMappings -
@@ -20,7 +22,10 @@ func (dwm *DatabaseWriterStub) PutMappings(_ string, _ *bytes.Buffer) error {
 }
 
 // UpdateByQuery -
-func (dwm *DatabaseWriterStub) UpdateByQuery(_ context.Context, _ string, _ *bytes.Buffer) error {
+func (dwm *DatabaseWriterStub) UpdateByQuery(_ context.Context, index string, buff *bytes.Buffer) error {
+	if dwm.UpdateByQueryCalled != nil {
+		return dwm.UpdateByQueryCalled(index, buff)
+	}
 	return nil
 }
 
@@ -29,6 +34,14 @@ func (dwm *DatabaseWriterStub) DoCountRequest(_ context.Context, _ string, _ []b
 	return 0, nil
 }
 
+// DoSearchRequest -
+func (dwm *DatabaseWriterStub) DoSearchRequest(_ context.Context, index string, body []byte, response interface{}) error {
+	if dwm.DoSearchRequestCalled != nil {
+		return dwm.DoSearchRequestCalled(index, body, response)
+	}
+	return nil
+}
+
 // DoScrollRequest -
 func (dwm *DatabaseWriterStub) DoScrollRequest(_ context.Context, index string, body []byte, withSource bool, handlerFunc func(responseBytes []byte) error) error {
 	if dwm.DoScrollRequestCalled != nil {
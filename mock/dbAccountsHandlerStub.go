@@ -66,3 +66,51 @@ func (dba *DBAccountsHandlerStub) PutTokenMedataDataInTokens(_ []*data.TokenInfo
 func (dba *DBAccountsHandlerStub) SerializeTypeForProvidedIDs(_ []string, _ string, _ *data.BufferSlice, _ string) error {
 	return nil
 }
+
+// PrepareAccountsActivity -
+func (dba *DBAccountsHandlerStub) PrepareAccountsActivity(_ *data.PreparedResults, _ uint64, _ uint32, _ uint32) map[string]*data.AccountActivity {
+	return nil
+}
+
+// AddTokensInAccountsActivity -
+func (dba *DBAccountsHandlerStub) AddTokensInAccountsActivity(_ map[string]*data.AccountActivity, _ map[string]*alteredAccount.AlteredAccount, _ uint64, _ uint32, _ uint32) {
+}
+
+// SerializeAccountsActivity -
+func (dba *DBAccountsHandlerStub) SerializeAccountsActivity(_ map[string]*data.AccountActivity, _ *data.BufferSlice, _ string) error {
+	return nil
+}
+
+// GetAccountsActivityIDs -
+func (dba *DBAccountsHandlerStub) GetAccountsActivityIDs(_ map[string]*data.AccountActivity) []string {
+	return nil
+}
+
+// GetAccountsESDTIDs -
+func (dba *DBAccountsHandlerStub) GetAccountsESDTIDs(_ map[string]*data.AccountActivity, _ map[string]*data.AccountActivity) []string {
+	return nil
+}
+
+// AddTokensCount -
+func (dba *DBAccountsHandlerStub) AddTokensCount(_ map[string]*data.AccountActivity, _ map[string]*data.AccountActivity, _ map[string]struct{}) {
+}
+
+// SerializeAccountsActivityChanges -
+func (dba *DBAccountsHandlerStub) SerializeAccountsActivityChanges(_ map[string]*data.AccountActivity, _ *data.BufferSlice, _ string) error {
+	return nil
+}
+
+// SerializeRevertedAccountsActivity -
+func (dba *DBAccountsHandlerStub) SerializeRevertedAccountsActivity(_ []*data.AccountActivity, _ map[string]uint64, _ *data.BufferSlice, _ string) error {
+	return nil
+}
+
+// PrepareAccountsActivityQuery -
+func (dba *DBAccountsHandlerStub) PrepareAccountsActivityQuery(_ uint64, _ uint32) []byte {
+	return nil
+}
+
+// PrepareLastActiveQuery -
+func (dba *DBAccountsHandlerStub) PrepareLastActiveQuery(_ []string, _ uint64) ([]byte, error) {
+	return nil, nil
+}
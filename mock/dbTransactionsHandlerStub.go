@@ -1,6 +1,8 @@
 package mock
 
 import (
+	"bytes"
+
 	coreData "github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/data/block"
 	"github.com/multiversx/mx-chain-core-go/data/outport"
@@ -65,3 +67,23 @@ func (tps *DBTransactionProcessorStub) SerializeDeploysData(_ []*data.ScDeployIn
 func (tps *DBTransactionProcessorStub) SerializeTokens(_ []*data.TokenInfo, _ *data.BufferSlice, _ string) error {
 	return nil
 }
+
+// SerializeTransactionsLifecycle -
+func (tps *DBTransactionProcessorStub) SerializeTransactionsLifecycle(_ []*data.Transaction, _ string, _ string, _ uint64, _ uint32, _ *data.BufferSlice, _ string) error {
+	return nil
+}
+
+// SerializeNotarizedTransactions -
+func (tps *DBTransactionProcessorStub) SerializeNotarizedTransactions(_ []string, _ []string, _ string, _ *data.BufferSlice, _ string) error {
+	return nil
+}
+
+// PrepareTransactionsLifecycleQueryInCaseOfRevert -
+func (tps *DBTransactionProcessorStub) PrepareTransactionsLifecycleQueryInCaseOfRevert(_ string) *bytes.Buffer {
+	return &bytes.Buffer{}
+}
+
+// PrepareStuckTransactionsQuery -
+func (tps *DBTransactionProcessorStub) PrepareStuckTransactionsQuery(_ uint64) []byte {
+	return nil
+}
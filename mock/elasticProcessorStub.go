@@ -4,21 +4,32 @@ import (
 	coreData "github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/data/block"
 	"github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-es-indexer-go/data"
 )
 
 // ElasticProcessorStub -
 type ElasticProcessorStub struct {
-	SaveHeaderCalled                 func(outportBlockWithHeader *outport.OutportBlockWithHeader) error
-	RemoveHeaderCalled               func(header coreData.HeaderHandler) error
-	RemoveMiniblocksCalled           func(header coreData.HeaderHandler, body *block.Body) error
-	RemoveTransactionsCalled         func(header coreData.HeaderHandler, body *block.Body) error
-	SaveMiniblocksCalled             func(header coreData.HeaderHandler, miniBlocks []*block.MiniBlock) error
-	SaveTransactionsCalled           func(outportBlockWithHeader *outport.OutportBlockWithHeader) error
-	SaveValidatorsRatingCalled       func(validatorsRating *outport.ValidatorsRating) error
-	SaveRoundsInfoCalled             func(infos *outport.RoundsInfo) error
-	SaveShardValidatorsPubKeysCalled func(validators *outport.ValidatorsPubKeys) error
-	SaveAccountsCalled               func(accountsData *outport.Accounts) error
-	RemoveAccountsESDTCalled         func(headerTimestamp uint64) error
+	SaveHeaderCalled                       func(outportBlockWithHeader *outport.OutportBlockWithHeader) error
+	RemoveHeaderCalled                     func(header coreData.HeaderHandler) error
+	RemoveMiniblocksCalled                 func(header coreData.HeaderHandler, body *block.Body) error
+	RemoveTransactionsCalled               func(header coreData.HeaderHandler, body *block.Body) error
+	SaveMiniblocksCalled                   func(header coreData.HeaderHandler, miniBlocks []*block.MiniBlock) error
+	SaveTransactionsCalled                 func(outportBlockWithHeader *outport.OutportBlockWithHeader) error
+	SaveValidatorsRatingCalled             func(validatorsRating *outport.ValidatorsRating) error
+	SaveRoundsInfoCalled                   func(infos *outport.RoundsInfo) error
+	SaveShardValidatorsPubKeysCalled       func(validators *outport.ValidatorsPubKeys) error
+	SaveAccountsCalled                     func(accountsData *outport.Accounts) error
+	RemoveAccountsESDTCalled               func(headerTimestamp uint64) error
+	EnableIndexCalled                      func(index string) error
+	DisableIndexCalled                     func(index string) error
+	GetEnabledIndexesCalled                func() []string
+	SetBulkRequestMaxSizeCalled            func(bulkRequestMaxSize int)
+	GetStuckTransactionsCalled             func(maxAgeInSec uint64) ([]*data.StuckTransaction, error)
+	GetTokenRolesAtTimestampCalled         func(token string, timestamp uint64) (map[string][]string, error)
+	SaveFinalizedBlockCalled               func(finalizedBlock *outport.FinalizedBlock) error
+	PrepareMainChainTokensSyncCalled       func() (*data.TokensSyncReport, *data.BufferSlice, error)
+	SaveMainChainTokensSyncCalled          func(buffSlice *data.BufferSlice) error
+	GetLastMainChainTokensSyncReportCalled func() (*data.TokensSyncReport, error)
 }
 
 // RemoveAccountsESDT -
@@ -116,6 +127,94 @@ func (eim *ElasticProcessorStub) SetOutportConfig(_ outport.OutportConfig) error
 	return nil
 }
 
+// EnableIndex -
+func (eim *ElasticProcessorStub) EnableIndex(index string) error {
+	if eim.EnableIndexCalled != nil {
+		return eim.EnableIndexCalled(index)
+	}
+
+	return nil
+}
+
+// DisableIndex -
+func (eim *ElasticProcessorStub) DisableIndex(index string) error {
+	if eim.DisableIndexCalled != nil {
+		return eim.DisableIndexCalled(index)
+	}
+
+	return nil
+}
+
+// SaveFinalizedBlock -
+func (eim *ElasticProcessorStub) SaveFinalizedBlock(finalizedBlock *outport.FinalizedBlock) error {
+	if eim.SaveFinalizedBlockCalled != nil {
+		return eim.SaveFinalizedBlockCalled(finalizedBlock)
+	}
+
+	return nil
+}
+
+// GetStuckTransactions -
+func (eim *ElasticProcessorStub) GetStuckTransactions(maxAgeInSec uint64) ([]*data.StuckTransaction, error) {
+	if eim.GetStuckTransactionsCalled != nil {
+		return eim.GetStuckTransactionsCalled(maxAgeInSec)
+	}
+
+	return nil, nil
+}
+
+// GetTokenRolesAtTimestamp -
+func (eim *ElasticProcessorStub) GetTokenRolesAtTimestamp(token string, timestamp uint64) (map[string][]string, error) {
+	if eim.GetTokenRolesAtTimestampCalled != nil {
+		return eim.GetTokenRolesAtTimestampCalled(token, timestamp)
+	}
+
+	return nil, nil
+}
+
+// PrepareMainChainTokensSync -
+func (eim *ElasticProcessorStub) PrepareMainChainTokensSync() (*data.TokensSyncReport, *data.BufferSlice, error) {
+	if eim.PrepareMainChainTokensSyncCalled != nil {
+		return eim.PrepareMainChainTokensSyncCalled()
+	}
+
+	return nil, nil, nil
+}
+
+// SaveMainChainTokensSync -
+func (eim *ElasticProcessorStub) SaveMainChainTokensSync(buffSlice *data.BufferSlice) error {
+	if eim.SaveMainChainTokensSyncCalled != nil {
+		return eim.SaveMainChainTokensSyncCalled(buffSlice)
+	}
+
+	return nil
+}
+
+// GetLastMainChainTokensSyncReport -
+func (eim *ElasticProcessorStub) GetLastMainChainTokensSyncReport() (*data.TokensSyncReport, error) {
+	if eim.GetLastMainChainTokensSyncReportCalled != nil {
+		return eim.GetLastMainChainTokensSyncReportCalled()
+	}
+
+	return nil, nil
+}
+
+// SetBulkRequestMaxSize -
+func (eim *ElasticProcessorStub) SetBulkRequestMaxSize(bulkRequestMaxSize int) {
+	if eim.SetBulkRequestMaxSizeCalled != nil {
+		eim.SetBulkRequestMaxSizeCalled(bulkRequestMaxSize)
+	}
+}
+
+// GetEnabledIndexes -
+func (eim *ElasticProcessorStub) GetEnabledIndexes() []string {
+	if eim.GetEnabledIndexesCalled != nil {
+		return eim.GetEnabledIndexesCalled()
+	}
+
+	return nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (eim *ElasticProcessorStub) IsInterfaceNil() bool {
 	return eim == nil
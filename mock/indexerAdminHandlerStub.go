@@ -0,0 +1,108 @@
+package mock
+
+import "github.com/multiversx/mx-chain-es-indexer-go/data"
+
+// IndexerAdminHandlerStub -
+type IndexerAdminHandlerStub struct {
+	PauseCalled                        func()
+	ResumeCalled                       func()
+	IsPausedCalled                     func() bool
+	EnableIndexCalled                  func(index string) error
+	DisableIndexCalled                 func(index string) error
+	GetEnabledIndexesCalled            func() []string
+	UpdateSettingsCalled               func(settings data.RuntimeSettings)
+	GetStuckTransactionsCalled         func(maxAgeInSec uint64) ([]*data.StuckTransaction, error)
+	GetTokenRolesAtTimestampCalled     func(token string, timestamp uint64) (map[string][]string, error)
+	SyncMainChainTokensCalled          func() (*data.TokensSyncReport, error)
+	GetMainChainTokensSyncReportCalled func() *data.TokensSyncReport
+}
+
+// Pause -
+func (ias *IndexerAdminHandlerStub) Pause() {
+	if ias.PauseCalled != nil {
+		ias.PauseCalled()
+	}
+}
+
+// Resume -
+func (ias *IndexerAdminHandlerStub) Resume() {
+	if ias.ResumeCalled != nil {
+		ias.ResumeCalled()
+	}
+}
+
+// IsPaused -
+func (ias *IndexerAdminHandlerStub) IsPaused() bool {
+	if ias.IsPausedCalled != nil {
+		return ias.IsPausedCalled()
+	}
+	return false
+}
+
+// EnableIndex -
+func (ias *IndexerAdminHandlerStub) EnableIndex(index string) error {
+	if ias.EnableIndexCalled != nil {
+		return ias.EnableIndexCalled(index)
+	}
+	return nil
+}
+
+// DisableIndex -
+func (ias *IndexerAdminHandlerStub) DisableIndex(index string) error {
+	if ias.DisableIndexCalled != nil {
+		return ias.DisableIndexCalled(index)
+	}
+	return nil
+}
+
+// GetEnabledIndexes -
+func (ias *IndexerAdminHandlerStub) GetEnabledIndexes() []string {
+	if ias.GetEnabledIndexesCalled != nil {
+		return ias.GetEnabledIndexesCalled()
+	}
+	return nil
+}
+
+// UpdateSettings -
+func (ias *IndexerAdminHandlerStub) UpdateSettings(settings data.RuntimeSettings) {
+	if ias.UpdateSettingsCalled != nil {
+		ias.UpdateSettingsCalled(settings)
+	}
+}
+
+// GetStuckTransactions -
+func (ias *IndexerAdminHandlerStub) GetStuckTransactions(maxAgeInSec uint64) ([]*data.StuckTransaction, error) {
+	if ias.GetStuckTransactionsCalled != nil {
+		return ias.GetStuckTransactionsCalled(maxAgeInSec)
+	}
+	return nil, nil
+}
+
+// GetTokenRolesAtTimestamp -
+func (ias *IndexerAdminHandlerStub) GetTokenRolesAtTimestamp(token string, timestamp uint64) (map[string][]string, error) {
+	if ias.GetTokenRolesAtTimestampCalled != nil {
+		return ias.GetTokenRolesAtTimestampCalled(token, timestamp)
+	}
+	return nil, nil
+}
+
+// SyncMainChainTokens -
+func (ias *IndexerAdminHandlerStub) SyncMainChainTokens() (*data.TokensSyncReport, error) {
+	if ias.SyncMainChainTokensCalled != nil {
+		return ias.SyncMainChainTokensCalled()
+	}
+	return &data.TokensSyncReport{}, nil
+}
+
+// GetMainChainTokensSyncReport -
+func (ias *IndexerAdminHandlerStub) GetMainChainTokensSyncReport() *data.TokensSyncReport {
+	if ias.GetMainChainTokensSyncReportCalled != nil {
+		return ias.GetMainChainTokensSyncReportCalled()
+	}
+	return nil
+}
+
+// IsInterfaceNil -
+func (ias *IndexerAdminHandlerStub) IsInterfaceNil() bool {
+	return ias == nil
+}
@@ -0,0 +1,39 @@
+package mock
+
+import "encoding/json"
+
+// MainChainTokensSourceStub -
+type MainChainTokensSourceStub struct {
+	GetTokensCalled         func(identifiers []string) (map[string]map[string]json.RawMessage, error)
+	HasAllTokenFieldsCalled func() bool
+	IsEnabledCalled         func() bool
+}
+
+// GetTokens -
+func (mts *MainChainTokensSourceStub) GetTokens(identifiers []string) (map[string]map[string]json.RawMessage, error) {
+	if mts.GetTokensCalled != nil {
+		return mts.GetTokensCalled(identifiers)
+	}
+	return make(map[string]map[string]json.RawMessage), nil
+}
+
+// HasAllTokenFields -
+func (mts *MainChainTokensSourceStub) HasAllTokenFields() bool {
+	if mts.HasAllTokenFieldsCalled != nil {
+		return mts.HasAllTokenFieldsCalled()
+	}
+	return true
+}
+
+// IsEnabled -
+func (mts *MainChainTokensSourceStub) IsEnabled() bool {
+	if mts.IsEnabledCalled != nil {
+		return mts.IsEnabledCalled()
+	}
+	return true
+}
+
+// IsInterfaceNil -
+func (mts *MainChainTokensSourceStub) IsInterfaceNil() bool {
+	return mts == nil
+}
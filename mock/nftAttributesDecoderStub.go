@@ -0,0 +1,22 @@
+package mock
+
+import "github.com/multiversx/mx-chain-es-indexer-go/data"
+
+// NFTAttributesDecoderStub -
+type NFTAttributesDecoderStub struct {
+	DecodeCalled func(collection string, attributes []byte) []*data.NFTAttribute
+}
+
+// Decode -
+func (nads *NFTAttributesDecoderStub) Decode(collection string, attributes []byte) []*data.NFTAttribute {
+	if nads.DecodeCalled != nil {
+		return nads.DecodeCalled(collection, attributes)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (nads *NFTAttributesDecoderStub) IsInterfaceNil() bool {
+	return nads == nil
+}
@@ -0,0 +1,39 @@
+package mock
+
+import "github.com/multiversx/mx-chain-es-indexer-go/data"
+
+// ReadFacadeStub -
+type ReadFacadeStub struct {
+	GetStuckPendingAgeInSecCalled  func() uint64
+	GetStuckTransactionsCalled     func(maxAgeInSec uint64) ([]*data.StuckTransaction, error)
+	GetTokenRolesAtTimestampCalled func(token string, timestamp uint64) (map[string][]string, error)
+}
+
+// GetStuckPendingAgeInSec -
+func (rfs *ReadFacadeStub) GetStuckPendingAgeInSec() uint64 {
+	if rfs.GetStuckPendingAgeInSecCalled != nil {
+		return rfs.GetStuckPendingAgeInSecCalled()
+	}
+	return 0
+}
+
+// GetStuckTransactions -
+func (rfs *ReadFacadeStub) GetStuckTransactions(maxAgeInSec uint64) ([]*data.StuckTransaction, error) {
+	if rfs.GetStuckTransactionsCalled != nil {
+		return rfs.GetStuckTransactionsCalled(maxAgeInSec)
+	}
+	return nil, nil
+}
+
+// GetTokenRolesAtTimestamp -
+func (rfs *ReadFacadeStub) GetTokenRolesAtTimestamp(token string, timestamp uint64) (map[string][]string, error) {
+	if rfs.GetTokenRolesAtTimestampCalled != nil {
+		return rfs.GetTokenRolesAtTimestampCalled(token, timestamp)
+	}
+	return nil, nil
+}
+
+// IsInterfaceNil -
+func (rfs *ReadFacadeStub) IsInterfaceNil() bool {
+	return rfs == nil
+}
@@ -0,0 +1,29 @@
+package mock
+
+import "github.com/multiversx/mx-chain-es-indexer-go/stream"
+
+// StreamFacadeStub -
+type StreamFacadeStub struct {
+	SubscribeCalled   func(filter stream.Filter) (*stream.Subscription, error)
+	UnsubscribeCalled func(id uint64)
+}
+
+// Subscribe -
+func (sfs *StreamFacadeStub) Subscribe(filter stream.Filter) (*stream.Subscription, error) {
+	if sfs.SubscribeCalled != nil {
+		return sfs.SubscribeCalled(filter)
+	}
+	return nil, nil
+}
+
+// Unsubscribe -
+func (sfs *StreamFacadeStub) Unsubscribe(id uint64) {
+	if sfs.UnsubscribeCalled != nil {
+		sfs.UnsubscribeCalled(id)
+	}
+}
+
+// IsInterfaceNil -
+func (sfs *StreamFacadeStub) IsInterfaceNil() bool {
+	return sfs == nil
+}
@@ -0,0 +1,20 @@
+package mock
+
+import "github.com/multiversx/mx-chain-es-indexer-go/data"
+
+// StreamPublisherStub -
+type StreamPublisherStub struct {
+	PublishCalled func(events []*data.StreamEvent)
+}
+
+// Publish -
+func (sps *StreamPublisherStub) Publish(events []*data.StreamEvent) {
+	if sps.PublishCalled != nil {
+		sps.PublishCalled(events)
+	}
+}
+
+// IsInterfaceNil -
+func (sps *StreamPublisherStub) IsInterfaceNil() bool {
+	return sps == nil
+}
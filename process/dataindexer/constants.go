@@ -17,6 +17,8 @@ const (
 	RatingIndex = "rating"
 	// AccountsIndex is the Elasticsearch index for the accounts
 	AccountsIndex = "accounts"
+	// AccountsActivityIndex is the Elasticsearch index for the changes of the activity counters of the accounts in every block
+	AccountsActivityIndex = "accountsactivity"
 	// AccountsHistoryIndex is the Elasticsearch index for the accounts history information
 	AccountsHistoryIndex = "accountshistory"
 	// ReceiptsIndex is the Elasticsearch index for the receipts
@@ -49,6 +51,30 @@ const (
 	ValuesIndex = "values"
 	// EventsIndex is the Elasticsearch index for log events
 	EventsIndex = "events"
+	// TransfersIndex is the Elasticsearch index for value transfers
+	TransfersIndex = "transfers"
+	// StatsIndex is the Elasticsearch index for the daily and per epoch chain statistics
+	StatsIndex = "stats"
+	// ContractStatsIndex is the Elasticsearch index for the daily usage statistics of the smart contracts
+	ContractStatsIndex = "contractstats"
+	// ContractCallersIndex is the Elasticsearch index for the addresses that called a smart contract in a day
+	ContractCallersIndex = "contractcallers"
+	// StakedKeysIndex is the Elasticsearch index for the ownership and the staking state of the validators BLS keys
+	StakedKeysIndex = "stakedkeys"
+	// ProvidersIndex is the Elasticsearch index for the summary of the delegation contracts
+	ProvidersIndex = "providers"
+	// UndelegationsIndex is the Elasticsearch index for the unDelegate funds of the delegators
+	UndelegationsIndex = "undelegations"
+	// TokenRolesIndex is the Elasticsearch index for the history of the tokens roles
+	TokenRolesIndex = "tokenroles"
+	// RelayersIndex is the Elasticsearch index for the daily statistics of the relayers of the relayed v3 transactions
+	RelayersIndex = "relayers"
+	// RelayerUsersIndex is the Elasticsearch index for the addresses whose transactions were relayed by a relayer in a day
+	RelayerUsersIndex = "relayerusers"
+	// BridgeTransfersIndex is the Elasticsearch index for the cross-chain transfers between a sovereign chain and the main chain
+	BridgeTransfersIndex = "bridgetransfers"
+	// TokensSyncReportsIndex is the Elasticsearch index for the reports of the synchronizations of the cross-chain tokens with the main chain
+	TokensSyncReportsIndex = "tokenssyncreports"
 
 	// TransactionsPolicy is the Elasticsearch policy for the transactions
 	TransactionsPolicy = "transactions_policy"
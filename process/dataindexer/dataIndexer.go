@@ -3,6 +3,7 @@ package dataindexer
 import (
 	"encoding/hex"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
@@ -12,6 +13,10 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data/outport"
 	"github.com/multiversx/mx-chain-core-go/marshal"
 	logger "github.com/multiversx/mx-chain-logger-go"
+
+	indexerCore "github.com/multiversx/mx-chain-es-indexer-go/core"
+	indexerData "github.com/multiversx/mx-chain-es-indexer-go/data"
+	"github.com/multiversx/mx-chain-es-indexer-go/metrics"
 )
 
 var log = logger.GetOrCreate("dataindexer")
@@ -21,12 +26,28 @@ type ArgDataIndexer struct {
 	HeaderMarshaller marshal.Marshalizer
 	ElasticProcessor ElasticProcessor
 	BlockContainer   BlockContainerHandler
+	// StatusMetrics is optional, if not provided the details about the last indexed blocks are not recorded
+	StatusMetrics indexerCore.StatusMetricsHandler
+	// TokensSyncInterval is the period of the synchronization of the cross-chain tokens with the main chain, 0 disables it
+	TokensSyncInterval time.Duration
 }
 
 type dataIndexer struct {
 	elasticProcessor ElasticProcessor
 	headerMarshaller marshal.Marshalizer
 	blockContainer   BlockContainerHandler
+	statusMetrics    indexerCore.StatusMetricsHandler
+
+	mutPendingSettings sync.Mutex
+	pendingSettings    *indexerData.RuntimeSettings
+
+	// mutBlockIndexing keeps the writes of the cross-chain tokens synchronization apart from the ones of a block
+	mutBlockIndexing    sync.Mutex
+	mutTokensSync       sync.Mutex
+	mutTokensSyncReport sync.RWMutex
+	tokensSyncReport    *indexerData.TokensSyncReport
+	closeChan           chan struct{}
+	closeOnce           sync.Once
 }
 
 // NewDataIndexer will create a new data indexer
@@ -40,6 +61,12 @@ func NewDataIndexer(arguments ArgDataIndexer) (*dataIndexer, error) {
 		elasticProcessor: arguments.ElasticProcessor,
 		headerMarshaller: arguments.HeaderMarshaller,
 		blockContainer:   arguments.BlockContainer,
+		statusMetrics:    arguments.StatusMetrics,
+		closeChan:        make(chan struct{}),
+	}
+
+	if arguments.TokensSyncInterval > 0 {
+		go dataIndexerObj.syncMainChainTokensPeriodically(arguments.TokensSyncInterval)
 	}
 
 	return dataIndexerObj, nil
@@ -89,11 +116,36 @@ func (di *dataIndexer) SaveBlock(outportBlock *outport.OutportBlock) error {
 	}()
 	log.Debug("indexer: starting indexing block", "hash", headerHash, "nonce", headerNonce)
 
+	di.mutBlockIndexing.Lock()
+	defer di.mutBlockIndexing.Unlock()
+
+	di.applyPendingSettings()
+
 	if outportBlock.TransactionPool == nil {
 		outportBlock.TransactionPool = &outport.TransactionPool{}
 	}
 
-	return di.saveBlockData(outportBlock, header)
+	err = di.saveBlockData(outportBlock, header)
+	if err != nil {
+		return err
+	}
+
+	di.setLastIndexedBlock(header)
+
+	return nil
+}
+
+func (di *dataIndexer) setLastIndexedBlock(header data.HeaderHandler) {
+	if check.IfNil(di.statusMetrics) {
+		return
+	}
+
+	di.statusMetrics.SetLastIndexedBlock(metrics.ArgsLastIndexedBlock{
+		ShardID:   header.GetShardID(),
+		Nonce:     header.GetNonce(),
+		Round:     header.GetRound(),
+		Timestamp: header.GetTimeStamp(),
+	})
 }
 
 func (di *dataIndexer) saveBlockData(outportBlock *outport.OutportBlock, header data.HeaderHandler) error {
@@ -132,9 +184,74 @@ func (di *dataIndexer) saveBlockData(outportBlock *outport.OutportBlock, header
 
 // Close will stop goroutine that index data in database
 func (di *dataIndexer) Close() error {
+	di.closeOnce.Do(func() {
+		close(di.closeChan)
+	})
+
 	return nil
 }
 
+func (di *dataIndexer) syncMainChainTokensPeriodically(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			_, err := di.SyncMainChainTokens()
+			if err != nil {
+				log.Warn("dataIndexer.syncMainChainTokensPeriodically cannot synchronize the cross-chain tokens", "error", err)
+			}
+		case <-di.closeChan:
+			return
+		}
+	}
+}
+
+// SyncMainChainTokens will refresh the cross-chain tokens from the main chain. Only one synchronization runs at a time and
+// its writes are done between the blocks
+func (di *dataIndexer) SyncMainChainTokens() (*indexerData.TokensSyncReport, error) {
+	di.mutTokensSync.Lock()
+	defer di.mutTokensSync.Unlock()
+
+	report, buffSlice, err := di.elasticProcessor.PrepareMainChainTokensSync()
+	if err != nil {
+		return nil, err
+	}
+
+	di.mutBlockIndexing.Lock()
+	err = di.elasticProcessor.SaveMainChainTokensSync(buffSlice)
+	di.mutBlockIndexing.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	di.mutTokensSyncReport.Lock()
+	di.tokensSyncReport = report
+	di.mutTokensSyncReport.Unlock()
+
+	return report, nil
+}
+
+// GetMainChainTokensSyncReport returns the report of the last synchronization of the cross-chain tokens, nil if there was none.
+// If no synchronization was done since the indexer started, the last stored report is returned
+func (di *dataIndexer) GetMainChainTokensSyncReport() *indexerData.TokensSyncReport {
+	di.mutTokensSyncReport.RLock()
+	report := di.tokensSyncReport
+	di.mutTokensSyncReport.RUnlock()
+	if report != nil {
+		return report
+	}
+
+	report, err := di.elasticProcessor.GetLastMainChainTokensSyncReport()
+	if err != nil {
+		log.Warn("dataIndexer.GetMainChainTokensSyncReport cannot get the last stored report", "error", err)
+		return nil
+	}
+
+	return report
+}
+
 // RevertIndexedBlock will remove from database block and miniblocks
 func (di *dataIndexer) RevertIndexedBlock(blockData *outport.BlockData) error {
 	header, err := di.getHeaderFromBytes(core.HeaderType(blockData.HeaderType), blockData.HeaderBytes)
@@ -142,6 +259,9 @@ func (di *dataIndexer) RevertIndexedBlock(blockData *outport.BlockData) error {
 		return err
 	}
 
+	di.mutBlockIndexing.Lock()
+	defer di.mutBlockIndexing.Unlock()
+
 	err = di.elasticProcessor.RemoveHeader(header)
 	if err != nil {
 		return err
@@ -180,9 +300,13 @@ func (di *dataIndexer) SaveAccounts(accounts *outport.Accounts) error {
 	return di.elasticProcessor.SaveAccounts(accounts)
 }
 
-// FinalizedBlock returns nil
-func (di *dataIndexer) FinalizedBlock(_ *outport.FinalizedBlock) error {
-	return nil
+// FinalizedBlock will mark the provided block as final
+func (di *dataIndexer) FinalizedBlock(finalizedBlock *outport.FinalizedBlock) error {
+	if finalizedBlock == nil {
+		return nil
+	}
+
+	return di.elasticProcessor.SaveFinalizedBlock(finalizedBlock)
 }
 
 // GetMarshaller return the marshaller
@@ -202,6 +326,87 @@ func (di *dataIndexer) SetCurrentSettings(cfg outport.OutportConfig) error {
 	return di.elasticProcessor.SetOutportConfig(cfg)
 }
 
+// EnableIndex will enable writing in the provided index
+func (di *dataIndexer) EnableIndex(index string) error {
+	log.Info("dataIndexer.EnableIndex", "index", index)
+
+	return di.elasticProcessor.EnableIndex(index)
+}
+
+// DisableIndex will disable writing in the provided index
+func (di *dataIndexer) DisableIndex(index string) error {
+	log.Info("dataIndexer.DisableIndex", "index", index)
+
+	return di.elasticProcessor.DisableIndex(index)
+}
+
+// GetEnabledIndexes returns the indices that are currently enabled
+func (di *dataIndexer) GetEnabledIndexes() []string {
+	return di.elasticProcessor.GetEnabledIndexes()
+}
+
+// GetStuckTransactions returns the cross-shard transactions that are pending for more than the provided age
+func (di *dataIndexer) GetStuckTransactions(maxAgeInSec uint64) ([]*indexerData.StuckTransaction, error) {
+	return di.elasticProcessor.GetStuckTransactions(maxAgeInSec)
+}
+
+// GetTokenRolesAtTimestamp returns the addresses that had each role of the provided token at the provided timestamp
+func (di *dataIndexer) GetTokenRolesAtTimestamp(token string, timestamp uint64) (map[string][]string, error) {
+	return di.elasticProcessor.GetTokenRolesAtTimestamp(token, timestamp)
+}
+
+// UpdateSettings will schedule the provided settings to be applied before indexing the next block
+func (di *dataIndexer) UpdateSettings(settings indexerData.RuntimeSettings) {
+	di.mutPendingSettings.Lock()
+	di.pendingSettings = &settings
+	di.mutPendingSettings.Unlock()
+
+	log.Info("dataIndexer.UpdateSettings: new settings will be applied before indexing the next block")
+}
+
+func (di *dataIndexer) applyPendingSettings() {
+	di.mutPendingSettings.Lock()
+	settings := di.pendingSettings
+	di.pendingSettings = nil
+	di.mutPendingSettings.Unlock()
+
+	if settings == nil {
+		return
+	}
+
+	newEnabledIndexes := make(map[string]struct{})
+	for _, index := range settings.EnabledIndexes {
+		newEnabledIndexes[index] = struct{}{}
+	}
+
+	currentEnabledIndexes := make(map[string]struct{})
+	for _, index := range di.elasticProcessor.GetEnabledIndexes() {
+		currentEnabledIndexes[index] = struct{}{}
+		_, stillEnabled := newEnabledIndexes[index]
+		if !stillEnabled {
+			log.LogIfError(di.DisableIndex(index))
+		}
+	}
+
+	for index := range newEnabledIndexes {
+		_, alreadyEnabled := currentEnabledIndexes[index]
+		if !alreadyEnabled {
+			log.LogIfError(di.EnableIndex(index))
+		}
+	}
+
+	if settings.BulkRequestMaxSize > 0 {
+		di.elasticProcessor.SetBulkRequestMaxSize(settings.BulkRequestMaxSize)
+	}
+
+	if settings.LogLevel != "" {
+		log.LogIfError(logger.SetLogLevel(settings.LogLevel))
+	}
+
+	log.Info("dataIndexer: settings applied", "bulk request max size", settings.BulkRequestMaxSize,
+		"enabled indices", len(settings.EnabledIndexes), "log level", settings.LogLevel)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (di *dataIndexer) IsInterfaceNil() bool {
 	return di == nil
@@ -1,14 +1,20 @@
 package dataindexer
 
 import (
+	"errors"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	coreData "github.com/multiversx/mx-chain-core-go/data"
 	dataBlock "github.com/multiversx/mx-chain-core-go/data/block"
 	"github.com/multiversx/mx-chain-core-go/data/outport"
+	indexerData "github.com/multiversx/mx-chain-es-indexer-go/data"
+	"github.com/multiversx/mx-chain-es-indexer-go/metrics"
 	"github.com/multiversx/mx-chain-es-indexer-go/mock"
+	logger "github.com/multiversx/mx-chain-logger-go"
 	"github.com/stretchr/testify/require"
 )
 
@@ -87,6 +93,95 @@ func TestDataIndexer_SaveBlock(t *testing.T) {
 	require.Equal(t, 1, countMap[2])
 }
 
+func TestDataIndexer_SaveBlockShouldSetLastIndexedBlock(t *testing.T) {
+	t.Parallel()
+
+	statusMetrics := metrics.NewStatusMetrics()
+	arguments := NewDataIndexerArguments()
+	arguments.StatusMetrics = statusMetrics
+	arguments.BlockContainer = &mock.BlockContainerStub{
+		GetCalled: func(headerType core.HeaderType) (dataBlock.EmptyBlockCreator, error) {
+			return dataBlock.NewEmptyHeaderCreator(), nil
+		},
+	}
+	ei, _ := NewDataIndexer(arguments)
+
+	header := &dataBlock.Header{ShardID: 1, Nonce: 10, Round: 11, TimeStamp: 5000}
+	headerBytes, _ := arguments.HeaderMarshaller.Marshal(header)
+	err := ei.SaveBlock(&outport.OutportBlock{
+		BlockData: &outport.BlockData{
+			HeaderType:  string(core.ShardHeaderV1),
+			Body:        &dataBlock.Body{},
+			HeaderBytes: headerBytes,
+		},
+	})
+	require.Nil(t, err)
+
+	prometheusMetrics := statusMetrics.GetMetricsForPrometheus()
+	require.Contains(t, prometheusMetrics, `indexer_last_indexed_block_nonce{shardID="1"} 10`)
+	require.Contains(t, prometheusMetrics, `indexer_last_indexed_block_round{shardID="1"} 11`)
+	require.Contains(t, prometheusMetrics, `indexer_last_indexed_block_timestamp{shardID="1"} 5000`)
+}
+
+func TestDataIndexer_UpdateSettingsShouldApplyBeforeNextBlock(t *testing.T) {
+	t.Parallel()
+
+	enabledIndexes := map[string]struct{}{"transactions": {}, "logs": {}}
+	bulkRequestMaxSize := 0
+	arguments := NewDataIndexerArguments()
+	arguments.BlockContainer = &mock.BlockContainerStub{
+		GetCalled: func(headerType core.HeaderType) (dataBlock.EmptyBlockCreator, error) {
+			return dataBlock.NewEmptyHeaderCreator(), nil
+		},
+	}
+	arguments.ElasticProcessor = &mock.ElasticProcessorStub{
+		GetEnabledIndexesCalled: func() []string {
+			indexes := make([]string, 0)
+			for index := range enabledIndexes {
+				indexes = append(indexes, index)
+			}
+			return indexes
+		},
+		EnableIndexCalled: func(index string) error {
+			enabledIndexes[index] = struct{}{}
+			return nil
+		},
+		DisableIndexCalled: func(index string) error {
+			delete(enabledIndexes, index)
+			return nil
+		},
+		SetBulkRequestMaxSizeCalled: func(size int) {
+			bulkRequestMaxSize = size
+		},
+	}
+	ei, _ := NewDataIndexer(arguments)
+
+	logLevel := logger.GetLogLevelPattern()
+	ei.UpdateSettings(indexerData.RuntimeSettings{
+		EnabledIndexes:     []string{"transactions", "events"},
+		BulkRequestMaxSize: 1024,
+		LogLevel:           logLevel + ",dataindexer:DEBUG",
+	})
+	require.Equal(t, map[string]struct{}{"transactions": {}, "logs": {}}, enabledIndexes)
+	require.Zero(t, bulkRequestMaxSize)
+	require.Equal(t, logLevel, logger.GetLogLevelPattern())
+
+	headerBytes, _ := arguments.HeaderMarshaller.Marshal(&dataBlock.Header{})
+	err := ei.SaveBlock(&outport.OutportBlock{
+		BlockData: &outport.BlockData{
+			HeaderType:  string(core.ShardHeaderV1),
+			Body:        &dataBlock.Body{},
+			HeaderBytes: headerBytes,
+		},
+	})
+	require.Nil(t, err)
+	require.Equal(t, map[string]struct{}{"transactions": {}, "events": {}}, enabledIndexes)
+	require.Equal(t, 1024, bulkRequestMaxSize)
+	require.Equal(t, logLevel+",dataindexer:DEBUG", logger.GetLogLevelPattern())
+
+	_ = logger.SetLogLevel(logLevel)
+}
+
 func TestDataIndexer_SaveRoundInfo(t *testing.T) {
 	called := false
 
@@ -185,3 +280,129 @@ func TestDataIndexer_RevertIndexedBlock(t *testing.T) {
 	require.Equal(t, 1, countMap[2])
 	require.Equal(t, 1, countMap[3])
 }
+
+func TestDataIndexer_SyncMainChainTokens(t *testing.T) {
+	t.Parallel()
+
+	expectedReport := &indexerData.TokensSyncReport{CheckedTokens: 2, UpdatedTokens: 1}
+	expectedBuffSlice := indexerData.NewBufferSlice(indexerData.DefaultMaxBulkSize)
+	syncErr := ErrTokensSyncNotAvailable
+	saveErr := errors.New("local error")
+	savedSyncs := 0
+	arguments := NewDataIndexerArguments()
+	arguments.ElasticProcessor = &mock.ElasticProcessorStub{
+		PrepareMainChainTokensSyncCalled: func() (*indexerData.TokensSyncReport, *indexerData.BufferSlice, error) {
+			if syncErr != nil {
+				return nil, nil, syncErr
+			}
+			return expectedReport, expectedBuffSlice, nil
+		},
+		SaveMainChainTokensSyncCalled: func(buffSlice *indexerData.BufferSlice) error {
+			require.True(t, expectedBuffSlice == buffSlice)
+			savedSyncs++
+			return saveErr
+		},
+	}
+	di, _ := NewDataIndexer(arguments)
+	require.Nil(t, di.GetMainChainTokensSyncReport())
+
+	report, err := di.SyncMainChainTokens()
+	require.Nil(t, report)
+	require.Equal(t, ErrTokensSyncNotAvailable, err)
+	require.Equal(t, 0, savedSyncs)
+
+	syncErr = nil
+	report, err = di.SyncMainChainTokens()
+	require.Nil(t, report)
+	require.Equal(t, saveErr, err)
+	require.Nil(t, di.GetMainChainTokensSyncReport())
+
+	saveErr = nil
+	report, err = di.SyncMainChainTokens()
+	require.Nil(t, err)
+	require.Equal(t, expectedReport, report)
+	require.Equal(t, 2, savedSyncs)
+	require.Equal(t, expectedReport, di.GetMainChainTokensSyncReport())
+	require.Nil(t, di.Close())
+	require.Nil(t, di.Close())
+}
+
+func TestDataIndexer_SyncMainChainTokensShouldWriteBetweenBlocks(t *testing.T) {
+	t.Parallel()
+
+	savingHeader := make(chan struct{})
+	releaseHeader := make(chan struct{})
+	mutEvents := sync.Mutex{}
+	events := make([]string, 0)
+	addEvent := func(event string) {
+		mutEvents.Lock()
+		events = append(events, event)
+		mutEvents.Unlock()
+	}
+
+	arguments := NewDataIndexerArguments()
+	arguments.BlockContainer = &mock.BlockContainerStub{
+		GetCalled: func(headerType core.HeaderType) (dataBlock.EmptyBlockCreator, error) {
+			return dataBlock.NewEmptyHeaderCreator(), nil
+		},
+	}
+	arguments.ElasticProcessor = &mock.ElasticProcessorStub{
+		SaveHeaderCalled: func(outportBlockWithHeader *outport.OutportBlockWithHeader) error {
+			close(savingHeader)
+			<-releaseHeader
+			addEvent("block")
+			return nil
+		},
+		PrepareMainChainTokensSyncCalled: func() (*indexerData.TokensSyncReport, *indexerData.BufferSlice, error) {
+			return &indexerData.TokensSyncReport{}, indexerData.NewBufferSlice(indexerData.DefaultMaxBulkSize), nil
+		},
+		SaveMainChainTokensSyncCalled: func(buffSlice *indexerData.BufferSlice) error {
+			addEvent("tokens sync")
+			return nil
+		},
+	}
+	di, _ := NewDataIndexer(arguments)
+
+	headerBytes, _ := arguments.HeaderMarshaller.Marshal(&dataBlock.Header{})
+	blockSaved := make(chan error)
+	go func() {
+		blockSaved <- di.SaveBlock(&outport.OutportBlock{
+			BlockData: &outport.BlockData{
+				HeaderType:  string(core.ShardHeaderV1),
+				Body:        &dataBlock.Body{},
+				HeaderBytes: headerBytes,
+			},
+		})
+	}()
+	<-savingHeader
+
+	syncDone := make(chan error)
+	go func() {
+		_, err := di.SyncMainChainTokens()
+		syncDone <- err
+	}()
+
+	time.Sleep(50 * time.Millisecond)
+	close(releaseHeader)
+	require.Nil(t, <-blockSaved)
+	require.Nil(t, <-syncDone)
+	require.Equal(t, []string{"block", "tokens sync"}, events)
+}
+
+func TestDataIndexer_GetMainChainTokensSyncReportShouldReturnTheStoredReport(t *testing.T) {
+	t.Parallel()
+
+	storedReport := &indexerData.TokensSyncReport{CheckedTokens: 3, Checkpoint: "TKN-000003"}
+	getErr := errors.New("local error")
+	arguments := NewDataIndexerArguments()
+	arguments.ElasticProcessor = &mock.ElasticProcessorStub{
+		GetLastMainChainTokensSyncReportCalled: func() (*indexerData.TokensSyncReport, error) {
+			return storedReport, getErr
+		},
+	}
+	di, _ := NewDataIndexer(arguments)
+	require.Nil(t, di.GetMainChainTokensSyncReport())
+
+	getErr = nil
+	require.Equal(t, storedReport, di.GetMainChainTokensSyncReport())
+}
@@ -86,8 +86,63 @@ var ErrNilBalanceConverter = errors.New("nil balance converter")
 // ErrNilOperationsHandler signals that a nil operations handler has been provided
 var ErrNilOperationsHandler = errors.New("nil operations handler")
 
+// ErrNilTransfersHandler signals that a nil transfers handler has been provided
+var ErrNilTransfersHandler = errors.New("nil transfers handler")
+
+// ErrNilStatsHandler signals that a nil stats handler has been provided
+var ErrNilStatsHandler = errors.New("nil stats handler")
+
+// ErrNilContractStatsHandler signals that a nil contract stats handler has been provided
+var ErrNilContractStatsHandler = errors.New("nil contract stats handler")
+
+// ErrNilRelayerStatsHandler signals that a nil relayer stats handler has been provided
+var ErrNilRelayerStatsHandler = errors.New("nil relayer stats handler")
+
+// ErrNilUndelegationsHandler signals that a nil undelegations handler has been provided
+var ErrNilUndelegationsHandler = errors.New("nil undelegations handler")
+
 // ErrNilBlockContainerHandler signals that a nil block container handler has been provided
 var ErrNilBlockContainerHandler = errors.New("nil bock container handler")
 
 // ErrNilIndexTokensHandler signals that a nil index tokens handler has been provided
 var ErrNilIndexTokensHandler = errors.New("nil index tokens handler")
+
+// ErrNilBridgeTransfersHandler signals that a nil bridge transfers handler has been provided
+var ErrNilBridgeTransfersHandler = errors.New("nil bridge transfers handler")
+
+// ErrTokensSyncNotAvailable signals that the cross-chain tokens cannot be synchronized, the indexer does not run for a
+// sovereign chain or there is no main chain tokens source enabled
+var ErrTokensSyncNotAvailable = errors.New("main chain tokens synchronization is not available")
+
+// ErrNilMainChainTokensSource signals that a nil main chain tokens source has been provided
+var ErrNilMainChainTokensSource = errors.New("nil main chain tokens source")
+
+// ErrUnknownMainChainTokensSource signals that the configured main chain tokens source type is not known
+var ErrUnknownMainChainTokensSource = errors.New("unknown main chain tokens source")
+
+// ErrNoProxyUrlProvided signals that the url of the main chain proxy was not provided
+var ErrNoProxyUrlProvided = errors.New("no proxy url provided")
+
+// ErrProxyRequestFailed signals that a request to the main chain proxy has failed
+var ErrProxyRequestFailed = errors.New("proxy request failed")
+
+// ErrNilStreamPublisher signals that a nil stream publisher has been provided
+var ErrNilStreamPublisher = errors.New("nil stream publisher")
+
+// ErrNilEventsDecoder signals that a nil events decoder has been provided
+var ErrNilEventsDecoder = errors.New("nil events decoder")
+
+// ErrNilABIDecoder signals that a nil abi decoder has been provided
+var ErrNilABIDecoder = errors.New("nil abi decoder")
+
+// ErrNilNFTAttributesDecoder signals that a nil nft attributes decoder has been provided
+var ErrNilNFTAttributesDecoder = errors.New("nil nft attributes decoder")
+
+// ErrUnknownIndex signals that the provided index is not known by the indexer
+var ErrUnknownIndex = errors.New("unknown index")
+
+// ErrUnsupportedPubkeyConverterType signals that the configured public key converter type is not supported
+var ErrUnsupportedPubkeyConverterType = errors.New("unsupported public key converter type")
+
+// ErrMissingPrivileges signals that the configured credentials do not grant all the privileges required by the indexer
+var ErrMissingPrivileges = errors.New("missing privileges")
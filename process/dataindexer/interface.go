@@ -8,6 +8,8 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data/block"
 	"github.com/multiversx/mx-chain-core-go/data/outport"
 	"github.com/multiversx/mx-chain-core-go/marshal"
+
+	"github.com/multiversx/mx-chain-es-indexer-go/data"
 )
 
 // ElasticProcessor defines the interface for the elastic search indexer
@@ -23,7 +25,17 @@ type ElasticProcessor interface {
 	SaveRoundsInfo(rounds *outport.RoundsInfo) error
 	SaveShardValidatorsPubKeys(validatorsPubKeys *outport.ValidatorsPubKeys) error
 	SaveAccounts(accounts *outport.Accounts) error
+	SaveFinalizedBlock(finalizedBlock *outport.FinalizedBlock) error
 	SetOutportConfig(cfg outport.OutportConfig) error
+	EnableIndex(index string) error
+	DisableIndex(index string) error
+	GetEnabledIndexes() []string
+	GetStuckTransactions(maxAgeInSec uint64) ([]*data.StuckTransaction, error)
+	GetTokenRolesAtTimestamp(token string, timestamp uint64) (map[string][]string, error)
+	PrepareMainChainTokensSync() (*data.TokensSyncReport, *data.BufferSlice, error)
+	SaveMainChainTokensSync(buffSlice *data.BufferSlice) error
+	GetLastMainChainTokensSyncReport() (*data.TokensSyncReport, error)
+	SetBulkRequestMaxSize(bulkRequestMaxSize int)
 	IsInterfaceNil() bool
 }
 
@@ -58,6 +70,14 @@ type Indexer interface {
 	GetMarshaller() marshal.Marshalizer
 	RegisterHandler(handler func() error, topic string) error
 	SetCurrentSettings(cfg outport.OutportConfig) error
+	EnableIndex(index string) error
+	DisableIndex(index string) error
+	GetEnabledIndexes() []string
+	UpdateSettings(settings data.RuntimeSettings)
+	GetStuckTransactions(maxAgeInSec uint64) ([]*data.StuckTransaction, error)
+	GetTokenRolesAtTimestamp(token string, timestamp uint64) (map[string][]string, error)
+	SyncMainChainTokens() (*data.TokensSyncReport, error)
+	GetMainChainTokensSyncReport() *data.TokensSyncReport
 	Close() error
 	IsInterfaceNil() bool
 }
@@ -0,0 +1,344 @@
+package abi
+
+import (
+	"encoding/hex"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/alteredAccount"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	indexerCore "github.com/multiversx/mx-chain-es-indexer-go/core"
+	"github.com/multiversx/mx-chain-es-indexer-go/data"
+	"github.com/multiversx/mx-chain-es-indexer-go/process/dataindexer"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/multiversx/mx-chain-vm-common-go/parsers"
+)
+
+const (
+	// EventsFailuresKind is the label of the events decoding failures
+	EventsFailuresKind = "events"
+	// ArgumentsFailuresKind is the label of the smart contract call arguments decoding failures
+	ArgumentsFailuresKind = "arguments"
+)
+
+var log = logger.GetOrCreate("indexer/process/abi")
+
+// ContractBinding binds an ABI file to a contract address or to all the contracts with the provided code hash.
+// The events are always decoded, while the call arguments only if DecodeCallArgs is set
+type ContractBinding struct {
+	Address        string
+	CodeHash       string
+	ABIFile        string
+	DecodeCallArgs bool
+}
+
+// ArgsABIDecoder holds all the components needed to create a new instance of abiDecoder
+type ArgsABIDecoder struct {
+	Directory       string
+	Bindings        []ContractBinding
+	PubKeyConverter core.PubkeyConverter
+	Marshalizer     marshal.Marshalizer
+	StatusMetrics   indexerCore.StatusMetricsHandler
+}
+
+type eventInputs struct {
+	indexed    []*namedType
+	nonIndexed []*namedType
+}
+
+type namedType struct {
+	name     string
+	typeExpr *typeExpression
+}
+
+type contractABI struct {
+	codec     *codec
+	events    map[string]*eventInputs
+	endpoints map[string][]*namedType
+}
+
+type boundContract struct {
+	abi            *contractABI
+	decodeCallArgs bool
+}
+
+type abiDecoder struct {
+	mutex      sync.RWMutex
+	byAddress  map[string]*boundContract
+	byCodeHash map[string]*boundContract
+	// learnedAddresses holds the contracts bound by their code hash, a nil value marks a contract without abi
+	learnedAddresses   map[string]*boundContract
+	pubKeyConverter    core.PubkeyConverter
+	callArgsParser     vmcommon.CallArgsParser
+	esdtTransferParser vmcommon.ESDTTransferParser
+	statusMetrics      indexerCore.StatusMetricsHandler
+}
+
+// NewABIDecoder will create a new instance of abiDecoder, loading all the bound ABI files from the provided directory
+func NewABIDecoder(args ArgsABIDecoder) (*abiDecoder, error) {
+	if check.IfNil(args.PubKeyConverter) {
+		return nil, dataindexer.ErrNilPubkeyConverter
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, dataindexer.ErrNilMarshalizer
+	}
+
+	esdtTransferParser, err := parsers.NewESDTTransferParser(args.Marshalizer)
+	if err != nil {
+		return nil, err
+	}
+
+	ad := &abiDecoder{
+		byAddress:          make(map[string]*boundContract),
+		byCodeHash:         make(map[string]*boundContract),
+		learnedAddresses:   make(map[string]*boundContract),
+		pubKeyConverter:    args.PubKeyConverter,
+		callArgsParser:     parsers.NewCallArgsParser(),
+		esdtTransferParser: esdtTransferParser,
+		statusMetrics:      args.StatusMetrics,
+	}
+
+	loadedFiles := make(map[string]*contractABI)
+	for _, binding := range args.Bindings {
+		if binding.ABIFile == "" {
+			return nil, errNoABIFile
+		}
+		if binding.Address == "" && binding.CodeHash == "" {
+			return nil, fmt.Errorf("%w, abi file %s", errNoContractBinding, binding.ABIFile)
+		}
+
+		contract, found := loadedFiles[binding.ABIFile]
+		if !found {
+			contract, err = loadContractABI(filepath.Join(args.Directory, binding.ABIFile), args.PubKeyConverter)
+			if err != nil {
+				return nil, fmt.Errorf("%w while loading abi file %s", err, binding.ABIFile)
+			}
+			loadedFiles[binding.ABIFile] = contract
+		}
+
+		bound := &boundContract{
+			abi:            contract,
+			decodeCallArgs: binding.DecodeCallArgs,
+		}
+		if binding.Address != "" {
+			ad.byAddress[binding.Address] = bound
+		}
+		if binding.CodeHash != "" {
+			ad.byCodeHash[binding.CodeHash] = bound
+		}
+	}
+
+	return ad, nil
+}
+
+func loadContractABI(path string, pubKeyConverter core.PubkeyConverter) (*contractABI, error) {
+	definition, err := loadABIDefinition(path)
+	if err != nil {
+		return nil, err
+	}
+
+	contract := &contractABI{
+		codec: &codec{
+			types:           definition.Types,
+			pubKeyConverter: pubKeyConverter,
+		},
+		events:    make(map[string]*eventInputs, len(definition.Events)),
+		endpoints: make(map[string][]*namedType, len(definition.Endpoints)),
+	}
+
+	for _, event := range definition.Events {
+		inputs := &eventInputs{}
+		for _, input := range event.Inputs {
+			named, errParse := newNamedType(input)
+			if errParse != nil {
+				return nil, errParse
+			}
+
+			if input.Indexed {
+				inputs.indexed = append(inputs.indexed, named)
+			} else {
+				inputs.nonIndexed = append(inputs.nonIndexed, named)
+			}
+		}
+
+		contract.events[event.Identifier] = inputs
+	}
+
+	for _, endpoint := range definition.Endpoints {
+		inputs := make([]*namedType, 0, len(endpoint.Inputs))
+		for _, input := range endpoint.Inputs {
+			named, errParse := newNamedType(input)
+			if errParse != nil {
+				return nil, errParse
+			}
+
+			inputs = append(inputs, named)
+		}
+
+		contract.endpoints[endpoint.Name] = inputs
+	}
+
+	return contract, nil
+}
+
+func newNamedType(input *inputDefinition) (*namedType, error) {
+	typeExpr, err := parseTypeExpression(input.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	return &namedType{name: input.Name, typeExpr: typeExpr}, nil
+}
+
+// RegisterContractsCodeHashes will bind the altered smart contracts to the ABI configured for their code hash
+func (ad *abiDecoder) RegisterContractsCodeHashes(alteredAccounts map[string]*alteredAccount.AlteredAccount) {
+	if len(ad.byCodeHash) == 0 {
+		return
+	}
+
+	ad.mutex.Lock()
+	defer ad.mutex.Unlock()
+
+	for address, account := range alteredAccounts {
+		if account == nil || account.AdditionalData == nil || len(account.AdditionalData.CodeHash) == 0 {
+			continue
+		}
+
+		// the contract could have been upgraded to a code that has no abi
+		ad.learnedAddresses[address] = ad.byCodeHash[hex.EncodeToString(account.AdditionalData.CodeHash)]
+	}
+}
+
+// DecodeEvent will decode the topics and the data of an event emitted by a contract with a known ABI. It returns nil
+// if the contract or the event are not described by an ABI or if the decoding fails
+func (ad *abiDecoder) DecodeEvent(address string, topics [][]byte, eventData []byte) []*data.DecodedEventField {
+	if len(topics) == 0 {
+		return nil
+	}
+
+	bound, found := ad.getBoundContract(address)
+	if !found {
+		return nil
+	}
+
+	inputs, found := bound.abi.events[string(topics[0])]
+	if !found {
+		return nil
+	}
+
+	decoded, err := bound.abi.decodeEvent(inputs, topics[1:], eventData)
+	if err != nil {
+		log.Debug("abiDecoder.DecodeEvent: cannot decode event", "address", address, "identifier", string(topics[0]), "error", err)
+		ad.countFailure(EventsFailuresKind)
+		return nil
+	}
+
+	return decoded
+}
+
+func (ca *contractABI) decodeEvent(inputs *eventInputs, indexedTopics [][]byte, eventData []byte) ([]*data.DecodedEventField, error) {
+	if len(indexedTopics) != len(inputs.indexed) {
+		return nil, fmt.Errorf("%w: expected %d topics, got %d", errInvalidValue, len(inputs.indexed), len(indexedTopics))
+	}
+
+	decoded := make([]*data.DecodedEventField, 0, len(inputs.indexed)+len(inputs.nonIndexed))
+	for idx, input := range inputs.indexed {
+		value, err := ca.codec.decodeTop(input.typeExpr, indexedTopics[idx])
+		if err != nil {
+			return nil, fmt.Errorf("%w for %s", err, input.name)
+		}
+		decoded = append(decoded, newDecodedEventField(input, value))
+	}
+
+	switch len(inputs.nonIndexed) {
+	case 0:
+		return decoded, nil
+	case 1:
+		value, err := ca.codec.decodeTop(inputs.nonIndexed[0].typeExpr, eventData)
+		if err != nil {
+			return nil, fmt.Errorf("%w for %s", err, inputs.nonIndexed[0].name)
+		}
+		decoded = append(decoded, newDecodedEventField(inputs.nonIndexed[0], value))
+		return decoded, nil
+	}
+
+	rest := eventData
+	for _, input := range inputs.nonIndexed {
+		var value interface{}
+		var err error
+		value, rest, err = ca.codec.decodeNested(input.typeExpr, rest, 0)
+		if err != nil {
+			return nil, fmt.Errorf("%w for %s", err, input.name)
+		}
+		decoded = append(decoded, newDecodedEventField(input, value))
+	}
+	if len(rest) != 0 {
+		return nil, errTrailingData
+	}
+
+	return decoded, nil
+}
+
+// newDecodedEventField renders the value as the arguments of the calls are rendered and keeps the integers and the
+// booleans also typed. Big integers that do not fit a double lose precision only in the typed value
+func newDecodedEventField(input *namedType, value interface{}) *data.DecodedEventField {
+	field := &data.DecodedEventField{
+		Name:  input.name,
+		Type:  input.typeExpr.String(),
+		Value: renderArgumentValue(value),
+	}
+
+	switch typedValue := value.(type) {
+	case bool:
+		field.ValueBool = &typedValue
+	case int64, uint64, string:
+		if !isIntegerType(input.typeExpr) {
+			break
+		}
+		num, err := strconv.ParseFloat(field.Value, 64)
+		if err == nil {
+			field.ValueNum = &num
+		}
+	}
+
+	return field
+}
+
+func isIntegerType(typeExpr *typeExpression) bool {
+	for typeExpr.name == "Option" && len(typeExpr.args) == 1 {
+		typeExpr = typeExpr.args[0]
+	}
+
+	return isFixedSizeInteger(typeExpr.name) || typeExpr.name == "BigUint" || typeExpr.name == "BigInt"
+}
+
+func (ad *abiDecoder) getBoundContract(address string) (*boundContract, bool) {
+	bound, found := ad.byAddress[address]
+	if found {
+		return bound, true
+	}
+
+	ad.mutex.RLock()
+	defer ad.mutex.RUnlock()
+
+	bound = ad.learnedAddresses[address]
+	return bound, bound != nil
+}
+
+func (ad *abiDecoder) countFailure(kind string) {
+	if check.IfNil(ad.statusMetrics) {
+		return
+	}
+
+	ad.statusMetrics.AddABIDecodingFailures(kind, 1)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ad *abiDecoder) IsInterfaceNil() bool {
+	return ad == nil
+}
@@ -0,0 +1,235 @@
+package abi
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/pubkeyConverter"
+	"github.com/multiversx/mx-chain-core-go/data/alteredAccount"
+	"github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-es-indexer-go/data"
+	"github.com/multiversx/mx-chain-es-indexer-go/metrics"
+	"github.com/multiversx/mx-chain-es-indexer-go/mock"
+	"github.com/multiversx/mx-chain-es-indexer-go/process/dataindexer"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	pairAddress = "erd1qqqqqqqqqqqqqpgqeel2kumf0r8ffyhth7pqdujjat9nx0862jpsg2pqaq"
+	pairABIFile = "pair.abi.json"
+)
+
+var callerAddressBytes = bytes.Repeat([]byte{1}, 32)
+
+var stateDecoded = []*data.DecodedEventField{{Name: "state", Type: "State", Value: "Active"}}
+
+func createMockArgsABIDecoder() ArgsABIDecoder {
+	pubKeyConv, _ := pubkeyConverter.NewBech32PubkeyConverter(32, "erd")
+
+	return ArgsABIDecoder{
+		Directory:       "./testdata",
+		Bindings:        []ContractBinding{{Address: pairAddress, ABIFile: pairABIFile}},
+		PubKeyConverter: pubKeyConv,
+		Marshalizer:     &mock.MarshalizerMock{},
+		StatusMetrics:   metrics.NewStatusMetrics(),
+	}
+}
+
+func createSwapEventTopics() [][]byte {
+	return [][]byte{[]byte("swap"), []byte("WEGLD-bd4d79"), callerAddressBytes, {0x05}}
+}
+
+func TestNewABIDecoder(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil pub key converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsABIDecoder()
+		args.PubKeyConverter = nil
+		_, err := NewABIDecoder(args)
+		require.Equal(t, dataindexer.ErrNilPubkeyConverter, err)
+	})
+
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsABIDecoder()
+		args.Marshalizer = nil
+		_, err := NewABIDecoder(args)
+		require.Equal(t, dataindexer.ErrNilMarshalizer, err)
+	})
+
+	t.Run("binding without target should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsABIDecoder()
+		args.Bindings = []ContractBinding{{ABIFile: pairABIFile}}
+		_, err := NewABIDecoder(args)
+		require.True(t, errors.Is(err, errNoContractBinding))
+	})
+
+	t.Run("binding without abi file should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsABIDecoder()
+		args.Bindings = []ContractBinding{{Address: pairAddress}}
+		_, err := NewABIDecoder(args)
+		require.Equal(t, errNoABIFile, err)
+	})
+
+	t.Run("missing abi file should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsABIDecoder()
+		args.Bindings = []ContractBinding{{Address: pairAddress, ABIFile: "missing.abi.json"}}
+		_, err := NewABIDecoder(args)
+		require.NotNil(t, err)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		ad, err := NewABIDecoder(createMockArgsABIDecoder())
+		require.Nil(t, err)
+		require.False(t, ad.IsInterfaceNil())
+	})
+}
+
+func TestABIDecoder_DecodeEvent(t *testing.T) {
+	t.Parallel()
+
+	ad, _ := NewABIDecoder(createMockArgsABIDecoder())
+	swapData := []byte{
+		0x00, 0x00, 0x00, 0x01, 0x64,
+		0x00,
+		0x00, 0x00, 0x00, 0x00,
+		0x00,
+	}
+
+	decoded := ad.DecodeEvent(pairAddress, createSwapEventTopics(), swapData)
+	epoch := float64(5)
+	require.Equal(t, []*data.DecodedEventField{
+		{Name: "token_in", Type: "TokenIdentifier", Value: "WEGLD-bd4d79"},
+		{Name: "caller", Type: "Address", Value: "erd1qyqszqgpqyqszqgpqyqszqgpqyqszqgpqyqszqgpqyqszqgpqyqsl6e0p7"},
+		{Name: "epoch", Type: "u64", Value: "5", ValueNum: &epoch},
+		{Name: "swap_event", Type: "SwapEvent", Value: `{"action":"Nothing","amount_in":"100","fee":null,"reserves":[]}`},
+	}, decoded)
+
+	decoded = ad.DecodeEvent(pairAddress, [][]byte{[]byte("state"), {0x01}}, nil)
+	require.Equal(t, stateDecoded, decoded)
+}
+
+func TestNewDecodedEventField(t *testing.T) {
+	t.Parallel()
+
+	newInput := func(name string, typeName string) *namedType {
+		typeExpr, _ := parseTypeExpression(typeName)
+		return &namedType{name: name, typeExpr: typeExpr}
+	}
+
+	amount := float64(1000)
+	require.Equal(t, &data.DecodedEventField{Name: "amount", Type: "BigUint", Value: "1000", ValueNum: &amount},
+		newDecodedEventField(newInput("amount", "BigUint"), "1000"))
+
+	delta := float64(-3)
+	require.Equal(t, &data.DecodedEventField{Name: "delta", Type: "Option<i32>", Value: "-3", ValueNum: &delta},
+		newDecodedEventField(newInput("delta", "Option<i32>"), int64(-3)))
+
+	isActive := true
+	require.Equal(t, &data.DecodedEventField{Name: "active", Type: "bool", Value: "true", ValueBool: &isActive},
+		newDecodedEventField(newInput("active", "bool"), true))
+
+	require.Equal(t, &data.DecodedEventField{Name: "amounts", Type: "List<BigUint>", Value: `["1","2"]`},
+		newDecodedEventField(newInput("amounts", "List<BigUint>"), []interface{}{"1", "2"}))
+	require.Equal(t, &data.DecodedEventField{Name: "fee", Type: "Option<BigUint>", Value: ""},
+		newDecodedEventField(newInput("fee", "Option<BigUint>"), nil))
+}
+
+func TestABIDecoder_DecodeEventNotDescribedByABI(t *testing.T) {
+	t.Parallel()
+
+	ad, _ := NewABIDecoder(createMockArgsABIDecoder())
+
+	require.Nil(t, ad.DecodeEvent("erd1other", createSwapEventTopics(), nil))
+	require.Nil(t, ad.DecodeEvent(pairAddress, [][]byte{[]byte("unknown")}, nil))
+	require.Nil(t, ad.DecodeEvent(pairAddress, nil, nil))
+}
+
+func TestABIDecoder_DecodeEventFailuresAreCounted(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsABIDecoder()
+	statusMetrics := metrics.NewStatusMetrics()
+	args.StatusMetrics = statusMetrics
+	ad, _ := NewABIDecoder(args)
+
+	// missing indexed topic
+	require.Nil(t, ad.DecodeEvent(pairAddress, [][]byte{[]byte("swap"), []byte("WEGLD-bd4d79")}, nil))
+	// invalid data
+	require.Nil(t, ad.DecodeEvent(pairAddress, createSwapEventTopics(), []byte{0x01}))
+
+	require.Contains(t, statusMetrics.GetMetricsForPrometheus(), `indexer_abi_decoding_failures_total{kind="events"} 2`)
+}
+
+func TestABIDecoder_RegisterContractsCodeHashes(t *testing.T) {
+	t.Parallel()
+
+	codeHash := []byte("pair-code-hash")
+	args := createMockArgsABIDecoder()
+	args.Bindings = []ContractBinding{{CodeHash: hex.EncodeToString(codeHash), ABIFile: pairABIFile}}
+	ad, _ := NewABIDecoder(args)
+
+	stateTopics := [][]byte{[]byte("state"), {0x01}}
+	require.Nil(t, ad.DecodeEvent(pairAddress, stateTopics, nil))
+
+	ad.RegisterContractsCodeHashes(map[string]*alteredAccount.AlteredAccount{
+		pairAddress: {Address: pairAddress, AdditionalData: &alteredAccount.AdditionalAccountData{CodeHash: codeHash}},
+		"erd1user":  {Address: "erd1user"},
+	})
+	require.Equal(t, stateDecoded, ad.DecodeEvent(pairAddress, stateTopics, nil))
+
+	// upgraded to a code without abi
+	ad.RegisterContractsCodeHashes(map[string]*alteredAccount.AlteredAccount{
+		pairAddress: {Address: pairAddress, AdditionalData: &alteredAccount.AdditionalAccountData{CodeHash: []byte("other")}},
+	})
+	require.Nil(t, ad.DecodeEvent(pairAddress, stateTopics, nil))
+}
+
+func TestABIDecoder_ResolveCodeHashesOfContractsNotAltered(t *testing.T) {
+	t.Parallel()
+
+	codeHash := []byte("pair-code-hash")
+	args := createMockArgsABIDecoder()
+	args.Bindings = []ContractBinding{{CodeHash: hex.EncodeToString(codeHash), ABIFile: pairABIFile}}
+	ad, _ := NewABIDecoder(args)
+
+	pairAddressBytes, _ := args.PubKeyConverter.Decode(pairAddress)
+	otherContractBytes := append(make([]byte, 10), bytes.Repeat([]byte{2}, 22)...)
+	otherContract := args.PubKeyConverter.SilentEncode(otherContractBytes, log)
+	pool := &outport.TransactionPool{
+		Transactions: map[string]*outport.TxInfo{
+			"tx": {Transaction: &transaction.Transaction{RcvAddr: otherContractBytes}},
+		},
+		Logs: []*outport.LogData{
+			{Log: &transaction.Log{Events: []*transaction.Event{{Address: pairAddressBytes}, {Address: callerAddressBytes}}}},
+		},
+	}
+	unresolved := ad.GetUnresolvedContracts(pool)
+	require.ElementsMatch(t, []string{pairAddress, otherContract}, unresolved)
+
+	ad.RegisterResolvedCodeHashes(unresolved, map[string][]byte{pairAddress: codeHash})
+	require.Empty(t, ad.GetUnresolvedContracts(pool))
+
+	stateTopics := [][]byte{[]byte("state"), {0x01}}
+	require.Equal(t, stateDecoded, ad.DecodeEvent(pairAddress, stateTopics, nil))
+	require.Nil(t, ad.DecodeEvent(otherContract, stateTopics, nil))
+
+	// without code hash bindings there is nothing to resolve
+	args.Bindings = []ContractBinding{{Address: pairAddress, ABIFile: pairABIFile}}
+	ad, _ = NewABIDecoder(args)
+	require.Nil(t, ad.GetUnresolvedContracts(pool))
+}
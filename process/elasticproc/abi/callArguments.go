@@ -0,0 +1,193 @@
+package abi
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+
+	"github.com/multiversx/mx-chain-es-indexer-go/data"
+)
+
+// DecodeCallArguments will decode the arguments of a smart contract call, based on the ABI of the called contract.
+// The calls wrapped in ESDT transfers are unwrapped first. It returns nil if the call arguments decoding is not
+// enabled for the contract, if the endpoint is not described by the ABI or if the decoding fails
+func (ad *abiDecoder) DecodeCallArguments(sender []byte, receiver []byte, dataField []byte) []*data.DecodedArg {
+	if len(ad.byAddress) == 0 && len(ad.byCodeHash) == 0 {
+		return nil
+	}
+
+	function, args, err := ad.callArgsParser.ParseData(string(dataField))
+	if err != nil {
+		return nil
+	}
+
+	contractAddress := receiver
+	if isESDTTransfer(function) {
+		parsedTransfers, errParse := ad.esdtTransferParser.ParseESDTTransfers(sender, receiver, function, args)
+		if errParse != nil {
+			return nil
+		}
+
+		contractAddress = parsedTransfers.RcvAddr
+		function = parsedTransfers.CallFunction
+		args = parsedTransfers.CallArgs
+	}
+	if function == "" || !core.IsSmartContractAddress(contractAddress) {
+		return nil
+	}
+
+	address := ad.pubKeyConverter.SilentEncode(contractAddress, log)
+	bound, found := ad.getBoundContract(address)
+	if !found || !bound.decodeCallArgs {
+		return nil
+	}
+
+	inputs, found := bound.abi.endpoints[function]
+	if !found {
+		return nil
+	}
+
+	decoded, err := bound.abi.decodeCallArguments(inputs, args)
+	if err != nil {
+		log.Debug("abiDecoder.DecodeCallArguments: cannot decode arguments", "address", address, "function", function, "error", err)
+		ad.countFailure(ArgumentsFailuresKind)
+		return nil
+	}
+
+	return decoded
+}
+
+func isESDTTransfer(function string) bool {
+	switch function {
+	case core.BuiltInFunctionESDTTransfer, core.BuiltInFunctionESDTNFTTransfer, core.BuiltInFunctionMultiESDTNFTTransfer:
+		return true
+	default:
+		return false
+	}
+}
+
+func (ca *contractABI) decodeCallArguments(inputs []*namedType, args [][]byte) ([]*data.DecodedArg, error) {
+	decoded := make([]*data.DecodedArg, 0, len(inputs))
+	for _, input := range inputs {
+		value, rest, isPresent, err := ca.decodeMultiValue(input.typeExpr, args)
+		if err != nil {
+			return nil, fmt.Errorf("%w for %s", err, input.name)
+		}
+
+		args = rest
+		if !isPresent {
+			continue
+		}
+
+		decoded = append(decoded, &data.DecodedArg{
+			Name:  input.name,
+			Type:  input.typeExpr.String(),
+			Value: renderArgumentValue(value),
+		})
+	}
+	if len(args) != 0 {
+		return nil, fmt.Errorf("%w: %d arguments left", errTrailingData, len(args))
+	}
+
+	return decoded, nil
+}
+
+// decodeMultiValue decodes a value that can span over several arguments, e.g. "variadic<multi<Address,BigUint>>",
+// and returns the remaining arguments. Absent optional values are reported as not present
+func (ca *contractABI) decodeMultiValue(typeExpr *typeExpression, args [][]byte) (interface{}, [][]byte, bool, error) {
+	switch typeExpr.name {
+	case "optional":
+		if len(typeExpr.args) != 1 {
+			return nil, nil, false, fmt.Errorf("%w: %s", errInvalidTypeExpression, typeExpr)
+		}
+		if len(args) == 0 {
+			return nil, args, false, nil
+		}
+		return ca.decodeMultiValue(typeExpr.args[0], args)
+	case "variadic":
+		if len(typeExpr.args) != 1 {
+			return nil, nil, false, fmt.Errorf("%w: %s", errInvalidTypeExpression, typeExpr)
+		}
+		return ca.decodeMultiValueItems(typeExpr.args[0], args, -1)
+	case "counted-variadic":
+		if len(typeExpr.args) != 1 {
+			return nil, nil, false, fmt.Errorf("%w: %s", errInvalidTypeExpression, typeExpr)
+		}
+		if len(args) == 0 {
+			return nil, nil, false, errNotEnoughData
+		}
+		if len(args[0]) > fixedSizeIntegers["u32"] {
+			return nil, nil, false, fmt.Errorf("%w for the items count", errInvalidValue)
+		}
+		return ca.decodeMultiValueItems(typeExpr.args[0], args[1:], int(decodeUnsigned(args[0])))
+	case "multi":
+		values := make([]interface{}, 0, len(typeExpr.args))
+		for _, itemType := range typeExpr.args {
+			value, rest, isPresent, err := ca.decodeMultiValue(itemType, args)
+			if err != nil {
+				return nil, nil, false, err
+			}
+			if isPresent {
+				values = append(values, value)
+			}
+			args = rest
+		}
+		return values, args, true, nil
+	}
+
+	if len(args) == 0 {
+		return nil, nil, false, errNotEnoughData
+	}
+
+	value, err := ca.codec.decodeTop(typeExpr, args[0])
+	if err != nil {
+		return nil, nil, false, err
+	}
+
+	return value, args[1:], true, nil
+}
+
+// decodeMultiValueItems decodes the provided number of items, or all the remaining arguments if numItems is negative
+func (ca *contractABI) decodeMultiValueItems(itemType *typeExpression, args [][]byte, numItems int) (interface{}, [][]byte, bool, error) {
+	if numItems > maxListItems {
+		return nil, nil, false, fmt.Errorf("%w: too many items", errInvalidValue)
+	}
+
+	items := make([]interface{}, 0)
+	for (numItems < 0 && len(args) > 0) || len(items) < numItems {
+		if len(items) >= maxListItems {
+			return nil, nil, false, fmt.Errorf("%w: too many items", errInvalidValue)
+		}
+
+		item, rest, _, err := ca.decodeMultiValue(itemType, args)
+		if err != nil {
+			return nil, nil, false, err
+		}
+		if len(rest) == len(args) {
+			return nil, nil, false, fmt.Errorf("%w: %s consumes no argument", errInvalidTypeExpression, itemType)
+		}
+
+		items = append(items, item)
+		args = rest
+	}
+
+	return items, args, true, nil
+}
+
+// renderArgumentValue renders a decoded value as string: scalars as they are, composite values as JSON
+func renderArgumentValue(value interface{}) string {
+	switch typedValue := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return typedValue
+	}
+
+	valueBytes, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+
+	return string(valueBytes)
+}
@@ -0,0 +1,157 @@
+package abi
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/pubkeyConverter"
+	"github.com/multiversx/mx-chain-es-indexer-go/data"
+	"github.com/multiversx/mx-chain-es-indexer-go/metrics"
+	"github.com/stretchr/testify/require"
+)
+
+const callerAddress = "erd1qyqszqgpqyqszqgpqyqszqgpqyqszqgpqyqszqgpqyqszqgpqyqsl6e0p7"
+
+func createArgsWithCallArgsDecoding() ArgsABIDecoder {
+	args := createMockArgsABIDecoder()
+	args.Bindings = []ContractBinding{{Address: pairAddress, ABIFile: pairABIFile, DecodeCallArgs: true}}
+
+	return args
+}
+
+func pairAddressBytes(t *testing.T) []byte {
+	pubKeyConv, _ := pubkeyConverter.NewBech32PubkeyConverter(32, "erd")
+	addressBytes, err := pubKeyConv.Decode(pairAddress)
+	require.Nil(t, err)
+
+	return addressBytes
+}
+
+func buildDataField(function string, args ...[]byte) []byte {
+	tokens := []string{function}
+	for _, arg := range args {
+		tokens = append(tokens, hex.EncodeToString(arg))
+	}
+
+	return []byte(strings.Join(tokens, "@"))
+}
+
+func TestABIDecoder_DecodeCallArguments(t *testing.T) {
+	t.Parallel()
+
+	ad, _ := NewABIDecoder(createArgsWithCallArgsDecoding())
+	pairAddr := pairAddressBytes(t)
+
+	t.Run("direct call with optional argument", func(t *testing.T) {
+		t.Parallel()
+
+		dataField := buildDataField("setFeeOn", []byte{0x01}, callerAddressBytes, []byte("WEGLD-bd4d79"))
+		require.Equal(t, []*data.DecodedArg{
+			{Name: "enabled", Type: "bool", Value: "true"},
+			{Name: "fee_to_address", Type: "Address", Value: callerAddress},
+			{Name: "fee_token", Type: "optional<TokenIdentifier>", Value: "WEGLD-bd4d79"},
+		}, ad.DecodeCallArguments(callerAddressBytes, pairAddr, dataField))
+	})
+
+	t.Run("direct call without optional argument", func(t *testing.T) {
+		t.Parallel()
+
+		dataField := buildDataField("setFeeOn", []byte{}, callerAddressBytes)
+		require.Equal(t, []*data.DecodedArg{
+			{Name: "enabled", Type: "bool", Value: "false"},
+			{Name: "fee_to_address", Type: "Address", Value: callerAddress},
+		}, ad.DecodeCallArguments(callerAddressBytes, pairAddr, dataField))
+	})
+
+	t.Run("variadic multi arguments", func(t *testing.T) {
+		t.Parallel()
+
+		dataField := buildDataField("whitelist", callerAddressBytes, []byte{0x64}, callerAddressBytes, []byte{0x01, 0x00})
+		require.Equal(t, []*data.DecodedArg{
+			{
+				Name:  "entries",
+				Type:  "variadic<multi<Address,BigUint>>",
+				Value: `[["` + callerAddress + `","100"],["` + callerAddress + `","256"]]`,
+			},
+		}, ad.DecodeCallArguments(callerAddressBytes, pairAddr, dataField))
+	})
+
+	t.Run("call wrapped in an ESDT transfer", func(t *testing.T) {
+		t.Parallel()
+
+		dataField := buildDataField("ESDTTransfer", []byte("WEGLD-bd4d79"), []byte{0x0a}, []byte("swapTokensFixedInput"), []byte("MEX-455c57"), []byte{0x05})
+		require.Equal(t, []*data.DecodedArg{
+			{Name: "token_out", Type: "TokenIdentifier", Value: "MEX-455c57"},
+			{Name: "amount_out_min", Type: "BigUint", Value: "5"},
+		}, ad.DecodeCallArguments(callerAddressBytes, pairAddr, dataField))
+	})
+
+	t.Run("call wrapped in a multi ESDT transfer", func(t *testing.T) {
+		t.Parallel()
+
+		dataField := buildDataField("MultiESDTNFTTransfer", pairAddr, []byte{0x01}, []byte("WEGLD-bd4d79"), []byte{}, []byte{0x0a},
+			[]byte("swapTokensFixedInput"), []byte("MEX-455c57"), []byte{0x05})
+		require.Equal(t, []*data.DecodedArg{
+			{Name: "token_out", Type: "TokenIdentifier", Value: "MEX-455c57"},
+			{Name: "amount_out_min", Type: "BigUint", Value: "5"},
+		}, ad.DecodeCallArguments(callerAddressBytes, callerAddressBytes, dataField))
+	})
+
+	t.Run("endpoint not described by the abi", func(t *testing.T) {
+		t.Parallel()
+
+		require.Nil(t, ad.DecodeCallArguments(callerAddressBytes, pairAddr, buildDataField("unknown", []byte{0x01})))
+	})
+
+	t.Run("transfer without a call", func(t *testing.T) {
+		t.Parallel()
+
+		dataField := buildDataField("ESDTTransfer", []byte("WEGLD-bd4d79"), []byte{0x0a})
+		require.Nil(t, ad.DecodeCallArguments(callerAddressBytes, pairAddr, dataField))
+	})
+}
+
+func TestABIDecoder_DecodeCallArgumentsDisabledByDefault(t *testing.T) {
+	t.Parallel()
+
+	ad, _ := NewABIDecoder(createMockArgsABIDecoder())
+
+	dataField := buildDataField("setFeeOn", []byte{0x01}, callerAddressBytes)
+	require.Nil(t, ad.DecodeCallArguments(callerAddressBytes, pairAddressBytes(t), dataField))
+}
+
+func TestABIDecoder_DecodeCallArgumentsFailuresAreCounted(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsWithCallArgsDecoding()
+	statusMetrics := metrics.NewStatusMetrics()
+	args.StatusMetrics = statusMetrics
+	ad, _ := NewABIDecoder(args)
+	pairAddr := pairAddressBytes(t)
+
+	// invalid bool
+	require.Nil(t, ad.DecodeCallArguments(callerAddressBytes, pairAddr, buildDataField("setFeeOn", []byte{0x02}, callerAddressBytes)))
+	// missing argument
+	require.Nil(t, ad.DecodeCallArguments(callerAddressBytes, pairAddr, buildDataField("setFeeOn", []byte{0x01})))
+	// too many arguments
+	require.Nil(t, ad.DecodeCallArguments(callerAddressBytes, pairAddr, buildDataField("setFeeOn", []byte{0x01}, callerAddressBytes, []byte("A"), []byte("B"))))
+
+	require.Contains(t, statusMetrics.GetMetricsForPrometheus(), `indexer_abi_decoding_failures_total{kind="arguments"} 3`)
+}
+
+func TestContractABI_DecodeCountedVariadic(t *testing.T) {
+	t.Parallel()
+
+	contract := &contractABI{codec: &codec{}}
+	typeExpr, _ := parseTypeExpression("counted-variadic<u32>")
+
+	value, rest, isPresent, err := contract.decodeMultiValue(typeExpr, [][]byte{{0x02}, {0x07}, {0x08}, {0x09}})
+	require.Nil(t, err)
+	require.True(t, isPresent)
+	require.Equal(t, []interface{}{uint64(7), uint64(8)}, value)
+	require.Equal(t, [][]byte{{0x09}}, rest)
+
+	_, _, _, err = contract.decodeMultiValue(typeExpr, [][]byte{{0x03}, {0x07}})
+	require.Equal(t, errNotEnoughData, err)
+}
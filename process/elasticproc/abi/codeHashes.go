@@ -0,0 +1,80 @@
+package abi
+
+import (
+	"encoding/hex"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/outport"
+)
+
+// GetUnresolvedContracts returns the smart contracts that emitted events or were called in the provided pool and whose
+// code hash is not known yet. It returns nil if no abi is bound to a code hash
+func (ad *abiDecoder) GetUnresolvedContracts(pool *outport.TransactionPool) []string {
+	if len(ad.byCodeHash) == 0 || pool == nil {
+		return nil
+	}
+
+	contracts := make(map[string]struct{})
+	for _, logData := range pool.Logs {
+		if logData == nil || logData.Log == nil {
+			continue
+		}
+
+		for _, event := range logData.Log.Events {
+			if event != nil {
+				ad.addUnresolvedContract(contracts, event.Address)
+			}
+		}
+	}
+	for _, txInfo := range pool.Transactions {
+		if txInfo != nil && txInfo.Transaction != nil {
+			ad.addUnresolvedContract(contracts, txInfo.Transaction.RcvAddr)
+		}
+	}
+	for _, scrInfo := range pool.SmartContractResults {
+		if scrInfo != nil && scrInfo.SmartContractResult != nil {
+			ad.addUnresolvedContract(contracts, scrInfo.SmartContractResult.RcvAddr)
+		}
+	}
+
+	unresolved := make([]string, 0, len(contracts))
+	for address := range contracts {
+		unresolved = append(unresolved, address)
+	}
+
+	return unresolved
+}
+
+func (ad *abiDecoder) addUnresolvedContract(contracts map[string]struct{}, addressBytes []byte) {
+	if !core.IsSmartContractAddress(addressBytes) {
+		return
+	}
+
+	address := ad.pubKeyConverter.SilentEncode(addressBytes, log)
+	_, isBoundByAddress := ad.byAddress[address]
+	if isBoundByAddress {
+		return
+	}
+
+	ad.mutex.RLock()
+	_, isResolved := ad.learnedAddresses[address]
+	ad.mutex.RUnlock()
+	if !isResolved {
+		contracts[address] = struct{}{}
+	}
+}
+
+// RegisterResolvedCodeHashes will bind the provided contracts to the ABI configured for the code hashes read from the
+// database. The contracts without a code hash are remembered as contracts without abi, until their code is altered
+func (ad *abiDecoder) RegisterResolvedCodeHashes(addresses []string, codeHashes map[string][]byte) {
+	if len(ad.byCodeHash) == 0 {
+		return
+	}
+
+	ad.mutex.Lock()
+	defer ad.mutex.Unlock()
+
+	for _, address := range addresses {
+		ad.learnedAddresses[address] = ad.byCodeHash[hex.EncodeToString(codeHashes[address])]
+	}
+}
@@ -0,0 +1,456 @@
+package abi
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+)
+
+const (
+	addressLength   = 32
+	lengthPrefixLen = 4
+	maxDepth        = 16
+	maxListItems    = 10000
+)
+
+var fixedSizeIntegers = map[string]int{
+	"u8":    1,
+	"u16":   2,
+	"u32":   4,
+	"u64":   8,
+	"usize": 4,
+	"i8":    1,
+	"i16":   2,
+	"i32":   4,
+	"i64":   8,
+	"isize": 4,
+}
+
+var stringTypes = map[string]struct{}{
+	"TokenIdentifier":           {},
+	"EgldOrEsdtTokenIdentifier": {},
+	"utf-8 string":              {},
+	"String":                    {},
+	"&str":                      {},
+	"str":                       {},
+}
+
+var bytesTypes = map[string]struct{}{
+	"bytes":         {},
+	"ManagedBuffer": {},
+	"BoxedBytes":    {},
+	"&[u8]":         {},
+}
+
+// codec decodes the values serialized with the smart contracts framework's encoding. Integers that fit in 32 bits are
+// rendered as numbers, bigger integers as decimal strings, addresses as bech32 and raw bytes as hex.
+type codec struct {
+	types           map[string]*typeDefinition
+	pubKeyConverter core.PubkeyConverter
+}
+
+// decodeTop decodes a value that occupies the whole provided buffer, e.g. an argument or a topic
+func (c *codec) decodeTop(typeExpr *typeExpression, encoded []byte) (interface{}, error) {
+	switch {
+	case isFixedSizeInteger(typeExpr.name):
+		if len(encoded) > fixedSizeIntegers[typeExpr.name] {
+			return nil, fmt.Errorf("%w for %s", errInvalidValue, typeExpr.name)
+		}
+		return renderInteger(typeExpr.name, encoded), nil
+	case typeExpr.name == "BigUint":
+		return new(big.Int).SetBytes(encoded).String(), nil
+	case typeExpr.name == "BigInt":
+		return signedBigInt(encoded).String(), nil
+	case typeExpr.name == "bool":
+		return decodeTopBool(encoded)
+	case isString(typeExpr.name):
+		return renderString(encoded), nil
+	case isBytes(typeExpr.name):
+		return hex.EncodeToString(encoded), nil
+	case typeExpr.name == "Option" || typeExpr.name == "optional":
+		if len(typeExpr.args) != 1 {
+			return nil, fmt.Errorf("%w: %s", errInvalidTypeExpression, typeExpr)
+		}
+		if len(encoded) == 0 {
+			return nil, nil
+		}
+		if typeExpr.name == "optional" {
+			return c.decodeTop(typeExpr.args[0], encoded)
+		}
+	case typeExpr.name == "List" || typeExpr.name == "vec":
+		if len(typeExpr.args) != 1 {
+			return nil, fmt.Errorf("%w: %s", errInvalidTypeExpression, typeExpr)
+		}
+		return c.decodeItemsUntilEnd(typeExpr.args[0], encoded)
+	default:
+		typeDef, isCustom := c.types[typeExpr.name]
+		if isCustom && typeDef.Type == "enum" && isFieldlessEnum(typeDef) {
+			if len(encoded) > 1 {
+				return nil, fmt.Errorf("%w for %s", errInvalidValue, typeExpr.name)
+			}
+			return renderEnumVariant(typeDef, typeExpr.name, decodeUnsigned(encoded))
+		}
+	}
+
+	value, rest, err := c.decodeNested(typeExpr, encoded, 0)
+	if err != nil {
+		return nil, err
+	}
+	if len(rest) != 0 {
+		return nil, fmt.Errorf("%w for %s", errTrailingData, typeExpr)
+	}
+
+	return value, nil
+}
+
+func (c *codec) decodeItemsUntilEnd(itemType *typeExpression, encoded []byte) (interface{}, error) {
+	items := make([]interface{}, 0)
+	for len(encoded) > 0 {
+		if len(items) >= maxListItems {
+			return nil, fmt.Errorf("%w: too many items", errInvalidValue)
+		}
+
+		item, rest, err := c.decodeNested(itemType, encoded, 1)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, item)
+		encoded = rest
+	}
+
+	return items, nil
+}
+
+// decodeNested decodes a value placed inside a bigger buffer and returns the remaining bytes
+func (c *codec) decodeNested(typeExpr *typeExpression, encoded []byte, depth int) (interface{}, []byte, error) {
+	if depth > maxDepth {
+		return nil, nil, errMaxDepthReached
+	}
+
+	switch {
+	case isFixedSizeInteger(typeExpr.name):
+		size := fixedSizeIntegers[typeExpr.name]
+		value, rest, err := take(encoded, size)
+		if err != nil {
+			return nil, nil, err
+		}
+		return renderInteger(typeExpr.name, value), rest, nil
+	case typeExpr.name == "BigUint" || typeExpr.name == "BigInt":
+		value, rest, err := takeWithLengthPrefix(encoded)
+		if err != nil {
+			return nil, nil, err
+		}
+		if typeExpr.name == "BigInt" {
+			return signedBigInt(value).String(), rest, nil
+		}
+		return new(big.Int).SetBytes(value).String(), rest, nil
+	case typeExpr.name == "bool":
+		value, rest, err := take(encoded, 1)
+		if err != nil {
+			return nil, nil, err
+		}
+		decoded, err := decodeTopBool(value)
+		return decoded, rest, err
+	case typeExpr.name == "Address":
+		value, rest, err := take(encoded, addressLength)
+		if err != nil {
+			return nil, nil, err
+		}
+		return c.pubKeyConverter.SilentEncode(value, log), rest, nil
+	case isString(typeExpr.name):
+		value, rest, err := takeWithLengthPrefix(encoded)
+		if err != nil {
+			return nil, nil, err
+		}
+		return renderString(value), rest, nil
+	case isBytes(typeExpr.name):
+		value, rest, err := takeWithLengthPrefix(encoded)
+		if err != nil {
+			return nil, nil, err
+		}
+		return hex.EncodeToString(value), rest, nil
+	case typeExpr.name == "H256":
+		value, rest, err := take(encoded, 32)
+		if err != nil {
+			return nil, nil, err
+		}
+		return hex.EncodeToString(value), rest, nil
+	case typeExpr.name == "Option":
+		return c.decodeNestedOption(typeExpr, encoded, depth)
+	case typeExpr.name == "List" || typeExpr.name == "vec":
+		return c.decodeNestedList(typeExpr, encoded, depth)
+	case typeExpr.name == "tuple":
+		return c.decodeNestedSequence(typeExpr.args, encoded, depth)
+	case strings.HasPrefix(typeExpr.name, "array"):
+		return c.decodeNestedArray(typeExpr, encoded, depth)
+	default:
+		return c.decodeNestedCustom(typeExpr, encoded, depth)
+	}
+}
+
+func (c *codec) decodeNestedOption(typeExpr *typeExpression, encoded []byte, depth int) (interface{}, []byte, error) {
+	if len(typeExpr.args) != 1 {
+		return nil, nil, fmt.Errorf("%w: %s", errInvalidTypeExpression, typeExpr)
+	}
+
+	flag, rest, err := take(encoded, 1)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	switch flag[0] {
+	case 0:
+		return nil, rest, nil
+	case 1:
+		return c.decodeNested(typeExpr.args[0], rest, depth+1)
+	default:
+		return nil, nil, fmt.Errorf("%w: option flag %d", errInvalidValue, flag[0])
+	}
+}
+
+func (c *codec) decodeNestedList(typeExpr *typeExpression, encoded []byte, depth int) (interface{}, []byte, error) {
+	if len(typeExpr.args) != 1 {
+		return nil, nil, fmt.Errorf("%w: %s", errInvalidTypeExpression, typeExpr)
+	}
+
+	lengthBytes, rest, err := take(encoded, lengthPrefixLen)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	numItems := binary.BigEndian.Uint32(lengthBytes)
+	if numItems > maxListItems {
+		return nil, nil, fmt.Errorf("%w: too many items", errInvalidValue)
+	}
+
+	items := make([]interface{}, 0, numItems)
+	for i := uint32(0); i < numItems; i++ {
+		var item interface{}
+		item, rest, err = c.decodeNested(typeExpr.args[0], rest, depth+1)
+		if err != nil {
+			return nil, nil, err
+		}
+		items = append(items, item)
+	}
+
+	return items, rest, nil
+}
+
+func (c *codec) decodeNestedArray(typeExpr *typeExpression, encoded []byte, depth int) (interface{}, []byte, error) {
+	numItems, err := strconv.Atoi(strings.TrimPrefix(typeExpr.name, "array"))
+	if err != nil || numItems > maxListItems || len(typeExpr.args) != 1 {
+		return nil, nil, fmt.Errorf("%w: %s", errUnknownType, typeExpr)
+	}
+
+	if typeExpr.args[0].name == "u8" {
+		value, rest, errTake := take(encoded, numItems)
+		if errTake != nil {
+			return nil, nil, errTake
+		}
+		return hex.EncodeToString(value), rest, nil
+	}
+
+	items := make([]interface{}, 0, numItems)
+	rest := encoded
+	for i := 0; i < numItems; i++ {
+		var item interface{}
+		item, rest, err = c.decodeNested(typeExpr.args[0], rest, depth+1)
+		if err != nil {
+			return nil, nil, err
+		}
+		items = append(items, item)
+	}
+
+	return items, rest, nil
+}
+
+func (c *codec) decodeNestedSequence(types []*typeExpression, encoded []byte, depth int) (interface{}, []byte, error) {
+	items := make([]interface{}, 0, len(types))
+	rest := encoded
+	for _, itemType := range types {
+		var item interface{}
+		var err error
+		item, rest, err = c.decodeNested(itemType, rest, depth+1)
+		if err != nil {
+			return nil, nil, err
+		}
+		items = append(items, item)
+	}
+
+	return items, rest, nil
+}
+
+func (c *codec) decodeNestedCustom(typeExpr *typeExpression, encoded []byte, depth int) (interface{}, []byte, error) {
+	typeDef, found := c.types[typeExpr.name]
+	if !found {
+		return nil, nil, fmt.Errorf("%w: %s", errUnknownType, typeExpr.name)
+	}
+
+	switch typeDef.Type {
+	case "struct":
+		return c.decodeNestedFields(typeDef.Fields, encoded, depth)
+	case "enum":
+		discriminant, rest, err := take(encoded, 1)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		variant, err := findVariant(typeDef, typeExpr.name, int(discriminant[0]))
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(variant.Fields) == 0 {
+			return variant.Name, rest, nil
+		}
+
+		fields, rest, err := c.decodeNestedFields(variant.Fields, rest, depth)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return map[string]interface{}{"variant": variant.Name, "fields": fields}, rest, nil
+	default:
+		return nil, nil, fmt.Errorf("%w: %s of kind %s", errUnknownType, typeExpr.name, typeDef.Type)
+	}
+}
+
+func (c *codec) decodeNestedFields(fields []*fieldDefinition, encoded []byte, depth int) (map[string]interface{}, []byte, error) {
+	decoded := make(map[string]interface{}, len(fields))
+	rest := encoded
+	for _, field := range fields {
+		fieldType, err := parseTypeExpression(field.Type)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		var value interface{}
+		value, rest, err = c.decodeNested(fieldType, rest, depth+1)
+		if err != nil {
+			return nil, nil, err
+		}
+		decoded[field.Name] = value
+	}
+
+	return decoded, rest, nil
+}
+
+func findVariant(typeDef *typeDefinition, typeName string, discriminant int) (*variantDefinition, error) {
+	for _, variant := range typeDef.Variants {
+		if variant.Discriminant == discriminant {
+			return variant, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: discriminant %d for %s", errInvalidValue, discriminant, typeName)
+}
+
+func renderEnumVariant(typeDef *typeDefinition, typeName string, discriminant uint64) (interface{}, error) {
+	variant, err := findVariant(typeDef, typeName, int(discriminant))
+	if err != nil {
+		return nil, err
+	}
+
+	return variant.Name, nil
+}
+
+func isFieldlessEnum(typeDef *typeDefinition) bool {
+	for _, variant := range typeDef.Variants {
+		if len(variant.Fields) > 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+func take(encoded []byte, size int) ([]byte, []byte, error) {
+	if len(encoded) < size {
+		return nil, nil, errNotEnoughData
+	}
+
+	return encoded[:size], encoded[size:], nil
+}
+
+func takeWithLengthPrefix(encoded []byte) ([]byte, []byte, error) {
+	lengthBytes, rest, err := take(encoded, lengthPrefixLen)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return take(rest, int(binary.BigEndian.Uint32(lengthBytes)))
+}
+
+func isFixedSizeInteger(name string) bool {
+	_, found := fixedSizeIntegers[name]
+	return found
+}
+
+func isString(name string) bool {
+	_, found := stringTypes[name]
+	return found
+}
+
+func isBytes(name string) bool {
+	_, found := bytesTypes[name]
+	return found
+}
+
+func decodeUnsigned(encoded []byte) uint64 {
+	value := uint64(0)
+	for _, b := range encoded {
+		value = value<<8 | uint64(b)
+	}
+
+	return value
+}
+
+func signedBigInt(encoded []byte) *big.Int {
+	value := new(big.Int).SetBytes(encoded)
+	if len(encoded) > 0 && encoded[0]&0x80 != 0 {
+		value.Sub(value, new(big.Int).Lsh(big.NewInt(1), uint(len(encoded)*8)))
+	}
+
+	return value
+}
+
+func renderInteger(name string, encoded []byte) interface{} {
+	isSigned := strings.HasPrefix(name, "i")
+	fitsInNumber := fixedSizeIntegers[name] <= 4
+
+	switch {
+	case isSigned && fitsInNumber:
+		return signedBigInt(encoded).Int64()
+	case isSigned:
+		return signedBigInt(encoded).String()
+	case fitsInNumber:
+		return decodeUnsigned(encoded)
+	default:
+		return strconv.FormatUint(decodeUnsigned(encoded), 10)
+	}
+}
+
+func decodeTopBool(encoded []byte) (bool, error) {
+	switch {
+	case len(encoded) == 0 || (len(encoded) == 1 && encoded[0] == 0):
+		return false, nil
+	case len(encoded) == 1 && encoded[0] == 1:
+		return true, nil
+	default:
+		return false, fmt.Errorf("%w for bool", errInvalidValue)
+	}
+}
+
+func renderString(encoded []byte) string {
+	if utf8.Valid(encoded) {
+		return string(encoded)
+	}
+
+	return hex.EncodeToString(encoded)
+}
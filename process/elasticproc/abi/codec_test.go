@@ -0,0 +1,129 @@
+package abi
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/pubkeyConverter"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestCodec(t *testing.T) *codec {
+	pubKeyConv, _ := pubkeyConverter.NewBech32PubkeyConverter(32, "erd")
+	definition, err := loadABIDefinition("./testdata/pair.abi.json")
+	require.Nil(t, err)
+
+	return &codec{
+		types:           definition.Types,
+		pubKeyConverter: pubKeyConv,
+	}
+}
+
+func mustParse(t *testing.T, expression string) *typeExpression {
+	parsed, err := parseTypeExpression(expression)
+	require.Nil(t, err)
+
+	return parsed
+}
+
+func TestCodec_DecodeTop(t *testing.T) {
+	t.Parallel()
+
+	c := createTestCodec(t)
+
+	tests := []struct {
+		typeExpr string
+		encoded  []byte
+		expected interface{}
+	}{
+		{typeExpr: "u8", encoded: []byte{}, expected: uint64(0)},
+		{typeExpr: "u32", encoded: []byte{0x01, 0x00}, expected: uint64(256)},
+		{typeExpr: "u64", encoded: []byte{0x01, 0x00, 0x00, 0x00, 0x00}, expected: "4294967296"},
+		{typeExpr: "i16", encoded: []byte{0xff}, expected: int64(-1)},
+		{typeExpr: "i64", encoded: []byte{0xfe}, expected: "-2"},
+		{typeExpr: "BigUint", encoded: []byte{0x0d, 0xe0, 0xb6, 0xb3, 0xa7, 0x64, 0x00, 0x00}, expected: "1000000000000000000"},
+		{typeExpr: "BigInt", encoded: []byte{0xff, 0x00}, expected: "-256"},
+		{typeExpr: "bool", encoded: []byte{0x01}, expected: true},
+		{typeExpr: "bool", encoded: []byte{}, expected: false},
+		{typeExpr: "TokenIdentifier", encoded: []byte("WEGLD-bd4d79"), expected: "WEGLD-bd4d79"},
+		{typeExpr: "bytes", encoded: []byte{0xab, 0xcd}, expected: "abcd"},
+		{typeExpr: "Option<u32>", encoded: []byte{}, expected: nil},
+		{typeExpr: "Option<u32>", encoded: []byte{0x01, 0x00, 0x00, 0x00, 0x05}, expected: uint64(5)},
+		{typeExpr: "List<u16>", encoded: []byte{0x00, 0x01, 0x00, 0x02}, expected: []interface{}{uint64(1), uint64(2)}},
+		{typeExpr: "State", encoded: []byte{}, expected: "Inactive"},
+		{typeExpr: "State", encoded: []byte{0x01}, expected: "Active"},
+		{typeExpr: "tuple<u8,bool>", encoded: []byte{0x07, 0x01}, expected: []interface{}{uint64(7), true}},
+		{typeExpr: "array2<u8>", encoded: []byte{0xaa, 0xbb}, expected: "aabb"},
+	}
+
+	for _, tt := range tests {
+		value, err := c.decodeTop(mustParse(t, tt.typeExpr), tt.encoded)
+		require.Nil(t, err, tt.typeExpr)
+		require.Equal(t, tt.expected, value, tt.typeExpr)
+	}
+}
+
+func TestCodec_DecodeTopAddress(t *testing.T) {
+	t.Parallel()
+
+	c := createTestCodec(t)
+
+	value, err := c.decodeTop(mustParse(t, "Address"), bytes.Repeat([]byte{1}, 32))
+	require.Nil(t, err)
+	require.Equal(t, "erd1qyqszqgpqyqszqgpqyqszqgpqyqszqgpqyqszqgpqyqszqgpqyqsl6e0p7", value)
+
+	_, err = c.decodeTop(mustParse(t, "Address"), []byte{1})
+	require.Equal(t, errNotEnoughData, err)
+}
+
+func TestCodec_DecodeNestedStructAndEnum(t *testing.T) {
+	t.Parallel()
+
+	c := createTestCodec(t)
+
+	encoded := []byte{
+		0x00, 0x00, 0x00, 0x01, 0x64, // amount_in: 100
+		0x01, 0x00, 0x00, 0x00, 0x01, 0x02, // fee: Some(2)
+		0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0x09, // reserves: [9]
+		0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, // action: Lock(10)
+	}
+	value, err := c.decodeTop(mustParse(t, "SwapEvent"), encoded)
+	require.Nil(t, err)
+	require.Equal(t, map[string]interface{}{
+		"amount_in": "100",
+		"fee":       "2",
+		"reserves":  []interface{}{uint64(9)},
+		"action": map[string]interface{}{
+			"variant": "Lock",
+			"fields":  map[string]interface{}{"0": "10"},
+		},
+	}, value)
+}
+
+func TestCodec_DecodeErrors(t *testing.T) {
+	t.Parallel()
+
+	c := createTestCodec(t)
+
+	_, err := c.decodeTop(mustParse(t, "u8"), []byte{0x01, 0x02})
+	require.True(t, errors.Is(err, errInvalidValue))
+
+	_, err = c.decodeTop(mustParse(t, "bool"), []byte{0x02})
+	require.True(t, errors.Is(err, errInvalidValue))
+
+	_, err = c.decodeTop(mustParse(t, "State"), []byte{0x05})
+	require.True(t, errors.Is(err, errInvalidValue))
+
+	_, err = c.decodeTop(mustParse(t, "Unknown"), []byte{0x05})
+	require.True(t, errors.Is(err, errUnknownType))
+
+	_, err = c.decodeTop(mustParse(t, "tuple<u8>"), []byte{0x01, 0x02})
+	require.True(t, errors.Is(err, errTrailingData))
+
+	_, err = c.decodeTop(mustParse(t, "List<bytes>"), []byte{0x00, 0x00, 0x00, 0x05, 0x01})
+	require.Equal(t, errNotEnoughData, err)
+
+	_, err = c.decodeTop(mustParse(t, "List<List<u8>>"), []byte{0xff, 0xff, 0xff, 0xff})
+	require.True(t, errors.Is(err, errInvalidValue))
+}
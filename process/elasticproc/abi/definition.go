@@ -0,0 +1,65 @@
+package abi
+
+import (
+	"encoding/json"
+	"os"
+)
+
+// abiDefinition is the JSON description of a smart contract, as generated by the smart contracts framework
+type abiDefinition struct {
+	Name               string                     `json:"name"`
+	Constructor        *endpointDefinition        `json:"constructor"`
+	UpgradeConstructor *endpointDefinition        `json:"upgradeConstructor"`
+	Endpoints          []*endpointDefinition      `json:"endpoints"`
+	Events             []*eventDefinition         `json:"events"`
+	Types              map[string]*typeDefinition `json:"types"`
+}
+
+type endpointDefinition struct {
+	Name   string             `json:"name"`
+	Inputs []*inputDefinition `json:"inputs"`
+}
+
+type eventDefinition struct {
+	Identifier string             `json:"identifier"`
+	Inputs     []*inputDefinition `json:"inputs"`
+}
+
+type inputDefinition struct {
+	Name     string `json:"name"`
+	Type     string `json:"type"`
+	Indexed  bool   `json:"indexed"`
+	MultiArg bool   `json:"multi_arg"`
+}
+
+type typeDefinition struct {
+	Type     string               `json:"type"`
+	Fields   []*fieldDefinition   `json:"fields"`
+	Variants []*variantDefinition `json:"variants"`
+}
+
+type fieldDefinition struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+type variantDefinition struct {
+	Name         string             `json:"name"`
+	Discriminant int                `json:"discriminant"`
+	Fields       []*fieldDefinition `json:"fields"`
+}
+
+func loadABIDefinition(path string) (*abiDefinition, error) {
+	abiBytes, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	definition := &abiDefinition{}
+	err = json.Unmarshal(abiBytes, definition)
+	if err != nil {
+		return nil, err
+	}
+
+	return definition, nil
+}
@@ -0,0 +1,19 @@
+package abi
+
+import "errors"
+
+var errInvalidTypeExpression = errors.New("invalid abi type expression")
+
+var errUnknownType = errors.New("unknown abi type")
+
+var errNotEnoughData = errors.New("not enough data to decode the value")
+
+var errTrailingData = errors.New("trailing data after decoding the value")
+
+var errInvalidValue = errors.New("invalid encoded value")
+
+var errMaxDepthReached = errors.New("max nesting depth reached while decoding")
+
+var errNoContractBinding = errors.New("an abi binding needs an address or a code hash")
+
+var errNoABIFile = errors.New("no abi file provided for the binding")
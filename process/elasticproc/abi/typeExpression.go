@@ -0,0 +1,76 @@
+package abi
+
+import (
+	"fmt"
+	"strings"
+)
+
+// typeExpression is a parsed abi type, e.g. "List<Option<BigUint>>"
+type typeExpression struct {
+	name string
+	args []*typeExpression
+}
+
+func (te *typeExpression) String() string {
+	if len(te.args) == 0 {
+		return te.name
+	}
+
+	args := make([]string, 0, len(te.args))
+	for _, arg := range te.args {
+		args = append(args, arg.String())
+	}
+
+	return fmt.Sprintf("%s<%s>", te.name, strings.Join(args, ","))
+}
+
+func parseTypeExpression(expression string) (*typeExpression, error) {
+	parsed, rest, err := parseTypeExpressionPrefix(expression)
+	if err != nil {
+		return nil, err
+	}
+	if rest != "" {
+		return nil, fmt.Errorf("%w: %s", errInvalidTypeExpression, expression)
+	}
+
+	return parsed, nil
+}
+
+func parseTypeExpressionPrefix(expression string) (*typeExpression, string, error) {
+	nameEnd := strings.IndexAny(expression, "<>,")
+	if nameEnd < 0 {
+		nameEnd = len(expression)
+	}
+
+	name := strings.TrimSpace(expression[:nameEnd])
+	if name == "" {
+		return nil, "", fmt.Errorf("%w: %s", errInvalidTypeExpression, expression)
+	}
+
+	parsed := &typeExpression{name: name}
+	rest := expression[nameEnd:]
+	if !strings.HasPrefix(rest, "<") {
+		return parsed, strings.TrimSpace(rest), nil
+	}
+
+	rest = rest[1:]
+	for {
+		var arg *typeExpression
+		var err error
+		arg, rest, err = parseTypeExpressionPrefix(rest)
+		if err != nil {
+			return nil, "", err
+		}
+		parsed.args = append(parsed.args, arg)
+
+		if strings.HasPrefix(rest, ",") {
+			rest = rest[1:]
+			continue
+		}
+		if strings.HasPrefix(rest, ">") {
+			return parsed, strings.TrimSpace(rest[1:]), nil
+		}
+
+		return nil, "", fmt.Errorf("%w: %s", errInvalidTypeExpression, expression)
+	}
+}
@@ -0,0 +1,27 @@
+package abi
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTypeExpression(t *testing.T) {
+	t.Parallel()
+
+	parsed, err := parseTypeExpression("variadic<multi<Address, List<Option<BigUint>>>>")
+	require.Nil(t, err)
+	require.Equal(t, "variadic<multi<Address,List<Option<BigUint>>>>", parsed.String())
+	require.Equal(t, "multi", parsed.args[0].name)
+	require.Len(t, parsed.args[0].args, 2)
+
+	parsed, err = parseTypeExpression("utf-8 string")
+	require.Nil(t, err)
+	require.Equal(t, "utf-8 string", parsed.name)
+
+	for _, invalid := range []string{"", "List<u8", "List<>", "List<u8>>", "Option<u8,>"} {
+		_, err = parseTypeExpression(invalid)
+		require.True(t, errors.Is(err, errInvalidTypeExpression), invalid)
+	}
+}
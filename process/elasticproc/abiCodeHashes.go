@@ -0,0 +1,41 @@
+package elasticproc
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-es-indexer-go/core/request"
+	"github.com/multiversx/mx-chain-es-indexer-go/data"
+	elasticIndexer "github.com/multiversx/mx-chain-es-indexer-go/process/dataindexer"
+)
+
+// resolveContractsCodeHashes binds the contracts that were not altered since the indexer started to the ABI configured
+// for their code hash, based on the code hash stored in the accounts index
+func (ei *elasticProcessor) resolveContractsCodeHashes(pool *outport.TransactionPool, shardID uint32) error {
+	if !ei.isIndexEnabled(elasticIndexer.AccountsIndex) {
+		return nil
+	}
+
+	addresses := ei.abiDecoder.GetUnresolvedContracts(pool)
+	if len(addresses) == 0 {
+		return nil
+	}
+
+	ctxWithValue := context.WithValue(context.Background(), request.ContextKey, request.ExtendTopicWithShardID(request.GetTopic, shardID))
+	responseAccounts := &data.ResponseAccountsCodeHashes{}
+	err := ei.elasticClient.DoMultiGet(ctxWithValue, addresses, elasticIndexer.AccountsIndex, true, responseAccounts)
+	if err != nil {
+		return err
+	}
+
+	codeHashes := make(map[string][]byte, len(responseAccounts.Docs))
+	for _, doc := range responseAccounts.Docs {
+		if doc.Found {
+			codeHashes[doc.ID] = doc.Source.CodeHash
+		}
+	}
+
+	ei.abiDecoder.RegisterResolvedCodeHashes(addresses, codeHashes)
+
+	return nil
+}
@@ -0,0 +1,329 @@
+package accounts
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/data/alteredAccount"
+
+	"github.com/multiversx/mx-chain-es-indexer-go/data"
+	"github.com/multiversx/mx-chain-es-indexer-go/process/elasticproc/converters"
+)
+
+// activityFields holds the counters, serialized as a painless parameter, that are changed by the account activity
+const activityFields = `["txsSent","txsReceived","scrsCount","tokensCount"]`
+
+// PrepareAccountsActivity will compute the changes of the activity counters for the addresses of the current shard
+func (ap *accountsProcessor) PrepareAccountsActivity(preparedResults *data.PreparedResults, timestamp uint64, epoch uint32, shardID uint32) map[string]*data.AccountActivity {
+	accountsActivity := make(map[string]*data.AccountActivity)
+	if preparedResults == nil {
+		return accountsActivity
+	}
+
+	for _, tx := range preparedResults.Transactions {
+		if tx.SenderShard == shardID && ap.isValidAddress(tx.Sender) {
+			getOrCreateAccountActivity(accountsActivity, tx.Sender, timestamp, epoch, shardID).TxsSent++
+		}
+		if tx.ReceiverShard == shardID && ap.isValidAddress(tx.Receiver) {
+			getOrCreateAccountActivity(accountsActivity, tx.Receiver, timestamp, epoch, shardID).TxsReceived++
+		}
+	}
+
+	for _, scr := range preparedResults.ScResults {
+		if scr.SenderShard == shardID && ap.isValidAddress(scr.Sender) {
+			getOrCreateAccountActivity(accountsActivity, scr.Sender, timestamp, epoch, shardID).ScrsCount++
+		}
+		isSelfSCR := scr.Sender == scr.Receiver
+		if scr.ReceiverShard == shardID && !isSelfSCR && ap.isValidAddress(scr.Receiver) {
+			getOrCreateAccountActivity(accountsActivity, scr.Receiver, timestamp, epoch, shardID).ScrsCount++
+		}
+	}
+
+	return accountsActivity
+}
+
+// AddTokensInAccountsActivity will add in the accounts activity the tokens changed by the provided altered accounts.
+// Whether a token is counted or discounted is decided by AddTokensCount
+func (ap *accountsProcessor) AddTokensInAccountsActivity(
+	accountsActivity map[string]*data.AccountActivity,
+	coreAlteredAccounts map[string]*alteredAccount.AlteredAccount,
+	timestamp uint64,
+	epoch uint32,
+	shardID uint32,
+) {
+	for _, account := range coreAlteredAccounts {
+		if len(account.Tokens) == 0 {
+			continue
+		}
+
+		accountActivity := getOrCreateAccountActivity(accountsActivity, account.Address, timestamp, epoch, shardID)
+		if accountActivity.Tokens == nil {
+			accountActivity.Tokens = make(map[string]bool, len(account.Tokens))
+		}
+		for _, tokenData := range account.Tokens {
+			accountActivity.Tokens[computeTokenID(tokenData)] = notZeroBalance(tokenData.Balance)
+		}
+	}
+}
+
+// GetAccountsActivityIDs returns the identifiers of the accounts activity documents of the addresses with changed tokens
+func (ap *accountsProcessor) GetAccountsActivityIDs(accountsActivity map[string]*data.AccountActivity) []string {
+	ids := make([]string, 0)
+	for _, accountActivity := range accountsActivity {
+		if len(accountActivity.Tokens) > 0 {
+			ids = append(ids, computeAccountActivityID(accountActivity))
+		}
+	}
+
+	return ids
+}
+
+// GetAccountsESDTIDs returns the identifiers of the ESDT accounts documents of the changed tokens. The addresses whose
+// activity was already indexed are skipped, because their tokens count is known
+func (ap *accountsProcessor) GetAccountsESDTIDs(accountsActivity map[string]*data.AccountActivity, indexedActivity map[string]*data.AccountActivity) []string {
+	ids := make([]string, 0)
+	for address, accountActivity := range accountsActivity {
+		_, isIndexed := indexedActivity[address]
+		if isIndexed {
+			continue
+		}
+
+		for token := range accountActivity.Tokens {
+			ids = append(ids, computeAccountESDTID(address, token))
+		}
+	}
+
+	return ids
+}
+
+// AddTokensCount will compute the tokens count of the accounts activity: a token held by an address without ESDT account
+// document is counted, while a token no longer held by an address with ESDT account document is discounted. The tokens
+// count of an already indexed activity is kept, because the ESDT accounts might have been changed by its block.
+// The accounts without activity are removed
+func (ap *accountsProcessor) AddTokensCount(
+	accountsActivity map[string]*data.AccountActivity,
+	indexedActivity map[string]*data.AccountActivity,
+	existingAccountsESDT map[string]struct{},
+) {
+	for address, accountActivity := range accountsActivity {
+		indexed, isIndexed := indexedActivity[address]
+		if isIndexed {
+			accountActivity.TokensCount = indexed.TokensCount
+		} else {
+			accountActivity.TokensCount = computeTokensCount(address, accountActivity.Tokens, existingAccountsESDT)
+		}
+
+		if isEmptyAccountActivity(accountActivity) {
+			delete(accountsActivity, address)
+		}
+	}
+}
+
+func computeTokensCount(address string, tokens map[string]bool, existingAccountsESDT map[string]struct{}) int64 {
+	tokensCount := int64(0)
+	for token, isHeld := range tokens {
+		_, existed := existingAccountsESDT[computeAccountESDTID(address, token)]
+		if isHeld && !existed {
+			tokensCount++
+		}
+		if !isHeld && existed {
+			tokensCount--
+		}
+	}
+
+	return tokensCount
+}
+
+func isEmptyAccountActivity(accountActivity *data.AccountActivity) bool {
+	return accountActivity.TxsSent == 0 &&
+		accountActivity.TxsReceived == 0 &&
+		accountActivity.ScrsCount == 0 &&
+		accountActivity.TokensCount == 0
+}
+
+func computeAccountActivityID(accountActivity *data.AccountActivity) string {
+	return fmt.Sprintf("%s-%d-%d", accountActivity.Address, accountActivity.ShardID, accountActivity.Timestamp)
+}
+
+// computeAccountESDTID returns the identifier of the ESDT account document of the provided address and token
+func computeAccountESDTID(address string, token string) string {
+	return fmt.Sprintf("%s-%s", address, token)
+}
+
+func computeTokenID(tokenData *alteredAccount.AccountTokenData) string {
+	return fmt.Sprintf("%s-%s", tokenData.Identifier, converters.EncodeNonceToHex(tokenData.Nonce))
+}
+
+func (ap *accountsProcessor) isValidAddress(address string) bool {
+	_, err := ap.addressPubkeyConverter.Decode(address)
+	return err == nil
+}
+
+func getOrCreateAccountActivity(accountsActivity map[string]*data.AccountActivity, address string, timestamp uint64, epoch uint32, shardID uint32) *data.AccountActivity {
+	accountActivity, found := accountsActivity[address]
+	if found {
+		return accountActivity
+	}
+
+	accountActivity = &data.AccountActivity{
+		Address:   address,
+		ShardID:   shardID,
+		Epoch:     epoch,
+		Timestamp: timestamp,
+	}
+	accountsActivity[address] = accountActivity
+
+	return accountActivity
+}
+
+// SerializeAccountsActivityChanges will serialize the provided accounts activity as documents of the accounts activity index
+func (ap *accountsProcessor) SerializeAccountsActivityChanges(accountsActivity map[string]*data.AccountActivity, buffSlice *data.BufferSlice, index string) error {
+	for _, accountActivity := range accountsActivity {
+		meta := []byte(fmt.Sprintf(`{ "index" : { "_index":"%s", "_id" : "%s" } }%s`, index, converters.JsonEscape(computeAccountActivityID(accountActivity)), "\n"))
+		serializedData, err := json.Marshal(accountActivity)
+		if err != nil {
+			return err
+		}
+
+		err = buffSlice.PutData(meta, serializedData)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SerializeAccountsActivity will serialize the provided accounts activity in a way that Elasticsearch expects a bulk request.
+// The changes of a block are added once, a block older than the last activity of the account is ignored
+func (ap *accountsProcessor) SerializeAccountsActivity(accountsActivity map[string]*data.AccountActivity, buffSlice *data.BufferSlice, index string) error {
+	codeToExecute := `
+		if ('create' == ctx.op) {
+			ctx._source.address = params.change.address;
+		} else if (ctx._source.containsKey('lastActive') && ctx._source.lastActive >= params.change.timestamp) {
+			ctx.op = 'noop';
+			return;
+		}
+		for (String field : params.fields) {
+			long total = ctx._source.containsKey(field) ? ctx._source[field] : 0;
+			ctx._source[field] = total + params.change[field];
+		}
+		if (!ctx._source.containsKey('firstSeen')) {
+			ctx._source.firstSeen = params.change.timestamp;
+		}
+		ctx._source.lastActive = params.change.timestamp;
+`
+	for _, accountActivity := range accountsActivity {
+		meta, serializedData, err := prepareSerializedAccountActivity(accountActivity, codeToExecute, "", index)
+		if err != nil {
+			return err
+		}
+
+		err = buffSlice.PutData(meta, serializedData)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SerializeRevertedAccountsActivity will serialize the updates that subtract the activity of a reverted block from the
+// accounts documents. The last activity before the reverted block is taken from the provided map, if it is missing the
+// first activity is used
+func (ap *accountsProcessor) SerializeRevertedAccountsActivity(
+	accountsActivity []*data.AccountActivity,
+	lastActive map[string]uint64,
+	buffSlice *data.BufferSlice,
+	index string,
+) error {
+	codeToExecute := `
+		if (!ctx._source.containsKey('lastActive') || ctx._source.lastActive != params.change.timestamp) {
+			ctx.op = 'noop';
+			return;
+		}
+		for (String field : params.fields) {
+			long total = ctx._source.containsKey(field) ? ctx._source[field] : 0;
+			ctx._source[field] = total - params.change[field];
+		}
+		if (ctx._source.containsKey('firstSeen') && ctx._source.firstSeen >= params.change.timestamp) {
+			ctx._source.remove('firstSeen');
+		}
+		if (params.lastActive > 0) {
+			ctx._source.lastActive = params.lastActive;
+		} else if (ctx._source.containsKey('firstSeen')) {
+			ctx._source.lastActive = ctx._source.firstSeen;
+		} else {
+			ctx._source.remove('lastActive');
+		}
+`
+	for _, accountActivity := range accountsActivity {
+		lastActiveParam := fmt.Sprintf(`, "lastActive": %d`, lastActive[accountActivity.Address])
+		meta, serializedData, err := prepareSerializedAccountActivity(accountActivity, codeToExecute, lastActiveParam, index)
+		if err != nil {
+			return err
+		}
+
+		err = buffSlice.PutData(meta, serializedData)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func prepareSerializedAccountActivity(accountActivity *data.AccountActivity, codeToExecute string, extraParams string, index string) ([]byte, []byte, error) {
+	meta := []byte(fmt.Sprintf(`{ "update" : {"_index": "%s", "_id" : "%s" } }%s`, index, converters.JsonEscape(accountActivity.Address), "\n"))
+
+	serializedChange, err := json.Marshal(accountActivity)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	serializedDataStr := fmt.Sprintf(`{"scripted_upsert": true, "script": {`+
+		`"source": "%s",`+
+		`"lang": "painless",`+
+		`"params": { "change": %s, "fields": %s%s }},`+
+		`"upsert": {}}`,
+		converters.FormatPainlessSource(codeToExecute), string(serializedChange), activityFields, extraParams,
+	)
+
+	return meta, []byte(serializedDataStr), nil
+}
+
+// PrepareAccountsActivityQuery will prepare the query that returns the accounts activity of the provided block
+func (ap *accountsProcessor) PrepareAccountsActivityQuery(timestamp uint64, shardID uint32) []byte {
+	query := fmt.Sprintf(`{"query": {"bool": {"must": [{"match": {"shardID": %d}},{"match": {"timestamp": "%d"}}]}}}`, shardID, timestamp)
+
+	return []byte(query)
+}
+
+// PrepareLastActiveQuery will prepare the query that returns, for every provided address, the timestamp of its last
+// activity before the provided timestamp
+func (ap *accountsProcessor) PrepareLastActiveQuery(addresses []string, timestamp uint64) ([]byte, error) {
+	serializedAddresses, err := json.Marshal(addresses)
+	if err != nil {
+		return nil, err
+	}
+
+	query := fmt.Sprintf(`{
+		"size": 0,
+		"query": {
+			"bool": {
+				"filter": [
+					{"terms": {"address": %s}},
+					{"range": {"timestamp": {"lt": %d}}}
+				]
+			}
+		},
+		"aggs": {
+			"accounts": {
+				"terms": {"field": "address", "size": %d},
+				"aggs": {"lastActive": {"max": {"field": "timestamp"}}}
+			}
+		}
+	}`, string(serializedAddresses), timestamp, len(addresses))
+
+	return []byte(query), nil
+}
@@ -0,0 +1,170 @@
+package accounts
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data/alteredAccount"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multiversx/mx-chain-es-indexer-go/data"
+	"github.com/multiversx/mx-chain-es-indexer-go/mock"
+)
+
+func TestAccountsProcessor_PrepareAccountsActivity(t *testing.T) {
+	t.Parallel()
+
+	ap, _ := NewAccountsProcessor(mock.NewPubkeyConverterMock(32), balanceConverter, false)
+
+	preparedResults := &data.PreparedResults{
+		Transactions: []*data.Transaction{
+			{Sender: "aa", Receiver: "bb", SenderShard: 0, ReceiverShard: 0},
+			{Sender: "aa", Receiver: "cc", SenderShard: 0, ReceiverShard: 1},
+			{Sender: "dd", Receiver: "aa", SenderShard: 1, ReceiverShard: 0},
+			{Sender: "metachain", Receiver: "bb", SenderShard: 0, ReceiverShard: 0},
+		},
+		ScResults: []*data.ScResult{
+			{Sender: "bb", Receiver: "aa", SenderShard: 0, ReceiverShard: 0},
+			{Sender: "bb", Receiver: "bb", SenderShard: 0, ReceiverShard: 0},
+		},
+	}
+
+	accountsActivity := ap.PrepareAccountsActivity(preparedResults, 100, 2, 0)
+	require.Equal(t, map[string]*data.AccountActivity{
+		"aa": {Address: "aa", TxsSent: 2, TxsReceived: 1, ScrsCount: 1, Epoch: 2, Timestamp: 100},
+		"bb": {Address: "bb", TxsReceived: 2, ScrsCount: 2, Epoch: 2, Timestamp: 100},
+	}, accountsActivity)
+}
+
+func TestAccountsProcessor_AddTokensInAccountsActivity(t *testing.T) {
+	t.Parallel()
+
+	ap, _ := NewAccountsProcessor(mock.NewPubkeyConverterMock(32), balanceConverter, false)
+
+	coreAlteredAccounts := map[string]*alteredAccount.AlteredAccount{
+		"aa": {
+			Address: "aa",
+			Tokens: []*alteredAccount.AccountTokenData{
+				{Identifier: "TKN-01", Balance: "10"},
+				{Identifier: "TKN-02", Balance: "0"},
+				{Identifier: "NFT-01", Nonce: 1, Balance: "1"},
+			},
+		},
+		"bb": {
+			Address: "bb",
+			Tokens: []*alteredAccount.AccountTokenData{
+				{Identifier: "TKN-01", Balance: "10"},
+			},
+		},
+		"cc": {
+			Address: "cc",
+		},
+	}
+
+	accountsActivity := map[string]*data.AccountActivity{
+		"aa": {Address: "aa", TxsSent: 1, Timestamp: 100, Epoch: 2},
+	}
+	ap.AddTokensInAccountsActivity(accountsActivity, coreAlteredAccounts, 100, 2, 0)
+	require.Equal(t, map[string]*data.AccountActivity{
+		"aa": {Address: "aa", TxsSent: 1, Timestamp: 100, Epoch: 2, Tokens: map[string]bool{"TKN-01-00": true, "TKN-02-00": false, "NFT-01-01": true}},
+		"bb": {Address: "bb", Timestamp: 100, Epoch: 2, Tokens: map[string]bool{"TKN-01-00": true}},
+	}, accountsActivity)
+}
+
+func TestAccountsProcessor_AddTokensCount(t *testing.T) {
+	t.Parallel()
+
+	ap, _ := NewAccountsProcessor(mock.NewPubkeyConverterMock(32), balanceConverter, false)
+
+	accountsActivity := map[string]*data.AccountActivity{
+		"aa": {Address: "aa", ShardID: 1, Timestamp: 100, Tokens: map[string]bool{"TKN-01-00": true, "TKN-02-00": false, "NFT-01-01": true}},
+		"bb": {Address: "bb", ShardID: 1, Timestamp: 100, Tokens: map[string]bool{"TKN-01-00": true}},
+		"cc": {Address: "cc", ShardID: 1, Timestamp: 100, Tokens: map[string]bool{"TKN-01-00": true}},
+		"dd": {Address: "dd", ShardID: 1, Timestamp: 100, TxsSent: 1},
+	}
+	// the activity of cc was indexed before the retry of the block, its ESDT account might have been created meanwhile
+	indexedActivity := map[string]*data.AccountActivity{
+		"cc": {Address: "cc", ShardID: 1, Timestamp: 100, TokensCount: 1},
+	}
+
+	require.ElementsMatch(t, []string{"aa-1-100", "bb-1-100", "cc-1-100"}, ap.GetAccountsActivityIDs(accountsActivity))
+	require.ElementsMatch(t, []string{"aa-TKN-01-00", "aa-TKN-02-00", "aa-NFT-01-01", "bb-TKN-01-00"}, ap.GetAccountsESDTIDs(accountsActivity, indexedActivity))
+
+	existingAccountsESDT := map[string]struct{}{
+		"aa-TKN-02-00": {},
+		"bb-TKN-01-00": {},
+		"cc-TKN-01-00": {},
+	}
+	ap.AddTokensCount(accountsActivity, indexedActivity, existingAccountsESDT)
+	require.Len(t, accountsActivity, 3)
+	// TKN-01 and NFT-01 are new, TKN-02 was removed
+	require.Equal(t, int64(1), accountsActivity["aa"].TokensCount)
+	// the only change of bb is the balance of a token it already held
+	require.Nil(t, accountsActivity["bb"])
+	require.Equal(t, int64(1), accountsActivity["cc"].TokensCount)
+	require.Zero(t, accountsActivity["dd"].TokensCount)
+}
+
+func TestAccountsProcessor_SerializeAccountsActivityChanges(t *testing.T) {
+	t.Parallel()
+
+	accountsActivity := map[string]*data.AccountActivity{
+		"aa": {Address: "aa", TxsSent: 1, TokensCount: -1, ShardID: 1, Epoch: 3, Timestamp: 100, Tokens: map[string]bool{"TKN-01-00": false}},
+	}
+
+	buffSlice := data.NewBufferSlice(data.DefaultMaxBulkSize)
+	err := (&accountsProcessor{}).SerializeAccountsActivityChanges(accountsActivity, buffSlice, "accountsactivity")
+	require.NoError(t, err)
+	require.Equal(t, `{ "index" : { "_index":"accountsactivity", "_id" : "aa-1-100" } }
+{"address":"aa","txsSent":1,"txsReceived":0,"scrsCount":0,"tokensCount":-1,"shardID":1,"epoch":3,"timestamp":100}
+`, buffSlice.Buffers()[0].String())
+}
+
+func TestAccountsProcessor_SerializeAccountsActivity(t *testing.T) {
+	t.Parallel()
+
+	accountsActivity := map[string]*data.AccountActivity{
+		"aa": {Address: "aa", TxsSent: 1, TxsReceived: 2, ScrsCount: 3, TokensCount: 1, ShardID: 1, Epoch: 3, Timestamp: 100},
+	}
+
+	buffSlice := data.NewBufferSlice(data.DefaultMaxBulkSize)
+	err := (&accountsProcessor{}).SerializeAccountsActivity(accountsActivity, buffSlice, "accounts")
+	require.NoError(t, err)
+	require.Equal(t, 1, len(buffSlice.Buffers()))
+
+	expectedRes := `{ "update" : {"_index": "accounts", "_id" : "aa" } }
+{"scripted_upsert": true, "script": {"source": "if ('create' == ctx.op) {ctx._source.address = params.change.address;} else if (ctx._source.containsKey('lastActive') && ctx._source.lastActive >= params.change.timestamp) {ctx.op = 'noop';return;}for (String field : params.fields) {long total = ctx._source.containsKey(field) ? ctx._source[field] : 0;ctx._source[field] = total + params.change[field];}if (!ctx._source.containsKey('firstSeen')) {ctx._source.firstSeen = params.change.timestamp;}ctx._source.lastActive = params.change.timestamp;","lang": "painless","params": { "change": {"address":"aa","txsSent":1,"txsReceived":2,"scrsCount":3,"tokensCount":1,"shardID":1,"epoch":3,"timestamp":100}, "fields": ["txsSent","txsReceived","scrsCount","tokensCount"] }},"upsert": {}}
+`
+	require.Equal(t, expectedRes, buffSlice.Buffers()[0].String())
+}
+
+func TestAccountsProcessor_SerializeRevertedAccountsActivity(t *testing.T) {
+	t.Parallel()
+
+	accountsActivity := []*data.AccountActivity{
+		{Address: "aa", TxsSent: 1, ShardID: 1, Timestamp: 100},
+		{Address: "bb", TxsReceived: 1, ShardID: 1, Timestamp: 100},
+	}
+
+	buffSlice := data.NewBufferSlice(data.DefaultMaxBulkSize)
+	err := (&accountsProcessor{}).SerializeRevertedAccountsActivity(accountsActivity, map[string]uint64{"aa": 80}, buffSlice, "accounts")
+	require.NoError(t, err)
+
+	bulk := buffSlice.Buffers()[0].String()
+	require.Contains(t, bulk, `if (!ctx._source.containsKey('lastActive') || ctx._source.lastActive != params.change.timestamp) {ctx.op = 'noop';return;}`)
+	require.Contains(t, bulk, `"params": { "change": {"address":"aa","txsSent":1,"txsReceived":0,"scrsCount":0,"tokensCount":0,"shardID":1,"epoch":0,"timestamp":100}, "fields": ["txsSent","txsReceived","scrsCount","tokensCount"], "lastActive": 80 }`)
+	require.Contains(t, bulk, `"params": { "change": {"address":"bb","txsSent":0,"txsReceived":1,"scrsCount":0,"tokensCount":0,"shardID":1,"epoch":0,"timestamp":100}, "fields": ["txsSent","txsReceived","scrsCount","tokensCount"], "lastActive": 0 }`)
+}
+
+func TestAccountsProcessor_AccountsActivityQueries(t *testing.T) {
+	t.Parallel()
+
+	ap := &accountsProcessor{}
+	query := string(ap.PrepareAccountsActivityQuery(100, 1))
+	require.Equal(t, `{"query": {"bool": {"must": [{"match": {"shardID": 1}},{"match": {"timestamp": "100"}}]}}}`, query)
+
+	lastActiveQuery, err := ap.PrepareLastActiveQuery([]string{"aa", "bb"}, 100)
+	require.NoError(t, err)
+	require.Contains(t, string(lastActiveQuery), `{"terms": {"address": ["aa","bb"]}}`)
+	require.Contains(t, string(lastActiveQuery), `{"range": {"timestamp": {"lt": 100}}}`)
+	require.Contains(t, string(lastActiveQuery), `"terms": {"field": "address", "size": 2}`)
+}
@@ -21,12 +21,14 @@ var log = logger.GetOrCreate("indexer/process/accounts")
 type accountsProcessor struct {
 	addressPubkeyConverter core.PubkeyConverter
 	balanceConverter       dataindexer.BalanceConverter
+	storeRawHexAddresses   bool
 }
 
 // NewAccountsProcessor will create a new instance of accounts processor
 func NewAccountsProcessor(
 	addressPubkeyConverter core.PubkeyConverter,
 	balanceConverter dataindexer.BalanceConverter,
+	storeRawHexAddresses bool,
 ) (*accountsProcessor, error) {
 	if check.IfNil(addressPubkeyConverter) {
 		return nil, dataindexer.ErrNilPubkeyConverter
@@ -38,6 +40,7 @@ func NewAccountsProcessor(
 	return &accountsProcessor{
 		addressPubkeyConverter: addressPubkeyConverter,
 		balanceConverter:       balanceConverter,
+		storeRawHexAddresses:   storeRawHexAddresses,
 	}, nil
 }
 
@@ -133,6 +136,10 @@ func (ap *accountsProcessor) PrepareRegularAccountsMap(timestamp uint64, account
 			ShardID:         shardID,
 		}
 
+		if ap.storeRawHexAddresses {
+			acc.AddressHex = hex.EncodeToString(addressBytes)
+		}
+
 		ap.addAdditionalDataInAccount(userAccount.UserAccount.AdditionalData, acc)
 
 		accountsMap[address] = acc
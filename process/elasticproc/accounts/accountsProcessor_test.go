@@ -53,7 +53,8 @@ func TestNewAccountsProcessor(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			_, err := NewAccountsProcessor(tt.argsFunc())
+			pubKeyConverter, balanceConv := tt.argsFunc()
+			_, err := NewAccountsProcessor(pubKeyConverter, balanceConv, false)
 			require.True(t, errors.Is(err, tt.exError))
 		})
 	}
@@ -62,7 +63,7 @@ func TestNewAccountsProcessor(t *testing.T) {
 func TestAccountsProcessor_GetAccountsWithNil(t *testing.T) {
 	t.Parallel()
 
-	ap, _ := NewAccountsProcessor(mock.NewPubkeyConverterMock(32), balanceConverter)
+	ap, _ := NewAccountsProcessor(mock.NewPubkeyConverterMock(32), balanceConverter, false)
 
 	regularAccounts, esdtAccounts := ap.GetAccounts(nil)
 	require.Len(t, regularAccounts, 0)
@@ -72,7 +73,7 @@ func TestAccountsProcessor_GetAccountsWithNil(t *testing.T) {
 func TestAccountsProcessor_PrepareRegularAccountsMapWithNil(t *testing.T) {
 	t.Parallel()
 
-	ap, _ := NewAccountsProcessor(mock.NewPubkeyConverterMock(32), balanceConverter)
+	ap, _ := NewAccountsProcessor(mock.NewPubkeyConverterMock(32), balanceConverter, false)
 
 	accountsInfo := ap.PrepareRegularAccountsMap(0, nil, 0)
 	require.Len(t, accountsInfo, 0)
@@ -81,7 +82,7 @@ func TestAccountsProcessor_PrepareRegularAccountsMapWithNil(t *testing.T) {
 func TestGetESDTInfo(t *testing.T) {
 	t.Parallel()
 
-	ap, _ := NewAccountsProcessor(mock.NewPubkeyConverterMock(32), balanceConverter)
+	ap, _ := NewAccountsProcessor(mock.NewPubkeyConverterMock(32), balanceConverter, false)
 	require.NotNil(t, ap)
 
 	tokenIdentifier := "token-001"
@@ -109,7 +110,7 @@ func TestGetESDTInfo(t *testing.T) {
 func TestGetESDTInfoNFT(t *testing.T) {
 	t.Parallel()
 
-	ap, _ := NewAccountsProcessor(mock.NewPubkeyConverterMock(32), balanceConverter)
+	ap, _ := NewAccountsProcessor(mock.NewPubkeyConverterMock(32), balanceConverter, false)
 	require.NotNil(t, ap)
 
 	tokenIdentifier := "token-001"
@@ -141,7 +142,7 @@ func TestGetESDTInfoNFTWithMetaData(t *testing.T) {
 	t.Parallel()
 
 	pubKeyConverter := mock.NewPubkeyConverterMock(32)
-	ap, _ := NewAccountsProcessor(pubKeyConverter, balanceConverter)
+	ap, _ := NewAccountsProcessor(pubKeyConverter, balanceConverter, false)
 	require.NotNil(t, ap)
 
 	nftName := "Test-nft"
@@ -192,7 +193,7 @@ func TestAccountsProcessor_GetAccountsEGLDAccounts(t *testing.T) {
 	alteredAccountsMap := map[string]*alteredAccount.AlteredAccount{
 		addr: acc,
 	}
-	ap, _ := NewAccountsProcessor(mock.NewPubkeyConverterMock(32), balanceConverter)
+	ap, _ := NewAccountsProcessor(mock.NewPubkeyConverterMock(32), balanceConverter, false)
 	require.NotNil(t, ap)
 
 	accounts, esdtAccounts := ap.GetAccounts(alteredAccountsMap)
@@ -220,7 +221,7 @@ func TestAccountsProcessor_GetAccountsESDTAccount(t *testing.T) {
 	alteredAccountsMap := map[string]*alteredAccount.AlteredAccount{
 		addr: acc,
 	}
-	ap, _ := NewAccountsProcessor(mock.NewPubkeyConverterMock(32), balanceConverter)
+	ap, _ := NewAccountsProcessor(mock.NewPubkeyConverterMock(32), balanceConverter, false)
 	require.NotNil(t, ap)
 
 	accounts, esdtAccounts := ap.GetAccounts(alteredAccountsMap)
@@ -244,7 +245,7 @@ func TestAccountsProcessor_GetAccountsESDTAccountNewAccountShouldBeInRegularAcco
 	alteredAccountsMap := map[string]*alteredAccount.AlteredAccount{
 		addr: acc,
 	}
-	ap, _ := NewAccountsProcessor(mock.NewPubkeyConverterMock(32), balanceConverter)
+	ap, _ := NewAccountsProcessor(mock.NewPubkeyConverterMock(32), balanceConverter, false)
 	require.NotNil(t, ap)
 
 	accounts, esdtAccounts := ap.GetAccounts(alteredAccountsMap)
@@ -280,7 +281,7 @@ func TestAccountsProcessor_PrepareAccountsMapEGLD(t *testing.T) {
 		IsSender:    false,
 	}
 
-	ap, _ := NewAccountsProcessor(mock.NewPubkeyConverterMock(32), balanceConverter)
+	ap, _ := NewAccountsProcessor(mock.NewPubkeyConverterMock(32), balanceConverter, false)
 	require.NotNil(t, ap)
 
 	balanceNum, _ := balanceConverter.ComputeBalanceAsFloat(big.NewInt(1000))
@@ -300,6 +301,26 @@ func TestAccountsProcessor_PrepareAccountsMapEGLD(t *testing.T) {
 		res[addr])
 }
 
+func TestAccountsProcessor_PrepareAccountsMapEGLDWithRawHexAddresses(t *testing.T) {
+	t.Parallel()
+
+	addrBytes := bytes.Repeat([]byte{1}, 32)
+	addr := hex.EncodeToString(addrBytes)
+
+	egldAccount := &data.Account{
+		UserAccount: &alteredAccount.AlteredAccount{
+			Address: addr,
+			Balance: "1000",
+		},
+	}
+
+	ap, _ := NewAccountsProcessor(mock.NewPubkeyConverterMock(32), balanceConverter, true)
+	require.NotNil(t, ap)
+
+	res := ap.PrepareRegularAccountsMap(123, []*data.Account{egldAccount}, 0)
+	require.Equal(t, hex.EncodeToString(addrBytes), res[addr].AddressHex)
+}
+
 func TestAccountsProcessor_PrepareAccountsMapESDT(t *testing.T) {
 	t.Parallel()
 
@@ -328,7 +349,7 @@ func TestAccountsProcessor_PrepareAccountsMapESDT(t *testing.T) {
 			},
 		},
 	}
-	ap, _ := NewAccountsProcessor(mock.NewPubkeyConverterMock(32), balanceConverter)
+	ap, _ := NewAccountsProcessor(mock.NewPubkeyConverterMock(32), balanceConverter, false)
 	require.NotNil(t, ap)
 
 	accountsESDT := []*data.AccountESDT{
@@ -385,7 +406,7 @@ func TestAccountsProcessor_PrepareAccountsHistory(t *testing.T) {
 		},
 	}
 
-	ap, _ := NewAccountsProcessor(mock.NewPubkeyConverterMock(32), balanceConverter)
+	ap, _ := NewAccountsProcessor(mock.NewPubkeyConverterMock(32), balanceConverter, false)
 
 	res := ap.PrepareAccountsHistory(100, accounts, 0)
 	accountBalanceHistory := res["addr1-token-112-10"]
@@ -406,7 +427,7 @@ func TestAccountsProcessor_PutTokenMedataDataInTokens(t *testing.T) {
 	t.Run("no tokens with missing data or nonce higher than 0", func(t *testing.T) {
 		t.Parallel()
 
-		ap, _ := NewAccountsProcessor(mock.NewPubkeyConverterMock(32), balanceConverter)
+		ap, _ := NewAccountsProcessor(mock.NewPubkeyConverterMock(32), balanceConverter, false)
 
 		oldCreator := "old creator"
 		tokensInfo := []*data.TokenInfo{
@@ -422,7 +443,7 @@ func TestAccountsProcessor_PutTokenMedataDataInTokens(t *testing.T) {
 	t.Run("error loading token, should not update metadata", func(t *testing.T) {
 		t.Parallel()
 
-		ap, _ := NewAccountsProcessor(mock.NewPubkeyConverterMock(32), balanceConverter)
+		ap, _ := NewAccountsProcessor(mock.NewPubkeyConverterMock(32), balanceConverter, false)
 
 		tokensInfo := []*data.TokenInfo{
 			{
@@ -442,7 +463,7 @@ func TestAccountsProcessor_PutTokenMedataDataInTokens(t *testing.T) {
 	t.Run("should work and update metadata", func(t *testing.T) {
 		t.Parallel()
 
-		ap, _ := NewAccountsProcessor(mock.NewPubkeyConverterMock(32), balanceConverter)
+		ap, _ := NewAccountsProcessor(mock.NewPubkeyConverterMock(32), balanceConverter, false)
 
 		metadata0, metadata1 := &alteredAccount.TokenMetaData{Creator: "creator 0"}, &alteredAccount.TokenMetaData{Creator: "creator 1"}
 		tokensInfo := []*data.TokenInfo{
@@ -484,7 +505,7 @@ func TestAccountsProcessor_PutTokenMedataDataInTokens(t *testing.T) {
 func TestAddAdditionalDataIntoAccounts(t *testing.T) {
 	t.Parallel()
 
-	ap, _ := NewAccountsProcessor(mock.NewPubkeyConverterMock(32), balanceConverter)
+	ap, _ := NewAccountsProcessor(mock.NewPubkeyConverterMock(32), balanceConverter, false)
 
 	account := &data.AccountInfo{}
 	ap.addAdditionalDataInAccount(&alteredAccount.AdditionalAccountData{
@@ -0,0 +1,212 @@
+package elasticproc
+
+import (
+	"context"
+	"encoding/json"
+
+	coreData "github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/alteredAccount"
+
+	"github.com/multiversx/mx-chain-es-indexer-go/core/request"
+	"github.com/multiversx/mx-chain-es-indexer-go/data"
+	elasticIndexer "github.com/multiversx/mx-chain-es-indexer-go/process/dataindexer"
+)
+
+const millisecondsInSecond = 1000
+
+// prepareAccountsActivity computes the changes of the activity counters of the block and indexes them in the accounts
+// activity index before the accounts documents are changed. The tokens count is computed only if the ESDT accounts
+// index is enabled
+func (ei *elasticProcessor) prepareAccountsActivity(
+	preparedResults *data.PreparedResults,
+	coreAlteredAccounts map[string]*alteredAccount.AlteredAccount,
+	header coreData.HeaderHandler,
+) (map[string]*data.AccountActivity, error) {
+	if !ei.isIndexEnabled(elasticIndexer.AccountsIndex) {
+		return nil, nil
+	}
+
+	accountsActivity := ei.accountsProc.PrepareAccountsActivity(preparedResults, header.GetTimeStamp(), header.GetEpoch(), header.GetShardID())
+	if ei.isIndexEnabled(elasticIndexer.AccountsESDTIndex) {
+		ei.accountsProc.AddTokensInAccountsActivity(accountsActivity, coreAlteredAccounts, header.GetTimeStamp(), header.GetEpoch(), header.GetShardID())
+	}
+
+	indexedActivity, err := ei.getIndexedAccountsActivity(accountsActivity, header.GetShardID())
+	if err != nil {
+		return nil, err
+	}
+
+	existingAccountsESDT, err := ei.getExistingAccountsESDT(accountsActivity, indexedActivity, header.GetShardID())
+	if err != nil {
+		return nil, err
+	}
+
+	ei.accountsProc.AddTokensCount(accountsActivity, indexedActivity, existingAccountsESDT)
+
+	buffSlice := data.NewBufferSlice(ei.getBulkRequestMaxSize())
+	err = ei.accountsProc.SerializeAccountsActivityChanges(accountsActivity, buffSlice, elasticIndexer.AccountsActivityIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	// the changes are indexed before the ESDT accounts documents are changed, so that a retried block reuses its tokens count
+	err = ei.doBulkRequests(elasticIndexer.AccountsActivityIndex, buffSlice.Buffers(), header.GetShardID())
+	if err != nil {
+		return nil, err
+	}
+
+	return accountsActivity, nil
+}
+
+// getIndexedAccountsActivity returns the accounts activity of the addresses with changed tokens that was already indexed
+// for the current block
+func (ei *elasticProcessor) getIndexedAccountsActivity(accountsActivity map[string]*data.AccountActivity, shardID uint32) (map[string]*data.AccountActivity, error) {
+	indexedActivity := make(map[string]*data.AccountActivity)
+	ids := ei.accountsProc.GetAccountsActivityIDs(accountsActivity)
+	if len(ids) == 0 {
+		return indexedActivity, nil
+	}
+
+	ctxWithValue := context.WithValue(context.Background(), request.ContextKey, request.ExtendTopicWithShardID(request.GetTopic, shardID))
+	responseActivity := &data.ResponseAccountsActivity{}
+	err := ei.elasticClient.DoMultiGet(ctxWithValue, ids, elasticIndexer.AccountsActivityIndex, true, responseActivity)
+	if err != nil {
+		return nil, err
+	}
+
+	for idx := range responseActivity.Docs {
+		if responseActivity.Docs[idx].Found {
+			indexedActivity[responseActivity.Docs[idx].Source.Address] = &responseActivity.Docs[idx].Source
+		}
+	}
+
+	return indexedActivity, nil
+}
+
+func (ei *elasticProcessor) getExistingAccountsESDT(
+	accountsActivity map[string]*data.AccountActivity,
+	indexedActivity map[string]*data.AccountActivity,
+	shardID uint32,
+) (map[string]struct{}, error) {
+	existingAccountsESDT := make(map[string]struct{})
+	ids := ei.accountsProc.GetAccountsESDTIDs(accountsActivity, indexedActivity)
+	if len(ids) == 0 {
+		return existingAccountsESDT, nil
+	}
+
+	ctxWithValue := context.WithValue(context.Background(), request.ContextKey, request.ExtendTopicWithShardID(request.GetTopic, shardID))
+	responseAccounts := &data.ResponseAccountsESDT{}
+	err := ei.elasticClient.DoMultiGet(ctxWithValue, ids, elasticIndexer.AccountsESDTIndex, false, responseAccounts)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, doc := range responseAccounts.Docs {
+		if doc.Found {
+			existingAccountsESDT[doc.ID] = struct{}{}
+		}
+	}
+
+	return existingAccountsESDT, nil
+}
+
+// indexAccountsActivity has to be called after the altered accounts were serialized, so that the activity counters are
+// added on top of the account document and not overwritten by it
+func (ei *elasticProcessor) indexAccountsActivity(accountsActivity map[string]*data.AccountActivity, buffSlice *data.BufferSlice) error {
+	if !ei.isIndexEnabled(elasticIndexer.AccountsIndex) {
+		return nil
+	}
+
+	return ei.accountsProc.SerializeAccountsActivity(accountsActivity, buffSlice, elasticIndexer.AccountsIndex)
+}
+
+// revertAccountsActivity subtracts the accounts activity of the reverted block from the accounts documents and removes
+// it from the accounts activity index afterwards, so that a failed revert can be retried
+func (ei *elasticProcessor) revertAccountsActivity(header coreData.HeaderHandler) error {
+	if !ei.isIndexEnabled(elasticIndexer.AccountsIndex) {
+		return nil
+	}
+
+	accountsActivity, err := ei.getAccountsActivityOfBlock(header.GetTimeStamp(), header.GetShardID())
+	if err != nil || len(accountsActivity) == 0 {
+		return err
+	}
+
+	lastActive, err := ei.getAccountsLastActive(accountsActivity, header.GetTimeStamp(), header.GetShardID())
+	if err != nil {
+		return err
+	}
+
+	buffSlice := data.NewBufferSlice(ei.getBulkRequestMaxSize())
+	err = ei.accountsProc.SerializeRevertedAccountsActivity(accountsActivity, lastActive, buffSlice, elasticIndexer.AccountsIndex)
+	if err != nil {
+		return err
+	}
+
+	err = ei.doBulkRequests(elasticIndexer.AccountsIndex, buffSlice.Buffers(), header.GetShardID())
+	if err != nil {
+		return err
+	}
+
+	return ei.removeFromIndexByTimestampAndShardID(header.GetTimeStamp(), header.GetShardID(), elasticIndexer.AccountsActivityIndex)
+}
+
+func (ei *elasticProcessor) getAccountsActivityOfBlock(timestamp uint64, shardID uint32) ([]*data.AccountActivity, error) {
+	accountsActivity := make([]*data.AccountActivity, 0)
+	handlerFunc := func(responseBytes []byte) error {
+		responseScroll := &data.ResponseScroll{}
+		err := json.Unmarshal(responseBytes, responseScroll)
+		if err != nil {
+			return err
+		}
+
+		for _, hit := range responseScroll.Hits.Hits {
+			accountActivity := &data.AccountActivity{}
+			err = json.Unmarshal(hit.Source, accountActivity)
+			if err != nil {
+				return err
+			}
+
+			accountsActivity = append(accountsActivity, accountActivity)
+		}
+
+		return nil
+	}
+
+	ctxWithValue := context.WithValue(context.Background(), request.ContextKey, request.ExtendTopicWithShardID(request.ScrollTopic, shardID))
+	query := ei.accountsProc.PrepareAccountsActivityQuery(timestamp, shardID)
+	err := ei.elasticClient.DoScrollRequest(ctxWithValue, elasticIndexer.AccountsActivityIndex, query, true, handlerFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	return accountsActivity, nil
+}
+
+// getAccountsLastActive returns the timestamp of the last activity of the provided addresses before the provided timestamp
+func (ei *elasticProcessor) getAccountsLastActive(accountsActivity []*data.AccountActivity, timestamp uint64, shardID uint32) (map[string]uint64, error) {
+	addresses := make([]string, 0, len(accountsActivity))
+	for _, accountActivity := range accountsActivity {
+		addresses = append(addresses, accountActivity.Address)
+	}
+
+	query, err := ei.accountsProc.PrepareLastActiveQuery(addresses, timestamp)
+	if err != nil {
+		return nil, err
+	}
+
+	ctxWithValue := context.WithValue(context.Background(), request.ContextKey, request.ExtendTopicWithShardID(request.GetTopic, shardID))
+	responseLastActive := &data.ResponseLastActive{}
+	err = ei.elasticClient.DoSearchRequest(ctxWithValue, elasticIndexer.AccountsActivityIndex, query, responseLastActive)
+	if err != nil {
+		return nil, err
+	}
+
+	lastActive := make(map[string]uint64)
+	for _, bucket := range responseLastActive.Aggregations.Accounts.Buckets {
+		// the max aggregation of a date field returns the value in milliseconds
+		lastActive[bucket.Key] = uint64(bucket.LastActive.Value) / millisecondsInSecond
+	}
+
+	return lastActive, nil
+}
@@ -1,9 +1,11 @@
 package block
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 
+	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	coreData "github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/data/block"
@@ -52,3 +54,92 @@ func (bp *blockProcessor) SerializeEpochInfoData(header coreData.HeaderHandler,
 
 	return buffSlice.PutData(meta, serializedData)
 }
+
+// SerializeNotarizedBlocks will serialize the updates that link the provided shard blocks with the metachain block that
+// notarized them. The shard blocks that are not indexed yet are linked when they are indexed
+func (bp *blockProcessor) SerializeNotarizedBlocks(notarizedBlocksHashes []string, metaBlockHash string, metaBlockRound uint64, buffSlice *data.BufferSlice, index string) error {
+	codeToExecute := `
+		if ('create' == ctx.op) {
+			ctx.op = 'noop';
+		} else {
+			ctx._source.notarizedByMetaHash = params.metaHash;
+			ctx._source.notarizedAtRound = params.round;
+			ctx._source.notarized = true;
+		}
+`
+	for _, hash := range notarizedBlocksHashes {
+		meta := []byte(fmt.Sprintf(`{ "update" : { "_index":"%s", "_id" : "%s" } }%s`, index, converters.JsonEscape(hash), "\n"))
+		serializedDataStr := fmt.Sprintf(`{"scripted_upsert": true, "script": {`+
+			`"source": "%s",`+
+			`"lang": "painless",`+
+			`"params": {"metaHash": "%s", "round": %d}},`+
+			`"upsert": {}}`,
+			converters.FormatPainlessSource(codeToExecute), converters.JsonEscape(metaBlockHash), metaBlockRound,
+		)
+
+		err := buffSlice.PutData(meta, []byte(serializedDataStr))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SerializeCrossShardTransactions will serialize the update that keeps, in the provided shard block, the hashes of its
+// cross-shard transactions. They are linked with the metachain block that notarizes the shard block when it is indexed
+func (bp *blockProcessor) SerializeCrossShardTransactions(blockHash string, txsHashes []string, buffSlice *data.BufferSlice, index string) error {
+	serializedHashes, err := json.Marshal(txsHashes)
+	if err != nil {
+		return err
+	}
+
+	meta := []byte(fmt.Sprintf(`{ "update" : { "_index":"%s", "_id" : "%s" } }%s`, index, converters.JsonEscape(blockHash), "\n"))
+	codeToExecute := `
+		if ('create' == ctx.op) {
+			ctx.op = 'noop';
+		} else {
+			ctx._source.crossShardTxsHashes = params.hashes;
+		}
+`
+	serializedDataStr := fmt.Sprintf(`{"scripted_upsert": true, "script": {"source": "%s","lang": "painless","params": {"hashes": %s}},"upsert": {}}`,
+		converters.FormatPainlessSource(codeToExecute), serializedHashes)
+
+	return buffSlice.PutData(meta, []byte(serializedDataStr))
+}
+
+// SerializeFinalizedBlock will serialize the update that marks the provided block as final
+func (bp *blockProcessor) SerializeFinalizedBlock(blockHash string, buffSlice *data.BufferSlice, index string) error {
+	meta := []byte(fmt.Sprintf(`{ "update" : { "_index":"%s", "_id" : "%s" } }%s`, index, converters.JsonEscape(blockHash), "\n"))
+	codeToExecute := `
+		if ('create' == ctx.op) {
+			ctx.op = 'noop';
+		} else {
+			ctx._source.finalized = true;
+		}
+`
+	serializedDataStr := fmt.Sprintf(`{"scripted_upsert": true, "script": {"source": "%s","lang": "painless"},"upsert": {}}`,
+		converters.FormatPainlessSource(codeToExecute))
+
+	return buffSlice.PutData(meta, []byte(serializedDataStr))
+}
+
+// PrepareNotarizedBlocksQueryInCaseOfRevert will prepare the update by query request that removes the links between
+// the shard blocks and a reverted metachain block
+func (bp *blockProcessor) PrepareNotarizedBlocksQueryInCaseOfRevert(metaBlockHash string) *bytes.Buffer {
+	codeToExecute := `
+		ctx._source.remove('notarizedByMetaHash');
+		ctx._source.remove('notarizedAtRound');
+		ctx._source.remove('notarized');
+`
+	query := fmt.Sprintf(`{"query": {"match": {"notarizedByMetaHash": "%s"}},"script": {"source": "%s","lang": "painless"}}`,
+		converters.JsonEscape(metaBlockHash), converters.FormatPainlessSource(codeToExecute))
+
+	return bytes.NewBuffer([]byte(query))
+}
+
+// PrepareNotarizingMetaBlockQuery will prepare the query that returns the metachain block which notarized the provided shard block
+func (bp *blockProcessor) PrepareNotarizingMetaBlockQuery(blockHash string) []byte {
+	return []byte(fmt.Sprintf(`{"_source": ["round"],"size": 1,"query": {"bool": {"must": [{"match": {"shardId": %d}},{"term": {"notarizedBlocksHashes": "%s"}}]}}}`,
+		core.MetachainShardId, converters.JsonEscape(blockHash)))
+}
@@ -106,3 +106,59 @@ func TestBlockProcessor_SerializeBlockEpochStartMeta(t *testing.T) {
 {"uuid":"","nonce":1,"round":2,"epoch":3,"miniBlocksHashes":["mb1Hash","mbHash2"],"notarizedBlocksHashes":["notarized1"],"proposer":5,"validators":[0,1,2,3,4,5],"pubKeyBitmap":"00000110","size":345,"sizeTxs":0,"timestamp":123456,"stateRootHash":"stateHash","prevHash":"prevHash","shardId":4294967295,"txCount":100,"notarizedTxsCount":120,"accumulatedFees":"1000","developerFees":"50","epochStartBlock":true,"searchOrder":1010,"epochStartInfo":{"totalSupply":"100","totalToDistribute":"55","totalNewlyMinted":"20","rewardsPerBlock":"15","rewardsForProtocolSustainability":"2","nodePrice":"10","prevEpochStartRound":222,"prevEpochStartHash":"7072657645706f6368"},"gasProvided":0,"gasRefunded":0,"gasPenalized":0,"maxGasLimit":0}
 `, buffSlice.Buffers()[0].String())
 }
+
+func TestBlockProcessor_SerializeNotarizedBlocks(t *testing.T) {
+	t.Parallel()
+
+	bp, _ := NewBlockProcessor(&mock.HasherMock{}, &mock.MarshalizerMock{})
+
+	buffSlice := data.NewBufferSlice(data.DefaultMaxBulkSize)
+	err := bp.SerializeNotarizedBlocks([]string{"h1", "h2"}, "metaHash", 101, buffSlice, "blocks")
+	require.Nil(t, err)
+
+	expectedRes := `{ "update" : { "_index":"blocks", "_id" : "h1" } }
+{"scripted_upsert": true, "script": {"source": "if ('create' == ctx.op) {ctx.op = 'noop';} else {ctx._source.notarizedByMetaHash = params.metaHash;ctx._source.notarizedAtRound = params.round;ctx._source.notarized = true;}","lang": "painless","params": {"metaHash": "metaHash", "round": 101}},"upsert": {}}
+{ "update" : { "_index":"blocks", "_id" : "h2" } }
+{"scripted_upsert": true, "script": {"source": "if ('create' == ctx.op) {ctx.op = 'noop';} else {ctx._source.notarizedByMetaHash = params.metaHash;ctx._source.notarizedAtRound = params.round;ctx._source.notarized = true;}","lang": "painless","params": {"metaHash": "metaHash", "round": 101}},"upsert": {}}
+`
+	require.Equal(t, expectedRes, buffSlice.Buffers()[0].String())
+}
+
+func TestBlockProcessor_SerializeCrossShardTransactions(t *testing.T) {
+	t.Parallel()
+
+	bp, _ := NewBlockProcessor(&mock.HasherMock{}, &mock.MarshalizerMock{})
+
+	buffSlice := data.NewBufferSlice(data.DefaultMaxBulkSize)
+	err := bp.SerializeCrossShardTransactions("hash", []string{"tx1", "tx2"}, buffSlice, "blocks")
+	require.Nil(t, err)
+
+	expectedRes := `{ "update" : { "_index":"blocks", "_id" : "hash" } }
+{"scripted_upsert": true, "script": {"source": "if ('create' == ctx.op) {ctx.op = 'noop';} else {ctx._source.crossShardTxsHashes = params.hashes;}","lang": "painless","params": {"hashes": ["tx1","tx2"]}},"upsert": {}}
+`
+	require.Equal(t, expectedRes, buffSlice.Buffers()[0].String())
+}
+
+func TestBlockProcessor_SerializeFinalizedBlock(t *testing.T) {
+	t.Parallel()
+
+	bp, _ := NewBlockProcessor(&mock.HasherMock{}, &mock.MarshalizerMock{})
+
+	buffSlice := data.NewBufferSlice(data.DefaultMaxBulkSize)
+	err := bp.SerializeFinalizedBlock("hash", buffSlice, "blocks")
+	require.Nil(t, err)
+
+	expectedRes := `{ "update" : { "_index":"blocks", "_id" : "hash" } }
+{"scripted_upsert": true, "script": {"source": "if ('create' == ctx.op) {ctx.op = 'noop';} else {ctx._source.finalized = true;}","lang": "painless"},"upsert": {}}
+`
+	require.Equal(t, expectedRes, buffSlice.Buffers()[0].String())
+}
+
+func TestBlockProcessor_PrepareNotarizedBlocksQueryInCaseOfRevert(t *testing.T) {
+	t.Parallel()
+
+	bp, _ := NewBlockProcessor(&mock.HasherMock{}, &mock.MarshalizerMock{})
+
+	query := bp.PrepareNotarizedBlocksQueryInCaseOfRevert("metaHash")
+	require.Equal(t, `{"query": {"match": {"notarizedByMetaHash": "metaHash"}},"script": {"source": "ctx._source.remove('notarizedByMetaHash');ctx._source.remove('notarizedAtRound');ctx._source.remove('notarized');","lang": "painless"}}`, query.String())
+}
@@ -0,0 +1,336 @@
+package bridge
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-es-indexer-go/core/request"
+	"github.com/multiversx/mx-chain-es-indexer-go/data"
+	indexerdata "github.com/multiversx/mx-chain-es-indexer-go/process/dataindexer"
+	"github.com/multiversx/mx-chain-es-indexer-go/process/elasticproc"
+	"github.com/multiversx/mx-chain-es-indexer-go/process/elasticproc/converters"
+)
+
+const (
+	// unmatchedTransfersWindowInSeconds is the period in which a bridge transfer is searched on the main chain, the older
+	// ones remain unmatched
+	unmatchedTransfersWindowInSeconds = 3600
+	maxTransfersToMatch               = 1000
+)
+
+var errMaxTransfersToMatchReached = errors.New("max number of bridge transfers to match reached")
+
+// bridgeTransferMatch holds the fields of a bridge transfer that are found on the main chain
+type bridgeTransferMatch struct {
+	id     string
+	fields map[string]string
+}
+
+// startMatching will search in the background the main chain side of the unmatched bridge transfers, unless a previous
+// search is still running. The matching is best-effort, the transfers that are not matched are searched again later
+func (sbt *sovereignBridgeTransfersHandler) startMatching(elasticClient elasticproc.DatabaseClientHandler, timestamp uint64) {
+	if sbt.isMatching.SetReturningPrevious() {
+		return
+	}
+
+	go func() {
+		defer sbt.isMatching.Reset()
+
+		sbt.matchBridgeTransfers(elasticClient, timestamp)
+	}()
+}
+
+func (sbt *sovereignBridgeTransfersHandler) popMatches() []*bridgeTransferMatch {
+	sbt.mutMatches.Lock()
+	defer sbt.mutMatches.Unlock()
+
+	matches := sbt.matches
+	sbt.matches = make([]*bridgeTransferMatch, 0)
+
+	return matches
+}
+
+func (sbt *sovereignBridgeTransfersHandler) matchBridgeTransfers(elasticClient elasticproc.DatabaseClientHandler, timestamp uint64) {
+	fromTimestamp := uint64(0)
+	if timestamp > unmatchedTransfersWindowInSeconds {
+		fromTimestamp = timestamp - unmatchedTransfersWindowInSeconds
+	}
+
+	unmatchedTransfers, err := getUnmatchedTransfers(elasticClient, fromTimestamp)
+	if err != nil {
+		log.Warn("sovereignBridgeTransfersHandler.matchBridgeTransfers cannot get the unmatched bridge transfers", "error", err)
+		return
+	}
+
+	// the main chain deposits are made before the sovereign chain receives them
+	mainChainFromTimestamp := uint64(0)
+	if fromTimestamp > unmatchedTransfersWindowInSeconds {
+		mainChainFromTimestamp = fromTimestamp - unmatchedTransfersWindowInSeconds
+	}
+
+	incomingMatches, err := sbt.matchIncomingTransfers(unmatchedTransfers, mainChainFromTimestamp)
+	if err != nil {
+		log.Warn("sovereignBridgeTransfersHandler.matchBridgeTransfers cannot match the incoming transfers", "error", err)
+	}
+	outgoingMatches, err := sbt.matchOutgoingTransfers(unmatchedTransfers, mainChainFromTimestamp)
+	if err != nil {
+		log.Warn("sovereignBridgeTransfersHandler.matchBridgeTransfers cannot match the outgoing transfers", "error", err)
+	}
+
+	sbt.mutMatches.Lock()
+	sbt.matches = append(sbt.matches, incomingMatches...)
+	sbt.matches = append(sbt.matches, outgoingMatches...)
+	sbt.mutMatches.Unlock()
+}
+
+func getUnmatchedTransfers(elasticClient elasticproc.DatabaseClientHandler, fromTimestamp uint64) ([]*data.BridgeTransfer, error) {
+	unmatchedTransfers := make([]*data.BridgeTransfer, 0)
+	handlerFunc := func(responseBytes []byte) error {
+		responseScroll := &data.ResponseScroll{}
+		err := json.Unmarshal(responseBytes, responseScroll)
+		if err != nil {
+			return err
+		}
+
+		for _, hit := range responseScroll.Hits.Hits {
+			bridgeTransfer := &data.BridgeTransfer{}
+			err = json.Unmarshal(hit.Source, bridgeTransfer)
+			if err != nil {
+				return err
+			}
+
+			bridgeTransfer.ID = hit.ID
+			unmatchedTransfers = append(unmatchedTransfers, bridgeTransfer)
+			if len(unmatchedTransfers) >= maxTransfersToMatch {
+				return errMaxTransfersToMatchReached
+			}
+		}
+
+		return nil
+	}
+
+	ctxWithValue := context.WithValue(context.Background(), request.ContextKey, request.ScrollTopic)
+	err := elasticClient.DoScrollRequest(ctxWithValue, indexerdata.BridgeTransfersIndex, prepareUnmatchedTransfersQuery(fromTimestamp), true, handlerFunc)
+	if err != nil && !errors.Is(err, errMaxTransfersToMatchReached) {
+		return nil, err
+	}
+
+	return unmatchedTransfers, nil
+}
+
+// matchIncomingTransfers will search the main chain deposit events of the incoming transfers, by receiver and deposit nonce
+func (sbt *sovereignBridgeTransfersHandler) matchIncomingTransfers(unmatchedTransfers []*data.BridgeTransfer, fromTimestamp uint64) ([]*bridgeTransferMatch, error) {
+	incomingTransfers := make(map[string]*data.BridgeTransfer)
+	receivers := make([]string, 0)
+	for _, bridgeTransfer := range unmatchedTransfers {
+		if bridgeTransfer.Direction != data.BridgeIncomingDirection {
+			continue
+		}
+
+		receiver, err := sbt.pubKeyConverter.Decode(bridgeTransfer.Receiver)
+		if err != nil {
+			log.Debug("sovereignBridgeTransfersHandler.matchIncomingTransfers cannot decode receiver",
+				"receiver", bridgeTransfer.Receiver, "error", err)
+			continue
+		}
+
+		receiverHex := hex.EncodeToString(receiver)
+		receivers = append(receivers, receiverHex)
+		incomingTransfers[computeDepositKey(receiverHex, bridgeTransfer.Nonce)] = bridgeTransfer
+	}
+	if len(incomingTransfers) == 0 {
+		return nil, nil
+	}
+
+	matches := make([]*bridgeTransferMatch, 0)
+	handlerFunc := func(responseBytes []byte) error {
+		responseScroll := &data.ResponseScroll{}
+		err := json.Unmarshal(responseBytes, responseScroll)
+		if err != nil {
+			return err
+		}
+
+		for _, hit := range responseScroll.Hits.Hits {
+			event := &data.LogEvent{}
+			err = json.Unmarshal(hit.Source, event)
+			if err != nil {
+				return err
+			}
+
+			deposit, errDecode := decodeDepositEvent(event.Topics, event.Data)
+			if errDecode != nil {
+				continue
+			}
+
+			key := computeDepositKey(hex.EncodeToString(deposit.receiver), deposit.nonce)
+			incomingTransfer, found := incomingTransfers[key]
+			if !found {
+				continue
+			}
+
+			delete(incomingTransfers, key)
+			matches = append(matches, &bridgeTransferMatch{
+				id: incomingTransfer.ID,
+				fields: map[string]string{
+					"sender":          sbt.pubKeyConverter.SilentEncode(deposit.sender, log),
+					"mainChainTxHash": event.TxHash,
+				},
+			})
+		}
+
+		return nil
+	}
+
+	ctxWithValue := context.WithValue(context.Background(), request.ContextKey, request.ScrollTopic)
+	query := prepareMainChainEventsQuery(DepositIdentifier, receivers, fromTimestamp)
+	err := sbt.mainChainElasticClient.DoScrollRequest(ctxWithValue, indexerdata.EventsIndex, query, true, handlerFunc)
+
+	return matches, err
+}
+
+// matchOutgoingTransfers will search the main chain execute events of the outgoing transfers, by bridge operation hash
+func (sbt *sovereignBridgeTransfersHandler) matchOutgoingTransfers(unmatchedTransfers []*data.BridgeTransfer, fromTimestamp uint64) ([]*bridgeTransferMatch, error) {
+	outgoingTransfers := make(map[string]*data.BridgeTransfer)
+	opHashes := make([]string, 0)
+	for _, bridgeTransfer := range unmatchedTransfers {
+		if bridgeTransfer.Direction == data.BridgeOutgoingDirection && bridgeTransfer.OpHash != "" {
+			outgoingTransfers[bridgeTransfer.OpHash] = bridgeTransfer
+			opHashes = append(opHashes, bridgeTransfer.OpHash)
+		}
+	}
+	if len(outgoingTransfers) == 0 {
+		return nil, nil
+	}
+
+	matches := make([]*bridgeTransferMatch, 0)
+	handlerFunc := func(responseBytes []byte) error {
+		responseScroll := &data.ResponseScroll{}
+		err := json.Unmarshal(responseBytes, responseScroll)
+		if err != nil {
+			return err
+		}
+
+		for _, hit := range responseScroll.Hits.Hits {
+			event := &data.LogEvent{}
+			err = json.Unmarshal(hit.Source, event)
+			if err != nil {
+				return err
+			}
+
+			opHash, errDecode := decodeExecuteEvent(event.Topics)
+			if errDecode != nil {
+				continue
+			}
+
+			outgoingTransfer, found := outgoingTransfers[opHash]
+			if !found {
+				continue
+			}
+
+			delete(outgoingTransfers, opHash)
+			matches = append(matches, &bridgeTransferMatch{
+				id: outgoingTransfer.ID,
+				fields: map[string]string{
+					"mainChainTxHash": event.TxHash,
+					"status":          data.BridgeStatusExecuted,
+				},
+			})
+		}
+
+		return nil
+	}
+
+	ctxWithValue := context.WithValue(context.Background(), request.ContextKey, request.ScrollTopic)
+	query := prepareMainChainEventsQuery(ExecuteIdentifier, opHashes, fromTimestamp)
+	err := sbt.mainChainElasticClient.DoScrollRequest(ctxWithValue, indexerdata.EventsIndex, query, true, handlerFunc)
+
+	return matches, err
+}
+
+func computeDepositKey(receiverHex string, nonce uint64) string {
+	return fmt.Sprintf("%s-%d", receiverHex, nonce)
+}
+
+func prepareUnmatchedTransfersQuery(fromTimestamp uint64) []byte {
+	return []byte(fmt.Sprintf(`{"query": {"bool": {"must": [{"match": {"matched": false}},{"range": {"timestamp": {"gte": %d}}}]}}}`, fromTimestamp))
+}
+
+func prepareMainChainEventsQuery(identifier string, topics []string, fromTimestamp uint64) []byte {
+	serializedTopics, _ := json.Marshal(topics)
+
+	return []byte(fmt.Sprintf(`{"query": {"bool": {"must": [{"match": {"identifier": "%s"}},{"terms": {"topics": %s}},{"range": {"timestamp": {"gte": %d}}}]}}}`,
+		identifier, serializedTopics, fromTimestamp))
+}
+
+// serializeBridgeTransfersMatches will serialize the matches found with the main chain, recording the block that added
+// them, so that they can be reverted
+func serializeBridgeTransfersMatches(matches []*bridgeTransferMatch, timestamp uint64, shardID uint32, buffSlice *data.BufferSlice) error {
+	codeToExecute := `
+		if ('create' == ctx.op || ctx._source.matched) {
+			ctx.op = 'noop';
+			return;
+		}
+		for (def field : params.fields.entrySet()) {
+			ctx._source[field.getKey()] = field.getValue();
+		}
+		ctx._source.matched = true;
+		ctx._source.matchTimestamp = params.timestamp;
+		ctx._source.matchShardID = params.shardID;
+`
+	for _, match := range matches {
+		meta := []byte(fmt.Sprintf(`{ "update" : { "_index":"%s", "_id" : "%s" } }%s`, indexerdata.BridgeTransfersIndex, converters.JsonEscape(match.id), "\n"))
+		serializedFields, err := json.Marshal(match.fields)
+		if err != nil {
+			return err
+		}
+
+		serializedDataStr := fmt.Sprintf(`{"scripted_upsert": true, "script": {`+
+			`"source": "%s",`+
+			`"lang": "painless",`+
+			`"params": { "fields": %s, "timestamp": %d, "shardID": %d }},`+
+			`"upsert": {}}`,
+			converters.FormatPainlessSource(codeToExecute), string(serializedFields), timestamp, shardID,
+		)
+
+		err = buffSlice.PutData(meta, []byte(serializedDataStr))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// RevertBridgeTransfers will put back the bridge transfers matched by the reverted block as unmatched, the executed
+// withdrawals becoming pending again
+func (sbt *sovereignBridgeTransfersHandler) RevertBridgeTransfers(elasticClient elasticproc.DatabaseClientHandler, timestamp uint64, shardID uint32) error {
+	if !sbt.mainChainElasticClient.IsEnabled() {
+		return nil
+	}
+
+	ctxWithValue := context.WithValue(context.Background(), request.ContextKey, request.ExtendTopicWithShardID(request.UpdateTopic, shardID))
+
+	return elasticClient.UpdateByQuery(ctxWithValue, indexerdata.BridgeTransfersIndex, prepareMatchesQueryInCaseOfRevert(timestamp, shardID))
+}
+
+func prepareMatchesQueryInCaseOfRevert(timestamp uint64, shardID uint32) *bytes.Buffer {
+	codeToExecute := fmt.Sprintf(`
+		if (ctx._source.direction == '%s') {
+			ctx._source.status = '%s';
+		} else {
+			ctx._source.remove('sender');
+		}
+		ctx._source.remove('mainChainTxHash');
+		ctx._source.remove('matchTimestamp');
+		ctx._source.remove('matchShardID');
+		ctx._source.matched = false;
+`, data.BridgeOutgoingDirection, data.BridgeStatusPending)
+	query := fmt.Sprintf(`{"query": {"bool": {"must": [{"match": {"matchShardID": {"query": %d,"operator": "AND"}}},{"match": {"matchTimestamp": {"query": "%d","operator": "AND"}}}]}},"script": {"source": "%s","lang": "painless"}}`,
+		shardID, timestamp, converters.FormatPainlessSource(codeToExecute))
+
+	return bytes.NewBuffer([]byte(query))
+}
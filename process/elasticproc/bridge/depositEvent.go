@@ -0,0 +1,151 @@
+package bridge
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"math/big"
+
+	"github.com/multiversx/mx-chain-es-indexer-go/process/elasticproc/converters"
+)
+
+const (
+	// DepositIdentifier is the identifier of the event emitted by the bridge contracts when tokens are sent to the other chain
+	DepositIdentifier = "deposit"
+	// ExecuteIdentifier is the identifier of the event emitted by the main chain bridge contract when it executes an
+	// operation received from the sovereign chain
+	ExecuteIdentifier = "execute"
+	// executedBridgeOpTopic is the first topic of an execute event, followed by the hash of the operations batch and the
+	// hash of the executed operation
+	executedBridgeOpTopic = "executedBridgeOp"
+	executeTopicsLen      = 3
+
+	// the topics of a deposit event are the event name, the receiver and a triplet (token, nonce, token data) for every token
+	depositTopicsPrefixLen = 2
+	depositTokenTopicsLen  = 3
+
+	nonceLen         = 8
+	addressLen       = 32
+	tokenTypeLen     = 1
+	bigUintLengthLen = 4
+)
+
+var (
+	errInvalidDepositEvent = errors.New("invalid deposit event")
+	errInvalidExecuteEvent = errors.New("invalid execute event")
+)
+
+// depositEvent holds the fields of a bridge deposit event that are needed for the bridge transfers
+type depositEvent struct {
+	nonce      uint64
+	sender     []byte
+	receiver   []byte
+	tokens     []string
+	esdtValues []string
+	// opHash is the hash of the bridge operation created from the deposit, as computed by the bridge contracts
+	opHash string
+}
+
+// decodeDepositEvent will decode the hex encoded topics and data of a deposit event. The topics hold the receiver and the
+// transferred tokens, while the data holds the nested encoded event data, starting with the deposit nonce and the sender
+func decodeDepositEvent(topics []string, eventData string) (*depositEvent, error) {
+	if len(topics) < depositTopicsPrefixLen+depositTokenTopicsLen || (len(topics)-depositTopicsPrefixLen)%depositTokenTopicsLen != 0 {
+		return nil, errInvalidDepositEvent
+	}
+
+	receiver, err := hex.DecodeString(topics[1])
+	if err != nil {
+		return nil, err
+	}
+
+	deposit := &depositEvent{
+		receiver: receiver,
+	}
+	numTokens := (len(topics) - depositTopicsPrefixLen) / depositTokenTopicsLen
+	serializedOperation := append([]byte{}, receiver...)
+	serializedOperation = binary.BigEndian.AppendUint32(serializedOperation, uint32(numTokens))
+	for idx := depositTopicsPrefixLen; idx < len(topics); idx += depositTokenTopicsLen {
+		token, value, serializedPayment, errDecode := decodeDepositToken(topics[idx], topics[idx+1], topics[idx+2])
+		if errDecode != nil {
+			return nil, errDecode
+		}
+
+		deposit.tokens = append(deposit.tokens, token)
+		deposit.esdtValues = append(deposit.esdtValues, value)
+		serializedOperation = append(serializedOperation, serializedPayment...)
+	}
+
+	operationData, err := hex.DecodeString(eventData)
+	if err != nil {
+		return nil, err
+	}
+
+	deposit.nonce, deposit.sender, err = decodeDepositEventData(operationData)
+	if err != nil {
+		return nil, err
+	}
+
+	opHash := sha256.Sum256(append(serializedOperation, operationData...))
+	deposit.opHash = hex.EncodeToString(opHash[:])
+
+	return deposit, nil
+}
+
+// decodeDepositToken returns the token identifier, the amount and the nested encoding of the token payment of the
+// bridge operation
+func decodeDepositToken(tokenHex string, nonceHex string, tokenDataHex string) (string, string, []byte, error) {
+	tokenBytes, err := hex.DecodeString(tokenHex)
+	if err != nil {
+		return "", "", nil, err
+	}
+	nonceBytes, err := hex.DecodeString(nonceHex)
+	if err != nil {
+		return "", "", nil, err
+	}
+	tokenData, err := hex.DecodeString(tokenDataHex)
+	if err != nil {
+		return "", "", nil, err
+	}
+
+	// the token data starts with the token type followed by the nested encoded amount
+	if len(tokenData) < tokenTypeLen+bigUintLengthLen {
+		return "", "", nil, errInvalidDepositEvent
+	}
+	amountLen := int(binary.BigEndian.Uint32(tokenData[tokenTypeLen : tokenTypeLen+bigUintLengthLen]))
+	amountStart := tokenTypeLen + bigUintLengthLen
+	if len(tokenData) < amountStart+amountLen {
+		return "", "", nil, errInvalidDepositEvent
+	}
+	amount := big.NewInt(0).SetBytes(tokenData[amountStart : amountStart+amountLen])
+
+	token := string(tokenBytes)
+	nonce := big.NewInt(0).SetBytes(nonceBytes).Uint64()
+	if nonce > 0 {
+		token = converters.ComputeTokenIdentifier(token, nonce)
+	}
+
+	serializedPayment := binary.BigEndian.AppendUint32(nil, uint32(len(tokenBytes)))
+	serializedPayment = append(serializedPayment, tokenBytes...)
+	serializedPayment = binary.BigEndian.AppendUint64(serializedPayment, nonce)
+	serializedPayment = append(serializedPayment, tokenData...)
+
+	return token, amount.String(), serializedPayment, nil
+}
+
+func decodeDepositEventData(eventData []byte) (uint64, []byte, error) {
+	if len(eventData) < nonceLen+addressLen {
+		return 0, nil, errInvalidDepositEvent
+	}
+
+	return binary.BigEndian.Uint64(eventData[:nonceLen]), eventData[nonceLen : nonceLen+addressLen], nil
+}
+
+// decodeExecuteEvent returns the hex encoded hash of the bridge operation executed by the main chain bridge contract
+func decodeExecuteEvent(topics []string) (string, error) {
+	if len(topics) != executeTopicsLen || topics[0] != hex.EncodeToString([]byte(executedBridgeOpTopic)) {
+		return "", errInvalidExecuteEvent
+	}
+
+	return topics[2], nil
+}
@@ -0,0 +1,125 @@
+package bridge
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func createDepositTokenData(amount *big.Int) []byte {
+	amountBytes := amount.Bytes()
+	tokenData := []byte{0}
+	tokenData = binary.BigEndian.AppendUint32(tokenData, uint32(len(amountBytes)))
+	tokenData = append(tokenData, amountBytes...)
+
+	// the other token properties are not decoded
+	return append(tokenData, 0, 0, 0, 0, 0)
+}
+
+func createDepositEventData(nonce uint64, sender []byte) []byte {
+	eventData := binary.BigEndian.AppendUint64(nil, nonce)
+	eventData = append(eventData, sender...)
+
+	// no transfer data
+	return append(eventData, 0)
+}
+
+func createDepositTopics(receiver []byte) []string {
+	return []string{
+		hex.EncodeToString([]byte(DepositIdentifier)),
+		hex.EncodeToString(receiver),
+		hex.EncodeToString([]byte("TKN-123456")),
+		"",
+		hex.EncodeToString(createDepositTokenData(big.NewInt(1000))),
+		hex.EncodeToString([]byte("NFT-abcdef")),
+		hex.EncodeToString([]byte{10}),
+		hex.EncodeToString(createDepositTokenData(big.NewInt(1))),
+	}
+}
+
+func createDepositPayment(token string, nonce uint64, amount *big.Int) []byte {
+	payment := binary.BigEndian.AppendUint32(nil, uint32(len(token)))
+	payment = append(payment, token...)
+	payment = binary.BigEndian.AppendUint64(payment, nonce)
+
+	return append(payment, createDepositTokenData(amount)...)
+}
+
+// computeDepositOpHash returns the hash of the bridge operation of the deposit created with createDepositTopics
+func computeDepositOpHash(receiver []byte, eventData []byte) string {
+	operation := append([]byte{}, receiver...)
+	operation = binary.BigEndian.AppendUint32(operation, 2)
+	operation = append(operation, createDepositPayment("TKN-123456", 0, big.NewInt(1000))...)
+	operation = append(operation, createDepositPayment("NFT-abcdef", 10, big.NewInt(1))...)
+	operation = append(operation, eventData...)
+
+	opHash := sha256.Sum256(operation)
+	return hex.EncodeToString(opHash[:])
+}
+
+func TestDecodeDepositEvent(t *testing.T) {
+	t.Parallel()
+
+	receiver := make([]byte, addressLen)
+	receiver[0] = 1
+	sender := make([]byte, addressLen)
+	sender[0] = 2
+
+	eventData := createDepositEventData(7, sender)
+	deposit, err := decodeDepositEvent(createDepositTopics(receiver), hex.EncodeToString(eventData))
+	require.Nil(t, err)
+	require.Equal(t, &depositEvent{
+		nonce:      7,
+		sender:     sender,
+		receiver:   receiver,
+		tokens:     []string{"TKN-123456", "NFT-abcdef-0a"},
+		esdtValues: []string{"1000", "1"},
+		opHash:     computeDepositOpHash(receiver, eventData),
+	}, deposit)
+}
+
+func TestDecodeDepositEvent_InvalidEvent(t *testing.T) {
+	t.Parallel()
+
+	receiver := make([]byte, addressLen)
+	eventData := hex.EncodeToString(createDepositEventData(7, make([]byte, addressLen)))
+	topics := createDepositTopics(receiver)
+
+	_, err := decodeDepositEvent(topics[:2], eventData)
+	require.Equal(t, errInvalidDepositEvent, err)
+
+	_, err = decodeDepositEvent(topics[:4], eventData)
+	require.Equal(t, errInvalidDepositEvent, err)
+
+	invalidTokenDataTopics := createDepositTopics(receiver)
+	invalidTokenDataTopics[4] = "0000"
+	_, err = decodeDepositEvent(invalidTokenDataTopics, eventData)
+	require.Equal(t, errInvalidDepositEvent, err)
+
+	_, err = decodeDepositEvent(topics, "0102")
+	require.Equal(t, errInvalidDepositEvent, err)
+
+	_, err = decodeDepositEvent(topics, "not hex")
+	require.NotNil(t, err)
+}
+
+func TestDecodeExecuteEvent(t *testing.T) {
+	t.Parallel()
+
+	opHash := hex.EncodeToString([]byte("operation hash"))
+	topics := []string{hex.EncodeToString([]byte(executedBridgeOpTopic)), hex.EncodeToString([]byte("hash of hashes")), opHash}
+
+	executedOpHash, err := decodeExecuteEvent(topics)
+	require.Nil(t, err)
+	require.Equal(t, opHash, executedOpHash)
+
+	_, err = decodeExecuteEvent(topics[:2])
+	require.Equal(t, errInvalidExecuteEvent, err)
+
+	_, err = decodeExecuteEvent([]string{hex.EncodeToString([]byte("registerToken")), topics[1], topics[2]})
+	require.Equal(t, errInvalidExecuteEvent, err)
+}
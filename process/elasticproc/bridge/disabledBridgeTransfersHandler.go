@@ -0,0 +1,28 @@
+package bridge
+
+import (
+	"github.com/multiversx/mx-chain-es-indexer-go/data"
+	"github.com/multiversx/mx-chain-es-indexer-go/process/elasticproc"
+)
+
+type disabledBridgeTransfersHandler struct{}
+
+// NewDisabledBridgeTransfersHandler creates a new disabled bridge transfers handler
+func NewDisabledBridgeTransfersHandler() *disabledBridgeTransfersHandler {
+	return &disabledBridgeTransfersHandler{}
+}
+
+// IndexBridgeTransfers should do nothing and return no error
+func (dbt *disabledBridgeTransfersHandler) IndexBridgeTransfers(_ elasticproc.DatabaseClientHandler, _ []*data.ScResult, _ []*data.LogEvent, _ uint64, _ uint32, _ *data.BufferSlice) error {
+	return nil
+}
+
+// RevertBridgeTransfers should do nothing and return no error
+func (dbt *disabledBridgeTransfersHandler) RevertBridgeTransfers(_ elasticproc.DatabaseClientHandler, _ uint64, _ uint32) error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dbt *disabledBridgeTransfersHandler) IsInterfaceNil() bool {
+	return dbt == nil
+}
@@ -0,0 +1,20 @@
+package bridge
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDisabledBridgeTransfersHandler_IndexBridgeTransfers(t *testing.T) {
+	t.Parallel()
+
+	dbt := NewDisabledBridgeTransfersHandler()
+	require.False(t, dbt.IsInterfaceNil())
+
+	err := dbt.IndexBridgeTransfers(nil, nil, nil, 0, 0, nil)
+	require.NoError(t, err)
+
+	err = dbt.RevertBridgeTransfers(nil, 0, 0)
+	require.NoError(t, err)
+}
@@ -0,0 +1,162 @@
+package bridge
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/atomic"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	logger "github.com/multiversx/mx-chain-logger-go"
+
+	"github.com/multiversx/mx-chain-es-indexer-go/data"
+	indexerdata "github.com/multiversx/mx-chain-es-indexer-go/process/dataindexer"
+	"github.com/multiversx/mx-chain-es-indexer-go/process/elasticproc"
+	"github.com/multiversx/mx-chain-es-indexer-go/process/elasticproc/converters"
+)
+
+var log = logger.GetOrCreate("indexer/process/bridge")
+
+type sovereignBridgeTransfersHandler struct {
+	mainChainElasticClient elasticproc.MainChainDatabaseClientHandler
+	pubKeyConverter        core.PubkeyConverter
+	isMatching             atomic.Flag
+	mutMatches             sync.Mutex
+	matches                []*bridgeTransferMatch
+}
+
+// NewSovereignBridgeTransfersHandler creates a new sovereign bridge transfers handler
+func NewSovereignBridgeTransfersHandler(
+	mainChainElasticClient elasticproc.MainChainDatabaseClientHandler,
+	pubKeyConverter core.PubkeyConverter,
+) (*sovereignBridgeTransfersHandler, error) {
+	if check.IfNil(mainChainElasticClient) {
+		return nil, indexerdata.ErrNilDatabaseClient
+	}
+	if check.IfNil(pubKeyConverter) {
+		return nil, indexerdata.ErrNilPubkeyConverter
+	}
+
+	return &sovereignBridgeTransfersHandler{
+		mainChainElasticClient: mainChainElasticClient,
+		pubKeyConverter:        pubKeyConverter,
+		matches:                make([]*bridgeTransferMatch, 0),
+	}, nil
+}
+
+// IndexBridgeTransfers will index the deposits received from the main chain and the withdrawals sent to the main chain.
+// If the main chain cluster is enabled, the matches found with the main chain since the previous block are added to the
+// bulk and a new matching round is started in the background
+func (sbt *sovereignBridgeTransfersHandler) IndexBridgeTransfers(
+	elasticClient elasticproc.DatabaseClientHandler,
+	scrs []*data.ScResult,
+	events []*data.LogEvent,
+	timestamp uint64,
+	shardID uint32,
+	buffSlice *data.BufferSlice,
+) error {
+	bridgeTransfers := append(prepareIncomingTransfers(scrs, shardID), sbt.prepareOutgoingTransfers(events)...)
+	err := serializeBridgeTransfers(bridgeTransfers, buffSlice)
+	if err != nil {
+		return err
+	}
+
+	if !sbt.mainChainElasticClient.IsEnabled() {
+		return nil
+	}
+
+	err = serializeBridgeTransfersMatches(sbt.popMatches(), timestamp, shardID, buffSlice)
+	if err != nil {
+		return err
+	}
+
+	sbt.startMatching(elasticClient, timestamp)
+
+	return nil
+}
+
+func prepareIncomingTransfers(scrs []*data.ScResult, shardID uint32) []*data.BridgeTransfer {
+	incomingTransfers := make([]*data.BridgeTransfer, 0)
+	for _, scr := range scrs {
+		if scr.SenderShard != core.MainChainShardId {
+			continue
+		}
+
+		status := data.BridgeStatusExecuted
+		if scr.Status == transaction.TxStatusFail.String() {
+			status = data.BridgeStatusFailed
+		}
+
+		incomingTransfers = append(incomingTransfers, &data.BridgeTransfer{
+			ID:              scr.Hash,
+			Direction:       data.BridgeIncomingDirection,
+			Nonce:           scr.Nonce,
+			Receiver:        scr.Receiver,
+			Tokens:          scr.Tokens,
+			ESDTValues:      scr.ESDTValues,
+			SovereignTxHash: scr.Hash,
+			Status:          status,
+			ShardID:         shardID,
+			Timestamp:       scr.Timestamp,
+		})
+	}
+
+	return incomingTransfers
+}
+
+func (sbt *sovereignBridgeTransfersHandler) prepareOutgoingTransfers(events []*data.LogEvent) []*data.BridgeTransfer {
+	outgoingTransfers := make([]*data.BridgeTransfer, 0)
+	for _, event := range events {
+		if event.Identifier != DepositIdentifier {
+			continue
+		}
+
+		deposit, err := decodeDepositEvent(event.Topics, event.Data)
+		if err != nil {
+			log.Debug("sovereignBridgeTransfersHandler.prepareOutgoingTransfers cannot decode deposit event",
+				"txHash", event.TxHash, "error", err)
+			continue
+		}
+
+		outgoingTransfers = append(outgoingTransfers, &data.BridgeTransfer{
+			ID:              event.ID,
+			Direction:       data.BridgeOutgoingDirection,
+			Nonce:           deposit.nonce,
+			OpHash:          deposit.opHash,
+			Sender:          sbt.pubKeyConverter.SilentEncode(deposit.sender, log),
+			Receiver:        sbt.pubKeyConverter.SilentEncode(deposit.receiver, log),
+			Tokens:          deposit.tokens,
+			ESDTValues:      deposit.esdtValues,
+			SovereignTxHash: event.TxHash,
+			Status:          data.BridgeStatusPending,
+			ShardID:         event.ShardID,
+			Timestamp:       event.Timestamp,
+		})
+	}
+
+	return outgoingTransfers
+}
+
+func serializeBridgeTransfers(bridgeTransfers []*data.BridgeTransfer, buffSlice *data.BufferSlice) error {
+	for _, bridgeTransfer := range bridgeTransfers {
+		meta := []byte(fmt.Sprintf(`{ "index" : { "_index":"%s", "_id" : "%s" } }%s`, indexerdata.BridgeTransfersIndex, converters.JsonEscape(bridgeTransfer.ID), "\n"))
+		serializedData, err := json.Marshal(bridgeTransfer)
+		if err != nil {
+			return err
+		}
+
+		err = buffSlice.PutData(meta, serializedData)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sbt *sovereignBridgeTransfersHandler) IsInterfaceNil() bool {
+	return sbt == nil
+}
@@ -0,0 +1,246 @@
+package bridge
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/pubkeyConverter"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multiversx/mx-chain-es-indexer-go/client"
+	"github.com/multiversx/mx-chain-es-indexer-go/client/disabled"
+	"github.com/multiversx/mx-chain-es-indexer-go/data"
+	"github.com/multiversx/mx-chain-es-indexer-go/mock"
+	"github.com/multiversx/mx-chain-es-indexer-go/process/dataindexer"
+)
+
+var pubKeyConverter, _ = pubkeyConverter.NewBech32PubkeyConverter(addressLen, "erd")
+
+func createAddress(firstByte byte) []byte {
+	address := make([]byte, addressLen)
+	address[0] = firstByte
+
+	return address
+}
+
+func createScrollResponse(hits ...interface{}) []byte {
+	rawHits := make([]string, 0, len(hits))
+	for idx, hit := range hits {
+		source, _ := json.Marshal(hit)
+		rawHits = append(rawHits, fmt.Sprintf(`{"_id":"id%d","_source":%s}`, idx, source))
+	}
+
+	return []byte(fmt.Sprintf(`{"hits":{"hits":[%s]}}`, strings.Join(rawHits, ",")))
+}
+
+func TestNewSovereignBridgeTransfersHandler(t *testing.T) {
+	t.Parallel()
+
+	sbt, err := NewSovereignBridgeTransfersHandler(nil, pubKeyConverter)
+	require.Nil(t, sbt)
+	require.Equal(t, dataindexer.ErrNilDatabaseClient, err)
+
+	sbt, err = NewSovereignBridgeTransfersHandler(disabled.NewDisabledElasticClient(), nil)
+	require.Nil(t, sbt)
+	require.Equal(t, dataindexer.ErrNilPubkeyConverter, err)
+
+	sbt, err = NewSovereignBridgeTransfersHandler(disabled.NewDisabledElasticClient(), pubKeyConverter)
+	require.Nil(t, err)
+	require.False(t, sbt.IsInterfaceNil())
+}
+
+func TestSovereignBridgeTransfersHandler_IndexBridgeTransfersWithoutMainChain(t *testing.T) {
+	t.Parallel()
+
+	sbt, _ := NewSovereignBridgeTransfersHandler(disabled.NewDisabledElasticClient(), pubKeyConverter)
+
+	receiver := pubKeyConverter.SilentEncode(createAddress(1), nil)
+	scrs := []*data.ScResult{
+		{Hash: "scr1", Nonce: 5, SenderShard: core.MainChainShardId, Receiver: receiver, Tokens: []string{"TKN-123456"}, ESDTValues: []string{"10"}, Timestamp: 100},
+		{Hash: "scr2", Nonce: 6, SenderShard: core.MainChainShardId, Receiver: receiver, Status: transaction.TxStatusFail.String(), Timestamp: 100},
+		{Hash: "scr3", SenderShard: core.SovereignChainShardId, Receiver: receiver, Timestamp: 100},
+	}
+	events := []*data.LogEvent{
+		{
+			ID:         "tx1-0-0",
+			TxHash:     "tx1",
+			Identifier: DepositIdentifier,
+			Topics:     createDepositTopics(createAddress(1)),
+			Data:       hex.EncodeToString(createDepositEventData(3, createAddress(2))),
+			ShardID:    core.SovereignChainShardId,
+			Timestamp:  100,
+		},
+		{ID: "tx2-0-0", TxHash: "tx2", Identifier: DepositIdentifier, Topics: []string{"00"}},
+		{ID: "tx3-0-0", TxHash: "tx3", Identifier: "transfer"},
+	}
+
+	buffSlice := data.NewBufferSlice(data.DefaultMaxBulkSize)
+	err := sbt.IndexBridgeTransfers(&mock.DatabaseWriterStub{}, scrs, events, 100, core.SovereignChainShardId, buffSlice)
+	require.Nil(t, err)
+
+	bulk := buffSlice.Buffers()[0].String()
+	require.Contains(t, bulk, `{ "index" : { "_index":"bridgetransfers", "_id" : "scr1" } }
+{"direction":"incoming","nonce":5,"receiver":"`+receiver+`","tokens":["TKN-123456"],"esdtValues":["10"],"sovereignTxHash":"scr1","status":"executed","matched":false,"shardID":0,"timestamp":100}
+`)
+	require.Contains(t, bulk, `"sovereignTxHash":"scr2","status":"failed"`)
+	require.Contains(t, bulk, `{ "index" : { "_index":"bridgetransfers", "_id" : "tx1-0-0" } }
+{"direction":"outgoing","nonce":3,"opHash":"`+computeDepositOpHash(createAddress(1), createDepositEventData(3, createAddress(2)))+`","sender":"`+pubKeyConverter.SilentEncode(createAddress(2), nil)+`","receiver":"`+receiver+`","tokens":["TKN-123456","NFT-abcdef-0a"],"esdtValues":["1000","1"],"sovereignTxHash":"tx1","status":"pending","matched":false,"shardID":0,"timestamp":100}
+`)
+	require.NotContains(t, bulk, "scr3")
+	require.NotContains(t, bulk, "tx2")
+	require.NotContains(t, bulk, "tx3")
+}
+
+func TestSovereignBridgeTransfersHandler_IndexBridgeTransfersShouldSerializeTheMatchesAndSearchInBackground(t *testing.T) {
+	t.Parallel()
+
+	mainChainClient, _ := client.NewMainChainElasticClient(&mock.DatabaseWriterStub{}, true)
+	sbt, _ := NewSovereignBridgeTransfersHandler(mainChainClient, pubKeyConverter)
+	sbt.matches = []*bridgeTransferMatch{
+		{id: "tx0-0-0", fields: map[string]string{"mainChainTxHash": "mainTx1", "status": data.BridgeStatusExecuted}},
+	}
+
+	searchedUnmatchedTransfers := make(chan struct{})
+	elasticClient := &mock.DatabaseWriterStub{
+		DoScrollRequestCalled: func(index string, body []byte, withSource bool, handlerFunc func(responseBytes []byte) error) error {
+			require.Equal(t, dataindexer.BridgeTransfersIndex, index)
+			require.Equal(t, `{"query": {"bool": {"must": [{"match": {"matched": false}},{"range": {"timestamp": {"gte": 6400}}}]}}}`, string(body))
+			close(searchedUnmatchedTransfers)
+			return nil
+		},
+	}
+
+	buffSlice := data.NewBufferSlice(data.DefaultMaxBulkSize)
+	err := sbt.IndexBridgeTransfers(elasticClient, nil, nil, 10000, core.SovereignChainShardId, buffSlice)
+	require.Nil(t, err)
+
+	bulk := buffSlice.Buffers()[0].String()
+	require.Contains(t, bulk, `{ "update" : { "_index":"bridgetransfers", "_id" : "tx0-0-0" } }`)
+	require.Contains(t, bulk, `"params": { "fields": {"mainChainTxHash":"mainTx1","status":"executed"}, "timestamp": 10000, "shardID": 0 }`)
+
+	select {
+	case <-searchedUnmatchedTransfers:
+	case <-time.After(time.Second):
+		require.Fail(t, "the unmatched bridge transfers were not searched")
+	}
+	require.Eventually(t, func() bool {
+		return !sbt.isMatching.IsSet()
+	}, time.Second, time.Millisecond)
+	require.Empty(t, sbt.popMatches())
+}
+
+func TestSovereignBridgeTransfersHandler_MatchBridgeTransfers(t *testing.T) {
+	t.Parallel()
+
+	receiver := pubKeyConverter.SilentEncode(createAddress(1), nil)
+	opHash := computeDepositOpHash(createAddress(1), createDepositEventData(3, createAddress(2)))
+	executeTopics := []string{hex.EncodeToString([]byte(executedBridgeOpTopic)), hex.EncodeToString([]byte("hash of hashes")), opHash}
+
+	mainChainClient, _ := client.NewMainChainElasticClient(&mock.DatabaseWriterStub{
+		DoScrollRequestCalled: func(index string, body []byte, withSource bool, handlerFunc func(responseBytes []byte) error) error {
+			require.Equal(t, dataindexer.EventsIndex, index)
+			require.Contains(t, string(body), `{"range": {"timestamp": {"gte": 2800}}}`)
+
+			if strings.Contains(string(body), `{"match": {"identifier": "`+DepositIdentifier+`"}}`) {
+				require.Contains(t, string(body), `{"terms": {"topics": ["`+hex.EncodeToString(createAddress(1))+`"]}}`)
+				return handlerFunc(createScrollResponse(
+					&data.LogEvent{TxHash: "mainTx1", Topics: createDepositTopics(createAddress(1)), Data: hex.EncodeToString(createDepositEventData(4, createAddress(3)))},
+					&data.LogEvent{TxHash: "mainTx2", Topics: createDepositTopics(createAddress(1)), Data: hex.EncodeToString(createDepositEventData(5, createAddress(3)))},
+				))
+			}
+
+			require.Contains(t, string(body), `{"match": {"identifier": "`+ExecuteIdentifier+`"}}`)
+			require.Contains(t, string(body), `{"terms": {"topics": ["`+opHash+`"]}}`)
+			return handlerFunc(createScrollResponse(
+				&data.LogEvent{TxHash: "mainTx3", Topics: []string{executeTopics[0], executeTopics[1], "aa"}},
+				&data.LogEvent{TxHash: "mainTx4", Topics: executeTopics},
+			))
+		},
+	}, true)
+	sbt, _ := NewSovereignBridgeTransfersHandler(mainChainClient, pubKeyConverter)
+
+	elasticClient := &mock.DatabaseWriterStub{
+		DoScrollRequestCalled: func(index string, body []byte, withSource bool, handlerFunc func(responseBytes []byte) error) error {
+			return handlerFunc(createScrollResponse(
+				&data.BridgeTransfer{Direction: data.BridgeIncomingDirection, Nonce: 5, Receiver: receiver, Status: data.BridgeStatusExecuted},
+				&data.BridgeTransfer{Direction: data.BridgeOutgoingDirection, Nonce: 3, OpHash: opHash, Receiver: receiver, Status: data.BridgeStatusPending},
+				&data.BridgeTransfer{Direction: data.BridgeOutgoingDirection, Nonce: 2, Receiver: receiver, Status: data.BridgeStatusPending},
+			))
+		},
+	}
+
+	sbt.matchBridgeTransfers(elasticClient, 10000)
+	require.Equal(t, []*bridgeTransferMatch{
+		{id: "id0", fields: map[string]string{"sender": pubKeyConverter.SilentEncode(createAddress(3), nil), "mainChainTxHash": "mainTx2"}},
+		{id: "id1", fields: map[string]string{"mainChainTxHash": "mainTx4", "status": data.BridgeStatusExecuted}},
+	}, sbt.popMatches())
+}
+
+func TestSovereignBridgeTransfersHandler_MatchBridgeTransfersShouldIgnoreTheMainChainErrors(t *testing.T) {
+	t.Parallel()
+
+	mainChainClient, _ := client.NewMainChainElasticClient(&mock.DatabaseWriterStub{
+		DoScrollRequestCalled: func(index string, body []byte, withSource bool, handlerFunc func(responseBytes []byte) error) error {
+			return errors.New("main chain cluster unavailable")
+		},
+	}, true)
+	sbt, _ := NewSovereignBridgeTransfersHandler(mainChainClient, pubKeyConverter)
+
+	elasticClient := &mock.DatabaseWriterStub{
+		DoScrollRequestCalled: func(index string, body []byte, withSource bool, handlerFunc func(responseBytes []byte) error) error {
+			return handlerFunc(createScrollResponse(
+				&data.BridgeTransfer{Direction: data.BridgeIncomingDirection, Nonce: 5, Receiver: pubKeyConverter.SilentEncode(createAddress(1), nil)},
+				&data.BridgeTransfer{Direction: data.BridgeOutgoingDirection, Nonce: 3, OpHash: "aa"},
+			))
+		},
+	}
+
+	sbt.matchBridgeTransfers(elasticClient, 10000)
+	require.Empty(t, sbt.popMatches())
+}
+
+func TestSovereignBridgeTransfersHandler_RevertBridgeTransfers(t *testing.T) {
+	t.Parallel()
+
+	t.Run("main chain disabled should not revert", func(t *testing.T) {
+		t.Parallel()
+
+		sbt, _ := NewSovereignBridgeTransfersHandler(disabled.NewDisabledElasticClient(), pubKeyConverter)
+		err := sbt.RevertBridgeTransfers(&mock.DatabaseWriterStub{
+			UpdateByQueryCalled: func(index string, buff *bytes.Buffer) error {
+				require.Fail(t, "should not have been called")
+				return nil
+			},
+		}, 100, 0)
+		require.Nil(t, err)
+	})
+
+	t.Run("should put back the matches of the block", func(t *testing.T) {
+		t.Parallel()
+
+		mainChainClient, _ := client.NewMainChainElasticClient(&mock.DatabaseWriterStub{}, true)
+		sbt, _ := NewSovereignBridgeTransfersHandler(mainChainClient, pubKeyConverter)
+
+		called := false
+		err := sbt.RevertBridgeTransfers(&mock.DatabaseWriterStub{
+			UpdateByQueryCalled: func(index string, buff *bytes.Buffer) error {
+				called = true
+				require.Equal(t, dataindexer.BridgeTransfersIndex, index)
+				require.Contains(t, buff.String(), `{"match": {"matchShardID": {"query": 0,"operator": "AND"}}},{"match": {"matchTimestamp": {"query": "100","operator": "AND"}}}`)
+				require.Contains(t, buff.String(), `ctx._source.status = 'pending'`)
+				require.Contains(t, buff.String(), `ctx._source.matched = false`)
+				return nil
+			},
+		}, 100, 0)
+		require.Nil(t, err)
+		require.True(t, called)
+	})
+}
@@ -0,0 +1,29 @@
+package elasticproc
+
+import (
+	coreData "github.com/multiversx/mx-chain-core-go/data"
+
+	"github.com/multiversx/mx-chain-es-indexer-go/data"
+	elasticIndexer "github.com/multiversx/mx-chain-es-indexer-go/process/dataindexer"
+)
+
+func (ei *elasticProcessor) indexBridgeTransfers(scrs []*data.ScResult, events []*data.LogEvent, header coreData.HeaderHandler, buffSlice *data.BufferSlice) error {
+	if !ei.isIndexEnabled(elasticIndexer.BridgeTransfersIndex) {
+		return nil
+	}
+
+	return ei.bridgeTransfersHandler.IndexBridgeTransfers(ei.elasticClient, scrs, events, header.GetTimeStamp(), header.GetShardID(), buffSlice)
+}
+
+func (ei *elasticProcessor) revertBridgeTransfers(header coreData.HeaderHandler) error {
+	if !ei.isIndexEnabled(elasticIndexer.BridgeTransfersIndex) {
+		return nil
+	}
+
+	err := ei.removeFromIndexByTimestampAndShardID(header.GetTimeStamp(), header.GetShardID(), elasticIndexer.BridgeTransfersIndex)
+	if err != nil {
+		return err
+	}
+
+	return ei.bridgeTransfersHandler.RevertBridgeTransfers(ei.elasticClient, header.GetTimeStamp(), header.GetShardID())
+}
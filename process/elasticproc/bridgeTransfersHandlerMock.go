@@ -0,0 +1,32 @@
+package elasticproc
+
+import (
+	"github.com/multiversx/mx-chain-es-indexer-go/data"
+)
+
+// BridgeTransfersHandlerMock -
+type BridgeTransfersHandlerMock struct {
+	IndexBridgeTransfersCalled  func(elasticClient DatabaseClientHandler, scrs []*data.ScResult, events []*data.LogEvent, timestamp uint64, shardID uint32, buffSlice *data.BufferSlice) error
+	RevertBridgeTransfersCalled func(elasticClient DatabaseClientHandler, timestamp uint64, shardID uint32) error
+}
+
+// IndexBridgeTransfers -
+func (bthm *BridgeTransfersHandlerMock) IndexBridgeTransfers(elasticClient DatabaseClientHandler, scrs []*data.ScResult, events []*data.LogEvent, timestamp uint64, shardID uint32, buffSlice *data.BufferSlice) error {
+	if bthm.IndexBridgeTransfersCalled != nil {
+		return bthm.IndexBridgeTransfersCalled(elasticClient, scrs, events, timestamp, shardID, buffSlice)
+	}
+	return nil
+}
+
+// RevertBridgeTransfers -
+func (bthm *BridgeTransfersHandlerMock) RevertBridgeTransfers(elasticClient DatabaseClientHandler, timestamp uint64, shardID uint32) error {
+	if bthm.RevertBridgeTransfersCalled != nil {
+		return bthm.RevertBridgeTransfersCalled(elasticClient, timestamp, shardID)
+	}
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (bthm *BridgeTransfersHandlerMock) IsInterfaceNil() bool {
+	return bthm == nil
+}
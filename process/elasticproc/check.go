@@ -40,9 +40,36 @@ func checkArguments(arguments *ArgElasticProcessor) error {
 	if check.IfNilReflect(arguments.OperationsProc) {
 		return elasticIndexer.ErrNilOperationsHandler
 	}
+	if check.IfNil(arguments.TransfersProc) {
+		return elasticIndexer.ErrNilTransfersHandler
+	}
+	if check.IfNil(arguments.StatsProc) {
+		return elasticIndexer.ErrNilStatsHandler
+	}
+	if check.IfNil(arguments.ContractStatsProc) {
+		return elasticIndexer.ErrNilContractStatsHandler
+	}
+	if check.IfNil(arguments.RelayerStatsProc) {
+		return elasticIndexer.ErrNilRelayerStatsHandler
+	}
+	if check.IfNil(arguments.UndelegationsProc) {
+		return elasticIndexer.ErrNilUndelegationsHandler
+	}
 	if check.IfNilReflect(arguments.IndexTokensHandler) {
 		return elasticIndexer.ErrNilIndexTokensHandler
 	}
+	if check.IfNil(arguments.BridgeTransfersHandler) {
+		return elasticIndexer.ErrNilBridgeTransfersHandler
+	}
+	if check.IfNil(arguments.StreamPublisher) {
+		return elasticIndexer.ErrNilStreamPublisher
+	}
+	if check.IfNil(arguments.NFTAttributesDecoder) {
+		return elasticIndexer.ErrNilNFTAttributesDecoder
+	}
+	if check.IfNil(arguments.ABIDecoder) {
+		return elasticIndexer.ErrNilABIDecoder
+	}
 
 	return nil
 }
@@ -0,0 +1,99 @@
+package elasticproc
+
+import (
+	"context"
+
+	coreData "github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/outport"
+
+	"github.com/multiversx/mx-chain-es-indexer-go/core/request"
+	"github.com/multiversx/mx-chain-es-indexer-go/data"
+	elasticIndexer "github.com/multiversx/mx-chain-es-indexer-go/process/dataindexer"
+)
+
+func (ei *elasticProcessor) indexContractStats(
+	preparedResults *data.PreparedResults,
+	txHashStatusInfo map[string]*outport.StatusInfo,
+	header coreData.HeaderHandler,
+	buffSlice *data.BufferSlice,
+) error {
+	if !ei.isIndexEnabled(elasticIndexer.ContractStatsIndex) {
+		return nil
+	}
+
+	changes := ei.contractStatsProc.PrepareContractStatsChanges(preparedResults, txHashStatusInfo, header.GetTimeStamp(), header.GetShardID())
+	if len(changes) == 0 {
+		return nil
+	}
+
+	existingCallers, err := ei.getExistingContractCallers(ei.contractStatsProc.GetContractCallersIDs(changes), header.GetTimeStamp(), header.GetShardID())
+	if err != nil {
+		return err
+	}
+
+	ei.contractStatsProc.AddUniqueCallers(changes, existingCallers)
+
+	err = ei.contractStatsProc.SerializeContractCallers(changes, buffSlice, elasticIndexer.ContractCallersIndex)
+	if err != nil {
+		return err
+	}
+
+	return ei.contractStatsProc.SerializeContractStatsChanges(changes, buffSlice, elasticIndexer.ContractStatsIndex)
+}
+
+// getExistingContractCallers returns the callers that called the contracts before the provided block, the callers first
+// seen in the block are not returned even if the block was already indexed
+func (ei *elasticProcessor) getExistingContractCallers(ids []string, timestamp uint64, shardID uint32) (map[string]struct{}, error) {
+	existingCallers := make(map[string]struct{})
+	if len(ids) == 0 {
+		return existingCallers, nil
+	}
+
+	ctxWithValue := context.WithValue(context.Background(), request.ContextKey, request.ExtendTopicWithShardID(request.GetTopic, shardID))
+	responseCallers := &data.ResponseContractCallers{}
+	err := ei.elasticClient.DoMultiGet(ctxWithValue, ids, elasticIndexer.ContractCallersIndex, true, responseCallers)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, caller := range responseCallers.Docs {
+		seenInBlock := caller.Source.Timestamp == timestamp && caller.Source.ShardID == shardID
+		if caller.Found && !seenInBlock {
+			existingCallers[caller.ID] = struct{}{}
+		}
+	}
+
+	return existingCallers, nil
+}
+
+// removeExpiredContractCallers removes, when a new day starts, the contract callers of the days older than the retention.
+// The callers are only read for the current day, so removing them does not change the statistics
+func (ei *elasticProcessor) removeExpiredContractCallers(header coreData.HeaderHandler) error {
+	shouldRemove := ei.isIndexEnabled(elasticIndexer.ContractStatsIndex) && ei.callersRetentionInDays > 0 && ei.isNewDay(header)
+	if !shouldRemove {
+		return nil
+	}
+
+	ctxWithValue := context.WithValue(context.Background(), request.ContextKey, request.ExtendTopicWithShardID(request.RemoveTopic, header.GetShardID()))
+	query := ei.contractStatsProc.PrepareExpiredContractCallersQuery(header.GetTimeStamp(), header.GetShardID(), ei.callersRetentionInDays)
+
+	return ei.elasticClient.DoQueryRemove(ctxWithValue, elasticIndexer.ContractCallersIndex, query)
+}
+
+func (ei *elasticProcessor) revertContractStats(header coreData.HeaderHandler) error {
+	if !ei.isIndexEnabled(elasticIndexer.ContractStatsIndex) {
+		return nil
+	}
+
+	ctxWithValue := context.WithValue(context.Background(), request.ContextKey, request.ExtendTopicWithShardID(request.UpdateTopic, header.GetShardID()))
+	query := ei.contractStatsProc.PrepareContractStatsQueryInCaseOfRevert(header.GetTimeStamp(), header.GetShardID())
+	err := ei.elasticClient.UpdateByQuery(ctxWithValue, elasticIndexer.ContractStatsIndex, query)
+	if err != nil {
+		return err
+	}
+
+	ctxWithValue = context.WithValue(context.Background(), request.ContextKey, request.ExtendTopicWithShardID(request.RemoveTopic, header.GetShardID()))
+	query = ei.contractStatsProc.PrepareContractCallersQueryInCaseOfRevert(header.GetTimeStamp(), header.GetShardID())
+
+	return ei.elasticClient.DoQueryRemove(ctxWithValue, elasticIndexer.ContractCallersIndex, query)
+}
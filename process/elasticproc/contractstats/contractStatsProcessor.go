@@ -0,0 +1,254 @@
+package contractstats
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	logger "github.com/multiversx/mx-chain-logger-go"
+
+	"github.com/multiversx/mx-chain-es-indexer-go/data"
+	"github.com/multiversx/mx-chain-es-indexer-go/process/dataindexer"
+)
+
+const (
+	dayFormat    = "2006-01-02"
+	secondsInDay = 24 * 60 * 60
+)
+
+var log = logger.GetOrCreate("indexer/process/contractstats")
+
+type contractCall struct {
+	contract string
+	caller   string
+	function string
+	value    string
+	gasUsed  uint64
+	failed   bool
+}
+
+type contractStatsProcessor struct {
+	pubKeyConverter  core.PubkeyConverter
+	balanceConverter dataindexer.BalanceConverter
+}
+
+// NewContractStatsProcessor will create a new instance of contractStatsProcessor
+func NewContractStatsProcessor(pubKeyConverter core.PubkeyConverter, balanceConverter dataindexer.BalanceConverter) (*contractStatsProcessor, error) {
+	if check.IfNil(pubKeyConverter) {
+		return nil, dataindexer.ErrNilPubkeyConverter
+	}
+	if check.IfNil(balanceConverter) {
+		return nil, dataindexer.ErrNilBalanceConverter
+	}
+
+	return &contractStatsProcessor{
+		pubKeyConverter:  pubKeyConverter,
+		balanceConverter: balanceConverter,
+	}, nil
+}
+
+// PrepareContractStatsChanges will aggregate the smart contract calls executed in the current shard per contract and day.
+// A transaction is a call if its receiver is a smart contract, while a smart contract result is a call only if it also
+// has a function, so that the refunds and the callbacks without data are not counted. The gas used by a smart contract
+// result call is not known, the gas provided to the call is counted instead
+func (csp *contractStatsProcessor) PrepareContractStatsChanges(
+	preparedResults *data.PreparedResults,
+	txHashStatusInfo map[string]*outport.StatusInfo,
+	timestamp uint64,
+	shardID uint32,
+) []*data.ContractStatsChange {
+	if preparedResults == nil {
+		return nil
+	}
+
+	calls := make([]*contractCall, 0)
+	for _, tx := range preparedResults.Transactions {
+		contract, contractShard := getTransactionCallee(tx)
+		if contractShard != shardID || !csp.isSmartContract(contract) {
+			continue
+		}
+
+		calls = append(calls, &contractCall{
+			contract: contract,
+			caller:   tx.Sender,
+			function: tx.Function,
+			value:    tx.Value,
+			gasUsed:  tx.GasUsed,
+			failed:   isFailed(tx.Hash, tx.Status, txHashStatusInfo),
+		})
+	}
+
+	for _, scr := range preparedResults.ScResults {
+		if scr.Function == "" || scr.ReceiverShard != shardID || !csp.isSmartContract(scr.Receiver) {
+			continue
+		}
+
+		calls = append(calls, &contractCall{
+			contract: scr.Receiver,
+			caller:   scr.Sender,
+			function: scr.Function,
+			value:    scr.Value,
+			gasUsed:  scr.GasLimit,
+			failed:   isFailed(scr.Hash, scr.Status, txHashStatusInfo),
+		})
+	}
+
+	return csp.aggregateCalls(calls, timestamp, shardID)
+}
+
+func (csp *contractStatsProcessor) aggregateCalls(calls []*contractCall, timestamp uint64, shardID uint32) []*data.ContractStatsChange {
+	day := time.Unix(int64(timestamp), 0).UTC().Format(dayFormat)
+
+	changes := make(map[string]*data.ContractStatsChange)
+	values := make(map[string]*big.Int)
+	callers := make(map[string]map[string]struct{})
+	functions := make(map[string]map[string]*data.FunctionCalls)
+	for _, call := range calls {
+		change, found := changes[call.contract]
+		if !found {
+			change = &data.ContractStatsChange{
+				ID:        ComputeContractStatsID(call.contract, day),
+				Contract:  call.contract,
+				Day:       day,
+				Functions: make([]*data.FunctionCalls, 0),
+				ShardID:   shardID,
+				Timestamp: timestamp,
+			}
+			changes[call.contract] = change
+			values[call.contract] = big.NewInt(0)
+			callers[call.contract] = make(map[string]struct{})
+			functions[call.contract] = make(map[string]*data.FunctionCalls)
+		}
+
+		change.CallsCount++
+		change.GasUsed += call.gasUsed
+		if call.failed {
+			change.FailedCalls++
+		}
+
+		value, ok := big.NewInt(0).SetString(call.value, 10)
+		if ok {
+			values[call.contract].Add(values[call.contract], value)
+		}
+
+		_, callerFound := callers[call.contract][call.caller]
+		if !callerFound {
+			callers[call.contract][call.caller] = struct{}{}
+			change.Callers = append(change.Callers, call.caller)
+		}
+
+		if call.function == "" {
+			continue
+		}
+		functionCalls, functionFound := functions[call.contract][call.function]
+		if !functionFound {
+			functionCalls = &data.FunctionCalls{Function: call.function}
+			functions[call.contract][call.function] = functionCalls
+			change.Functions = append(change.Functions, functionCalls)
+		}
+		functionCalls.Calls++
+	}
+
+	result := make([]*data.ContractStatsChange, 0, len(changes))
+	for contract, change := range changes {
+		valueNum, err := csp.balanceConverter.ConvertBigValueToFloat(values[contract])
+		if err != nil {
+			log.Warn("contractStatsProcessor.aggregateCalls cannot compute value as num", "value", values[contract], "contract", contract, "error", err)
+		}
+
+		change.Value = values[contract].String()
+		change.ValueNum = valueNum
+		result = append(result, change)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].ID < result[j].ID
+	})
+
+	return result
+}
+
+// GetContractCallersIDs returns the ids of the documents that mark the callers of the provided contract statistics changes
+func (csp *contractStatsProcessor) GetContractCallersIDs(changes []*data.ContractStatsChange) []string {
+	ids := make([]string, 0)
+	for _, change := range changes {
+		for _, caller := range change.Callers {
+			ids = append(ids, ComputeContractCallerID(change.Contract, change.Day, caller))
+		}
+	}
+
+	return ids
+}
+
+// AddUniqueCallers will count for every change the callers that did not call the contract earlier in the same day
+func (csp *contractStatsProcessor) AddUniqueCallers(changes []*data.ContractStatsChange, existingCallers map[string]struct{}) {
+	for _, change := range changes {
+		newCallers := make([]string, 0, len(change.Callers))
+		for _, caller := range change.Callers {
+			_, exists := existingCallers[ComputeContractCallerID(change.Contract, change.Day, caller)]
+			if !exists {
+				newCallers = append(newCallers, caller)
+			}
+		}
+
+		change.Callers = newCallers
+		change.UniqueCallers = uint64(len(newCallers))
+	}
+}
+
+// ComputeContractStatsID returns the id of the daily usage statistics document of a smart contract
+func ComputeContractStatsID(contract string, day string) string {
+	return fmt.Sprintf("%s-%s", contract, day)
+}
+
+// ComputeContractCallerID returns the id of the document that marks an address as a caller of a smart contract in a day
+func ComputeContractCallerID(contract string, day string, caller string) string {
+	return fmt.Sprintf("%s-%s-%s", contract, day, caller)
+}
+
+func (csp *contractStatsProcessor) isSmartContract(address string) bool {
+	if address == "" {
+		return false
+	}
+
+	addressBytes, err := csp.pubKeyConverter.Decode(address)
+	if err != nil {
+		return false
+	}
+
+	return core.IsSmartContractAddress(addressBytes)
+}
+
+// getTransactionCallee returns the called contract, for the ESDT NFT transfers the receiver of the transaction is the sender
+// and the contract is the only receiver of the transfer
+func getTransactionCallee(tx *data.Transaction) (string, uint32) {
+	if tx.IsScCall {
+		return tx.Receiver, tx.ReceiverShard
+	}
+
+	hasOneReceiver := len(tx.Receivers) == 1 && len(tx.ReceiversShardIDs) == 1
+	if tx.Function != "" && hasOneReceiver {
+		return tx.Receivers[0], tx.ReceiversShardIDs[0]
+	}
+
+	return "", 0
+}
+
+func isFailed(hash string, status string, txHashStatusInfo map[string]*outport.StatusInfo) bool {
+	statusInfo, found := txHashStatusInfo[hash]
+	if found && statusInfo.Status != "" {
+		status = statusInfo.Status
+	}
+
+	return status == transaction.TxStatusFail.String() || status == transaction.TxStatusInvalid.String()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (csp *contractStatsProcessor) IsInterfaceNil() bool {
+	return csp == nil
+}
@@ -0,0 +1,126 @@
+package contractstats
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multiversx/mx-chain-es-indexer-go/data"
+	"github.com/multiversx/mx-chain-es-indexer-go/mock"
+	"github.com/multiversx/mx-chain-es-indexer-go/process/dataindexer"
+	"github.com/multiversx/mx-chain-es-indexer-go/process/elasticproc/converters"
+)
+
+const (
+	contractAddr  = "00000000000000000500aabb"
+	contractAddr2 = "00000000000000000500ccdd"
+	userAddr      = "aabb"
+	userAddr2     = "ccdd"
+)
+
+func createContractStatsProcessor() *contractStatsProcessor {
+	balanceConverter, _ := converters.NewBalanceConverter(18)
+	csp, _ := NewContractStatsProcessor(&mock.PubkeyConverterMock{}, balanceConverter)
+
+	return csp
+}
+
+func TestNewContractStatsProcessor(t *testing.T) {
+	t.Parallel()
+
+	balanceConverter, _ := converters.NewBalanceConverter(18)
+	csp, err := NewContractStatsProcessor(nil, balanceConverter)
+	require.Nil(t, csp)
+	require.Equal(t, dataindexer.ErrNilPubkeyConverter, err)
+
+	csp, err = NewContractStatsProcessor(&mock.PubkeyConverterMock{}, nil)
+	require.Nil(t, csp)
+	require.Equal(t, dataindexer.ErrNilBalanceConverter, err)
+
+	csp = createContractStatsProcessor()
+	require.NotNil(t, csp)
+	require.False(t, csp.IsInterfaceNil())
+}
+
+func TestContractStatsProcessor_PrepareContractStatsChanges(t *testing.T) {
+	t.Parallel()
+
+	// the block is created on 2026-10-18 10:00:00 UTC
+	timestamp := uint64(1792317600)
+	day := "2026-10-18"
+	preparedResults := &data.PreparedResults{
+		Transactions: []*data.Transaction{
+			{Hash: "h1", Sender: userAddr, Receiver: contractAddr, ReceiverShard: 1, IsScCall: true, Function: "stake", Value: "10", GasUsed: 100, Status: transaction.TxStatusSuccess.String()},
+			{Hash: "h2", Sender: userAddr, Receiver: contractAddr, ReceiverShard: 1, IsScCall: true, Function: "stake", Value: "5", GasUsed: 50, Status: transaction.TxStatusSuccess.String()},
+			{Hash: "h3", Sender: userAddr2, Receiver: userAddr2, Function: "ESDTNFTTransfer", Receivers: []string{contractAddr2}, ReceiversShardIDs: []uint32{1}, Value: "0", GasUsed: 30, Status: transaction.TxStatusSuccess.String()},
+			{Hash: "h4", Sender: userAddr, Receiver: userAddr2, ReceiverShard: 1, Value: "1", GasUsed: 10},
+			{Hash: "h5", Sender: userAddr, Receiver: contractAddr, ReceiverShard: 0, IsScCall: true, Function: "stake", Value: "1", GasUsed: 10},
+		},
+		ScResults: []*data.ScResult{
+			{Hash: "s1", Sender: contractAddr2, Receiver: contractAddr, ReceiverShard: 1, Function: "claim", Value: "0", GasLimit: 20, Status: transaction.TxStatusFail.String()},
+			{Hash: "s2", Sender: contractAddr, Receiver: contractAddr2, ReceiverShard: 1, Value: "7", GasLimit: 40},
+		},
+	}
+	statusInfo := map[string]*outport.StatusInfo{
+		"h2": {Status: transaction.TxStatusFail.String()},
+	}
+
+	changes := createContractStatsProcessor().PrepareContractStatsChanges(preparedResults, statusInfo, timestamp, 1)
+	require.Equal(t, []*data.ContractStatsChange{
+		{
+			ID:          contractAddr + "-" + day,
+			Contract:    contractAddr,
+			Day:         day,
+			Callers:     []string{userAddr, contractAddr2},
+			CallsCount:  3,
+			FailedCalls: 2,
+			GasUsed:     170,
+			Value:       "15",
+			ValueNum:    1.5e-17,
+			Functions: []*data.FunctionCalls{
+				{Function: "stake", Calls: 2},
+				{Function: "claim", Calls: 1},
+			},
+			ShardID:   1,
+			Timestamp: timestamp,
+		},
+		{
+			ID:         contractAddr2 + "-" + day,
+			Contract:   contractAddr2,
+			Day:        day,
+			Callers:    []string{userAddr2},
+			CallsCount: 1,
+			GasUsed:    30,
+			Value:      "0",
+			Functions: []*data.FunctionCalls{
+				{Function: "ESDTNFTTransfer", Calls: 1},
+			},
+			ShardID:   1,
+			Timestamp: timestamp,
+		},
+	}, changes)
+}
+
+func TestContractStatsProcessor_AddUniqueCallers(t *testing.T) {
+	t.Parallel()
+
+	day := "2026-10-18"
+	csp := createContractStatsProcessor()
+	changes := []*data.ContractStatsChange{
+		{Contract: contractAddr, Day: day, Callers: []string{userAddr, userAddr2}},
+	}
+
+	ids := csp.GetContractCallersIDs(changes)
+	require.Equal(t, []string{
+		contractAddr + "-" + day + "-" + userAddr,
+		contractAddr + "-" + day + "-" + userAddr2,
+	}, ids)
+
+	csp.AddUniqueCallers(changes, map[string]struct{}{
+		contractAddr + "-" + day + "-" + userAddr: {},
+	})
+	require.Equal(t, []string{userAddr2}, changes[0].Callers)
+	require.Equal(t, uint64(1), changes[0].UniqueCallers)
+}
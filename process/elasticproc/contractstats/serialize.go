@@ -0,0 +1,208 @@
+package contractstats
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-es-indexer-go/data"
+	"github.com/multiversx/mx-chain-es-indexer-go/process/elasticproc/converters"
+)
+
+// contractStatsFields holds the counters, serialized as a painless parameter, that are changed by the contract calls
+const contractStatsFields = `["callsCount","failedCalls","uniqueCallers","gasUsed"]`
+
+// SerializeContractCallers will serialize the first calls of the day of the callers of the provided contract statistics
+// changes in a way that Elasticsearch expects a bulk request
+func (csp *contractStatsProcessor) SerializeContractCallers(changes []*data.ContractStatsChange, buffSlice *data.BufferSlice, index string) error {
+	for _, change := range changes {
+		for _, caller := range change.Callers {
+			meta, serializedData, err := prepareSerializedContractCaller(change, caller, index)
+			if err != nil {
+				return err
+			}
+
+			err = buffSlice.PutData(meta, serializedData)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// SerializeContractStatsChanges will serialize the provided contract statistics changes in a way that Elasticsearch expects
+// a bulk request
+func (csp *contractStatsProcessor) SerializeContractStatsChanges(changes []*data.ContractStatsChange, buffSlice *data.BufferSlice, index string) error {
+	for _, change := range changes {
+		meta, serializedData, err := prepareSerializedContractStatsChange(change, index)
+		if err != nil {
+			return err
+		}
+
+		err = buffSlice.PutData(meta, serializedData)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func prepareSerializedContractCaller(change *data.ContractStatsChange, caller string, index string) ([]byte, []byte, error) {
+	id := ComputeContractCallerID(change.Contract, change.Day, caller)
+	meta := []byte(fmt.Sprintf(`{ "update" : { "_index":"%s", "_id" : "%s" } }%s`, index, converters.JsonEscape(id), "\n"))
+
+	serializedCaller, err := json.Marshal(&data.ContractCaller{
+		Contract:  change.Contract,
+		Day:       change.Day,
+		Caller:    caller,
+		ShardID:   change.ShardID,
+		Timestamp: change.Timestamp,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// the first call of the day has to be kept, otherwise reverting a later block would remove the caller
+	codeToExecute := `
+		if ('create' == ctx.op) {
+			ctx._source = params.caller;
+		} else {
+			ctx.op = 'noop';
+		}
+`
+	serializedDataStr := fmt.Sprintf(`{"scripted_upsert": true, "script": {`+
+		`"source": "%s",`+
+		`"lang": "painless",`+
+		`"params": { "caller": %s }},`+
+		`"upsert": {}}`,
+		converters.FormatPainlessSource(codeToExecute), string(serializedCaller),
+	)
+
+	return meta, []byte(serializedDataStr), nil
+}
+
+func prepareSerializedContractStatsChange(change *data.ContractStatsChange, index string) ([]byte, []byte, error) {
+	meta := []byte(fmt.Sprintf(`{ "update" : { "_index":"%s", "_id" : "%s" } }%s`, index, converters.JsonEscape(change.ID), "\n"))
+
+	serializedChange, err := json.Marshal(change)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	serializedStats, err := json.Marshal(&data.ContractStats{
+		Contract: change.Contract,
+		Day:      change.Day,
+		ShardID:  change.ShardID,
+		Value:    "0",
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	codeToExecute := `
+		if ('create' == ctx.op) {
+			ctx._source = params.stats;
+		}
+` + converters.ApplyBlockChangeScript("contractChanges", `
+		for (String field : params.fields) {
+			long total = ctx._source.containsKey(field) ? ctx._source[field] : 0;
+			ctx._source[field] = total + params.change[field];
+		}
+		ctx._source.value = new BigInteger(ctx._source.value).add(new BigInteger(params.change.value)).toString();
+		ctx._source.valueNum = ctx._source.valueNum + params.change.valueNum;
+		if (!ctx._source.containsKey('functions')) {
+			ctx._source.functions = [];
+		}
+		for (def functionCalls : params.change.functions) {
+			boolean found = false;
+			for (def existing : ctx._source.functions) {
+				if (existing.function == functionCalls.function) {
+					existing.calls = existing.calls + functionCalls.calls;
+					found = true;
+				}
+			}
+			if (!found) {
+				ctx._source.functions.add(['function': functionCalls.function, 'calls': functionCalls.calls]);
+			}
+		}
+		ctx._source.lastCall = params.change.timestamp;
+`)
+	serializedDataStr := fmt.Sprintf(`{"scripted_upsert": true, "script": {`+
+		`"source": "%s",`+
+		`"lang": "painless",`+
+		`"params": { "stats": %s, "change": %s, "fields": %s, "maxChanges": %d }},`+
+		`"upsert": {}}`,
+		converters.FormatPainlessSource(codeToExecute), string(serializedStats), string(serializedChange), contractStatsFields, converters.MaxBlockChangesInDocument,
+	)
+
+	return meta, []byte(serializedDataStr), nil
+}
+
+// PrepareContractStatsQueryInCaseOfRevert will prepare the query that subtracts the contract statistics changes of a reverted block
+func (csp *contractStatsProcessor) PrepareContractStatsQueryInCaseOfRevert(timestamp uint64, shardID uint32) *bytes.Buffer {
+	codeToExecute := converters.RevertBlockChangeScript("contractChanges", `
+			for (String field : params.fields) {
+				ctx._source[field] = ctx._source[field] - change[field];
+			}
+			ctx._source.value = new BigInteger(ctx._source.value).subtract(new BigInteger(change.value)).toString();
+			ctx._source.valueNum = ctx._source.valueNum - change.valueNum;
+			for (def functionCalls : change.functions) {
+				for (def existing : ctx._source.functions) {
+					if (existing.function == functionCalls.function) {
+						existing.calls = existing.calls - functionCalls.calls;
+					}
+				}
+			}
+			ctx._source.functions.removeIf(existing -> existing.calls <= 0);
+`) + `
+	if (ctx._source.contractChanges.length == 0) {
+		ctx.op = 'delete';
+		return;
+	}
+	ctx._source.lastCall = ctx._source.contractChanges[ctx._source.contractChanges.length - 1].timestamp;
+`
+
+	query := fmt.Sprintf(`
+	{
+	  "query": {
+		"bool": {
+		  "must": [
+			{"match": {"contractChanges.timestamp": "%d"}},
+			{"match": {"contractChanges.shardID": %d}}
+		  ]
+		}
+	  },
+	  "script": {
+		"source": "%s",
+		"lang": "painless",
+		"params": {"timestamp": %d, "shardID": %d, "fields": %s}
+	  }
+	}`, timestamp, shardID, converters.FormatPainlessSource(codeToExecute), timestamp, shardID, contractStatsFields)
+
+	return bytes.NewBuffer([]byte(query))
+}
+
+// PrepareContractCallersQueryInCaseOfRevert will prepare the query that removes the callers first seen in a reverted block
+func (csp *contractStatsProcessor) PrepareContractCallersQueryInCaseOfRevert(timestamp uint64, shardID uint32) *bytes.Buffer {
+	query := fmt.Sprintf(`{"query": {"bool": {"must": [{"match": {"shardID": {"query": %d,"operator": "AND"}}},{"match": {"timestamp": {"query": "%d","operator": "AND"}}}]}}}`,
+		shardID, timestamp)
+
+	return bytes.NewBuffer([]byte(query))
+}
+
+// PrepareExpiredContractCallersQuery will prepare the query that removes the callers of the days older than the retention
+func (csp *contractStatsProcessor) PrepareExpiredContractCallersQuery(timestamp uint64, shardID uint32, retentionInDays uint64) *bytes.Buffer {
+	dayStart := timestamp - timestamp%secondsInDay
+	expiryTimestamp := uint64(0)
+	if dayStart > retentionInDays*secondsInDay {
+		expiryTimestamp = dayStart - retentionInDays*secondsInDay
+	}
+
+	query := fmt.Sprintf(`{"query": {"bool": {"must": [{"match": {"shardID": {"query": %d,"operator": "AND"}}},{"range": {"timestamp": {"lt": %d}}}]}}}`,
+		shardID, expiryTimestamp)
+
+	return bytes.NewBuffer([]byte(query))
+}
@@ -0,0 +1,78 @@
+package contractstats
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/multiversx/mx-chain-es-indexer-go/data"
+)
+
+func createContractStatsChanges() []*data.ContractStatsChange {
+	return []*data.ContractStatsChange{
+		{
+			ID:            "sc-2026-10-18",
+			Contract:      "sc",
+			Day:           "2026-10-18",
+			Callers:       []string{"alice"},
+			CallsCount:    2,
+			UniqueCallers: 1,
+			GasUsed:       100,
+			Value:         "10",
+			ValueNum:      0.1,
+			Functions:     []*data.FunctionCalls{{Function: "stake", Calls: 2}},
+			ShardID:       1,
+			Timestamp:     100,
+		},
+	}
+}
+
+func TestContractStatsProcessor_SerializeContractCallers(t *testing.T) {
+	t.Parallel()
+
+	buffSlice := data.NewBufferSlice(data.DefaultMaxBulkSize)
+	err := createContractStatsProcessor().SerializeContractCallers(createContractStatsChanges(), buffSlice, "contractcallers")
+	require.Nil(t, err)
+	require.Equal(t, `{ "update" : { "_index":"contractcallers", "_id" : "sc-2026-10-18-alice" } }
+{"scripted_upsert": true, "script": {"source": "if ('create' == ctx.op) {ctx._source = params.caller;} else {ctx.op = 'noop';}","lang": "painless","params": { "caller": {"contract":"sc","day":"2026-10-18","caller":"alice","shardID":1,"timestamp":100} }},"upsert": {}}
+`, buffSlice.Buffers()[0].String())
+}
+
+func TestContractStatsProcessor_SerializeContractStatsChanges(t *testing.T) {
+	t.Parallel()
+
+	buffSlice := data.NewBufferSlice(data.DefaultMaxBulkSize)
+	err := createContractStatsProcessor().SerializeContractStatsChanges(createContractStatsChanges(), buffSlice, "contractstats")
+	require.Nil(t, err)
+
+	bulk := buffSlice.Buffers()[0].String()
+	require.NotContains(t, bulk, "alice")
+	require.Contains(t, bulk, `{ "update" : { "_index":"contractstats", "_id" : "sc-2026-10-18" } }`)
+	require.Contains(t, bulk, `"params": { "stats": {"contract":"sc","day":"2026-10-18","shardID":1,"value":"0","valueNum":0}, "change": {"callsCount":2,"failedCalls":0,"uniqueCallers":1,"gasUsed":100,"value":"10","valueNum":0.1,"functions":[{"function":"stake","calls":2}],"shardID":1,"timestamp":100}, "fields": ["callsCount","failedCalls","uniqueCallers","gasUsed"], "maxChanges": 100 }}`)
+}
+
+func TestContractStatsProcessor_PrepareQueriesInCaseOfRevert(t *testing.T) {
+	t.Parallel()
+
+	csp := createContractStatsProcessor()
+
+	query := csp.PrepareContractStatsQueryInCaseOfRevert(100, 1).String()
+	require.Contains(t, query, `{"match": {"contractChanges.timestamp": "100"}}`)
+	require.Contains(t, query, `"params": {"timestamp": 100, "shardID": 1, "fields": ["callsCount","failedCalls","uniqueCallers","gasUsed"]}`)
+
+	query = csp.PrepareContractCallersQueryInCaseOfRevert(100, 1).String()
+	require.Equal(t, `{"query": {"bool": {"must": [{"match": {"shardID": {"query": 1,"operator": "AND"}}},{"match": {"timestamp": {"query": "100","operator": "AND"}}}]}}}`, query)
+}
+
+func TestContractStatsProcessor_PrepareExpiredContractCallersQuery(t *testing.T) {
+	t.Parallel()
+
+	csp := createContractStatsProcessor()
+
+	// the block is created on 2026-10-18 10:00:00 UTC, the callers before 2026-10-11 are removed
+	query := csp.PrepareExpiredContractCallersQuery(1792317600, 1, 7).String()
+	require.Equal(t, `{"query": {"bool": {"must": [{"match": {"shardID": {"query": 1,"operator": "AND"}}},{"range": {"timestamp": {"lt": 1791676800}}}]}}}`, query)
+
+	query = csp.PrepareExpiredContractCallersQuery(100, 1, 7).String()
+	require.Contains(t, query, `{"range": {"timestamp": {"lt": 0}}}`)
+}
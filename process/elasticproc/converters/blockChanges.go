@@ -0,0 +1,55 @@
+package converters
+
+// MaxBlockChangesInDocument is the number of block changes kept in a document, they are needed only to undo the
+// changes of the blocks that can still be reverted
+const MaxBlockChangesInDocument = 100
+
+// ApplyBlockChangeScript returns the painless code that applies the block change from params.change on a document only
+// once. The applied changes are kept in the provided field, so indexing again the same block will not alter the document.
+// The provided code applies the change and can add fields on blockChange, which is the entry kept in the document.
+// The script expects the params.maxChanges parameter
+func ApplyBlockChangeScript(changesField string, applyChangeCode string) string {
+	return `
+		if (!ctx._source.containsKey('` + changesField + `')) {
+			ctx._source.` + changesField + ` = [];
+		}
+		for (def change : ctx._source.` + changesField + `) {
+			if (change.timestamp == params.change.timestamp && change.shardID == params.change.shardID) {
+				ctx.op = 'noop';
+				return;
+			}
+		}
+		Map blockChange = new HashMap(params.change);
+` + applyChangeCode + `
+		ctx._source.` + changesField + `.add(blockChange);
+		if (ctx._source.` + changesField + `.length > params.maxChanges) {
+			ctx._source.` + changesField + `.remove(0);
+		}
+`
+}
+
+// RevertBlockChangeScript returns the painless code that undoes the change of the block with params.timestamp and
+// params.shardID. The provided code reverts the entry held by the change variable. The script stops without altering
+// the document if the block did not change it
+func RevertBlockChangeScript(changesField string, revertChangeCode string) string {
+	return `
+	if (!ctx._source.containsKey('` + changesField + `')) {
+		ctx.op = 'noop';
+		return;
+	}
+	boolean found = false;
+	Iterator itr = ctx._source.` + changesField + `.iterator();
+	while (itr.hasNext()) {
+		def change = itr.next();
+		if (change.timestamp == params.timestamp && change.shardID == params.shardID) {
+` + revertChangeCode + `
+			itr.remove();
+			found = true;
+		}
+	}
+	if (!found) {
+		ctx.op = 'noop';
+		return;
+	}
+`
+}
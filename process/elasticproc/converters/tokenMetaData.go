@@ -137,6 +137,10 @@ func PrepareNFTUpdateData(buffSlice *data.BufferSlice, updateNFTData []*data.NFT
 		if errM != nil {
 			return errM
 		}
+		marshalizedDecodedAttributes, errM := json.Marshal(nftUpdate.NewDecodedAttributes)
+		if errM != nil {
+			return errM
+		}
 
 		codeToExecute := `
 			if (ctx._source.containsKey('data')) {
@@ -155,10 +159,17 @@ func PrepareNFTUpdateData(buffSlice *data.BufferSlice, updateNFTData []*data.NFT
 						ctx._source.data.remove('tags')
 					}
 				}
+				if (params.decodedAttributes != null) {
+					ctx._source.attributes = params.decodedAttributes
+				} else {
+					if (ctx._source.containsKey('attributes')) {
+						ctx._source.remove('attributes')
+					}
+				}
 			}
 `
-		serializedData := []byte(fmt.Sprintf(`{"script": {"source": "%s","lang": "painless","params": {"attributes": "%s", "metadata": "%s", "tags": %s}}, "upsert": {}}`,
-			FormatPainlessSource(codeToExecute), base64Attr, newMetadata, marshalizedTags),
+		serializedData := []byte(fmt.Sprintf(`{"script": {"source": "%s","lang": "painless","params": {"attributes": "%s", "metadata": "%s", "tags": %s, "decodedAttributes": %s}}, "upsert": {}}`,
+			FormatPainlessSource(codeToExecute), base64Attr, newMetadata, marshalizedTags, marshalizedDecodedAttributes),
 		)
 		if len(nftUpdate.URIsToAdd) != 0 {
 			uris := make([]string, 0, len(nftUpdate.URIsToAdd))
@@ -234,12 +245,23 @@ func prepareSerializedDataForMetaDataRecreate(nftUpdateData *data.NFTDataUpdate)
 	if err != nil {
 		return nil, err
 	}
+	decodedAttributesBytes, err := json.Marshal(nftUpdateData.NewDecodedAttributes)
+	if err != nil {
+		return nil, err
+	}
 
 	codeToExecute := `
 			ctx._source.data = params.metaData;
+			if (params.decodedAttributes != null) {
+				ctx._source.attributes = params.decodedAttributes
+			} else {
+				if (ctx._source.containsKey('attributes')) {
+					ctx._source.remove('attributes')
+				}
+			}
 `
-	serializedData := []byte(fmt.Sprintf(`{"script": {"source": "%s","lang": "painless","params": {"metaData": %s}}, "upsert": {}}`,
-		FormatPainlessSource(codeToExecute), tokenMetaDataBytes),
+	serializedData := []byte(fmt.Sprintf(`{"script": {"source": "%s","lang": "painless","params": {"metaData": %s, "decodedAttributes": %s}}, "upsert": {}}`,
+		FormatPainlessSource(codeToExecute), tokenMetaDataBytes, decodedAttributesBytes),
 	)
 
 	return serializedData, nil
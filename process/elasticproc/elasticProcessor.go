@@ -6,6 +6,7 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"sort"
 	"sync"
 
 	"github.com/multiversx/mx-chain-core-go/core"
@@ -32,7 +33,10 @@ var (
 		elasticIndexer.TransactionsIndex, elasticIndexer.BlockIndex, elasticIndexer.MiniblocksIndex, elasticIndexer.RatingIndex, elasticIndexer.RoundsIndex, elasticIndexer.ValidatorsIndex,
 		elasticIndexer.AccountsIndex, elasticIndexer.AccountsHistoryIndex, elasticIndexer.ReceiptsIndex, elasticIndexer.ScResultsIndex, elasticIndexer.AccountsESDTHistoryIndex, elasticIndexer.AccountsESDTIndex,
 		elasticIndexer.EpochInfoIndex, elasticIndexer.SCDeploysIndex, elasticIndexer.TokensIndex, elasticIndexer.TagsIndex, elasticIndexer.LogsIndex, elasticIndexer.DelegatorsIndex, elasticIndexer.OperationsIndex,
-		elasticIndexer.ESDTsIndex, elasticIndexer.ValuesIndex, elasticIndexer.EventsIndex,
+		elasticIndexer.ESDTsIndex, elasticIndexer.ValuesIndex, elasticIndexer.EventsIndex, elasticIndexer.TransfersIndex, elasticIndexer.StatsIndex,
+		elasticIndexer.ContractStatsIndex, elasticIndexer.ContractCallersIndex, elasticIndexer.StakedKeysIndex,
+		elasticIndexer.ProvidersIndex, elasticIndexer.UndelegationsIndex, elasticIndexer.TokenRolesIndex, elasticIndexer.RelayersIndex,
+		elasticIndexer.RelayerUsersIndex, elasticIndexer.BridgeTransfersIndex, elasticIndexer.TokensSyncReportsIndex, elasticIndexer.AccountsActivityIndex,
 	}
 )
 
@@ -41,41 +45,63 @@ const versionStr = "indexer-version"
 // ArgElasticProcessor holds all dependencies required by the elasticProcessor in order to create
 // new instances
 type ArgElasticProcessor struct {
-	BulkRequestMaxSize int
-	UseKibana          bool
-	ImportDB           bool
-	IndexTemplates     map[string]*bytes.Buffer
-	IndexPolicies      map[string]*bytes.Buffer
-	ExtraMappings      []templates.ExtraMapping
-	EnabledIndexes     map[string]struct{}
-	TransactionsProc   DBTransactionsHandler
-	AccountsProc       DBAccountHandler
-	BlockProc          DBBlockHandler
-	MiniblocksProc     DBMiniblocksHandler
-	StatisticsProc     DBStatisticsHandler
-	ValidatorsProc     DBValidatorsHandler
-	DBClient           DatabaseClientHandler
-	LogsAndEventsProc  DBLogsAndEventsHandler
-	OperationsProc     OperationsHandler
-	Version            string
-	IndexTokensHandler IndexTokensHandler
+	BulkRequestMaxSize     int
+	UseKibana              bool
+	ImportDB               bool
+	IndexTemplates         map[string]*bytes.Buffer
+	IndexPolicies          map[string]*bytes.Buffer
+	ExtraMappings          []templates.ExtraMapping
+	EnabledIndexes         map[string]struct{}
+	TransactionsProc       DBTransactionsHandler
+	AccountsProc           DBAccountHandler
+	BlockProc              DBBlockHandler
+	MiniblocksProc         DBMiniblocksHandler
+	StatisticsProc         DBStatisticsHandler
+	ValidatorsProc         DBValidatorsHandler
+	DBClient               DatabaseClientHandler
+	LogsAndEventsProc      DBLogsAndEventsHandler
+	OperationsProc         OperationsHandler
+	TransfersProc          DBTransfersHandler
+	StatsProc              DBStatsHandler
+	ContractStatsProc      DBContractStatsHandler
+	RelayerStatsProc       DBRelayerStatsHandler
+	UndelegationsProc      DBUndelegationsHandler
+	Version                string
+	IndexTokensHandler     IndexTokensHandler
+	BridgeTransfersHandler BridgeTransfersHandler
+	StreamPublisher        StreamPublisher
+	NFTAttributesDecoder   NFTAttributesDecoder
+	ABIDecoder             ABIDecoder
+	CallersRetentionInDays uint64
 }
 
 type elasticProcessor struct {
-	bulkRequestMaxSize int
-	importDB           bool
-	enabledIndexes     map[string]struct{}
-	mutex              sync.RWMutex
-	elasticClient      DatabaseClientHandler
-	accountsProc       DBAccountHandler
-	blockProc          DBBlockHandler
-	transactionsProc   DBTransactionsHandler
-	miniblocksProc     DBMiniblocksHandler
-	statisticsProc     DBStatisticsHandler
-	validatorsProc     DBValidatorsHandler
-	logsAndEventsProc  DBLogsAndEventsHandler
-	operationsProc     OperationsHandler
-	indexTokensHandler IndexTokensHandler
+	bulkRequestMaxSize     int
+	importDB               bool
+	enabledIndexes         map[string]struct{}
+	mutex                  sync.RWMutex
+	elasticClient          DatabaseClientHandler
+	accountsProc           DBAccountHandler
+	blockProc              DBBlockHandler
+	transactionsProc       DBTransactionsHandler
+	miniblocksProc         DBMiniblocksHandler
+	statisticsProc         DBStatisticsHandler
+	validatorsProc         DBValidatorsHandler
+	logsAndEventsProc      DBLogsAndEventsHandler
+	operationsProc         OperationsHandler
+	transfersProc          DBTransfersHandler
+	statsProc              DBStatsHandler
+	contractStatsProc      DBContractStatsHandler
+	relayerStatsProc       DBRelayerStatsHandler
+	undelegationsProc      DBUndelegationsHandler
+	indexTokensHandler     IndexTokensHandler
+	bridgeTransfersHandler BridgeTransfersHandler
+	streamPublisher        StreamPublisher
+	nftAttributesDecoder   NFTAttributesDecoder
+	abiDecoder             ABIDecoder
+	callersRetentionInDays uint64
+	dayStart               uint64
+	notarizingMetaBlock    *notarizingMetaBlockInfo
 }
 
 // NewElasticProcessor handles Elasticsearch operations such as initialization, adding, modifying or removing data
@@ -86,18 +112,28 @@ func NewElasticProcessor(arguments *ArgElasticProcessor) (*elasticProcessor, err
 	}
 
 	ei := &elasticProcessor{
-		elasticClient:      arguments.DBClient,
-		enabledIndexes:     arguments.EnabledIndexes,
-		accountsProc:       arguments.AccountsProc,
-		blockProc:          arguments.BlockProc,
-		miniblocksProc:     arguments.MiniblocksProc,
-		transactionsProc:   arguments.TransactionsProc,
-		statisticsProc:     arguments.StatisticsProc,
-		validatorsProc:     arguments.ValidatorsProc,
-		logsAndEventsProc:  arguments.LogsAndEventsProc,
-		operationsProc:     arguments.OperationsProc,
-		bulkRequestMaxSize: arguments.BulkRequestMaxSize,
-		indexTokensHandler: arguments.IndexTokensHandler,
+		elasticClient:          arguments.DBClient,
+		enabledIndexes:         arguments.EnabledIndexes,
+		accountsProc:           arguments.AccountsProc,
+		blockProc:              arguments.BlockProc,
+		miniblocksProc:         arguments.MiniblocksProc,
+		transactionsProc:       arguments.TransactionsProc,
+		statisticsProc:         arguments.StatisticsProc,
+		validatorsProc:         arguments.ValidatorsProc,
+		logsAndEventsProc:      arguments.LogsAndEventsProc,
+		operationsProc:         arguments.OperationsProc,
+		transfersProc:          arguments.TransfersProc,
+		statsProc:              arguments.StatsProc,
+		contractStatsProc:      arguments.ContractStatsProc,
+		relayerStatsProc:       arguments.RelayerStatsProc,
+		undelegationsProc:      arguments.UndelegationsProc,
+		bulkRequestMaxSize:     arguments.BulkRequestMaxSize,
+		indexTokensHandler:     arguments.IndexTokensHandler,
+		bridgeTransfersHandler: arguments.BridgeTransfersHandler,
+		streamPublisher:        arguments.StreamPublisher,
+		nftAttributesDecoder:   arguments.NFTAttributesDecoder,
+		abiDecoder:             arguments.ABIDecoder,
+		callersRetentionInDays: arguments.CallersRetentionInDays,
 	}
 
 	err = ei.init(arguments.UseKibana, arguments.IndexTemplates, arguments.IndexPolicies, arguments.ExtraMappings)
@@ -258,27 +294,83 @@ func getTemplateByName(templateName string, templateList map[string]*bytes.Buffe
 
 // SaveHeader will prepare and save information about a header in elasticsearch server
 func (ei *elasticProcessor) SaveHeader(outportBlockWithHeader *outport.OutportBlockWithHeader) error {
+	buffSlice := data.NewBufferSlice(ei.getBulkRequestMaxSize())
+	elasticBlock, err := ei.indexBlock(outportBlockWithHeader, buffSlice)
+	if err != nil {
+		return err
+	}
+
+	err = ei.indexEpochTransition(outportBlockWithHeader.Header, buffSlice)
+	if err != nil {
+		return err
+	}
+
+	err = ei.finalizeDayStats(outportBlockWithHeader.Header, buffSlice)
+	if err != nil {
+		return err
+	}
+
+	err = ei.removeExpiredContractCallers(outportBlockWithHeader.Header)
+	if err != nil {
+		return err
+	}
+
+	err = ei.doBulkRequests("", buffSlice.Buffers(), outportBlockWithHeader.ShardID)
+	if err != nil {
+		return err
+	}
+
+	ei.setDayStart(outportBlockWithHeader.Header)
+
+	if elasticBlock != nil {
+		ei.streamPublisher.Publish([]*data.StreamEvent{createBlockStreamEvent(elasticBlock)})
+	}
+
+	return nil
+}
+
+// indexBlock returns nil if the blocks index is disabled
+func (ei *elasticProcessor) indexBlock(outportBlockWithHeader *outport.OutportBlockWithHeader, buffSlice *data.BufferSlice) (*data.Block, error) {
 	if !ei.isIndexEnabled(elasticIndexer.BlockIndex) {
-		return nil
+		return nil, nil
 	}
 
 	elasticBlock, err := ei.blockProc.PrepareBlockForDB(outportBlockWithHeader)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	err = ei.linkNotarizingMetaBlock(elasticBlock)
+	if err != nil {
+		return nil, err
 	}
 
-	buffSlice := data.NewBufferSlice(ei.bulkRequestMaxSize)
 	err = ei.blockProc.SerializeBlock(elasticBlock, buffSlice, elasticIndexer.BlockIndex)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	err = ei.indexNotarizedBlocks(outportBlockWithHeader, buffSlice)
+	if err != nil {
+		return nil, err
 	}
 
 	err = ei.indexEpochInfoData(outportBlockWithHeader.Header, buffSlice)
+	if err != nil {
+		return nil, err
+	}
+
+	return elasticBlock, nil
+}
+
+// indexEpochTransition serializes the changes made at the start of an epoch, they do not depend on the blocks index
+func (ei *elasticProcessor) indexEpochTransition(header coreData.HeaderHandler, buffSlice *data.BufferSlice) error {
+	err := ei.finalizeEpochStats(header, buffSlice)
 	if err != nil {
 		return err
 	}
 
-	return ei.doBulkRequests("", buffSlice.Buffers(), outportBlockWithHeader.ShardID)
+	return ei.indexWithdrawableUndelegations(header, buffSlice)
 }
 
 func (ei *elasticProcessor) indexEpochInfoData(header coreData.HeaderHandler, buffSlice *data.BufferSlice) error {
@@ -297,12 +389,25 @@ func (ei *elasticProcessor) RemoveHeader(header coreData.HeaderHandler) error {
 		return err
 	}
 
+	encodedHeaderHash := hex.EncodeToString(headerHash)
 	ctxWithValue := context.WithValue(context.Background(), request.ContextKey, request.ExtendTopicWithShardID(request.RemoveTopic, header.GetShardID()))
-	return ei.elasticClient.DoQueryRemove(
+	err = ei.elasticClient.DoQueryRemove(
 		ctxWithValue,
 		elasticIndexer.BlockIndex,
-		converters.PrepareHashesForQueryRemove([]string{hex.EncodeToString(headerHash)}),
+		converters.PrepareHashesForQueryRemove([]string{encodedHeaderHash}),
 	)
+	if err != nil {
+		return err
+	}
+
+	err = ei.revertNotarizedBlocks(header, encodedHeaderHash)
+	if err != nil {
+		return err
+	}
+
+	ei.streamPublisher.Publish([]*data.StreamEvent{createRevertStreamEvent(header, encodedHeaderHash)})
+
+	return nil
 }
 
 // RemoveMiniblocks will remove all miniblocks that are in header from elasticsearch server
@@ -350,6 +455,66 @@ func (ei *elasticProcessor) RemoveTransactions(header coreData.HeaderHandler, bo
 		return err
 	}
 
+	err = ei.revertTransfers(header)
+	if err != nil {
+		return err
+	}
+
+	err = ei.revertTokensSupply(header)
+	if err != nil {
+		return err
+	}
+
+	err = ei.revertTokenRolesHistory(header)
+	if err != nil {
+		return err
+	}
+
+	err = ei.revertBridgeTransfers(header)
+	if err != nil {
+		return err
+	}
+
+	err = ei.revertAccountsActivity(header)
+	if err != nil {
+		return err
+	}
+
+	err = ei.revertStats(header)
+	if err != nil {
+		return err
+	}
+
+	err = ei.revertContractStats(header)
+	if err != nil {
+		return err
+	}
+
+	err = ei.revertRelayerStats(header)
+	if err != nil {
+		return err
+	}
+
+	err = ei.revertUndelegations(header)
+	if err != nil {
+		return err
+	}
+
+	err = ei.revertStakedKeys(header)
+	if err != nil {
+		return err
+	}
+
+	err = ei.revertProviders(header)
+	if err != nil {
+		return err
+	}
+
+	err = ei.revertTransactionsLifecycle(header)
+	if err != nil {
+		return err
+	}
+
 	return ei.updateDelegatorsInCaseOfRevert(header, body)
 }
 
@@ -412,7 +577,7 @@ func (ei *elasticProcessor) SaveMiniblocks(header coreData.HeaderHandler, miniBl
 		return nil
 	}
 
-	buffSlice := data.NewBufferSlice(ei.bulkRequestMaxSize)
+	buffSlice := data.NewBufferSlice(ei.getBulkRequestMaxSize())
 	ei.miniblocksProc.SerializeBulkMiniBlocks(mbs, buffSlice, elasticIndexer.MiniblocksIndex, header.GetShardID())
 
 	return ei.doBulkRequests("", buffSlice.Buffers(), header.GetShardID())
@@ -421,13 +586,28 @@ func (ei *elasticProcessor) SaveMiniblocks(header coreData.HeaderHandler, miniBl
 // SaveTransactions will prepare and save information about a transactions in elasticsearch server
 func (ei *elasticProcessor) SaveTransactions(obh *outport.OutportBlockWithHeader) error {
 	headerTimestamp := obh.Header.GetTimeStamp()
+	ei.abiDecoder.RegisterContractsCodeHashes(obh.AlteredAccounts)
+	err := ei.resolveContractsCodeHashes(obh.TransactionPool, obh.Header.GetShardID())
+	if err != nil {
+		return err
+	}
 
 	miniBlocks := append(obh.BlockData.Body.MiniBlocks, obh.BlockData.IntraShardMiniBlocks...)
 	preparedResults := ei.transactionsProc.PrepareTransactionsForDatabase(miniBlocks, obh.Header, obh.TransactionPool, ei.isImportDB(), obh.NumberOfShards)
 	logsData := ei.logsAndEventsProc.ExtractDataFromLogs(obh.TransactionPool.Logs, preparedResults, headerTimestamp, obh.Header.GetShardID(), obh.NumberOfShards)
 
-	buffers := data.NewBufferSlice(ei.bulkRequestMaxSize)
-	err := ei.indexTransactions(preparedResults.Transactions, logsData.TxHashStatusInfo, obh.Header, buffers)
+	buffers := data.NewBufferSlice(ei.getBulkRequestMaxSize())
+	err = ei.indexTransactions(preparedResults.Transactions, logsData.TxHashStatusInfo, obh.Header, buffers)
+	if err != nil {
+		return err
+	}
+
+	err = ei.indexTransactionsLifecycle(preparedResults.Transactions, obh.BlockData.HeaderHash, obh.Header, buffers)
+	if err != nil {
+		return err
+	}
+
+	err = ei.indexNotarizedTransactions(obh.NotarizedHeadersHashes, obh.BlockData.HeaderHash, obh.Header, buffers)
 	if err != nil {
 		return err
 	}
@@ -462,17 +642,50 @@ func (ei *elasticProcessor) SaveTransactions(obh *outport.OutportBlockWithHeader
 		return err
 	}
 
+	transfers := ei.extractTransfers(preparedResults, logsData, obh)
+	err = ei.indexTransfers(transfers, buffers)
+	if err != nil {
+		return err
+	}
+
+	err = ei.indexContractStats(preparedResults, logsData.TxHashStatusInfo, obh.Header, buffers)
+	if err != nil {
+		return err
+	}
+
+	err = ei.indexRelayerStats(preparedResults, logsData.TxHashStatusInfo, obh.Header, buffers)
+	if err != nil {
+		return err
+	}
+
 	err = ei.indexReceipts(preparedResults.Receipts, buffers)
 	if err != nil {
 		return err
 	}
 
+	ei.decodeNFTsDataUpdatesAttributes(logsData.NFTsDataUpdates)
+
 	tagsCount := tags.NewTagsCount()
 	err = ei.indexAlteredAccounts(headerTimestamp, logsData.NFTsDataUpdates, obh.AlteredAccounts, buffers, tagsCount, obh.Header.GetShardID())
 	if err != nil {
 		return err
 	}
 
+	err = ei.indexStats(preparedResults, transfers, obh.Header, buffers)
+	if err != nil {
+		return err
+	}
+
+	accountsActivity, err := ei.prepareAccountsActivity(preparedResults, obh.AlteredAccounts, obh.Header)
+	if err != nil {
+		return err
+	}
+
+	err = ei.indexAccountsActivity(accountsActivity, buffers)
+	if err != nil {
+		return err
+	}
+
 	err = ei.prepareAndIndexTagsCount(tagsCount, buffers)
 	if err != nil {
 		return err
@@ -488,11 +701,31 @@ func (ei *elasticProcessor) SaveTransactions(obh *outport.OutportBlockWithHeader
 		return err
 	}
 
+	err = ei.prepareAndIndexStakedKeys(logsData.StakedKeys, buffers)
+	if err != nil {
+		return err
+	}
+
+	err = ei.prepareAndIndexProviders(logsData.ProvidersChanges, buffers)
+	if err != nil {
+		return err
+	}
+
+	err = ei.indexUndelegations(logsData.DelegatorsOperations, obh.Header, buffers)
+	if err != nil {
+		return err
+	}
+
 	err = ei.indexNFTBurnInfo(logsData.TokensSupply, buffers, obh.ShardID)
 	if err != nil {
 		return err
 	}
 
+	err = ei.indexTokensSupply(logsData.TokensSupplyChanges, buffers)
+	if err != nil {
+		return err
+	}
+
 	err = ei.prepareAndIndexRolesData(logsData.TokenRolesAndProperties, buffers, elasticIndexer.TokensIndex)
 	if err != nil {
 		return err
@@ -502,6 +735,11 @@ func (ei *elasticProcessor) SaveTransactions(obh *outport.OutportBlockWithHeader
 		return err
 	}
 
+	err = ei.indexTokenRolesHistory(logsData.TokenRolesHistory, buffers)
+	if err != nil {
+		return err
+	}
+
 	err = ei.indexScDeploys(logsData.ScDeploys, logsData.ChangeOwnerOperations, buffers)
 	if err != nil {
 		return err
@@ -512,7 +750,19 @@ func (ei *elasticProcessor) SaveTransactions(obh *outport.OutportBlockWithHeader
 		return err
 	}
 
-	return ei.doBulkRequests("", buffers.Buffers(), obh.ShardID)
+	err = ei.indexBridgeTransfers(preparedResults.ScResults, logsData.DBEvents, obh.Header, buffers)
+	if err != nil {
+		return err
+	}
+
+	err = ei.doBulkRequests("", buffers.Buffers(), obh.ShardID)
+	if err != nil {
+		return err
+	}
+
+	ei.streamPublisher.Publish(createTransactionsStreamEvents(preparedResults, logsData.DBEvents, obh.Header.GetShardID()))
+
+	return nil
 }
 
 func (ei *elasticProcessor) prepareAndIndexRolesData(tokenRolesAndProperties *tokeninfo.TokenRolesAndProperties, buffSlice *data.BufferSlice, index string) error {
@@ -531,6 +781,44 @@ func (ei *elasticProcessor) prepareAndIndexDelegators(delegators map[string]*dat
 	return ei.logsAndEventsProc.SerializeDelegators(delegators, buffSlice, elasticIndexer.DelegatorsIndex)
 }
 
+func (ei *elasticProcessor) prepareAndIndexStakedKeys(stakedKeys map[string]*data.StakedKey, buffSlice *data.BufferSlice) error {
+	if !ei.isIndexEnabled(elasticIndexer.StakedKeysIndex) {
+		return nil
+	}
+
+	return ei.logsAndEventsProc.SerializeStakedKeys(stakedKeys, buffSlice, elasticIndexer.StakedKeysIndex)
+}
+
+func (ei *elasticProcessor) revertStakedKeys(header coreData.HeaderHandler) error {
+	if !ei.isIndexEnabled(elasticIndexer.StakedKeysIndex) {
+		return nil
+	}
+
+	ctxWithValue := context.WithValue(context.Background(), request.ContextKey, request.ExtendTopicWithShardID(request.UpdateTopic, header.GetShardID()))
+	query := ei.logsAndEventsProc.PrepareStakedKeysQueryInCaseOfRevert(header.GetTimeStamp(), header.GetShardID())
+
+	return ei.elasticClient.UpdateByQuery(ctxWithValue, elasticIndexer.StakedKeysIndex, query)
+}
+
+func (ei *elasticProcessor) revertProviders(header coreData.HeaderHandler) error {
+	if !ei.isIndexEnabled(elasticIndexer.ProvidersIndex) {
+		return nil
+	}
+
+	ctxWithValue := context.WithValue(context.Background(), request.ContextKey, request.ExtendTopicWithShardID(request.UpdateTopic, header.GetShardID()))
+	query := ei.logsAndEventsProc.PrepareProvidersQueryInCaseOfRevert(header.GetTimeStamp(), header.GetShardID())
+
+	return ei.elasticClient.UpdateByQuery(ctxWithValue, elasticIndexer.ProvidersIndex, query)
+}
+
+func (ei *elasticProcessor) prepareAndIndexProviders(providersChanges []*data.ProviderChange, buffSlice *data.BufferSlice) error {
+	if !ei.isIndexEnabled(elasticIndexer.ProvidersIndex) {
+		return nil
+	}
+
+	return ei.logsAndEventsProc.SerializeProvidersChanges(providersChanges, buffSlice, elasticIndexer.ProvidersIndex)
+}
+
 func (ei *elasticProcessor) indexTransactionsFeeData(txsHashFeeData map[string]*data.FeeData, buffSlice *data.BufferSlice) error {
 	if len(txsHashFeeData) == 0 {
 		return nil
@@ -560,6 +848,45 @@ func (ei *elasticProcessor) indexEvents(eventsDB []*data.LogEvent, buffSlice *da
 	return ei.logsAndEventsProc.SerializeEvents(eventsDB, buffSlice, elasticIndexer.EventsIndex)
 }
 
+// extractTransfers computes the value transfers of the block, they are needed by the transfers and by the stats indices
+func (ei *elasticProcessor) extractTransfers(
+	preparedResults *data.PreparedResults,
+	logsData *data.PreparedLogsResults,
+	obh *outport.OutportBlockWithHeader,
+) []*data.Transfer {
+	shouldExtract := ei.isIndexEnabled(elasticIndexer.TransfersIndex) || ei.isIndexEnabled(elasticIndexer.StatsIndex)
+	if !shouldExtract {
+		return nil
+	}
+
+	return ei.transfersProc.ExtractTransfers(preparedResults, logsData, obh.Header.GetTimeStamp(), obh.Header.GetShardID(), obh.NumberOfShards)
+}
+
+func (ei *elasticProcessor) indexTransfers(transfers []*data.Transfer, buffSlice *data.BufferSlice) error {
+	if !ei.isIndexEnabled(elasticIndexer.TransfersIndex) {
+		return nil
+	}
+
+	return ei.transfersProc.SerializeTransfers(transfers, buffSlice, elasticIndexer.TransfersIndex)
+}
+
+// revertTransfers removes the legs written by the reverted block and marks again as pending the cross shard legs it completed
+func (ei *elasticProcessor) revertTransfers(header coreData.HeaderHandler) error {
+	if !ei.isIndexEnabled(elasticIndexer.TransfersIndex) {
+		return nil
+	}
+
+	err := ei.removeFromIndexByTimestampAndShardID(header.GetTimeStamp(), header.GetShardID(), elasticIndexer.TransfersIndex)
+	if err != nil {
+		return err
+	}
+
+	ctxWithValue := context.WithValue(context.Background(), request.ContextKey, request.ExtendTopicWithShardID(request.UpdateTopic, header.GetShardID()))
+	query := ei.transfersProc.PrepareTransfersQueryInCaseOfRevert(header.GetTimeStamp(), header.GetShardID())
+
+	return ei.elasticClient.UpdateByQuery(ctxWithValue, elasticIndexer.TransfersIndex, query)
+}
+
 func (ei *elasticProcessor) indexScDeploys(deployData map[string]*data.ScDeployInfo, changeOwnerOperation map[string]*data.OwnerData, buffSlice *data.BufferSlice) error {
 	if !ei.isIndexEnabled(elasticIndexer.SCDeploysIndex) {
 		return nil
@@ -670,6 +997,8 @@ func (ei *elasticProcessor) saveAccountsESDT(
 	shardID uint32,
 ) error {
 	accountsESDTMap, tokensData := ei.accountsProc.PrepareAccountsMapESDT(timestamp, wrappedAccounts, tagsCount, shardID)
+	ei.decodeAccountsESDTAttributes(accountsESDTMap)
+
 	err := ei.addTokenTypeAndCurrentOwnerInAccountsESDT(tokensData, accountsESDTMap, shardID)
 	if err != nil {
 		return err
@@ -739,6 +1068,7 @@ func (ei *elasticProcessor) indexNFTCreateInfo(tokensData data.TokensHandler, co
 
 	tokens := tokensData.GetAllWithoutMetaESDT()
 	ei.accountsProc.PutTokenMedataDataInTokens(tokens, coreAlteredAccounts)
+	ei.decodeTokensAttributes(tokens)
 
 	return ei.accountsProc.SerializeNFTCreateInfo(tokens, buffSlice, elasticIndexer.TokensIndex)
 }
@@ -763,7 +1093,7 @@ func (ei *elasticProcessor) indexNFTBurnInfo(tokensData data.TokensHandler, buff
 
 // SaveAccounts will prepare and save information about provided accounts in elasticsearch server
 func (ei *elasticProcessor) SaveAccounts(accountsData *outport.Accounts) error {
-	buffSlice := data.NewBufferSlice(ei.bulkRequestMaxSize)
+	buffSlice := data.NewBufferSlice(ei.getBulkRequestMaxSize())
 
 	accounts := make([]*data.Account, 0, len(accountsData.AlteredAccounts))
 	for _, account := range accountsData.AlteredAccounts {
@@ -839,10 +1169,85 @@ func (ei *elasticProcessor) indexReceipts(receipts []*data.Receipt, buffSlice *d
 }
 
 func (ei *elasticProcessor) isIndexEnabled(index string) bool {
+	ei.mutex.RLock()
+	defer ei.mutex.RUnlock()
+
 	_, isEnabled := ei.enabledIndexes[index]
 	return isEnabled
 }
 
+// EnableIndex will start writing data in the provided index
+func (ei *elasticProcessor) EnableIndex(index string) error {
+	if !isKnownIndex(index) {
+		return fmt.Errorf("%w: %s", elasticIndexer.ErrUnknownIndex, index)
+	}
+
+	ei.mutex.Lock()
+	ei.enabledIndexes[index] = struct{}{}
+	ei.mutex.Unlock()
+
+	return nil
+}
+
+// DisableIndex will stop writing data in the provided index
+func (ei *elasticProcessor) DisableIndex(index string) error {
+	if !isKnownIndex(index) {
+		return fmt.Errorf("%w: %s", elasticIndexer.ErrUnknownIndex, index)
+	}
+
+	ei.mutex.Lock()
+	delete(ei.enabledIndexes, index)
+	ei.mutex.Unlock()
+
+	return nil
+}
+
+// SetBulkRequestMaxSize will change the maximum size of the bulk requests sent from now on
+func (ei *elasticProcessor) SetBulkRequestMaxSize(bulkRequestMaxSize int) {
+	ei.mutex.Lock()
+	ei.bulkRequestMaxSize = bulkRequestMaxSize
+	ei.mutex.Unlock()
+}
+
+func (ei *elasticProcessor) getBulkRequestMaxSize() int {
+	ei.mutex.RLock()
+	defer ei.mutex.RUnlock()
+
+	return ei.bulkRequestMaxSize
+}
+
+// GetEnabledIndexes returns the sorted list of indices the processor currently writes in
+func (ei *elasticProcessor) GetEnabledIndexes() []string {
+	ei.mutex.RLock()
+	enabledIndexes := make([]string, 0, len(ei.enabledIndexes))
+	for index := range ei.enabledIndexes {
+		enabledIndexes = append(enabledIndexes, index)
+	}
+	ei.mutex.RUnlock()
+
+	sort.Strings(enabledIndexes)
+
+	return enabledIndexes
+}
+
+// GetKnownIndexes returns the names of all the indices the elastic processor is able to write in
+func GetKnownIndexes() []string {
+	knownIndexes := make([]string, len(indexes))
+	copy(knownIndexes, indexes)
+
+	return knownIndexes
+}
+
+func isKnownIndex(index string) bool {
+	for _, knownIndex := range indexes {
+		if knownIndex == index {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (ei *elasticProcessor) doBulkRequests(index string, buffSlice []*bytes.Buffer, shardID uint32) error {
 	var err error
 	for idx := range buffSlice {
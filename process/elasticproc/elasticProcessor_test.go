@@ -3,9 +3,11 @@ package elasticproc
 import (
 	"bytes"
 	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	coreData "github.com/multiversx/mx-chain-core-go/data"
@@ -19,28 +21,43 @@ import (
 	"github.com/multiversx/mx-chain-es-indexer-go/process/dataindexer"
 	"github.com/multiversx/mx-chain-es-indexer-go/process/elasticproc/accounts"
 	"github.com/multiversx/mx-chain-es-indexer-go/process/elasticproc/block"
+	"github.com/multiversx/mx-chain-es-indexer-go/process/elasticproc/contractstats"
 	"github.com/multiversx/mx-chain-es-indexer-go/process/elasticproc/converters"
 	"github.com/multiversx/mx-chain-es-indexer-go/process/elasticproc/logsevents"
 	"github.com/multiversx/mx-chain-es-indexer-go/process/elasticproc/miniblocks"
+	"github.com/multiversx/mx-chain-es-indexer-go/process/elasticproc/networkstats"
 	"github.com/multiversx/mx-chain-es-indexer-go/process/elasticproc/operations"
+	"github.com/multiversx/mx-chain-es-indexer-go/process/elasticproc/relayerstats"
 	"github.com/multiversx/mx-chain-es-indexer-go/process/elasticproc/statistics"
 	"github.com/multiversx/mx-chain-es-indexer-go/process/elasticproc/tags"
 	"github.com/multiversx/mx-chain-es-indexer-go/process/elasticproc/transactions"
+	"github.com/multiversx/mx-chain-es-indexer-go/process/elasticproc/transfers"
+	"github.com/multiversx/mx-chain-es-indexer-go/process/elasticproc/undelegations"
 	"github.com/multiversx/mx-chain-es-indexer-go/process/elasticproc/validators"
 )
 
 func newElasticsearchProcessor(elasticsearchWriter DatabaseClientHandler, arguments *ArgElasticProcessor) *elasticProcessor {
 	return &elasticProcessor{
-		elasticClient:      elasticsearchWriter,
-		enabledIndexes:     arguments.EnabledIndexes,
-		blockProc:          arguments.BlockProc,
-		transactionsProc:   arguments.TransactionsProc,
-		miniblocksProc:     arguments.MiniblocksProc,
-		accountsProc:       arguments.AccountsProc,
-		validatorsProc:     arguments.ValidatorsProc,
-		statisticsProc:     arguments.StatisticsProc,
-		logsAndEventsProc:  arguments.LogsAndEventsProc,
-		indexTokensHandler: arguments.IndexTokensHandler,
+		elasticClient:          elasticsearchWriter,
+		enabledIndexes:         arguments.EnabledIndexes,
+		blockProc:              arguments.BlockProc,
+		transactionsProc:       arguments.TransactionsProc,
+		miniblocksProc:         arguments.MiniblocksProc,
+		accountsProc:           arguments.AccountsProc,
+		validatorsProc:         arguments.ValidatorsProc,
+		statisticsProc:         arguments.StatisticsProc,
+		logsAndEventsProc:      arguments.LogsAndEventsProc,
+		transfersProc:          arguments.TransfersProc,
+		statsProc:              arguments.StatsProc,
+		contractStatsProc:      arguments.ContractStatsProc,
+		relayerStatsProc:       arguments.RelayerStatsProc,
+		undelegationsProc:      arguments.UndelegationsProc,
+		indexTokensHandler:     arguments.IndexTokensHandler,
+		bridgeTransfersHandler: arguments.BridgeTransfersHandler,
+		streamPublisher:        arguments.StreamPublisher,
+		nftAttributesDecoder:   arguments.NFTAttributesDecoder,
+		abiDecoder:             arguments.ABIDecoder,
+		callersRetentionInDays: arguments.CallersRetentionInDays,
 	}
 }
 
@@ -63,7 +80,7 @@ func createEmptyOutportBlockWithHeader() *outport.OutportBlockWithHeader {
 func createMockElasticProcessorArgs() *ArgElasticProcessor {
 	balanceConverter, _ := converters.NewBalanceConverter(10)
 
-	acp, _ := accounts.NewAccountsProcessor(&mock.PubkeyConverterMock{}, balanceConverter)
+	acp, _ := accounts.NewAccountsProcessor(&mock.PubkeyConverterMock{}, balanceConverter, false)
 	bp, _ := block.NewBlockProcessor(&mock.HasherMock{}, &mock.MarshalizerMock{})
 	mp, _ := miniblocks.NewMiniblocksProcessor(&mock.HasherMock{}, &mock.MarshalizerMock{})
 	vp, _ := validators.NewValidatorsProcessor(mock.NewPubkeyConverterMock(32), 0)
@@ -72,24 +89,38 @@ func createMockElasticProcessorArgs() *ArgElasticProcessor {
 		Marshalizer:      &mock.MarshalizerMock{},
 		BalanceConverter: balanceConverter,
 		Hasher:           &mock.HasherMock{},
+		EventsDecoder:    &mock.ABIDecoderStub{},
 	}
 	lp, _ := logsevents.NewLogsAndEventsProcessor(args)
 	op, _ := operations.NewOperationsProcessor()
+	tp, _ := transfers.NewTransfersProcessor(&mock.PubkeyConverterMock{}, balanceConverter)
+	sp, _ := networkstats.NewStatsProcessor(balanceConverter)
+	csp, _ := contractstats.NewContractStatsProcessor(&mock.PubkeyConverterMock{}, balanceConverter)
+	rsp, _ := relayerstats.NewRelayerStatsProcessor(balanceConverter)
 
 	return &ArgElasticProcessor{
 		DBClient: &mock.DatabaseWriterStub{},
 		EnabledIndexes: map[string]struct{}{
 			dataindexer.BlockIndex: {}, dataindexer.TransactionsIndex: {}, dataindexer.MiniblocksIndex: {}, dataindexer.ValidatorsIndex: {}, dataindexer.RoundsIndex: {}, dataindexer.AccountsIndex: {}, dataindexer.RatingIndex: {}, dataindexer.AccountsHistoryIndex: {},
 		},
-		ValidatorsProc:     vp,
-		StatisticsProc:     statistics.NewStatisticsProcessor(),
-		TransactionsProc:   &mock.DBTransactionProcessorStub{},
-		MiniblocksProc:     mp,
-		AccountsProc:       acp,
-		BlockProc:          bp,
-		LogsAndEventsProc:  lp,
-		OperationsProc:     op,
-		IndexTokensHandler: &IndexTokenHandlerMock{},
+		ValidatorsProc:         vp,
+		StatisticsProc:         statistics.NewStatisticsProcessor(),
+		TransactionsProc:       &mock.DBTransactionProcessorStub{},
+		MiniblocksProc:         mp,
+		AccountsProc:           acp,
+		BlockProc:              bp,
+		LogsAndEventsProc:      lp,
+		OperationsProc:         op,
+		TransfersProc:          tp,
+		StatsProc:              sp,
+		ContractStatsProc:      csp,
+		RelayerStatsProc:       rsp,
+		UndelegationsProc:      undelegations.NewUndelegationsProcessor(10),
+		IndexTokensHandler:     &IndexTokenHandlerMock{},
+		BridgeTransfersHandler: &BridgeTransfersHandlerMock{},
+		StreamPublisher:        &mock.StreamPublisherStub{},
+		NFTAttributesDecoder:   &mock.NFTAttributesDecoderStub{},
+		ABIDecoder:             &mock.ABIDecoderStub{},
 	}
 }
 
@@ -203,6 +234,69 @@ func TestNewElasticProcessor(t *testing.T) {
 			},
 			exErr: dataindexer.ErrNilTransactionsHandler,
 		},
+		{
+			name: "NilStatsProc",
+			args: func() *ArgElasticProcessor {
+				arguments := createMockElasticProcessorArgs()
+				arguments.StatsProc = nil
+				return arguments
+			},
+			exErr: dataindexer.ErrNilStatsHandler,
+		},
+		{
+			name: "NilContractStatsProc",
+			args: func() *ArgElasticProcessor {
+				arguments := createMockElasticProcessorArgs()
+				arguments.ContractStatsProc = nil
+				return arguments
+			},
+			exErr: dataindexer.ErrNilContractStatsHandler,
+		},
+		{
+			name: "NilRelayerStatsProc",
+			args: func() *ArgElasticProcessor {
+				arguments := createMockElasticProcessorArgs()
+				arguments.RelayerStatsProc = nil
+				return arguments
+			},
+			exErr: dataindexer.ErrNilRelayerStatsHandler,
+		},
+		{
+			name: "NilUndelegationsProc",
+			args: func() *ArgElasticProcessor {
+				arguments := createMockElasticProcessorArgs()
+				arguments.UndelegationsProc = nil
+				return arguments
+			},
+			exErr: dataindexer.ErrNilUndelegationsHandler,
+		},
+		{
+			name: "NilBridgeTransfersHandler",
+			args: func() *ArgElasticProcessor {
+				arguments := createMockElasticProcessorArgs()
+				arguments.BridgeTransfersHandler = nil
+				return arguments
+			},
+			exErr: dataindexer.ErrNilBridgeTransfersHandler,
+		},
+		{
+			name: "NilNFTAttributesDecoder",
+			args: func() *ArgElasticProcessor {
+				arguments := createMockElasticProcessorArgs()
+				arguments.NFTAttributesDecoder = nil
+				return arguments
+			},
+			exErr: dataindexer.ErrNilNFTAttributesDecoder,
+		},
+		{
+			name: "NilABIDecoder",
+			args: func() *ArgElasticProcessor {
+				arguments := createMockElasticProcessorArgs()
+				arguments.ABIDecoder = nil
+				return arguments
+			},
+			exErr: dataindexer.ErrNilABIDecoder,
+		},
 		{
 			name: "InitError",
 			args: func() *ArgElasticProcessor {
@@ -332,7 +426,6 @@ func TestElasticseachDatabaseSaveHeader_RequestError(t *testing.T) {
 			return localErr
 		},
 	}
-	arguments.BlockProc, _ = block.NewBlockProcessor(&mock.HasherMock{}, &mock.MarshalizerMock{})
 	elasticDatabase := newElasticsearchProcessor(dbWriter, arguments)
 
 	err := elasticDatabase.SaveHeader(createEmptyOutportBlockWithHeader())
@@ -359,6 +452,7 @@ func TestElasticseachSaveTransactions(t *testing.T) {
 		BalanceConverter:       bc,
 		TxHashExtractor:        transactions.NewTxHashExtractor(),
 		RewardTxData:           &mock.RewardTxDataMock{},
+		CallArgsDecoder:        &mock.ABIDecoderStub{},
 	}
 	txDbProc, _ := transactions.NewTransactionsProcessor(args)
 	arguments.TransactionsProc = txDbProc
@@ -468,8 +562,8 @@ func TestElasticProcessor_RemoveTransactions(t *testing.T) {
 	dbWriter := &mock.DatabaseWriterStub{
 		DoQueryRemoveCalled: func(index string, body *bytes.Buffer) error {
 			bodyStr := body.String()
-			require.Contains(t, []string{dataindexer.TransactionsIndex, dataindexer.OperationsIndex, dataindexer.LogsIndex, dataindexer.EventsIndex}, index)
-			if index != dataindexer.EventsIndex {
+			require.Contains(t, []string{dataindexer.TransactionsIndex, dataindexer.OperationsIndex, dataindexer.LogsIndex, dataindexer.EventsIndex, dataindexer.TransfersIndex}, index)
+			if index != dataindexer.EventsIndex && index != dataindexer.TransfersIndex {
 				require.True(t, strings.Contains(bodyStr, expectedHashes[0]))
 				require.True(t, strings.Contains(bodyStr, expectedHashes[1]))
 				called = true
@@ -515,6 +609,27 @@ func TestElasticProcessor_RemoveTransactions(t *testing.T) {
 	require.True(t, called)
 }
 
+func TestElasticProcessor_RemoveTransactionsShouldRevertProvidersOfShardBlockWithoutMiniblocks(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockElasticProcessorArgs()
+	arguments.EnabledIndexes = map[string]struct{}{dataindexer.ProvidersIndex: {}}
+
+	revertedIndexes := make([]string, 0)
+	dbWriter := &mock.DatabaseWriterStub{
+		UpdateByQueryCalled: func(index string, buff *bytes.Buffer) error {
+			revertedIndexes = append(revertedIndexes, index)
+			require.Contains(t, buff.String(), `"params": {"timestamp": 5000, "shardID": 1}`)
+			return nil
+		},
+	}
+	elasticSearchProc := newElasticsearchProcessor(dbWriter, arguments)
+
+	err := elasticSearchProc.RemoveTransactions(&dataBlock.Header{ShardID: 1, TimeStamp: 5000}, &dataBlock.Body{})
+	require.Nil(t, err)
+	require.Equal(t, []string{dataindexer.ProvidersIndex}, revertedIndexes)
+}
+
 func TestElasticProcessor_IndexEpochInfoData(t *testing.T) {
 	called := false
 	arguments := createMockElasticProcessorArgs()
@@ -597,3 +712,605 @@ func TestElasticProcessor_IndexAlteredAccounts(t *testing.T) {
 	require.Nil(t, err)
 	require.True(t, called)
 }
+
+func TestElasticProcessor_EnableAndDisableIndex(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockElasticProcessorArgs()
+	elasticSearchProc := newElasticsearchProcessor(&mock.DatabaseWriterStub{}, arguments)
+
+	err := elasticSearchProc.EnableIndex("unknown")
+	require.True(t, errors.Is(err, dataindexer.ErrUnknownIndex))
+	err = elasticSearchProc.DisableIndex("unknown")
+	require.True(t, errors.Is(err, dataindexer.ErrUnknownIndex))
+
+	require.False(t, elasticSearchProc.isIndexEnabled(dataindexer.LogsIndex))
+	err = elasticSearchProc.EnableIndex(dataindexer.LogsIndex)
+	require.Nil(t, err)
+	require.True(t, elasticSearchProc.isIndexEnabled(dataindexer.LogsIndex))
+
+	err = elasticSearchProc.DisableIndex(dataindexer.BlockIndex)
+	require.Nil(t, err)
+	require.False(t, elasticSearchProc.isIndexEnabled(dataindexer.BlockIndex))
+
+	require.Equal(t, []string{
+		dataindexer.AccountsIndex, dataindexer.AccountsHistoryIndex, dataindexer.LogsIndex, dataindexer.MiniblocksIndex,
+		dataindexer.RatingIndex, dataindexer.RoundsIndex, dataindexer.TransactionsIndex, dataindexer.ValidatorsIndex,
+	}, elasticSearchProc.GetEnabledIndexes())
+}
+
+func TestElasticProcessor_SaveHeaderShouldPublishOnlyAfterSuccessfulBulk(t *testing.T) {
+	t.Parallel()
+
+	localErr := errors.New("localErr")
+	bulkErr := localErr
+	dbWriter := &mock.DatabaseWriterStub{
+		DoBulkRequestCalled: func(buff *bytes.Buffer, index string) error {
+			return bulkErr
+		},
+	}
+
+	publishedEvents := make([]*data.StreamEvent, 0)
+	arguments := createMockElasticProcessorArgs()
+	arguments.StreamPublisher = &mock.StreamPublisherStub{
+		PublishCalled: func(events []*data.StreamEvent) {
+			publishedEvents = append(publishedEvents, events...)
+		},
+	}
+	elasticDatabase := newElasticsearchProcessor(dbWriter, arguments)
+
+	err := elasticDatabase.SaveHeader(createEmptyOutportBlockWithHeader())
+	require.Equal(t, localErr, err)
+	require.Len(t, publishedEvents, 0)
+
+	bulkErr = nil
+	err = elasticDatabase.SaveHeader(createEmptyOutportBlockWithHeader())
+	require.Nil(t, err)
+	require.Len(t, publishedEvents, 1)
+	require.Equal(t, data.StreamEventBlock, publishedEvents[0].Type)
+}
+
+func TestElasticProcessor_SaveHeaderShouldFinalizeEpochStatsWhenBlocksIndexIsDisabled(t *testing.T) {
+	t.Parallel()
+
+	bulkRequests := make([]string, 0)
+	dbWriter := &mock.DatabaseWriterStub{
+		DoBulkRequestCalled: func(buff *bytes.Buffer, index string) error {
+			bulkRequests = append(bulkRequests, buff.String())
+			return nil
+		},
+	}
+
+	publishedEvents := make([]*data.StreamEvent, 0)
+	arguments := createMockElasticProcessorArgs()
+	arguments.EnabledIndexes = map[string]struct{}{dataindexer.StatsIndex: {}}
+	arguments.StreamPublisher = &mock.StreamPublisherStub{
+		PublishCalled: func(events []*data.StreamEvent) {
+			publishedEvents = append(publishedEvents, events...)
+		},
+	}
+	elasticDatabase := newElasticsearchProcessor(dbWriter, arguments)
+
+	obh := createEmptyOutportBlockWithHeader()
+	obh.Header = &dataBlock.Header{Nonce: 1, Epoch: 3, ShardID: 1, TimeStamp: 100, EpochStartMetaHash: []byte("meta")}
+	err := elasticDatabase.SaveHeader(obh)
+	require.Nil(t, err)
+	require.Len(t, bulkRequests, 1)
+	require.Contains(t, bulkRequests[0], `"_id" : "epoch-2-1"`)
+	require.Len(t, publishedEvents, 0)
+}
+
+func TestElasticProcessor_SaveHeaderShouldLinkTheShardBlockWithTheMetaBlockIndexedFirst(t *testing.T) {
+	t.Parallel()
+
+	bulkRequests := make([]string, 0)
+	dbWriter := &mock.DatabaseWriterStub{
+		DoSearchRequestCalled: func(index string, body []byte, response interface{}) error {
+			require.Equal(t, dataindexer.BlockIndex, index)
+			require.Contains(t, string(body), `"notarizedBlocksHashes"`)
+			return json.Unmarshal([]byte(`{"hits":{"hits":[{"_id":"metaHash","_source":{"round":7}}]}}`), response)
+		},
+		DoBulkRequestCalled: func(buff *bytes.Buffer, index string) error {
+			bulkRequests = append(bulkRequests, buff.String())
+			return nil
+		},
+	}
+	elasticDatabase := newElasticsearchProcessor(dbWriter, createMockElasticProcessorArgs())
+
+	err := elasticDatabase.SaveHeader(createEmptyOutportBlockWithHeader())
+	require.Nil(t, err)
+	require.Len(t, bulkRequests, 1)
+	require.Contains(t, bulkRequests[0], `"notarizedByMetaHash":"metaHash","notarizedAtRound":7,"notarized":true`)
+}
+
+func TestElasticProcessor_NotarizingMetaBlockShouldBeSearchedOncePerBlock(t *testing.T) {
+	t.Parallel()
+
+	searches := 0
+	dbWriter := &mock.DatabaseWriterStub{
+		DoSearchRequestCalled: func(index string, body []byte, response interface{}) error {
+			searches++
+			return json.Unmarshal([]byte(`{"hits":{"hits":[{"_id":"metaHash","_source":{"round":7}}]}}`), response)
+		},
+	}
+	elasticDatabase := newElasticsearchProcessor(dbWriter, createMockElasticProcessorArgs())
+
+	obh := createEmptyOutportBlockWithHeader()
+	obh.BlockData.HeaderHash = []byte("shard")
+	err := elasticDatabase.SaveHeader(obh)
+	require.Nil(t, err)
+	require.Equal(t, 1, searches)
+
+	// the lifecycle of the transactions of the same block reuses the metachain block found for the block
+	txs := []*data.Transaction{{Hash: "txHash", SenderShard: 0, ReceiverShard: 1}}
+	err = elasticDatabase.indexTransactionsLifecycle(txs, obh.BlockData.HeaderHash, obh.Header, data.NewBufferSlice(data.DefaultMaxBulkSize))
+	require.Nil(t, err)
+	require.Equal(t, 1, searches)
+
+	hash, round, err := elasticDatabase.getNotarizingMetaBlock("6f74686572", 0)
+	require.Nil(t, err)
+	require.Equal(t, "metaHash", hash)
+	require.Equal(t, uint64(7), round)
+	require.Equal(t, 2, searches)
+}
+
+func TestElasticProcessor_SaveHeaderShouldMarkWithdrawableUndelegationsInTheBulk(t *testing.T) {
+	t.Parallel()
+
+	bulkRequests := make([]string, 0)
+	dbWriter := &mock.DatabaseWriterStub{
+		DoScrollRequestCalled: func(index string, body []byte, withSource bool, handlerFunc func(responseBytes []byte) error) error {
+			require.Equal(t, dataindexer.UndelegationsIndex, index)
+			require.Contains(t, string(body), `{"range": {"unlockEpoch": {"lte": 3}}}`)
+			return handlerFunc([]byte(`{"hits": {"hits": [{"_id": "contract-01"}]}}`))
+		},
+		DoBulkRequestCalled: func(buff *bytes.Buffer, index string) error {
+			bulkRequests = append(bulkRequests, buff.String())
+			return nil
+		},
+		UpdateByQueryCalled: func(index string, buff *bytes.Buffer) error {
+			require.Fail(t, "the undelegations should be updated in the bulk")
+			return nil
+		},
+	}
+
+	arguments := createMockElasticProcessorArgs()
+	arguments.EnabledIndexes = map[string]struct{}{dataindexer.UndelegationsIndex: {}}
+	elasticDatabase := newElasticsearchProcessor(dbWriter, arguments)
+
+	obh := createEmptyOutportBlockWithHeader()
+	obh.Header = &dataBlock.Header{Nonce: 1, Epoch: 3, ShardID: 0, TimeStamp: 100, EpochStartMetaHash: []byte("meta")}
+	err := elasticDatabase.SaveHeader(obh)
+	require.Nil(t, err)
+	require.Len(t, bulkRequests, 1)
+	require.Contains(t, bulkRequests[0], `{ "update" : { "_index":"undelegations", "_id" : "contract-01" } }`)
+	require.Contains(t, bulkRequests[0], `"params": { "pendingStatus": "pending", "status": "withdrawable", "epoch": 3 }`)
+}
+
+func TestElasticProcessor_SaveHeaderShouldFinalizeThePreviousDayStatsOnce(t *testing.T) {
+	t.Parallel()
+
+	bulkRequests := make([]string, 0)
+	searchedIndices := make([]string, 0)
+	dbWriter := &mock.DatabaseWriterStub{
+		DoBulkRequestCalled: func(buff *bytes.Buffer, index string) error {
+			bulkRequests = append(bulkRequests, buff.String())
+			return nil
+		},
+		DoSearchRequestCalled: func(index string, body []byte, response interface{}) error {
+			searchedIndices = append(searchedIndices, index)
+			if index == dataindexer.TransactionsIndex {
+				require.Contains(t, string(body), `"range": {"timestamp": {"gte": 1792195200, "lt": 1792281600}}`)
+				return json.Unmarshal([]byte(`{"aggregations":{"senders":{"addresses":{"value":3}},"receivers":{"addresses":{"value":5}}}}`), response)
+			}
+
+			require.Contains(t, string(body), `{"range": {"firstSeen": {"gte": 1792195200, "lt": 1792281600}}}`)
+			return json.Unmarshal([]byte(`{"hits":{"total":{"value":2}}}`), response)
+		},
+	}
+
+	arguments := createMockElasticProcessorArgs()
+	arguments.EnabledIndexes = map[string]struct{}{
+		dataindexer.StatsIndex:        {},
+		dataindexer.TransactionsIndex: {},
+		dataindexer.AccountsIndex:     {},
+	}
+	elasticDatabase := newElasticsearchProcessor(dbWriter, arguments)
+
+	// the first block of 2026-10-18 finalizes the statistics of 2026-10-17
+	obh := createEmptyOutportBlockWithHeader()
+	obh.Header = &dataBlock.Header{Nonce: 1, ShardID: 1, TimeStamp: 1792281606}
+	err := elasticDatabase.SaveHeader(obh)
+	require.Nil(t, err)
+	require.Equal(t, []string{dataindexer.TransactionsIndex, dataindexer.AccountsIndex}, searchedIndices)
+	require.Len(t, bulkRequests, 1)
+	require.Contains(t, bulkRequests[0], `{ "update" : { "_index":"stats", "_id" : "day-2026-10-17-1" } }`)
+	require.Contains(t, bulkRequests[0], `"params": { "stats": null, "timestamp": 1792281606, "counters": {"distinctSenders":3,"distinctReceivers":5,"newAccounts":2} }`)
+
+	obh.Header = &dataBlock.Header{Nonce: 2, ShardID: 1, TimeStamp: 1792281612}
+	err = elasticDatabase.SaveHeader(obh)
+	require.Nil(t, err)
+	require.Len(t, searchedIndices, 2)
+	require.Len(t, bulkRequests, 1)
+}
+
+func TestElasticProcessor_SaveHeaderShouldRemoveTheExpiredContractCallersOnce(t *testing.T) {
+	t.Parallel()
+
+	removeQueries := make([]string, 0)
+	dbWriter := &mock.DatabaseWriterStub{
+		DoQueryRemoveCalled: func(index string, body *bytes.Buffer) error {
+			require.Equal(t, dataindexer.ContractCallersIndex, index)
+			removeQueries = append(removeQueries, body.String())
+			return nil
+		},
+	}
+
+	arguments := createMockElasticProcessorArgs()
+	arguments.EnabledIndexes = map[string]struct{}{
+		dataindexer.ContractStatsIndex: {},
+	}
+	arguments.CallersRetentionInDays = 7
+	elasticDatabase := newElasticsearchProcessor(dbWriter, arguments)
+
+	// the first block of 2026-10-18 removes the callers before 2026-10-11
+	obh := createEmptyOutportBlockWithHeader()
+	obh.Header = &dataBlock.Header{Nonce: 1, ShardID: 1, TimeStamp: 1792281606}
+	err := elasticDatabase.SaveHeader(obh)
+	require.Nil(t, err)
+	require.Len(t, removeQueries, 1)
+	require.Contains(t, removeQueries[0], `{"range": {"timestamp": {"lt": 1791676800}}}`)
+
+	obh.Header = &dataBlock.Header{Nonce: 2, ShardID: 1, TimeStamp: 1792281612}
+	err = elasticDatabase.SaveHeader(obh)
+	require.Nil(t, err)
+	require.Len(t, removeQueries, 1)
+
+	elasticDatabase.callersRetentionInDays = 0
+	obh.Header = &dataBlock.Header{Nonce: 3, ShardID: 1, TimeStamp: 1792368006}
+	err = elasticDatabase.SaveHeader(obh)
+	require.Nil(t, err)
+	require.Len(t, removeQueries, 1)
+}
+
+func TestElasticProcessor_GetExistingContractCallersShouldIgnoreTheCallersOfTheBlock(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockElasticProcessorArgs()
+	dbWriter := &mock.DatabaseWriterStub{
+		DoMultiGetCalled: func(ids []string, index string, withSource bool, res interface{}) error {
+			require.Equal(t, dataindexer.ContractCallersIndex, index)
+			require.True(t, withSource)
+			return json.Unmarshal([]byte(`{"docs":[
+				{"found":true,"_id":"sc-day-alice","_source":{"shardID":1,"timestamp":50}},
+				{"found":true,"_id":"sc-day-bob","_source":{"shardID":1,"timestamp":100}},
+				{"found":false,"_id":"sc-day-carol"}]}`), res)
+		},
+	}
+	elasticDatabase := newElasticsearchProcessor(dbWriter, arguments)
+
+	existingCallers, err := elasticDatabase.getExistingContractCallers([]string{"sc-day-alice", "sc-day-bob", "sc-day-carol"}, 100, 1)
+	require.Nil(t, err)
+	require.Equal(t, map[string]struct{}{"sc-day-alice": {}}, existingCallers)
+}
+
+func TestElasticProcessor_GetExistingRelayerUsersShouldIgnoreTheUsersOfTheBlock(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockElasticProcessorArgs()
+	dbWriter := &mock.DatabaseWriterStub{
+		DoMultiGetCalled: func(ids []string, index string, withSource bool, res interface{}) error {
+			require.Equal(t, dataindexer.RelayerUsersIndex, index)
+			require.True(t, withSource)
+			return json.Unmarshal([]byte(`{"docs":[
+				{"found":true,"_id":"relayer-day-alice","_source":{"shardID":1,"timestamp":50}},
+				{"found":true,"_id":"relayer-day-bob","_source":{"shardID":1,"timestamp":100}},
+				{"found":false,"_id":"relayer-day-carol"}]}`), res)
+		},
+	}
+	elasticDatabase := newElasticsearchProcessor(dbWriter, arguments)
+
+	existingUsers, err := elasticDatabase.getExistingRelayerUsers([]string{"relayer-day-alice", "relayer-day-bob", "relayer-day-carol"}, 100, 1)
+	require.Nil(t, err)
+	require.Equal(t, map[string]struct{}{"relayer-day-alice": {}}, existingUsers)
+}
+
+func TestCreateTransactionsStreamEvents(t *testing.T) {
+	t.Parallel()
+
+	preparedResults := &data.PreparedResults{
+		Transactions: []*data.Transaction{{Hash: "tx", Sender: "alice", Receiver: "bob", Tokens: []string{"TKN-abcdef"}}},
+		ScResults:    []*data.ScResult{{Hash: "scr", Sender: "bob", Receiver: "alice"}},
+	}
+	logEvents := []*data.LogEvent{
+		{TxHash: "scr", OriginalTxHash: "tx", Identifier: "ESDTTransfer", Address: "bob", ShardID: 1,
+			Topics: []string{hex.EncodeToString([]byte("TKN-abcdef")), "", "0a", hex.EncodeToString([]byte("alice"))}},
+		{TxHash: "tx", Identifier: "MultiESDTNFTTransfer", Address: "alice", ShardID: 1,
+			Topics: []string{hex.EncodeToString([]byte("TKN-abcdef")), "", "0a", hex.EncodeToString([]byte("NFT-abcdef")), "0f", "01", hex.EncodeToString([]byte("bob"))}},
+		{TxHash: "tx", Identifier: "writeLog", Address: "alice", ShardID: 1, Topics: []string{"0a", "0b"}},
+	}
+
+	events := createTransactionsStreamEvents(preparedResults, logEvents, 1)
+	require.Len(t, events, 5)
+
+	require.Equal(t, data.StreamEventTransaction, events[0].Type)
+	require.Equal(t, uint32(1), events[0].ShardID)
+	require.Equal(t, []string{"alice", "bob"}, events[0].Addresses)
+	require.Equal(t, []string{"TKN-abcdef"}, events[0].Tokens)
+	require.Equal(t, []string{"ESDTTransfer", "MultiESDTNFTTransfer", "writeLog"}, events[0].Identifiers)
+
+	require.Equal(t, data.StreamEventScResult, events[1].Type)
+	require.Equal(t, []string{"ESDTTransfer"}, events[1].Identifiers)
+
+	require.Equal(t, data.StreamEventLogEvent, events[2].Type)
+	require.Equal(t, "scr", events[2].Hash)
+	require.Equal(t, []string{"bob"}, events[2].Addresses)
+	require.Equal(t, []string{"TKN-abcdef"}, events[2].Tokens)
+	require.Equal(t, []string{"TKN-abcdef", "NFT-abcdef-0f"}, events[3].Tokens)
+	require.Nil(t, events[4].Tokens)
+}
+
+func TestElasticProcessor_GetStuckTransactions(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockElasticProcessorArgs()
+	dbWriter := &mock.DatabaseWriterStub{
+		DoScrollRequestCalled: func(index string, body []byte, withSource bool, handlerFunc func(responseBytes []byte) error) error {
+			require.Equal(t, dataindexer.TransactionsIndex, index)
+			require.True(t, withSource)
+
+			// the timestamp of the second transaction is in the future because of a clock drift
+			response := `{"hits":{"hits":[{"_id":"txHash","_source":{"sender":"alice","receiver":"bob","senderShard":0,"receiverShard":1,"timestamp":1000,"lifecycle":{"sourceBlockHash":"blockHash","sourceTimestamp":1000}}},` +
+				`{"_id":"driftedTxHash","_source":{"sender":"alice","receiver":"bob","senderShard":0,"receiverShard":1,"timestamp":99999999999}}]}}`
+			return handlerFunc([]byte(response))
+		},
+	}
+	elasticSearchProc := newElasticsearchProcessor(dbWriter, arguments)
+
+	stuckTxs, err := elasticSearchProc.GetStuckTransactions(600)
+	require.Nil(t, err)
+	require.Len(t, stuckTxs, 2)
+	require.Equal(t, "txHash", stuckTxs[0].Hash)
+	require.Equal(t, "alice", stuckTxs[0].Sender)
+	require.Equal(t, uint32(1), stuckTxs[0].ReceiverShard)
+	require.Equal(t, "blockHash", stuckTxs[0].Lifecycle.SourceBlockHash)
+	require.InDelta(t, uint64(time.Now().Unix())-1000, stuckTxs[0].PendingFor, 1)
+	require.Zero(t, stuckTxs[1].PendingFor)
+}
+
+func TestElasticProcessor_ResolveContractsCodeHashesFromTheAccountsIndex(t *testing.T) {
+	t.Parallel()
+
+	var resolvedCodeHashes map[string][]byte
+	arguments := createMockElasticProcessorArgs()
+	arguments.ABIDecoder = &mock.ABIDecoderStub{
+		GetUnresolvedContractsCalled: func(pool *outport.TransactionPool) []string {
+			return []string{"contract1", "contract2"}
+		},
+		RegisterResolvedCodeHashesCalled: func(addresses []string, codeHashes map[string][]byte) {
+			require.Equal(t, []string{"contract1", "contract2"}, addresses)
+			resolvedCodeHashes = codeHashes
+		},
+	}
+	dbWriter := &mock.DatabaseWriterStub{
+		DoMultiGetCalled: func(ids []string, index string, withSource bool, res interface{}) error {
+			require.Equal(t, dataindexer.AccountsIndex, index)
+			require.Equal(t, []string{"contract1", "contract2"}, ids)
+			return json.Unmarshal([]byte(`{"docs":[{"found":true,"_id":"contract1","_source":{"codeHash":"Y29kZQ=="}},{"found":false,"_id":"contract2"}]}`), res)
+		},
+	}
+	elasticSearchProc := newElasticsearchProcessor(dbWriter, arguments)
+
+	err := elasticSearchProc.resolveContractsCodeHashes(&outport.TransactionPool{}, 0)
+	require.Nil(t, err)
+	require.Equal(t, map[string][]byte{"contract1": []byte("code")}, resolvedCodeHashes)
+}
+
+func TestElasticProcessor_IndexTransactionsLifecycleShouldLinkTheMetaBlockIndexedFirst(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockElasticProcessorArgs()
+	bc, _ := converters.NewBalanceConverter(18)
+	txDbProc, err := transactions.NewTransactionsProcessor(&transactions.ArgsTransactionProcessor{
+		AddressPubkeyConverter: mock.NewPubkeyConverterMock(32),
+		Hasher:                 &mock.HasherMock{},
+		Marshalizer:            &mock.MarshalizerMock{},
+		BalanceConverter:       bc,
+		TxHashExtractor:        transactions.NewTxHashExtractor(),
+		RewardTxData:           &mock.RewardTxDataMock{},
+		CallArgsDecoder:        &mock.ABIDecoderStub{},
+	})
+	require.Nil(t, err)
+	arguments.TransactionsProc = txDbProc
+	dbWriter := &mock.DatabaseWriterStub{
+		DoSearchRequestCalled: func(index string, body []byte, response interface{}) error {
+			require.Equal(t, dataindexer.BlockIndex, index)
+			require.Contains(t, string(body), `"size": 1`)
+			require.Contains(t, string(body), `{"term": {"notarizedBlocksHashes": "7368617264"}}`)
+
+			return json.Unmarshal([]byte(`{"hits":{"hits":[{"_id":"metaHash","_source":{"round":50}}]}}`), response)
+		},
+	}
+	elasticSearchProc := newElasticsearchProcessor(dbWriter, arguments)
+
+	txs := []*data.Transaction{{Hash: "txHash", SenderShard: 0, ReceiverShard: 1}}
+	buffSlice := data.NewBufferSlice(data.DefaultMaxBulkSize)
+	err = elasticSearchProc.indexTransactionsLifecycle(txs, []byte("shard"), &dataBlock.Header{ShardID: 0, TimeStamp: 100}, buffSlice)
+	require.Nil(t, err)
+	require.Contains(t, buffSlice.Buffers()[0].String(), `{"sourceBlockHash":"7368617264","sourceTimestamp":100,"sourceNotarizedBlockHash":"metaHash"}`)
+}
+
+func TestElasticProcessor_IndexTransactionsLifecycleShouldKeepTheHashesInTheBlockIfTheMetaBlockIsNotIndexed(t *testing.T) {
+	t.Parallel()
+
+	dbWriter := &mock.DatabaseWriterStub{
+		DoSearchRequestCalled: func(index string, body []byte, response interface{}) error {
+			return json.Unmarshal([]byte(`{"hits":{"hits":[]}}`), response)
+		},
+	}
+	elasticSearchProc := newElasticsearchProcessor(dbWriter, createMockElasticProcessorArgs())
+
+	txs := []*data.Transaction{
+		{Hash: "txHash", SenderShard: 0, ReceiverShard: 1},
+		{Hash: "intraShardTxHash", SenderShard: 0, ReceiverShard: 0},
+	}
+	buffSlice := data.NewBufferSlice(data.DefaultMaxBulkSize)
+	err := elasticSearchProc.indexTransactionsLifecycle(txs, []byte("shard"), &dataBlock.Header{ShardID: 0, TimeStamp: 100}, buffSlice)
+	require.Nil(t, err)
+
+	res := buffSlice.Buffers()[0].String()
+	require.Contains(t, res, `{ "update" : { "_index":"blocks", "_id" : "7368617264" } }`)
+	require.Contains(t, res, `"params": {"hashes": ["txHash"]}`)
+}
+
+func TestElasticProcessor_IndexNotarizedTransactionsShouldUpdateTheTransactionsInTheBulk(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockElasticProcessorArgs()
+	bc, _ := converters.NewBalanceConverter(18)
+	txDbProc, err := transactions.NewTransactionsProcessor(&transactions.ArgsTransactionProcessor{
+		AddressPubkeyConverter: mock.NewPubkeyConverterMock(32),
+		Hasher:                 &mock.HasherMock{},
+		Marshalizer:            &mock.MarshalizerMock{},
+		BalanceConverter:       bc,
+		TxHashExtractor:        transactions.NewTxHashExtractor(),
+		RewardTxData:           &mock.RewardTxDataMock{},
+		CallArgsDecoder:        &mock.ABIDecoderStub{},
+	})
+	require.Nil(t, err)
+	arguments.TransactionsProc = txDbProc
+	dbWriter := &mock.DatabaseWriterStub{
+		DoMultiGetCalled: func(ids []string, index string, withSource bool, res interface{}) error {
+			require.Equal(t, dataindexer.BlockIndex, index)
+			require.Equal(t, []string{"shardHash", "otherShardHash"}, ids)
+			require.True(t, withSource)
+
+			return json.Unmarshal([]byte(`{"docs":[{"found":true,"_id":"shardHash","_source":{"crossShardTxsHashes":["txHash"]}},{"found":false,"_id":"otherShardHash"}]}`), res)
+		},
+		DoScrollRequestCalled: func(index string, body []byte, withSource bool, handlerFunc func(responseBytes []byte) error) error {
+			require.Fail(t, "the transactions should not be searched")
+			return nil
+		},
+		UpdateByQueryCalled: func(index string, buff *bytes.Buffer) error {
+			require.Fail(t, "the transactions should be linked in the bulk")
+			return nil
+		},
+	}
+	elasticSearchProc := newElasticsearchProcessor(dbWriter, arguments)
+
+	buffSlice := data.NewBufferSlice(data.DefaultMaxBulkSize)
+	header := &dataBlock.MetaBlock{TimeStamp: 100}
+	err = elasticSearchProc.indexNotarizedTransactions([]string{"shardHash", "otherShardHash"}, []byte("meta"), header, buffSlice)
+	require.Nil(t, err)
+
+	res := buffSlice.Buffers()[0].String()
+	require.Contains(t, res, `{"update":{ "_index":"transactions","_id":"txHash"}}`)
+	require.Contains(t, res, `"params": {"hashes": ["shardHash","otherShardHash"], "metaBlockHash": "6d657461"}`)
+}
+
+func TestElasticProcessor_GetTokenRolesAtTimestamp(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockElasticProcessorArgs()
+	dbWriter := &mock.DatabaseWriterStub{
+		DoScrollRequestCalled: func(index string, body []byte, withSource bool, handlerFunc func(responseBytes []byte) error) error {
+			require.Equal(t, dataindexer.TokenRolesIndex, index)
+			require.Contains(t, string(body), `{"match": {"token": "TKN-abcd"}}`)
+			require.Contains(t, string(body), `{"range": {"timestamp": {"lte": 2000}}}`)
+
+			response := `{"hits":{"hits":[` +
+				`{"_source":{"token":"TKN-abcd","address":"bob","role":"ESDTRoleLocalMint","action":"set","timestamp":1000}},` +
+				`{"_source":{"token":"TKN-abcd","address":"alice","role":"ESDTRoleLocalMint","action":"set","timestamp":1000}},` +
+				`{"_source":{"token":"TKN-abcd","address":"alice","role":"ESDTRoleLocalBurn","action":"set","timestamp":1000}},` +
+				`{"_source":{"token":"TKN-abcd","address":"alice","role":"ESDTRoleLocalBurn","action":"unset","timestamp":1500}}` +
+				`]}}`
+			return handlerFunc([]byte(response))
+		},
+	}
+	elasticSearchProc := newElasticsearchProcessor(dbWriter, arguments)
+
+	roles, err := elasticSearchProc.GetTokenRolesAtTimestamp("TKN-abcd", 2000)
+	require.Nil(t, err)
+	require.Equal(t, map[string][]string{"ESDTRoleLocalMint": {"alice", "bob"}}, roles)
+}
+
+func TestElasticProcessor_SaveFinalizedBlock(t *testing.T) {
+	t.Parallel()
+
+	called := false
+	arguments := createMockElasticProcessorArgs()
+	dbWriter := &mock.DatabaseWriterStub{
+		DoBulkRequestCalled: func(buff *bytes.Buffer, index string) error {
+			called = true
+			require.Equal(t, dataindexer.BlockIndex, index)
+			require.True(t, strings.Contains(buff.String(), hex.EncodeToString([]byte("hash"))))
+			return nil
+		},
+	}
+	elasticSearchProc := newElasticsearchProcessor(dbWriter, arguments)
+
+	err := elasticSearchProc.SaveFinalizedBlock(&outport.FinalizedBlock{ShardID: 1, HeaderHash: []byte("hash")})
+	require.Nil(t, err)
+	require.True(t, called)
+}
+
+func TestElasticProcessor_DecodeNFTsDataUpdatesAttributes(t *testing.T) {
+	t.Parallel()
+
+	decodedCollections := make([]string, 0)
+	arguments := createMockElasticProcessorArgs()
+	arguments.NFTAttributesDecoder = &mock.NFTAttributesDecoderStub{
+		DecodeCalled: func(collection string, attributes []byte) []*data.NFTAttribute {
+			decodedCollections = append(decodedCollections, collection)
+			return []*data.NFTAttribute{{Key: "key", Value: string(attributes)}}
+		},
+	}
+	elasticSearchProc := newElasticsearchProcessor(&mock.DatabaseWriterStub{}, arguments)
+
+	updates := []*data.NFTDataUpdate{
+		{Identifier: "NFT-abcdef-01", NewAttributes: []byte("new")},
+		{Identifier: "NFT-abcdef-02", NewAttributes: []byte("new"), NewMetaData: &data.TokenMetaData{Attributes: []byte("recreated")}},
+		{Identifier: "SFT-abcdef-01", Freeze: true},
+	}
+	elasticSearchProc.decodeNFTsDataUpdatesAttributes(updates)
+
+	require.Equal(t, []string{"NFT-abcdef", "NFT-abcdef"}, decodedCollections)
+	require.Equal(t, []*data.NFTAttribute{{Key: "key", Value: "new"}}, updates[0].NewDecodedAttributes)
+	require.Equal(t, []*data.NFTAttribute{{Key: "key", Value: "recreated"}}, updates[1].NewDecodedAttributes)
+	require.Nil(t, updates[2].NewDecodedAttributes)
+}
+
+func TestElasticProcessor_MainChainTokensSyncShouldUseTheConfiguredBulkSize(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockElasticProcessorArgs()
+	arguments.EnabledIndexes = map[string]struct{}{dataindexer.TokensIndex: {}, dataindexer.ESDTsIndex: {}}
+	arguments.IndexTokensHandler = &IndexTokenHandlerMock{
+		SyncCrossChainTokensCalled: func(elasticClient DatabaseClientHandler, indexes []string, buffSlice *data.BufferSlice) (*data.TokensSyncReport, error) {
+			require.Equal(t, []string{dataindexer.TokensIndex, dataindexer.ESDTsIndex}, indexes)
+			for idx := 0; idx < 3; idx++ {
+				err := buffSlice.PutData([]byte(`{ "update" : { "_index":"tokens", "_id" : "TKN-123456" } }`+"\n"), bytes.Repeat([]byte("a"), 100))
+				require.Nil(t, err)
+			}
+			return &data.TokensSyncReport{UpdatedTokens: 3}, nil
+		},
+	}
+
+	numBulks := 0
+	elasticSearchProc := newElasticsearchProcessor(&mock.DatabaseWriterStub{
+		DoBulkRequestCalled: func(buff *bytes.Buffer, index string) error {
+			numBulks++
+			return nil
+		},
+	}, arguments)
+	elasticSearchProc.SetBulkRequestMaxSize(200)
+
+	report, buffSlice, err := elasticSearchProc.PrepareMainChainTokensSync()
+	require.Nil(t, err)
+	require.Equal(t, 3, report.UpdatedTokens)
+	require.Equal(t, 0, numBulks)
+
+	err = elasticSearchProc.SaveMainChainTokensSync(buffSlice)
+	require.Nil(t, err)
+	require.Equal(t, 3, numBulks)
+}
@@ -1,21 +1,30 @@
 package factory
 
 import (
+	"time"
+
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/hashing"
 	"github.com/multiversx/mx-chain-core-go/marshal"
 
 	"github.com/multiversx/mx-chain-es-indexer-go/process/dataindexer"
 	"github.com/multiversx/mx-chain-es-indexer-go/process/elasticproc"
+	"github.com/multiversx/mx-chain-es-indexer-go/process/elasticproc/abi"
 	"github.com/multiversx/mx-chain-es-indexer-go/process/elasticproc/accounts"
 	blockProc "github.com/multiversx/mx-chain-es-indexer-go/process/elasticproc/block"
+	"github.com/multiversx/mx-chain-es-indexer-go/process/elasticproc/contractstats"
 	"github.com/multiversx/mx-chain-es-indexer-go/process/elasticproc/converters"
 	"github.com/multiversx/mx-chain-es-indexer-go/process/elasticproc/logsevents"
 	"github.com/multiversx/mx-chain-es-indexer-go/process/elasticproc/miniblocks"
+	"github.com/multiversx/mx-chain-es-indexer-go/process/elasticproc/networkstats"
+	"github.com/multiversx/mx-chain-es-indexer-go/process/elasticproc/nftattributes"
 	"github.com/multiversx/mx-chain-es-indexer-go/process/elasticproc/operations"
+	"github.com/multiversx/mx-chain-es-indexer-go/process/elasticproc/relayerstats"
 	"github.com/multiversx/mx-chain-es-indexer-go/process/elasticproc/statistics"
 	"github.com/multiversx/mx-chain-es-indexer-go/process/elasticproc/templatesAndPolicies"
 	"github.com/multiversx/mx-chain-es-indexer-go/process/elasticproc/transactions"
+	"github.com/multiversx/mx-chain-es-indexer-go/process/elasticproc/transfers"
+	"github.com/multiversx/mx-chain-es-indexer-go/process/elasticproc/undelegations"
 	"github.com/multiversx/mx-chain-es-indexer-go/process/elasticproc/validators"
 )
 
@@ -27,6 +36,16 @@ type ElasticConfig struct {
 	Password string
 }
 
+// MainChainTokensSourceConfig holds the settings of the source of the main chain tokens properties
+type MainChainTokensSourceConfig struct {
+	Type           string
+	ProxyURL       string
+	AddressPrefix  string
+	RequestTimeout time.Duration
+	CacheExpiry    time.Duration
+	CacheMaxSize   int
+}
+
 // ArgElasticProcessorFactory is struct that is used to store all components that are needed to create an elastic processor factory
 type ArgElasticProcessorFactory struct {
 	Marshalizer              marshal.Marshalizer
@@ -38,11 +57,18 @@ type ArgElasticProcessorFactory struct {
 	Version                  string
 	Denomination             int
 	BulkRequestMaxSize       int
+	UnBondPeriodInEpochs     uint32
 	UseKibana                bool
 	ImportDB                 bool
+	StoreRawHexAddresses     bool
 	TxHashExtractor          transactions.TxHashExtractor
 	RewardTxData             transactions.RewardTxDataHandler
 	IndexTokensHandler       elasticproc.IndexTokensHandler
+	BridgeTransfersHandler   elasticproc.BridgeTransfersHandler
+	StreamPublisher          elasticproc.StreamPublisher
+	NFTAttributes            nftattributes.ArgsAttributesDecoder
+	ABI                      abi.ArgsABIDecoder
+	CallersRetentionInDays   uint64
 }
 
 // CreateElasticProcessor will create a new instance of ElasticProcessor
@@ -73,6 +99,7 @@ func CreateElasticProcessor(arguments ArgElasticProcessorFactory) (dataindexer.E
 	accountsProc, err := accounts.NewAccountsProcessor(
 		arguments.AddressPubkeyConverter,
 		balanceConverter,
+		arguments.StoreRawHexAddresses,
 	)
 	if err != nil {
 		return nil, err
@@ -94,6 +121,14 @@ func CreateElasticProcessor(arguments ArgElasticProcessorFactory) (dataindexer.E
 
 	generalInfoProc := statistics.NewStatisticsProcessor()
 
+	argsABIDecoder := arguments.ABI
+	argsABIDecoder.PubKeyConverter = arguments.AddressPubkeyConverter
+	argsABIDecoder.Marshalizer = arguments.Marshalizer
+	abiDecoder, err := abi.NewABIDecoder(argsABIDecoder)
+	if err != nil {
+		return nil, err
+	}
+
 	argsTxsProc := &transactions.ArgsTransactionProcessor{
 		AddressPubkeyConverter: arguments.AddressPubkeyConverter,
 		Hasher:                 arguments.Hasher,
@@ -101,6 +136,8 @@ func CreateElasticProcessor(arguments ArgElasticProcessorFactory) (dataindexer.E
 		BalanceConverter:       balanceConverter,
 		TxHashExtractor:        arguments.TxHashExtractor,
 		RewardTxData:           arguments.RewardTxData,
+		CallArgsDecoder:        abiDecoder,
+		StoreRawHexAddresses:   arguments.StoreRawHexAddresses,
 	}
 	txsProc, err := transactions.NewTransactionsProcessor(argsTxsProc)
 	if err != nil {
@@ -112,6 +149,7 @@ func CreateElasticProcessor(arguments ArgElasticProcessorFactory) (dataindexer.E
 		Marshalizer:      arguments.Marshalizer,
 		BalanceConverter: balanceConverter,
 		Hasher:           arguments.Hasher,
+		EventsDecoder:    abiDecoder,
 	}
 	logsAndEventsProc, err := logsevents.NewLogsAndEventsProcessor(argsLogsAndEventsProc)
 	if err != nil {
@@ -123,25 +161,60 @@ func CreateElasticProcessor(arguments ArgElasticProcessorFactory) (dataindexer.E
 		return nil, err
 	}
 
+	transfersProc, err := transfers.NewTransfersProcessor(arguments.AddressPubkeyConverter, balanceConverter)
+	if err != nil {
+		return nil, err
+	}
+
+	statsProc, err := networkstats.NewStatsProcessor(balanceConverter)
+	if err != nil {
+		return nil, err
+	}
+
+	contractStatsProc, err := contractstats.NewContractStatsProcessor(arguments.AddressPubkeyConverter, balanceConverter)
+	if err != nil {
+		return nil, err
+	}
+
+	relayerStatsProc, err := relayerstats.NewRelayerStatsProcessor(balanceConverter)
+	if err != nil {
+		return nil, err
+	}
+
+	nftAttributesDecoder, err := nftattributes.NewAttributesDecoder(arguments.NFTAttributes)
+	if err != nil {
+		return nil, err
+	}
+
 	args := &elasticproc.ArgElasticProcessor{
-		BulkRequestMaxSize: arguments.BulkRequestMaxSize,
-		TransactionsProc:   txsProc,
-		AccountsProc:       accountsProc,
-		BlockProc:          blockProcHandler,
-		MiniblocksProc:     miniblocksProc,
-		ValidatorsProc:     validatorsProc,
-		StatisticsProc:     generalInfoProc,
-		LogsAndEventsProc:  logsAndEventsProc,
-		DBClient:           arguments.DBClient,
-		EnabledIndexes:     enabledIndexesMap,
-		UseKibana:          arguments.UseKibana,
-		IndexTemplates:     indexTemplates,
-		IndexPolicies:      indexPolicies,
-		ExtraMappings:      extraMappings,
-		OperationsProc:     operationsProc,
-		ImportDB:           arguments.ImportDB,
-		Version:            arguments.Version,
-		IndexTokensHandler: arguments.IndexTokensHandler,
+		BulkRequestMaxSize:     arguments.BulkRequestMaxSize,
+		TransactionsProc:       txsProc,
+		AccountsProc:           accountsProc,
+		BlockProc:              blockProcHandler,
+		MiniblocksProc:         miniblocksProc,
+		ValidatorsProc:         validatorsProc,
+		StatisticsProc:         generalInfoProc,
+		LogsAndEventsProc:      logsAndEventsProc,
+		DBClient:               arguments.DBClient,
+		EnabledIndexes:         enabledIndexesMap,
+		UseKibana:              arguments.UseKibana,
+		IndexTemplates:         indexTemplates,
+		IndexPolicies:          indexPolicies,
+		ExtraMappings:          extraMappings,
+		OperationsProc:         operationsProc,
+		TransfersProc:          transfersProc,
+		StatsProc:              statsProc,
+		ContractStatsProc:      contractStatsProc,
+		RelayerStatsProc:       relayerStatsProc,
+		UndelegationsProc:      undelegations.NewUndelegationsProcessor(arguments.UnBondPeriodInEpochs),
+		ImportDB:               arguments.ImportDB,
+		Version:                arguments.Version,
+		IndexTokensHandler:     arguments.IndexTokensHandler,
+		BridgeTransfersHandler: arguments.BridgeTransfersHandler,
+		StreamPublisher:        arguments.StreamPublisher,
+		NFTAttributesDecoder:   nftAttributesDecoder,
+		ABIDecoder:             abiDecoder,
+		CallersRetentionInDays: arguments.CallersRetentionInDays,
 	}
 
 	return elasticproc.NewElasticProcessor(args)
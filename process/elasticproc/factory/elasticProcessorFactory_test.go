@@ -23,6 +23,8 @@ func TestCreateElasticProcessor(t *testing.T) {
 		TxHashExtractor:          &mock.TxHashExtractorMock{},
 		RewardTxData:             &mock.RewardTxDataMock{},
 		IndexTokensHandler:       &elasticproc.IndexTokenHandlerMock{},
+		BridgeTransfersHandler:   &elasticproc.BridgeTransfersHandlerMock{},
+		StreamPublisher:          &mock.StreamPublisherStub{},
 	}
 
 	ep, err := CreateElasticProcessor(args)
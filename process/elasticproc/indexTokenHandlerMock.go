@@ -6,7 +6,9 @@ import (
 
 // IndexTokenHandlerMock -
 type IndexTokenHandlerMock struct {
-	IndexCrossChainTokensCalled func(elasticClient DatabaseClientHandler, scrs []*data.ScResult, buffSlice *data.BufferSlice) error
+	IndexCrossChainTokensCalled             func(elasticClient DatabaseClientHandler, scrs []*data.ScResult, buffSlice *data.BufferSlice) error
+	SyncCrossChainTokensCalled              func(elasticClient DatabaseClientHandler, indexes []string, buffSlice *data.BufferSlice) (*data.TokensSyncReport, error)
+	GetLastCrossChainTokensSyncReportCalled func(elasticClient DatabaseClientHandler) (*data.TokensSyncReport, error)
 }
 
 // IndexCrossChainTokens -
@@ -17,6 +19,22 @@ func (ithh *IndexTokenHandlerMock) IndexCrossChainTokens(elasticClient DatabaseC
 	return nil
 }
 
+// SyncCrossChainTokens -
+func (ithh *IndexTokenHandlerMock) SyncCrossChainTokens(elasticClient DatabaseClientHandler, indexes []string, buffSlice *data.BufferSlice) (*data.TokensSyncReport, error) {
+	if ithh.SyncCrossChainTokensCalled != nil {
+		return ithh.SyncCrossChainTokensCalled(elasticClient, indexes, buffSlice)
+	}
+	return &data.TokensSyncReport{}, nil
+}
+
+// GetLastCrossChainTokensSyncReport -
+func (ithh *IndexTokenHandlerMock) GetLastCrossChainTokensSyncReport(elasticClient DatabaseClientHandler) (*data.TokensSyncReport, error) {
+	if ithh.GetLastCrossChainTokensSyncReportCalled != nil {
+		return ithh.GetLastCrossChainTokensSyncReportCalled(elasticClient)
+	}
+	return nil, nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (ithh *IndexTokenHandlerMock) IsInterfaceNil() bool {
 	return ithh == nil
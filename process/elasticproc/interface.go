@@ -3,6 +3,7 @@ package elasticproc
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 
 	coreData "github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/data/alteredAccount"
@@ -20,6 +21,16 @@ type MainChainDatabaseClientHandler interface {
 	IsInterfaceNil() bool
 }
 
+// MainChainTokensSource defines the actions that a component which provides the properties of the main chain tokens should do
+type MainChainTokensSource interface {
+	// GetTokens returns the found tokens, by identifier, with the fields of the main chain tokens index
+	GetTokens(identifiers []string) (map[string]map[string]json.RawMessage, error)
+	// HasAllTokenFields returns true if the returned tokens hold every field indexed on the main chain
+	HasAllTokenFields() bool
+	IsEnabled() bool
+	IsInterfaceNil() bool
+}
+
 // DatabaseClientHandler defines the actions that a component that handles requests should do
 type DatabaseClientHandler interface {
 	DoBulkRequest(ctx context.Context, buff *bytes.Buffer, index string) error
@@ -27,6 +38,7 @@ type DatabaseClientHandler interface {
 	DoMultiGet(ctx context.Context, ids []string, index string, withSource bool, res interface{}) error
 	DoScrollRequest(ctx context.Context, index string, body []byte, withSource bool, handlerFunc func(responseBytes []byte) error) error
 	DoCountRequest(ctx context.Context, index string, body []byte) (uint64, error)
+	DoSearchRequest(ctx context.Context, index string, body []byte, res interface{}) error
 	UpdateByQuery(ctx context.Context, index string, buff *bytes.Buffer) error
 
 	PutMappings(indexName string, mappings *bytes.Buffer) error
@@ -51,6 +63,32 @@ type DBAccountHandler interface {
 	SerializeAccountsESDT(accounts map[string]*data.AccountInfo, updateNFTData []*data.NFTDataUpdate, buffSlice *data.BufferSlice, index string) error
 	SerializeNFTCreateInfo(tokensInfo []*data.TokenInfo, buffSlice *data.BufferSlice, index string) error
 	SerializeTypeForProvidedIDs(ids []string, tokenType string, buffSlice *data.BufferSlice, index string) error
+
+	PrepareAccountsActivity(preparedResults *data.PreparedResults, timestamp uint64, epoch uint32, shardID uint32) map[string]*data.AccountActivity
+	AddTokensInAccountsActivity(
+		accountsActivity map[string]*data.AccountActivity,
+		coreAlteredAccounts map[string]*alteredAccount.AlteredAccount,
+		timestamp uint64,
+		epoch uint32,
+		shardID uint32,
+	)
+	GetAccountsActivityIDs(accountsActivity map[string]*data.AccountActivity) []string
+	GetAccountsESDTIDs(accountsActivity map[string]*data.AccountActivity, indexedActivity map[string]*data.AccountActivity) []string
+	AddTokensCount(
+		accountsActivity map[string]*data.AccountActivity,
+		indexedActivity map[string]*data.AccountActivity,
+		existingAccountsESDT map[string]struct{},
+	)
+	SerializeAccountsActivityChanges(accountsActivity map[string]*data.AccountActivity, buffSlice *data.BufferSlice, index string) error
+	SerializeAccountsActivity(accountsActivity map[string]*data.AccountActivity, buffSlice *data.BufferSlice, index string) error
+	SerializeRevertedAccountsActivity(
+		accountsActivity []*data.AccountActivity,
+		lastActive map[string]uint64,
+		buffSlice *data.BufferSlice,
+		index string,
+	) error
+	PrepareAccountsActivityQuery(timestamp uint64, shardID uint32) []byte
+	PrepareLastActiveQuery(addresses []string, timestamp uint64) ([]byte, error)
 }
 
 // DBBlockHandler defines the actions that a block handler should do
@@ -60,6 +98,11 @@ type DBBlockHandler interface {
 
 	SerializeEpochInfoData(header coreData.HeaderHandler, buffSlice *data.BufferSlice, index string) error
 	SerializeBlock(elasticBlock *data.Block, buffSlice *data.BufferSlice, index string) error
+	SerializeNotarizedBlocks(notarizedBlocksHashes []string, metaBlockHash string, metaBlockRound uint64, buffSlice *data.BufferSlice, index string) error
+	SerializeCrossShardTransactions(blockHash string, txsHashes []string, buffSlice *data.BufferSlice, index string) error
+	SerializeFinalizedBlock(blockHash string, buffSlice *data.BufferSlice, index string) error
+	PrepareNotarizedBlocksQueryInCaseOfRevert(metaBlockHash string) *bytes.Buffer
+	PrepareNotarizingMetaBlockQuery(blockHash string) []byte
 }
 
 // DBTransactionsHandler defines the actions that a transactions handler should do
@@ -77,6 +120,11 @@ type DBTransactionsHandler interface {
 	SerializeTransactions(transactions []*data.Transaction, txHashStatusInfo map[string]*outport.StatusInfo, selfShardID uint32, buffSlice *data.BufferSlice, index string) error
 	SerializeTransactionsFeeData(txHashRefund map[string]*data.FeeData, buffSlice *data.BufferSlice, index string) error
 	SerializeScResults(scResults []*data.ScResult, buffSlice *data.BufferSlice, index string) error
+	SerializeTransactionsLifecycle(transactions []*data.Transaction, headerHash string, notarizedBlockHash string, timestamp uint64, selfShardID uint32, buffSlice *data.BufferSlice, index string) error
+
+	SerializeNotarizedTransactions(txsHashes []string, notarizedBlocksHashes []string, metaBlockHash string, buffSlice *data.BufferSlice, index string) error
+	PrepareTransactionsLifecycleQueryInCaseOfRevert(blockHash string) *bytes.Buffer
+	PrepareStuckTransactionsQuery(maxTimestamp uint64) []byte
 }
 
 // DBMiniblocksHandler defines the actions that a miniblocks handler should do
@@ -120,7 +168,15 @@ type DBLogsAndEventsHandler interface {
 		buffSlice *data.BufferSlice,
 		index string,
 	) error
+	SerializeStakedKeys(stakedKeys map[string]*data.StakedKey, buffSlice *data.BufferSlice, index string) error
+	PrepareStakedKeysQueryInCaseOfRevert(timestamp uint64, shardID uint32) *bytes.Buffer
 	PrepareDelegatorsQueryInCaseOfRevert(timestamp uint64) *bytes.Buffer
+	SerializeProvidersChanges(providersChanges []*data.ProviderChange, buffSlice *data.BufferSlice, index string) error
+	PrepareProvidersQueryInCaseOfRevert(timestamp uint64, shardID uint32) *bytes.Buffer
+	SerializeTokensSupplyChanges(supplyChanges []*data.TokenSupplyChange, buffSlice *data.BufferSlice, index string) error
+	PrepareTokensSupplyQueryInCaseOfRevert(timestamp uint64, shardID uint32) *bytes.Buffer
+	SerializeTokenRolesHistory(tokenRoles []*data.TokenRole, buffSlice *data.BufferSlice, index string) error
+	PrepareTokenRolesHistoryQuery(token string, timestamp uint64) []byte
 }
 
 // OperationsHandler defines the actions that an operations' handler should do
@@ -129,8 +185,114 @@ type OperationsHandler interface {
 	SerializeSCRs(scrs []*data.ScResult, buffSlice *data.BufferSlice, index string, shardID uint32) error
 }
 
+// DBTransfersHandler defines the actions that a transfers' handler should do
+type DBTransfersHandler interface {
+	ExtractTransfers(
+		preparedResults *data.PreparedResults,
+		logsResults *data.PreparedLogsResults,
+		timestamp uint64,
+		selfShardID uint32,
+		numOfShards uint32,
+	) []*data.Transfer
+	SerializeTransfers(transfers []*data.Transfer, buffSlice *data.BufferSlice, index string) error
+	PrepareTransfersQueryInCaseOfRevert(timestamp uint64, shardID uint32) *bytes.Buffer
+	IsInterfaceNil() bool
+}
+
+// DBStatsHandler defines the actions that a chain statistics' handler should do
+type DBStatsHandler interface {
+	PrepareStatsChanges(preparedResults *data.PreparedResults, transfersList []*data.Transfer, header coreData.HeaderHandler) []*data.StatsChange
+	SerializeStatsChanges(statsChanges []*data.StatsChange, buffSlice *data.BufferSlice, index string) error
+	GetDayStart(timestamp uint64) uint64
+	GetEpochStatsID(epoch uint32, shardID uint32) string
+	PrepareDistinctAddressesQuery(shardID uint32, startTimestamp uint64, endTimestamp uint64) []byte
+	PrepareNewAccountsQuery(shardID uint32, startTimestamp uint64, endTimestamp uint64) []byte
+	SerializeFinalizedDayStats(dayStart uint64, shardID uint32, timestamp uint64, counters *data.StatsAccountsCounters, buffSlice *data.BufferSlice, index string) error
+	SerializeFinalizedEpochStats(epoch uint32, shardID uint32, timestamp uint64, counters *data.StatsAccountsCounters, buffSlice *data.BufferSlice, index string) error
+	PrepareStatsQueryInCaseOfRevert(timestamp uint64, shardID uint32) *bytes.Buffer
+	IsInterfaceNil() bool
+}
+
+// DBContractStatsHandler defines the actions that a smart contracts statistics' handler should do
+type DBContractStatsHandler interface {
+	PrepareContractStatsChanges(
+		preparedResults *data.PreparedResults,
+		txHashStatusInfo map[string]*outport.StatusInfo,
+		timestamp uint64,
+		shardID uint32,
+	) []*data.ContractStatsChange
+	GetContractCallersIDs(changes []*data.ContractStatsChange) []string
+	AddUniqueCallers(changes []*data.ContractStatsChange, existingCallers map[string]struct{})
+	SerializeContractCallers(changes []*data.ContractStatsChange, buffSlice *data.BufferSlice, index string) error
+	SerializeContractStatsChanges(changes []*data.ContractStatsChange, buffSlice *data.BufferSlice, index string) error
+	PrepareContractStatsQueryInCaseOfRevert(timestamp uint64, shardID uint32) *bytes.Buffer
+	PrepareContractCallersQueryInCaseOfRevert(timestamp uint64, shardID uint32) *bytes.Buffer
+	PrepareExpiredContractCallersQuery(timestamp uint64, shardID uint32, retentionInDays uint64) *bytes.Buffer
+	IsInterfaceNil() bool
+}
+
+// DBRelayerStatsHandler defines the actions that a relayers statistics' handler should do
+type DBRelayerStatsHandler interface {
+	PrepareRelayerStatsChanges(
+		preparedResults *data.PreparedResults,
+		txHashStatusInfo map[string]*outport.StatusInfo,
+		timestamp uint64,
+		shardID uint32,
+	) []*data.RelayerStatsChange
+	GetRelayerUsersIDs(changes []*data.RelayerStatsChange) []string
+	AddUniqueUsers(changes []*data.RelayerStatsChange, existingUsers map[string]struct{})
+	SerializeRelayerUsers(changes []*data.RelayerStatsChange, buffSlice *data.BufferSlice, index string) error
+	SerializeRelayerStatsChanges(changes []*data.RelayerStatsChange, buffSlice *data.BufferSlice, index string) error
+	PrepareRelayerStatsQueryInCaseOfRevert(timestamp uint64, shardID uint32) *bytes.Buffer
+	PrepareRelayerUsersQueryInCaseOfRevert(timestamp uint64, shardID uint32) *bytes.Buffer
+	IsInterfaceNil() bool
+}
+
+// DBUndelegationsHandler defines the actions that an unDelegate funds' handler should do
+type DBUndelegationsHandler interface {
+	PrepareUndelegations(delegatorsOperations []*data.Delegator, epoch uint32) []*data.Undelegation
+	GetWithdrawnUndelegationsIDs(delegatorsOperations []*data.Delegator) []string
+	SerializeUndelegations(undelegations []*data.Undelegation, withdrawnIDs []string, timestamp uint64, buffSlice *data.BufferSlice, index string) error
+	PrepareWithdrawableQuery(epoch uint32) []byte
+	SerializeWithdrawableUndelegations(ids []string, epoch uint32, buffSlice *data.BufferSlice, index string) error
+	PrepareWithdrawableQueryInCaseOfRevert(epoch uint32) *bytes.Buffer
+	PrepareWithdrawnQueryInCaseOfRevert(timestamp uint64, epoch uint32) *bytes.Buffer
+	PrepareCreatedQueryInCaseOfRevert(timestamp uint64) *bytes.Buffer
+	IsInterfaceNil() bool
+}
+
+// StreamPublisher defines what a component that pushes the indexed entities to the live stream subscribers should do
+type StreamPublisher interface {
+	Publish(events []*data.StreamEvent)
+	IsInterfaceNil() bool
+}
+
 // IndexTokensHandler defines what index tokens handler should be able to do
 type IndexTokensHandler interface {
 	IndexCrossChainTokens(handler DatabaseClientHandler, scrs []*data.ScResult, buffSlice *data.BufferSlice) error
+	SyncCrossChainTokens(handler DatabaseClientHandler, indexes []string, buffSlice *data.BufferSlice) (*data.TokensSyncReport, error)
+	GetLastCrossChainTokensSyncReport(handler DatabaseClientHandler) (*data.TokensSyncReport, error)
+	IsInterfaceNil() bool
+}
+
+// BridgeTransfersHandler defines what a component that indexes the cross-chain transfers of a sovereign chain should do
+type BridgeTransfersHandler interface {
+	IndexBridgeTransfers(handler DatabaseClientHandler, scrs []*data.ScResult, events []*data.LogEvent, timestamp uint64, shardID uint32, buffSlice *data.BufferSlice) error
+	RevertBridgeTransfers(handler DatabaseClientHandler, timestamp uint64, shardID uint32) error
+	IsInterfaceNil() bool
+}
+
+// NFTAttributesDecoder defines what a component that decodes the attributes of the NFTs into structured traits should do
+type NFTAttributesDecoder interface {
+	Decode(collection string, attributes []byte) []*data.NFTAttribute
+	IsInterfaceNil() bool
+}
+
+// ABIDecoder defines what a component that decodes the smart contracts data based on their ABI should do
+type ABIDecoder interface {
+	RegisterContractsCodeHashes(alteredAccounts map[string]*alteredAccount.AlteredAccount)
+	GetUnresolvedContracts(pool *outport.TransactionPool) []string
+	RegisterResolvedCodeHashes(addresses []string, codeHashes map[string][]byte)
+	DecodeEvent(address string, topics [][]byte, eventData []byte) []*data.DecodedEventField
 	IsInterfaceNil() bool
 }
@@ -79,7 +79,7 @@ func (epp *esdtPropertiesProc) processEvent(args *argsProcessEvent) argOutputPro
 			addr = ""
 		}
 
-		args.tokenRolesAndProperties.AddRole(string(topics[tokenTopicsIndex]), addr, string(roleBytes), shouldAddRole)
+		args.tokenRolesAndProperties.AddRole(string(topics[tokenTopicsIndex]), addr, string(roleBytes), shouldAddRole, args.txHashHexEncoded)
 	}
 
 	return argOutputProcessEvent{
@@ -92,7 +92,7 @@ func (epp *esdtPropertiesProc) extractDataNFTCreateRoleTransfer(args *argsProces
 
 	addrBech := epp.pubKeyConverter.SilentEncode(args.event.GetAddress(), log)
 	shouldAddCreateRole := bytesToBool(topics[3])
-	args.tokenRolesAndProperties.AddRole(string(topics[tokenTopicsIndex]), addrBech, core.ESDTRoleNFTCreate, shouldAddCreateRole)
+	args.tokenRolesAndProperties.AddRole(string(topics[tokenTopicsIndex]), addrBech, core.ESDTRoleNFTCreate, shouldAddCreateRole, args.txHashHexEncoded)
 
 	return argOutputProcessEvent{
 		processed: true,
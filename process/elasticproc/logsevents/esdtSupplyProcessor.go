@@ -0,0 +1,123 @@
+package logsevents
+
+import (
+	"encoding/hex"
+	"math/big"
+	"strings"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/sharding"
+)
+
+const (
+	numSupplyEventTopics       = 3
+	argumentsSeparator         = "@"
+	initialSupplyArgumentIndex = 3
+)
+
+type esdtSupplyProcessor struct {
+	supplyOperationsIdentifiers map[string]struct{}
+}
+
+func newESDTSupplyProcessor() *esdtSupplyProcessor {
+	return &esdtSupplyProcessor{
+		supplyOperationsIdentifiers: map[string]struct{}{
+			core.BuiltInFunctionESDTLocalMint:      {},
+			core.BuiltInFunctionESDTLocalBurn:      {},
+			core.BuiltInFunctionESDTNFTCreate:      {},
+			core.BuiltInFunctionESDTNFTAddQuantity: {},
+			core.BuiltInFunctionESDTNFTBurn:        {},
+			core.BuiltInFunctionESDTWipe:           {},
+			issueFungibleESDTFunc:                  {},
+		},
+	}
+}
+
+// processEvent will collect the supply changes of a token. The event is never marked as processed because
+// the same events are also handled by the other events processors
+func (esp *esdtSupplyProcessor) processEvent(args *argsProcessEvent) argOutputProcessEvent {
+	identifier := string(args.event.GetIdentifier())
+	_, ok := esp.supplyOperationsIdentifiers[identifier]
+	if !ok {
+		return argOutputProcessEvent{}
+	}
+
+	if identifier == issueFungibleESDTFunc {
+		esp.processIssueEvent(args)
+		return argOutputProcessEvent{}
+	}
+
+	// topics slice contains:
+	// topics[0] -- token identifier
+	// topics[1] -- token nonce
+	// topics[2] -- value
+	// topics[3] -- wiped address in case of ESDTWipe
+	topics := args.event.GetTopics()
+	if len(topics) < numSupplyEventTopics || len(topics[0]) == 0 {
+		return argOutputProcessEvent{}
+	}
+
+	token := string(topics[0])
+	nonce := big.NewInt(0).SetBytes(topics[1]).Uint64()
+	value := big.NewInt(0).SetBytes(topics[2])
+
+	switch identifier {
+	case core.BuiltInFunctionESDTLocalMint, core.BuiltInFunctionESDTNFTCreate, core.BuiltInFunctionESDTNFTAddQuantity:
+		args.tokensSupplyChanges.addMinted(token, nonce, value)
+	case core.BuiltInFunctionESDTLocalBurn, core.BuiltInFunctionESDTNFTBurn:
+		args.tokensSupplyChanges.addBurned(token, nonce, value)
+	case core.BuiltInFunctionESDTWipe:
+		isWipedAccountInOtherShard := len(topics) >= numTopicsWithReceiverAddress &&
+			sharding.ComputeShardID(topics[3], args.numOfShards) != args.selfShardID
+		if isWipedAccountInOtherShard {
+			return argOutputProcessEvent{}
+		}
+
+		args.tokensSupplyChanges.addWiped(token, nonce, value)
+	}
+
+	// a non-fungible token nonce is always created, burned or wiped as a single unit and its quantity cannot be added
+	hasQuantity := identifier == core.BuiltInFunctionESDTNFTAddQuantity || value.Cmp(big.NewInt(1)) > 0
+	if nonce != 0 && hasQuantity {
+		args.tokensSupplyChanges.markQuantity(token, nonce)
+	}
+
+	return argOutputProcessEvent{}
+}
+
+// processIssueEvent will extract the initial supply of a fungible token from the arguments of the issue call,
+// because the issue event does not contain it
+func (esp *esdtSupplyProcessor) processIssueEvent(args *argsProcessEvent) {
+	topics := args.event.GetTopics()
+	if len(topics) < numIssueLogTopics || len(topics[0]) == 0 {
+		return
+	}
+
+	callData := esp.getCallData(args)
+	arguments := strings.Split(string(callData), argumentsSeparator)
+	if len(arguments) <= initialSupplyArgumentIndex || arguments[0] != issueFungibleESDTFunc {
+		return
+	}
+
+	initialSupplyBytes, err := hex.DecodeString(arguments[initialSupplyArgumentIndex])
+	if err != nil {
+		log.Warn("esdtSupplyProcessor.processIssueEvent: cannot decode initial supply", "txHash", args.txHashHexEncoded, "error", err)
+		return
+	}
+
+	args.tokensSupplyChanges.addInitialSupply(string(topics[0]), big.NewInt(0).SetBytes(initialSupplyBytes))
+}
+
+func (esp *esdtSupplyProcessor) getCallData(args *argsProcessEvent) []byte {
+	tx, found := args.txs[args.txHashHexEncoded]
+	if found {
+		return tx.Data
+	}
+
+	scr, found := args.scrs[args.txHashHexEncoded]
+	if found {
+		return scr.Data
+	}
+
+	return nil
+}
@@ -0,0 +1,153 @@
+package logsevents
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multiversx/mx-chain-es-indexer-go/data"
+	"github.com/multiversx/mx-chain-es-indexer-go/process/elasticproc/converters"
+)
+
+func processSupplyEvent(t *testing.T, esdtSupplyProc *esdtSupplyProcessor, changes *tokensSupplyChanges, identifier string, topics [][]byte) {
+	args := &argsProcessEvent{
+		event: &transaction.Event{
+			Address:    []byte("addr"),
+			Identifier: []byte(identifier),
+			Topics:     topics,
+		},
+		tokensSupplyChanges: changes,
+		selfShardID:         0,
+		numOfShards:         3,
+	}
+
+	res := esdtSupplyProc.processEvent(args)
+	require.False(t, res.processed)
+}
+
+func TestEsdtSupplyProcessor_FungibleToken(t *testing.T) {
+	t.Parallel()
+
+	balanceConverter, _ := converters.NewBalanceConverter(18)
+	esdtSupplyProc := newESDTSupplyProcessor()
+	changes := newTokensSupplyChanges()
+
+	token := []byte("TKN-abcd")
+	processSupplyEvent(t, esdtSupplyProc, changes, core.BuiltInFunctionESDTLocalMint, [][]byte{token, nil, big.NewInt(1000).Bytes()})
+	processSupplyEvent(t, esdtSupplyProc, changes, core.BuiltInFunctionESDTLocalMint, [][]byte{token, nil, big.NewInt(500).Bytes()})
+	processSupplyEvent(t, esdtSupplyProc, changes, core.BuiltInFunctionESDTLocalBurn, [][]byte{token, nil, big.NewInt(200).Bytes()})
+	processSupplyEvent(t, esdtSupplyProc, changes, core.BuiltInFunctionESDTWipe, [][]byte{token, nil, big.NewInt(10).Bytes(), append(bytes.Repeat([]byte{1}, 31), 0)})
+	processSupplyEvent(t, esdtSupplyProc, changes, core.BuiltInFunctionESDTWipe, [][]byte{token, nil, big.NewInt(20).Bytes(), append(bytes.Repeat([]byte{1}, 31), 1)})
+	processSupplyEvent(t, esdtSupplyProc, changes, core.BuiltInFunctionESDTTransfer, [][]byte{token, nil, big.NewInt(30).Bytes()})
+
+	require.Equal(t, []*data.TokenSupplyChange{
+		{
+			Token:         "TKN-abcd",
+			InitialSupply: "0",
+			Minted:        "1500",
+			MintedNum:     1.5e-15,
+			Burned:        "200",
+			BurnedNum:     2e-16,
+			Wiped:         "10",
+			WipedNum:      1e-17,
+			ShardID:       0,
+			Timestamp:     100,
+		},
+	}, changes.getAll(100, 0, balanceConverter))
+}
+
+func TestEsdtSupplyProcessor_SemiFungibleToken(t *testing.T) {
+	t.Parallel()
+
+	balanceConverter, _ := converters.NewBalanceConverter(0)
+	esdtSupplyProc := newESDTSupplyProcessor()
+	changes := newTokensSupplyChanges()
+
+	token := []byte("SFT-abcd")
+	processSupplyEvent(t, esdtSupplyProc, changes, core.BuiltInFunctionESDTNFTCreate, [][]byte{token, big.NewInt(1).Bytes(), big.NewInt(100).Bytes(), []byte("metadata")})
+	processSupplyEvent(t, esdtSupplyProc, changes, core.BuiltInFunctionESDTNFTAddQuantity, [][]byte{token, big.NewInt(2).Bytes(), big.NewInt(50).Bytes()})
+	processSupplyEvent(t, esdtSupplyProc, changes, core.BuiltInFunctionESDTNFTBurn, [][]byte{token, big.NewInt(1).Bytes(), big.NewInt(30).Bytes()})
+
+	supplyChanges := changes.getAll(100, 1, balanceConverter)
+	require.Len(t, supplyChanges, 3)
+
+	require.Equal(t, "SFT-abcd", supplyChanges[0].Token)
+	require.Equal(t, "", supplyChanges[0].Identifier)
+	require.Equal(t, "150", supplyChanges[0].Minted)
+	require.Equal(t, float64(150), supplyChanges[0].MintedNum)
+	require.Equal(t, "30", supplyChanges[0].Burned)
+
+	require.False(t, supplyChanges[0].HasQuantity)
+
+	require.Equal(t, "SFT-abcd-01", supplyChanges[1].Identifier)
+	require.Equal(t, uint64(1), supplyChanges[1].Nonce)
+	require.Equal(t, "100", supplyChanges[1].Minted)
+	require.Equal(t, "30", supplyChanges[1].Burned)
+	require.Equal(t, uint32(1), supplyChanges[1].ShardID)
+	require.True(t, supplyChanges[1].HasQuantity)
+
+	require.Equal(t, "SFT-abcd-02", supplyChanges[2].Identifier)
+	require.Equal(t, "50", supplyChanges[2].Minted)
+	require.Equal(t, "0", supplyChanges[2].Burned)
+	require.True(t, supplyChanges[2].HasQuantity)
+}
+
+func TestEsdtSupplyProcessor_NonFungibleToken(t *testing.T) {
+	t.Parallel()
+
+	balanceConverter, _ := converters.NewBalanceConverter(0)
+	esdtSupplyProc := newESDTSupplyProcessor()
+	changes := newTokensSupplyChanges()
+
+	token := []byte("NFT-abcd")
+	processSupplyEvent(t, esdtSupplyProc, changes, core.BuiltInFunctionESDTNFTCreate, [][]byte{token, big.NewInt(1).Bytes(), big.NewInt(1).Bytes(), []byte("metadata")})
+	processSupplyEvent(t, esdtSupplyProc, changes, core.BuiltInFunctionESDTNFTBurn, [][]byte{token, big.NewInt(1).Bytes(), big.NewInt(1).Bytes()})
+
+	supplyChanges := changes.getAll(100, 1, balanceConverter)
+	require.Len(t, supplyChanges, 2)
+
+	require.Equal(t, "NFT-abcd", supplyChanges[0].Token)
+	require.Equal(t, "1", supplyChanges[0].Minted)
+	require.Equal(t, "1", supplyChanges[0].Burned)
+
+	// a nonce moved only as a single unit does not prove a quantity, its document gets a supply only if it already has one
+	require.Equal(t, "NFT-abcd-01", supplyChanges[1].Identifier)
+	require.False(t, supplyChanges[1].HasQuantity)
+}
+
+func TestEsdtSupplyProcessor_IssueInitialSupply(t *testing.T) {
+	t.Parallel()
+
+	balanceConverter, _ := converters.NewBalanceConverter(0)
+	esdtSupplyProc := newESDTSupplyProcessor()
+	changes := newTokensSupplyChanges()
+
+	args := &argsProcessEvent{
+		txHashHexEncoded: "h1",
+		event: &transaction.Event{
+			Address:    []byte("addr"),
+			Identifier: []byte(issueFungibleESDTFunc),
+			Topics:     [][]byte{[]byte("TKN-abcd"), []byte("token"), []byte("TKN"), []byte(core.FungibleESDT), big.NewInt(18).Bytes()},
+		},
+		txs: map[string]*data.Transaction{
+			"h1": {Data: []byte("issue@746f6b656e@544b4e@03e8@12")},
+		},
+		tokensSupplyChanges: changes,
+	}
+	res := esdtSupplyProc.processEvent(args)
+	require.False(t, res.processed)
+
+	args.txHashHexEncoded = "h2"
+	_ = esdtSupplyProc.processEvent(args)
+
+	supplyChanges := changes.getAll(100, core.MetachainShardId, balanceConverter)
+	require.Len(t, supplyChanges, 1)
+	require.Equal(t, "TKN-abcd", supplyChanges[0].Token)
+	require.Equal(t, "1000", supplyChanges[0].InitialSupply)
+	require.Equal(t, float64(1000), supplyChanges[0].InitialSupplyNum)
+	require.Equal(t, "0", supplyChanges[0].Minted)
+}
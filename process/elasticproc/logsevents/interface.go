@@ -16,6 +16,8 @@ type argsProcessEvent struct {
 	event                   coreData.EventHandler
 	tokens                  data.TokensHandler
 	tokensSupply            data.TokensHandler
+	tokensSupplyChanges     *tokensSupplyChanges
+	providersChanges        *providersChanges
 	tokenRolesAndProperties *tokeninfo.TokenRolesAndProperties
 	txHashStatusInfoProc    txHashStatusInfoHandler
 	timestamp               uint64
@@ -27,10 +29,17 @@ type argsProcessEvent struct {
 type argOutputProcessEvent struct {
 	tokenInfo     *data.TokenInfo
 	delegator     *data.Delegator
+	stakedKeys    []*data.StakedKey
 	updatePropNFT *data.NFTDataUpdate
 	processed     bool
 }
 
+// EventsDecoder defines what a component that decodes the smart contracts events based on their ABI should do
+type EventsDecoder interface {
+	DecodeEvent(address string, topics [][]byte, eventData []byte) []*data.DecodedEventField
+	IsInterfaceNil() bool
+}
+
 type eventsProcessor interface {
 	processEvent(args *argsProcessEvent) argOutputProcessEvent
 }
@@ -25,12 +25,15 @@ type ArgsLogsAndEventsProcessor struct {
 	Marshalizer      marshal.Marshalizer
 	BalanceConverter dataindexer.BalanceConverter
 	Hasher           hashing.Hasher
+	EventsDecoder    EventsDecoder
 }
 
 type logsAndEventsProcessor struct {
 	hasher           hashing.Hasher
 	pubKeyConverter  core.PubkeyConverter
+	balanceConverter dataindexer.BalanceConverter
 	eventsProcessors []eventsProcessor
+	eventsDecoder    EventsDecoder
 }
 
 // NewLogsAndEventsProcessor will create a new instance for the logsAndEventsProcessor
@@ -44,8 +47,10 @@ func NewLogsAndEventsProcessor(args ArgsLogsAndEventsProcessor) (*logsAndEventsP
 
 	return &logsAndEventsProcessor{
 		pubKeyConverter:  args.PubKeyConverter,
+		balanceConverter: args.BalanceConverter,
 		eventsProcessors: eventsProcessors,
 		hasher:           args.Hasher,
+		eventsDecoder:    args.EventsDecoder,
 	}, nil
 }
 
@@ -62,6 +67,9 @@ func checkArgsLogsAndEventsProcessor(args ArgsLogsAndEventsProcessor) error {
 	if check.IfNil(args.Hasher) {
 		return dataindexer.ErrNilHasher
 	}
+	if check.IfNil(args.EventsDecoder) {
+		return dataindexer.ErrNilEventsDecoder
+	}
 
 	return nil
 }
@@ -74,14 +82,21 @@ func createEventsProcessors(args ArgsLogsAndEventsProcessor) []eventsProcessor {
 	esdtPropProc := newEsdtPropertiesProcessor(args.PubKeyConverter)
 	esdtIssueProc := newESDTIssueProcessor(args.PubKeyConverter)
 	delegatorsProcessor := newDelegatorsProcessor(args.PubKeyConverter, args.BalanceConverter)
+	esdtSupplyProc := newESDTSupplyProcessor()
+	stakingProc := newStakingProcessor(args.PubKeyConverter, args.BalanceConverter)
+	providersProc := newProvidersProcessor(args.PubKeyConverter, args.BalanceConverter)
 
+	// the supply and the providers processors are the first ones because they never mark an event as processed
 	eventsProcs := []eventsProcessor{
+		esdtSupplyProc,
+		providersProc,
 		scDeploysProc,
 		informativeProc,
 		updateNFTProc,
 		esdtPropProc,
 		esdtIssueProc,
 		delegatorsProcessor,
+		stakingProc,
 		nftsProc,
 	}
 
@@ -124,9 +139,14 @@ func (lep *logsAndEventsProcessor) ExtractDataFromLogs(
 		ScDeploys:               lgData.scDeploys,
 		TokensInfo:              lgData.tokensInfo,
 		TokensSupply:            lgData.tokensSupply,
+		TokensSupplyChanges:     lgData.tokensSupplyChanges.getAll(timestamp, shardID, lep.balanceConverter),
 		Delegators:              lgData.delegators,
+		DelegatorsOperations:    lgData.delegatorsOperations,
+		ProvidersChanges:        lgData.providersChanges.getAll(timestamp, shardID, lep.balanceConverter),
+		StakedKeys:              lgData.stakedKeys,
 		NFTsDataUpdates:         lgData.nftsDataUpdates,
 		TokenRolesAndProperties: lgData.tokenRolesAndProperties,
+		TokenRolesHistory:       prepareTokenRolesHistory(lgData.tokenRolesAndProperties.GetRolesChanges(), timestamp, shardID),
 		TxHashStatusInfo:        lgData.txHashStatusInfoProc.getAllRecords(),
 		ChangeOwnerOperations:   lgData.changeOwnerOperations,
 		DBLogs:                  dbLogs,
@@ -152,6 +172,8 @@ func (lep *logsAndEventsProcessor) processEvent(lgData *logsData, logHashHexEnco
 			logAddress:              logAddress,
 			tokens:                  lgData.tokens,
 			tokensSupply:            lgData.tokensSupply,
+			tokensSupplyChanges:     lgData.tokensSupplyChanges,
+			providersChanges:        lgData.providersChanges,
 			timestamp:               lgData.timestamp,
 			scDeploys:               lgData.scDeploys,
 			txs:                     lgData.txsMap,
@@ -167,6 +189,15 @@ func (lep *logsAndEventsProcessor) processEvent(lgData *logsData, logHashHexEnco
 		}
 		if res.delegator != nil {
 			lgData.delegators[res.delegator.Address+res.delegator.Contract] = res.delegator
+			lgData.delegatorsOperations = append(lgData.delegatorsOperations, res.delegator)
+		}
+		for _, stakedKey := range res.stakedKeys {
+			existing, found := lgData.stakedKeys[stakedKey.BLSKey]
+			if found {
+				mergeStakedKey(existing, stakedKey)
+				continue
+			}
+			lgData.stakedKeys[stakedKey.BLSKey] = stakedKey
 		}
 		if res.updatePropNFT != nil {
 			lgData.nftsDataUpdates = append(lgData.nftsDataUpdates, res.updatePropNFT)
@@ -236,13 +267,15 @@ func (lep *logsAndEventsProcessor) prepareLog(
 			continue
 		}
 
+		eventAddress := lep.pubKeyConverter.SilentEncode(event.GetAddress(), log)
 		logEvent := &data.Event{
-			Address:        lep.pubKeyConverter.SilentEncode(event.GetAddress(), log),
+			Address:        eventAddress,
 			Identifier:     string(event.GetIdentifier()),
 			Topics:         event.GetTopics(),
 			Data:           event.GetData(),
 			AdditionalData: event.GetAdditionalData(),
 			Order:          idx,
+			Decoded:        lep.eventsDecoder.DecodeEvent(eventAddress, event.GetTopics(), event.GetData()),
 		}
 		logsDB.Events = append(logsDB.Events, logEvent)
 
@@ -263,6 +296,7 @@ func (lep *logsAndEventsProcessor) prepareLogEvent(dbLog *data.Logs, event *data
 		Data:           hex.EncodeToString(event.Data),
 		AdditionalData: hexEncodeSlice(event.AdditionalData),
 		Topics:         hexEncodeSlice(event.Topics),
+		Decoded:        event.Decoded,
 		Order:          event.Order,
 		ShardID:        shardID,
 		TxOrder:        execOrder,
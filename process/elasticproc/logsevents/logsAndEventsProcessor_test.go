@@ -23,6 +23,7 @@ func createMockArgs() ArgsLogsAndEventsProcessor {
 		Marshalizer:      &mock.MarshalizerMock{},
 		BalanceConverter: balanceConverter,
 		Hasher:           &mock.HasherMock{},
+		EventsDecoder:    &mock.ABIDecoderStub{},
 	}
 }
 
@@ -49,6 +50,11 @@ func TestNewLogsAndEventsProcessor(t *testing.T) {
 	_, err = NewLogsAndEventsProcessor(args)
 	require.Equal(t, elasticIndexer.ErrNilHasher, err)
 
+	args = createMockArgs()
+	args.EventsDecoder = nil
+	_, err = NewLogsAndEventsProcessor(args)
+	require.Equal(t, elasticIndexer.ErrNilEventsDecoder, err)
+
 	args = createMockArgs()
 	proc, err := NewLogsAndEventsProcessor(args)
 	require.NotNil(t, proc)
@@ -371,6 +377,43 @@ func TestPrepareLogsAndEvents_LogEvents(t *testing.T) {
 	}, results.DBEvents)
 }
 
+func TestPrepareLogsAndEvents_DecodedEvents(t *testing.T) {
+	t.Parallel()
+
+	logsAndEvents := []*outport.LogData{
+		{
+			TxHash: hex.EncodeToString([]byte("txHash")),
+			Log: &transaction.Log{
+				Address: []byte("contract"),
+				Events: []*transaction.Event{
+					{
+						Address:    []byte("contract"),
+						Identifier: []byte("swap"),
+						Topics:     [][]byte{[]byte("swap"), []byte("caller")},
+						Data:       []byte("data"),
+					},
+				},
+			},
+		},
+	}
+
+	decoded := []*data.DecodedEventField{{Name: "caller", Type: "Address", Value: "caller"}}
+	args := createMockArgs()
+	args.EventsDecoder = &mock.ABIDecoderStub{
+		DecodeEventCalled: func(address string, topics [][]byte, eventData []byte) []*data.DecodedEventField {
+			require.Equal(t, "636f6e7472616374", address)
+			require.Equal(t, [][]byte{[]byte("swap"), []byte("caller")}, topics)
+			require.Equal(t, []byte("data"), eventData)
+			return decoded
+		},
+	}
+	proc, _ := NewLogsAndEventsProcessor(args)
+
+	results := proc.ExtractDataFromLogs(logsAndEvents, &data.PreparedResults{}, 1234, 0, 3)
+	require.Equal(t, decoded, results.DBLogs[0].Events[0].Decoded)
+	require.Equal(t, decoded, results.DBEvents[0].Decoded)
+}
+
 func TestHexEncodeSlice(t *testing.T) {
 	t.Parallel()
 
@@ -11,11 +11,15 @@ type logsData struct {
 	txHashStatusInfoProc    txHashStatusInfoHandler
 	tokens                  data.TokensHandler
 	tokensSupply            data.TokensHandler
+	tokensSupplyChanges     *tokensSupplyChanges
+	providersChanges        *providersChanges
 	txsMap                  map[string]*data.Transaction
 	scrsMap                 map[string]*data.ScResult
 	scDeploys               map[string]*data.ScDeployInfo
 	changeOwnerOperations   map[string]*data.OwnerData
 	delegators              map[string]*data.Delegator
+	delegatorsOperations    []*data.Delegator
+	stakedKeys              map[string]*data.StakedKey
 	tokensInfo              []*data.TokenInfo
 	nftsDataUpdates         []*data.NFTDataUpdate
 	tokenRolesAndProperties *tokeninfo.TokenRolesAndProperties
@@ -32,10 +36,14 @@ func newLogsData(
 	ld.scrsMap = converters.ConvertScrsSliceIntoMap(scrs)
 	ld.tokens = data.NewTokensInfo()
 	ld.tokensSupply = data.NewTokensInfo()
+	ld.tokensSupplyChanges = newTokensSupplyChanges()
+	ld.providersChanges = newProvidersChanges()
 	ld.timestamp = timestamp
 	ld.scDeploys = make(map[string]*data.ScDeployInfo)
 	ld.tokensInfo = make([]*data.TokenInfo, 0)
 	ld.delegators = make(map[string]*data.Delegator)
+	ld.delegatorsOperations = make([]*data.Delegator, 0)
+	ld.stakedKeys = make(map[string]*data.StakedKey)
 	ld.changeOwnerOperations = make(map[string]*data.OwnerData)
 	ld.nftsDataUpdates = make([]*data.NFTDataUpdate, 0)
 	ld.tokenRolesAndProperties = tokeninfo.NewTokenRolesAndProperties()
@@ -0,0 +1,136 @@
+package logsevents
+
+import (
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/multiversx/mx-chain-es-indexer-go/data"
+	"github.com/multiversx/mx-chain-es-indexer-go/process/dataindexer"
+)
+
+type providerChange struct {
+	contract             string
+	hasStakeInfo         bool
+	totalActiveStake     *big.Int
+	numDelegators        uint64
+	delegatorsDeleted    uint64
+	rewardsClaimed       *big.Int
+	claimsCount          uint64
+	serviceFeeChanges    []*data.ServiceFeeChange
+	delegationCapChanges []*data.DelegationCapChange
+}
+
+type providersChanges struct {
+	changes map[string]*providerChange
+}
+
+func newProvidersChanges() *providersChanges {
+	return &providersChanges{
+		changes: make(map[string]*providerChange),
+	}
+}
+
+// setStakeInfo will keep the total active stake and the number of delegators of a contract after a delegation operation.
+// The number of delegators from the event already excludes the delegator deleted by the operation
+func (pc *providersChanges) setStakeInfo(contract string, totalActiveStake *big.Int, numDelegators uint64) {
+	change := pc.getOrCreate(contract)
+	change.hasStakeInfo = true
+	change.totalActiveStake = totalActiveStake
+	change.numDelegators = numDelegators
+	change.delegatorsDeleted = 0
+}
+
+// addDeletedDelegator will count a delegator deleted by an operation whose event does not hold the number of delegators
+func (pc *providersChanges) addDeletedDelegator(contract string) {
+	change := pc.getOrCreate(contract)
+	if change.hasStakeInfo {
+		if change.numDelegators > 0 {
+			change.numDelegators--
+		}
+		return
+	}
+
+	change.delegatorsDeleted++
+}
+
+func (pc *providersChanges) addClaimedRewards(contract string, value *big.Int) {
+	change := pc.getOrCreate(contract)
+	change.rewardsClaimed.Add(change.rewardsClaimed, value)
+	change.claimsCount++
+}
+
+func (pc *providersChanges) addServiceFeeChange(contract string, serviceFee uint64, timestamp uint64) {
+	change := pc.getOrCreate(contract)
+	change.serviceFeeChanges = append(change.serviceFeeChanges, &data.ServiceFeeChange{
+		ServiceFee: serviceFee,
+		Timestamp:  time.Duration(timestamp),
+	})
+}
+
+func (pc *providersChanges) addDelegationCapChange(contract string, maxDelegationCap *big.Int, maxDelegationCapNum float64, timestamp uint64) {
+	change := pc.getOrCreate(contract)
+	change.delegationCapChanges = append(change.delegationCapChanges, &data.DelegationCapChange{
+		MaxDelegationCap:    maxDelegationCap.String(),
+		MaxDelegationCapNum: maxDelegationCapNum,
+		Timestamp:           time.Duration(timestamp),
+	})
+}
+
+func (pc *providersChanges) getOrCreate(contract string) *providerChange {
+	change, found := pc.changes[contract]
+	if found {
+		return change
+	}
+
+	change = &providerChange{
+		contract:             contract,
+		totalActiveStake:     big.NewInt(0),
+		rewardsClaimed:       big.NewInt(0),
+		serviceFeeChanges:    make([]*data.ServiceFeeChange, 0),
+		delegationCapChanges: make([]*data.DelegationCapChange, 0),
+	}
+	pc.changes[contract] = change
+
+	return change
+}
+
+// getAll will return all the providers changes, sorted by contract, converted in the structure that is stored in the database
+func (pc *providersChanges) getAll(timestamp uint64, shardID uint32, balanceConverter dataindexer.BalanceConverter) []*data.ProviderChange {
+	contracts := make([]string, 0, len(pc.changes))
+	for contract := range pc.changes {
+		contracts = append(contracts, contract)
+	}
+	sort.Strings(contracts)
+
+	dbChanges := make([]*data.ProviderChange, 0, len(contracts))
+	for _, contract := range contracts {
+		change := pc.changes[contract]
+		dbChanges = append(dbChanges, &data.ProviderChange{
+			Contract:             change.contract,
+			HasStakeInfo:         change.hasStakeInfo,
+			TotalActiveStake:     change.totalActiveStake.String(),
+			TotalActiveStakeNum:  convertProviderValueToFloat(balanceConverter, change.totalActiveStake),
+			NumDelegators:        change.numDelegators,
+			DelegatorsDeleted:    change.delegatorsDeleted,
+			RewardsClaimed:       change.rewardsClaimed.String(),
+			RewardsClaimedNum:    convertProviderValueToFloat(balanceConverter, change.rewardsClaimed),
+			ClaimsCount:          change.claimsCount,
+			ServiceFeeChanges:    change.serviceFeeChanges,
+			DelegationCapChanges: change.delegationCapChanges,
+			ShardID:              shardID,
+			Timestamp:            time.Duration(timestamp),
+		})
+	}
+
+	return dbChanges
+}
+
+func convertProviderValueToFloat(balanceConverter dataindexer.BalanceConverter, value *big.Int) float64 {
+	valueNum, err := balanceConverter.ComputeBalanceAsFloat(value)
+	if err != nil {
+		log.Warn("providersChanges: cannot convert value to float", "value", value.String(), "error", err)
+	}
+
+	return valueNum
+}
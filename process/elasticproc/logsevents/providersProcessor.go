@@ -0,0 +1,106 @@
+package logsevents
+
+import (
+	"math/big"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	indexer "github.com/multiversx/mx-chain-es-indexer-go/process/dataindexer"
+)
+
+const (
+	changeServiceFeeFunc         = "changeServiceFee"
+	modifyTotalDelegationCapFunc = "modifyTotalDelegationCap"
+)
+
+type providersProcessor struct {
+	balanceConverter indexer.BalanceConverter
+	pubkeyConverter  core.PubkeyConverter
+	operations       map[string]struct{}
+}
+
+func newProvidersProcessor(
+	pubkeyConverter core.PubkeyConverter,
+	balanceConverter indexer.BalanceConverter,
+) *providersProcessor {
+	return &providersProcessor{
+		operations: map[string]struct{}{
+			delegateFunc:                 {},
+			unDelegateFunc:               {},
+			withdrawFunc:                 {},
+			reDelegateRewardsFunc:        {},
+			claimRewardsFunc:             {},
+			changeServiceFeeFunc:         {},
+			modifyTotalDelegationCapFunc: {},
+		},
+		pubkeyConverter:  pubkeyConverter,
+		balanceConverter: balanceConverter,
+	}
+}
+
+// processEvent will collect the changes of the delegation contracts. The event is never marked as processed because
+// the delegation events are also handled by the delegators processor
+func (pp *providersProcessor) processEvent(args *argsProcessEvent) argOutputProcessEvent {
+	eventIdentifierStr := string(args.event.GetIdentifier())
+	_, ok := pp.operations[eventIdentifierStr]
+	if !ok {
+		return argOutputProcessEvent{}
+	}
+
+	topics := args.event.GetTopics()
+	switch eventIdentifierStr {
+	case claimRewardsFunc:
+		pp.processClaimRewards(args, topics)
+	case changeServiceFeeFunc:
+		// topics[0] = the new service fee
+		if len(topics) < 1 {
+			return argOutputProcessEvent{}
+		}
+		serviceFee := big.NewInt(0).SetBytes(topics[0]).Uint64()
+		args.providersChanges.addServiceFeeChange(pp.pubkeyConverter.SilentEncode(args.logAddress, log), serviceFee, args.timestamp)
+	case modifyTotalDelegationCapFunc:
+		// topics[0] = the new maximum delegation cap, zero meaning uncapped
+		if len(topics) < 1 {
+			return argOutputProcessEvent{}
+		}
+		maxDelegationCap := big.NewInt(0).SetBytes(topics[0])
+		args.providersChanges.addDelegationCapChange(
+			pp.pubkeyConverter.SilentEncode(args.logAddress, log),
+			maxDelegationCap,
+			convertProviderValueToFloat(pp.balanceConverter, maxDelegationCap),
+			args.timestamp,
+		)
+	default:
+		// the topics of the delegate / unDelegate / withdraw / reDelegateRewards events are described in the delegators processor
+		if len(topics) < minNumTopicsDelegators {
+			return argOutputProcessEvent{}
+		}
+
+		contractAddr := pp.pubkeyConverter.SilentEncode(args.logAddress, log)
+		if len(topics) >= minNumTopicsDelegators+1 && eventIdentifierStr == delegateFunc {
+			contractAddr = pp.pubkeyConverter.SilentEncode(topics[4], log)
+		}
+
+		numDelegators := big.NewInt(0).SetBytes(topics[2]).Uint64()
+		totalActiveStake := big.NewInt(0).SetBytes(topics[3])
+		args.providersChanges.setStakeInfo(contractAddr, totalActiveStake, numDelegators)
+	}
+
+	return argOutputProcessEvent{}
+}
+
+func (pp *providersProcessor) processClaimRewards(args *argsProcessEvent, topics [][]byte) {
+	// the topics of the claimRewards event are described in the delegators processor
+	if len(topics) < minNumTopicsClaimRewards {
+		return
+	}
+
+	contractAddr := pp.pubkeyConverter.SilentEncode(args.logAddress, log)
+	if len(topics) == numTopicsClaimRewardsWithContractAddress {
+		contractAddr = pp.pubkeyConverter.SilentEncode(topics[numTopicsClaimRewardsWithContractAddress-1], log)
+	}
+
+	args.providersChanges.addClaimedRewards(contractAddr, big.NewInt(0).SetBytes(topics[0]))
+	if bytesToBool(topics[minNumTopicsClaimRewards-1]) {
+		args.providersChanges.addDeletedDelegator(contractAddr)
+	}
+}
@@ -0,0 +1,106 @@
+package logsevents
+
+import (
+	"math/big"
+	"strconv"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-es-indexer-go/data"
+	"github.com/multiversx/mx-chain-es-indexer-go/process/elasticproc/converters"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogsAndEventsProcessor_ExtractDataFromLogsProvidersChanges(t *testing.T) {
+	t.Parallel()
+
+	logsAndEvents := []*outport.LogData{
+		{
+			TxHash: "h1",
+			Log: &transaction.Log{
+				Address: []byte("contract"),
+				Events: []*transaction.Event{
+					{
+						Address:    []byte("addr"),
+						Identifier: []byte(delegateFunc),
+						Topics:     [][]byte{big.NewInt(1000).Bytes(), big.NewInt(1000).Bytes(), big.NewInt(10).Bytes(), big.NewInt(1000000000).Bytes()},
+					},
+					{
+						Address:    []byte("addr2"),
+						Identifier: []byte(claimRewardsFunc),
+						Topics:     [][]byte{big.NewInt(100).Bytes(), []byte(strconv.FormatBool(true))},
+					},
+					{
+						Address:    []byte("owner"),
+						Identifier: []byte(changeServiceFeeFunc),
+						Topics:     [][]byte{big.NewInt(1200).Bytes()},
+					},
+				},
+			},
+		},
+		{
+			TxHash: "h2",
+			Log: &transaction.Log{
+				Address: []byte("contract2"),
+				Events: []*transaction.Event{
+					{
+						Address:    []byte("addr"),
+						Identifier: []byte(claimRewardsFunc),
+						Topics:     [][]byte{big.NewInt(200).Bytes(), []byte(strconv.FormatBool(true))},
+					},
+					{
+						Address:    []byte("owner"),
+						Identifier: []byte(modifyTotalDelegationCapFunc),
+						Topics:     [][]byte{big.NewInt(5000000000).Bytes()},
+					},
+				},
+			},
+		},
+	}
+
+	args := createMockArgs()
+	balanceConverter, _ := converters.NewBalanceConverter(10)
+	args.BalanceConverter = balanceConverter
+	proc, _ := NewLogsAndEventsProcessor(args)
+
+	resLogs := proc.ExtractDataFromLogs(logsAndEvents, &data.PreparedResults{}, 1000, core.MetachainShardId, 3)
+	require.Equal(t, []*data.ProviderChange{
+		{
+			Contract:            "636f6e7472616374",
+			HasStakeInfo:        true,
+			TotalActiveStake:    "1000000000",
+			TotalActiveStakeNum: 0.1,
+			NumDelegators:       9,
+			RewardsClaimed:      "100",
+			RewardsClaimedNum:   0.00000001,
+			ClaimsCount:         1,
+			ServiceFeeChanges: []*data.ServiceFeeChange{
+				{ServiceFee: 1200, Timestamp: 1000},
+			},
+			DelegationCapChanges: []*data.DelegationCapChange{},
+			ShardID:              core.MetachainShardId,
+			Timestamp:            1000,
+		},
+		{
+			Contract:          "636f6e747261637432",
+			TotalActiveStake:  "0",
+			DelegatorsDeleted: 1,
+			RewardsClaimed:    "200",
+			RewardsClaimedNum: 0.00000002,
+			ClaimsCount:       1,
+			ServiceFeeChanges: []*data.ServiceFeeChange{},
+			DelegationCapChanges: []*data.DelegationCapChange{
+				{MaxDelegationCap: "5000000000", MaxDelegationCapNum: 0.5, Timestamp: 1000},
+			},
+			ShardID:   core.MetachainShardId,
+			Timestamp: 1000,
+		},
+	}, resLogs.ProvidersChanges)
+	require.Len(t, resLogs.Delegators, 3)
+
+	resLogs = proc.ExtractDataFromLogs(logsAndEvents, &data.PreparedResults{}, 1000, 0, 3)
+	require.Len(t, resLogs.ProvidersChanges, 2)
+	require.Equal(t, uint32(0), resLogs.ProvidersChanges[0].ShardID)
+}
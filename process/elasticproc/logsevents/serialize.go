@@ -215,8 +215,15 @@ func serializeToken(tokenData *data.TokenInfo, index string) ([]byte, []byte, er
 	codeToExecute := `
 		if (ctx._source.containsKey('roles')) {
 			HashMap roles = ctx._source.roles;
+			HashMap supply = new HashMap();
+			for (String key : ['initialSupply', 'initialSupplyNum', 'minted', 'mintedNum', 'burned', 'burnedNum', 'wiped', 'wipedNum', 'supply', 'supplyNum', 'supplyChanges']) {
+				if (ctx._source.containsKey(key)) {
+					supply[key] = ctx._source[key];
+				}
+			}
 			ctx._source = params.token;
-			ctx._source.roles = roles
+			ctx._source.roles = roles;
+			ctx._source.putAll(supply)
 		}
 `
 	serializedDataStr := fmt.Sprintf(`{"script": {`+
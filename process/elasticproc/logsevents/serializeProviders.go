@@ -0,0 +1,151 @@
+package logsevents
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-es-indexer-go/data"
+	"github.com/multiversx/mx-chain-es-indexer-go/process/elasticproc/converters"
+)
+
+// SerializeProvidersChanges will serialize the provided delegation contracts changes in a way that Elasticsearch expects a bulk request
+func (lep *logsAndEventsProcessor) SerializeProvidersChanges(providersChanges []*data.ProviderChange, buffSlice *data.BufferSlice, index string) error {
+	for _, providerChange := range providersChanges {
+		meta, serializedData, err := prepareSerializedProviderChange(providerChange, index)
+		if err != nil {
+			return err
+		}
+
+		err = buffSlice.PutData(meta, serializedData)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func prepareSerializedProviderChange(providerChange *data.ProviderChange, index string) ([]byte, []byte, error) {
+	meta := []byte(fmt.Sprintf(`{ "update" : { "_index":"%s", "_id" : "%s" } }%s`, index, converters.JsonEscape(providerChange.Contract), "\n"))
+
+	serializedChange, err := json.Marshal(providerChange)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	serializedProvider, err := json.Marshal(&data.Provider{
+		Contract:       providerChange.Contract,
+		RewardsClaimed: "0",
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	codeToExecute := `
+		if ('create' == ctx.op) {
+			ctx._source = params.provider;
+		}
+` + converters.ApplyBlockChangeScript("providerChanges", `
+		blockChange.prevNumDelegators = ctx._source.numDelegators;
+		if (params.change.hasStakeInfo) {
+			if (ctx._source.containsKey('totalActiveStake')) {
+				blockChange.prevTotalActiveStake = ctx._source.totalActiveStake;
+				blockChange.prevTotalActiveStakeNum = ctx._source.totalActiveStakeNum;
+			}
+			ctx._source.totalActiveStake = params.change.totalActiveStake;
+			ctx._source.totalActiveStakeNum = params.change.totalActiveStakeNum;
+			ctx._source.numDelegators = params.change.numDelegators;
+		} else {
+			long numDelegators = ctx._source.numDelegators - params.change.delegatorsDeleted;
+			ctx._source.numDelegators = numDelegators > 0 ? numDelegators : 0;
+		}
+		ctx._source.rewardsClaimed = new BigInteger(ctx._source.rewardsClaimed).add(new BigInteger(params.change.rewardsClaimed)).toString();
+		ctx._source.rewardsClaimedNum = ctx._source.rewardsClaimedNum + params.change.rewardsClaimedNum;
+		ctx._source.claimsCount = ctx._source.claimsCount + params.change.claimsCount;
+		if (!ctx._source.containsKey('serviceFeeHistory')) {
+			ctx._source.serviceFeeHistory = [];
+		}
+		for (def feeChange : params.change.serviceFeeChanges) {
+			ctx._source.serviceFeeHistory.add(feeChange);
+			ctx._source.serviceFee = feeChange.serviceFee;
+		}
+		if (!ctx._source.containsKey('delegationCapHistory')) {
+			ctx._source.delegationCapHistory = [];
+		}
+		for (def capChange : params.change.delegationCapChanges) {
+			ctx._source.delegationCapHistory.add(capChange);
+			ctx._source.maxDelegationCap = capChange.maxDelegationCap;
+			ctx._source.maxDelegationCapNum = capChange.maxDelegationCapNum;
+		}
+		ctx._source.timestamp = params.change.timestamp;
+`)
+	serializedDataStr := fmt.Sprintf(`{"scripted_upsert": true, "script": {`+
+		`"source": "%s",`+
+		`"lang": "painless",`+
+		`"params": { "provider": %s, "change": %s, "maxChanges": %d }},`+
+		`"upsert": {}}`,
+		converters.FormatPainlessSource(codeToExecute), string(serializedProvider), string(serializedChange), converters.MaxBlockChangesInDocument,
+	)
+
+	return meta, []byte(serializedDataStr), nil
+}
+
+// PrepareProvidersQueryInCaseOfRevert will prepare the query that undoes the delegation contracts changes of a reverted block.
+// The total active stake and the number of delegators are restored to the values they had before the reverted block
+func (lep *logsAndEventsProcessor) PrepareProvidersQueryInCaseOfRevert(timestamp uint64, shardID uint32) *bytes.Buffer {
+	codeToExecute := converters.RevertBlockChangeScript("providerChanges", `
+			ctx._source.numDelegators = change.prevNumDelegators;
+			if (change.hasStakeInfo) {
+				if (change.containsKey('prevTotalActiveStake')) {
+					ctx._source.totalActiveStake = change.prevTotalActiveStake;
+					ctx._source.totalActiveStakeNum = change.prevTotalActiveStakeNum;
+				} else {
+					ctx._source.remove('totalActiveStake');
+					ctx._source.remove('totalActiveStakeNum');
+				}
+			}
+			ctx._source.rewardsClaimed = new BigInteger(ctx._source.rewardsClaimed).subtract(new BigInteger(change.rewardsClaimed)).toString();
+			ctx._source.rewardsClaimedNum = ctx._source.rewardsClaimedNum - change.rewardsClaimedNum;
+			ctx._source.claimsCount = ctx._source.claimsCount - change.claimsCount;
+`) + `
+	if (ctx._source.containsKey('serviceFeeHistory')) {
+		ctx._source.serviceFeeHistory.removeIf(feeChange -> feeChange.timestamp == params.timestamp);
+		if (ctx._source.serviceFeeHistory.length > 0) {
+			ctx._source.serviceFee = ctx._source.serviceFeeHistory[ctx._source.serviceFeeHistory.length - 1].serviceFee;
+		} else {
+			ctx._source.remove('serviceFee');
+		}
+	}
+	if (ctx._source.containsKey('delegationCapHistory')) {
+		ctx._source.delegationCapHistory.removeIf(capChange -> capChange.timestamp == params.timestamp);
+		if (ctx._source.delegationCapHistory.length > 0) {
+			def lastCapChange = ctx._source.delegationCapHistory[ctx._source.delegationCapHistory.length - 1];
+			ctx._source.maxDelegationCap = lastCapChange.maxDelegationCap;
+			ctx._source.maxDelegationCapNum = lastCapChange.maxDelegationCapNum;
+		} else {
+			ctx._source.remove('maxDelegationCap');
+			ctx._source.remove('maxDelegationCapNum');
+		}
+	}
+`
+
+	query := fmt.Sprintf(`
+	{
+	  "query": {
+		"bool": {
+		  "must": [
+			{"match": {"providerChanges.timestamp": "%d"}},
+			{"match": {"providerChanges.shardID": %d}}
+		  ]
+		}
+	  },
+	  "script": {
+		"source": "%s",
+		"lang": "painless",
+		"params": {"timestamp": %d, "shardID": %d}
+	  }
+	}`, timestamp, shardID, converters.FormatPainlessSource(codeToExecute), timestamp, shardID)
+
+	return bytes.NewBuffer([]byte(query))
+}
@@ -0,0 +1,107 @@
+package logsevents
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-es-indexer-go/data"
+	"github.com/multiversx/mx-chain-es-indexer-go/process/elasticproc/converters"
+)
+
+// SerializeStakedKeys will serialize the provided staked keys in a way that Elasticsearch expects a bulk request
+func (lep *logsAndEventsProcessor) SerializeStakedKeys(stakedKeys map[string]*data.StakedKey, buffSlice *data.BufferSlice, index string) error {
+	for _, stakedKey := range stakedKeys {
+		meta, serializedData, err := prepareSerializedStakedKey(stakedKey, index)
+		if err != nil {
+			return err
+		}
+
+		err = buffSlice.PutData(meta, serializedData)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func prepareSerializedStakedKey(stakedKey *data.StakedKey, index string) ([]byte, []byte, error) {
+	meta := []byte(fmt.Sprintf(`{ "update" : { "_index":"%s", "_id" : "%s" } }%s`, index, converters.JsonEscape(stakedKey.BLSKey), "\n"))
+
+	stakedKeySerialized, err := json.Marshal(stakedKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// only the fields changed by the events are serialized, the others have to be kept. The overwritten values are kept
+	// in the key change, so that the block can be reverted, and the events of an older block indexed late are ignored
+	codeToExecute := `
+		boolean created = 'create' == ctx.op;
+		if (!created && ctx._source.containsKey('timestamp') && ctx._source.timestamp > params.key.timestamp) {
+			ctx.op = 'noop';
+			return;
+		}
+` + converters.ApplyBlockChangeScript("keyChanges", `
+		Map previous = new HashMap();
+		List fields = new ArrayList(params.key.keySet());
+		if (params.key.containsKey('topUp') && !params.key.containsKey('topUpNum')) {
+			fields.add('topUpNum');
+		}
+		for (String field : fields) {
+			if (ctx._source.containsKey(field)) {
+				previous.put(field, ctx._source[field]);
+			}
+			ctx._source[field] = params.key.containsKey(field) ? params.key[field] : 0;
+		}
+		blockChange.created = created;
+		blockChange.fields = fields;
+		blockChange.previous = previous;
+`)
+	serializedDataStr := fmt.Sprintf(`{"scripted_upsert": true, "script": {`+
+		`"source": "%s",`+
+		`"lang": "painless",`+
+		`"params": { "key": %s, "change": {"timestamp": %d, "shardID": %d}, "maxChanges": %d }},`+
+		`"upsert": {}}`,
+		converters.FormatPainlessSource(codeToExecute), string(stakedKeySerialized), stakedKey.Timestamp, stakedKey.ShardID, converters.MaxBlockChangesInDocument,
+	)
+
+	return meta, []byte(serializedDataStr), nil
+}
+
+// PrepareStakedKeysQueryInCaseOfRevert will prepare the query that restores the staked keys changed by a reverted block.
+// The keys created by the block are removed
+func (lep *logsAndEventsProcessor) PrepareStakedKeysQueryInCaseOfRevert(timestamp uint64, shardID uint32) *bytes.Buffer {
+	codeToExecute := converters.RevertBlockChangeScript("keyChanges", `
+			if (change.created) {
+				ctx.op = 'delete';
+				return;
+			}
+			for (String field : change.fields) {
+				if (change.previous.containsKey(field)) {
+					ctx._source[field] = change.previous[field];
+				} else {
+					ctx._source.remove(field);
+				}
+			}
+`)
+
+	query := fmt.Sprintf(`
+	{
+	  "query": {
+		"bool": {
+		  "must": [
+			{"match": {"keyChanges.timestamp": "%d"}},
+			{"match": {"keyChanges.shardID": %d}}
+		  ]
+		}
+	  },
+	  "script": {
+		"source": "%s",
+		"lang": "painless",
+		"params": {"timestamp": %d, "shardID": %d}
+	  }
+	}`, timestamp, shardID, converters.FormatPainlessSource(codeToExecute), timestamp, shardID)
+
+	return bytes.NewBuffer([]byte(query))
+}
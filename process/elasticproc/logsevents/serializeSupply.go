@@ -0,0 +1,123 @@
+package logsevents
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-es-indexer-go/data"
+	"github.com/multiversx/mx-chain-es-indexer-go/process/elasticproc/converters"
+)
+
+const recomputeSupplyCode = `
+	ctx._source.supply = new BigInteger(ctx._source.initialSupply).add(new BigInteger(ctx._source.minted)).subtract(new BigInteger(ctx._source.burned)).subtract(new BigInteger(ctx._source.wiped)).toString();
+	ctx._source.supplyNum = ctx._source.initialSupplyNum + ctx._source.mintedNum - ctx._source.burnedNum - ctx._source.wipedNum;
+`
+
+// supplyFields holds the fields, serialized as a painless parameter, that are changed by the supply events
+const supplyFields = `["initialSupply","minted","burned","wiped"]`
+
+// SerializeTokensSupplyChanges will serialize the provided supply changes in a way that Elasticsearch expects a bulk request
+func (lep *logsAndEventsProcessor) SerializeTokensSupplyChanges(supplyChanges []*data.TokenSupplyChange, buffSlice *data.BufferSlice, index string) error {
+	for _, supplyChange := range supplyChanges {
+		meta, serializedData, err := prepareSerializedSupplyChange(supplyChange, index)
+		if err != nil {
+			return err
+		}
+
+		err = buffSlice.PutData(meta, serializedData)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func prepareSerializedSupplyChange(supplyChange *data.TokenSupplyChange, index string) ([]byte, []byte, error) {
+	id := supplyChange.Token
+	if supplyChange.Identifier != "" {
+		id = supplyChange.Identifier
+	}
+	meta := []byte(fmt.Sprintf(`{ "update" : { "_index":"%s", "_id" : "%s" } }%s`, index, converters.JsonEscape(id), "\n"))
+
+	serializedChange, err := json.Marshal(supplyChange)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tokenData := &data.TokenInfo{
+		Token:      supplyChange.Token,
+		Identifier: supplyChange.Identifier,
+		Nonce:      supplyChange.Nonce,
+		Timestamp:  supplyChange.Timestamp,
+	}
+	serializedToken, err := json.Marshal(tokenData)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// the supply of a token nonce is kept only when the events proved that the nonce holds a quantity, otherwise the
+	// change is applied only if the nonce document already tracks a supply. A non-fungible token never gets a supply,
+	// and the document of a burned NFT is not created again
+	hasQuantity := supplyChange.Nonce == 0 || supplyChange.HasQuantity
+	codeToExecute := `
+		if ('create' == ctx.op) {
+			if (!params.hasQuantity) {
+				ctx.op = 'noop';
+				return;
+			}
+			ctx._source = params.token;
+		} else if (!params.hasQuantity && !ctx._source.containsKey('supply')) {
+			ctx.op = 'noop';
+			return;
+		}
+` + converters.ApplyBlockChangeScript("supplyChanges", `
+		for (String field : params.fields) {
+			String numField = field + 'Num';
+			BigInteger total = ctx._source.containsKey(field) ? new BigInteger(ctx._source[field]) : BigInteger.ZERO;
+			double totalNum = ctx._source.containsKey(numField) ? ctx._source[numField] : 0;
+			ctx._source[field] = total.add(new BigInteger(params.change[field])).toString();
+			ctx._source[numField] = totalNum + params.change[numField];
+		}
+`+recomputeSupplyCode)
+	serializedDataStr := fmt.Sprintf(`{"scripted_upsert": true, "script": {`+
+		`"source": "%s",`+
+		`"lang": "painless",`+
+		`"params": { "token": %s, "change": %s, "fields": %s, "maxChanges": %d, "hasQuantity": %t }},`+
+		`"upsert": {}}`,
+		converters.FormatPainlessSource(codeToExecute), string(serializedToken), string(serializedChange), supplyFields, converters.MaxBlockChangesInDocument, hasQuantity,
+	)
+
+	return meta, []byte(serializedDataStr), nil
+}
+
+// PrepareTokensSupplyQueryInCaseOfRevert will prepare the query that subtracts the supply changes of a reverted block
+func (lep *logsAndEventsProcessor) PrepareTokensSupplyQueryInCaseOfRevert(timestamp uint64, shardID uint32) *bytes.Buffer {
+	codeToExecute := converters.RevertBlockChangeScript("supplyChanges", `
+			for (String field : params.fields) {
+				String numField = field + 'Num';
+				ctx._source[field] = new BigInteger(ctx._source[field]).subtract(new BigInteger(change[field])).toString();
+				ctx._source[numField] = ctx._source[numField] - change[numField];
+			}
+`) + recomputeSupplyCode
+
+	query := fmt.Sprintf(`
+	{
+	  "query": {
+		"bool": {
+		  "must": [
+			{"match": {"supplyChanges.timestamp": "%d"}},
+			{"match": {"supplyChanges.shardID": %d}}
+		  ]
+		}
+	  },
+	  "script": {
+		"source": "%s",
+		"lang": "painless",
+		"params": {"timestamp": %d, "shardID": %d, "fields": %s}
+	  }
+	}`, timestamp, shardID, converters.FormatPainlessSource(codeToExecute), timestamp, shardID, supplyFields)
+
+	return bytes.NewBuffer([]byte(query))
+}
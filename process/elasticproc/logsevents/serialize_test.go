@@ -2,6 +2,7 @@ package logsevents
 
 import (
 	"math/big"
+	"strings"
 	"testing"
 	"time"
 
@@ -104,7 +105,7 @@ func TestSerializeTokens(t *testing.T) {
 	require.Equal(t, 1, len(buffSlice.Buffers()))
 
 	expectedRes := `{ "update" : { "_index":"tokens", "_id" : "TKN-01234" } }
-{"script": {"source": "if (ctx._source.containsKey('roles')) {HashMap roles = ctx._source.roles;ctx._source = params.token;ctx._source.roles = roles}","lang": "painless","params": {"token": {"name":"TokenName","ticker":"TKN","token":"TKN-01234","issuer":"erd123","currentOwner":"erd123","numDecimals":0,"type":"SemiFungibleESDT","timestamp":50000,"ownersHistory":[{"address":"erd123","timestamp":50000}]}}},"upsert": {"name":"TokenName","ticker":"TKN","token":"TKN-01234","issuer":"erd123","currentOwner":"erd123","numDecimals":0,"type":"SemiFungibleESDT","timestamp":50000,"ownersHistory":[{"address":"erd123","timestamp":50000}]}}
+{"script": {"source": "if (ctx._source.containsKey('roles')) {HashMap roles = ctx._source.roles;HashMap supply = new HashMap();for (String key : ['initialSupply', 'initialSupplyNum', 'minted', 'mintedNum', 'burned', 'burnedNum', 'wiped', 'wipedNum', 'supply', 'supplyNum', 'supplyChanges']) {if (ctx._source.containsKey(key)) {supply[key] = ctx._source[key];}}ctx._source = params.token;ctx._source.roles = roles;ctx._source.putAll(supply)}","lang": "painless","params": {"token": {"name":"TokenName","ticker":"TKN","token":"TKN-01234","issuer":"erd123","currentOwner":"erd123","numDecimals":0,"type":"SemiFungibleESDT","timestamp":50000,"ownersHistory":[{"address":"erd123","timestamp":50000}]}}},"upsert": {"name":"TokenName","ticker":"TKN","token":"TKN-01234","issuer":"erd123","currentOwner":"erd123","numDecimals":0,"type":"SemiFungibleESDT","timestamp":50000,"ownersHistory":[{"address":"erd123","timestamp":50000}]}}
 { "update" : { "_index":"tokens", "_id" : "TKN2-51234" } }
 {"script": {"source": "if (!ctx._source.containsKey('ownersHistory')) {ctx._source.ownersHistory = [params.elem]} else {ctx._source.ownersHistory.add(params.elem)}ctx._source.currentOwner = params.owner","lang": "painless","params": {"elem": {"address":"abde123456","timestamp":60000}, "owner": "abde123456"}},"upsert": {"name":"Token2","ticker":"TKN2","token":"TKN2-51234","issuer":"erd1231213123","currentOwner":"abde123456","numDecimals":0,"type":"NonFungibleESDT","timestamp":60000,"ownersHistory":[{"address":"abde123456","timestamp":60000}]}}
 `
@@ -164,3 +165,118 @@ func TestLogsAndEventsProcessor_SerializeDelegatorsDelete(t *testing.T) {
 `
 	require.Equal(t, expectedRes, buffSlice.Buffers()[0].String())
 }
+
+func TestLogsAndEventsProcessor_SerializeTokensSupplyChanges(t *testing.T) {
+	t.Parallel()
+
+	supplyChanges := []*data.TokenSupplyChange{
+		{
+			Token:         "SFT-abcd",
+			Identifier:    "SFT-abcd-01",
+			Nonce:         1,
+			InitialSupply: "0",
+			Minted:        "100",
+			MintedNum:     100,
+			Burned:        "0",
+			Wiped:         "0",
+			ShardID:       1,
+			Timestamp:     5000,
+			HasQuantity:   true,
+		},
+	}
+
+	buffSlice := data.NewBufferSlice(data.DefaultMaxBulkSize)
+	logsAndEventsProc := &logsAndEventsProcessor{}
+	err := logsAndEventsProc.SerializeTokensSupplyChanges(supplyChanges, buffSlice, "tokens")
+	require.Nil(t, err)
+
+	serialized := buffSlice.Buffers()[0].String()
+	require.True(t, strings.HasPrefix(serialized, `{ "update" : { "_index":"tokens", "_id" : "SFT-abcd-01" } }
+{"scripted_upsert": true, "script": {"source": "if ('create' == ctx.op) {if (!params.hasQuantity) {ctx.op = 'noop';return;}ctx._source = params.token;} else if (!params.hasQuantity && !ctx._source.containsKey('supply')) {ctx.op = 'noop';return;}`))
+	require.Contains(t, serialized, `"params": { "token": {"identifier":"SFT-abcd-01","token":"SFT-abcd","numDecimals":0,"nonce":1,"timestamp":5000}, `+
+		`"change": {"initialSupply":"0","initialSupplyNum":0,"minted":"100","mintedNum":100,"burned":"0","burnedNum":0,"wiped":"0","wipedNum":0,"shardID":1,"timestamp":5000}, `+
+		`"fields": ["initialSupply","minted","burned","wiped"], "maxChanges": 100, "hasQuantity": true }},"upsert": {}}`)
+}
+
+func TestLogsAndEventsProcessor_PrepareTokensSupplyQueryInCaseOfRevert(t *testing.T) {
+	t.Parallel()
+
+	logsAndEventsProc := &logsAndEventsProcessor{}
+	query := logsAndEventsProc.PrepareTokensSupplyQueryInCaseOfRevert(5000, 1).String()
+
+	require.Contains(t, query, `{"match": {"supplyChanges.timestamp": "5000"}}`)
+	require.Contains(t, query, `{"match": {"supplyChanges.shardID": 1}}`)
+	require.Contains(t, query, `"params": {"timestamp": 5000, "shardID": 1, "fields": ["initialSupply","minted","burned","wiped"]}`)
+}
+
+func TestLogsAndEventsProcessor_SerializeStakedKeys(t *testing.T) {
+	t.Parallel()
+
+	stakedKeys := map[string]*data.StakedKey{
+		"6b657931": {
+			BLSKey:    "6b657931",
+			Owner:     "6f776e6572",
+			Status:    StakedStatus,
+			TopUp:     "0",
+			ShardID:   2,
+			Timestamp: time.Duration(1000),
+		},
+	}
+
+	buffSlice := data.NewBufferSlice(data.DefaultMaxBulkSize)
+	err := (&logsAndEventsProcessor{}).SerializeStakedKeys(stakedKeys, buffSlice, "stakedkeys")
+	require.Nil(t, err)
+
+	expectedRes := `{ "update" : { "_index":"stakedkeys", "_id" : "6b657931" } }
+{"scripted_upsert": true, "script": {"source": "boolean created = 'create' == ctx.op;if (!created && ctx._source.containsKey('timestamp') && ctx._source.timestamp > params.key.timestamp) {ctx.op = 'noop';return;}if (!ctx._source.containsKey('keyChanges')) {ctx._source.keyChanges = [];}for (def change : ctx._source.keyChanges) {if (change.timestamp == params.change.timestamp && change.shardID == params.change.shardID) {ctx.op = 'noop';return;}}Map blockChange = new HashMap(params.change);Map previous = new HashMap();List fields = new ArrayList(params.key.keySet());if (params.key.containsKey('topUp') && !params.key.containsKey('topUpNum')) {fields.add('topUpNum');}for (String field : fields) {if (ctx._source.containsKey(field)) {previous.put(field, ctx._source[field]);}ctx._source[field] = params.key.containsKey(field) ? params.key[field] : 0;}blockChange.created = created;blockChange.fields = fields;blockChange.previous = previous;ctx._source.keyChanges.add(blockChange);if (ctx._source.keyChanges.length > params.maxChanges) {ctx._source.keyChanges.remove(0);}","lang": "painless","params": { "key": {"blsKey":"6b657931","owner":"6f776e6572","status":"staked","topUp":"0","shardID":2,"timestamp":1000}, "change": {"timestamp": 1000, "shardID": 2}, "maxChanges": 100 }},"upsert": {}}
+`
+	require.Equal(t, expectedRes, buffSlice.Buffers()[0].String())
+}
+
+func TestLogsAndEventsProcessor_PrepareStakedKeysQueryInCaseOfRevert(t *testing.T) {
+	t.Parallel()
+
+	query := (&logsAndEventsProcessor{}).PrepareStakedKeysQueryInCaseOfRevert(1000, 2).String()
+	require.Contains(t, query, `"keyChanges.timestamp": "1000"`)
+	require.Contains(t, query, `"keyChanges.shardID": 2`)
+	require.Contains(t, query, `if (change.created) {ctx.op = 'delete';return;}`)
+	require.Contains(t, query, `"params": {"timestamp": 1000, "shardID": 2}`)
+}
+
+func TestLogsAndEventsProcessor_SerializeProvidersChanges(t *testing.T) {
+	t.Parallel()
+
+	providersChanges := []*data.ProviderChange{
+		{
+			Contract:             "636f6e7472616374",
+			DelegatorsDeleted:    1,
+			TotalActiveStake:     "0",
+			RewardsClaimed:       "100",
+			ClaimsCount:          1,
+			ServiceFeeChanges:    []*data.ServiceFeeChange{{ServiceFee: 1200, Timestamp: 1000}},
+			DelegationCapChanges: []*data.DelegationCapChange{},
+			ShardID:              core.MetachainShardId,
+			Timestamp:            time.Duration(1000),
+		},
+	}
+
+	buffSlice := data.NewBufferSlice(data.DefaultMaxBulkSize)
+	err := (&logsAndEventsProcessor{}).SerializeProvidersChanges(providersChanges, buffSlice, "providers")
+	require.Nil(t, err)
+
+	res := buffSlice.Buffers()[0].String()
+	require.True(t, strings.HasPrefix(res, `{ "update" : { "_index":"providers", "_id" : "636f6e7472616374" } }
+{"scripted_upsert": true, "script": {"source": "if ('create' == ctx.op) {ctx._source = params.provider;}`))
+	require.Contains(t, res, `"params": { "provider": {"contract":"636f6e7472616374","numDelegators":0,"rewardsClaimed":"0","rewardsClaimedNum":0,"claimsCount":0}, "change": {"hasStakeInfo":false,"totalActiveStake":"0","totalActiveStakeNum":0,"numDelegators":0,"delegatorsDeleted":1,"rewardsClaimed":"100","rewardsClaimedNum":0,"claimsCount":1,"serviceFeeChanges":[{"serviceFee":1200,"timestamp":1000}],"delegationCapChanges":[],"shardID":4294967295,"timestamp":1000}, "maxChanges": 100 }}`)
+}
+
+func TestLogsAndEventsProcessor_PrepareProvidersQueryInCaseOfRevert(t *testing.T) {
+	t.Parallel()
+
+	query := (&logsAndEventsProcessor{}).PrepareProvidersQueryInCaseOfRevert(1000, 1).String()
+	require.Contains(t, query, `"providerChanges.timestamp": "1000"`)
+	require.Contains(t, query, `"providerChanges.shardID": 1`)
+	require.Contains(t, query, `ctx._source.numDelegators = change.prevNumDelegators;`)
+	require.Contains(t, query, `ctx._source.totalActiveStake = change.prevTotalActiveStake;`)
+	require.Contains(t, query, `"params": {"timestamp": 1000, "shardID": 1}`)
+}
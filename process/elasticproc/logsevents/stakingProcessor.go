@@ -0,0 +1,158 @@
+package logsevents
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-es-indexer-go/data"
+	indexer "github.com/multiversx/mx-chain-es-indexer-go/process/dataindexer"
+)
+
+const (
+	stakeFunc                                   = "stake"
+	unStakeFunc                                 = "unStake"
+	unBondFunc                                  = "unBond"
+	unJailFunc                                  = "unJail"
+	changeRewardAddressFunc                     = "changeRewardAddress"
+	makeNewContractFromValidatorDataFunc        = "makeNewContractFromValidatorData"
+	mergeValidatorToDelegationSameOwnerFunc     = "mergeValidatorToDelegationSameOwner"
+	mergeValidatorToDelegationWithWhitelistFunc = "mergeValidatorToDelegationWithWhitelist"
+
+	// StakedStatus is the status of a BLS key that is staked
+	StakedStatus = "staked"
+	// UnStakedStatus is the status of a BLS key that was unStaked and waits for the unBond period
+	UnStakedStatus = "unStaked"
+	// UnBondedStatus is the status of a BLS key that was unBonded and is no longer in the staking system
+	UnBondedStatus = "unBonded"
+	// UnJailedStatus is the status of a BLS key that was unJailed and can be selected as validator again
+	UnJailedStatus = "unJailed"
+
+	minNumTopicsStaking = 2
+)
+
+var (
+	validatorSCAddress         = []byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 255, 255}
+	delegationManagerSCAddress = []byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 4, 255, 255}
+)
+
+type stakingProc struct {
+	balanceConverter  indexer.BalanceConverter
+	pubkeyConverter   core.PubkeyConverter
+	stakingOperations map[string]string
+	mergeOperations   map[string]struct{}
+}
+
+func newStakingProcessor(
+	pubkeyConverter core.PubkeyConverter,
+	balanceConverter indexer.BalanceConverter,
+) *stakingProc {
+	return &stakingProc{
+		stakingOperations: map[string]string{
+			stakeFunc:   StakedStatus,
+			unStakeFunc: UnStakedStatus,
+			unBondFunc:  UnBondedStatus,
+			unJailFunc:  UnJailedStatus,
+		},
+		mergeOperations: map[string]struct{}{
+			makeNewContractFromValidatorDataFunc:        {},
+			mergeValidatorToDelegationSameOwnerFunc:     {},
+			mergeValidatorToDelegationWithWhitelistFunc: {},
+		},
+		pubkeyConverter:  pubkeyConverter,
+		balanceConverter: balanceConverter,
+	}
+}
+
+func (sp *stakingProc) processEvent(args *argsProcessEvent) argOutputProcessEvent {
+	isStakingSystemSC := bytes.Equal(args.logAddress, validatorSCAddress) || bytes.Equal(args.logAddress, delegationManagerSCAddress)
+	if !isStakingSystemSC {
+		return argOutputProcessEvent{}
+	}
+
+	eventIdentifierStr := string(args.event.GetIdentifier())
+	status, isStakingOperation := sp.stakingOperations[eventIdentifierStr]
+	_, isMergeOperation := sp.mergeOperations[eventIdentifierStr]
+	isChangeRewardAddress := eventIdentifierStr == changeRewardAddressFunc
+	if !isStakingOperation && !isMergeOperation && !isChangeRewardAddress {
+		return argOutputProcessEvent{}
+	}
+
+	topics := args.event.GetTopics()
+	if len(topics) < minNumTopicsStaking {
+		return argOutputProcessEvent{
+			processed: true,
+		}
+	}
+
+	// the event address is the owner of the BLS keys
+	// for stake / unStake / unBond / unJail
+	// topics slice contains:
+	// topics[0] = the top-up of the owner after the operation
+	// topics[1:] = the BLS keys changed by the operation
+	// for changeRewardAddress
+	// topics[0] = the new reward address
+	// topics[1:] = the BLS keys of the owner
+	// for makeNewContractFromValidatorData / mergeValidatorToDelegationSameOwner / mergeValidatorToDelegationWithWhitelist
+	// topics[0] = the delegation contract address, that becomes the owner of the keys
+	// topics[1:] = the BLS keys moved to the delegation contract
+	keyTemplate := &data.StakedKey{
+		Owner:     sp.pubkeyConverter.SilentEncode(args.event.GetAddress(), log),
+		ShardID:   args.selfShardID,
+		Timestamp: time.Duration(args.timestamp),
+	}
+	switch {
+	case isStakingOperation:
+		topUp := big.NewInt(0).SetBytes(topics[0])
+		topUpNum, err := sp.balanceConverter.ComputeBalanceAsFloat(topUp)
+		if err != nil {
+			log.Warn("stakingProc.processEvent cannot compute top-up as num", "top-up", topUp,
+				"hash", args.txHashHexEncoded, "error", err)
+		}
+
+		keyTemplate.Status = status
+		keyTemplate.TopUp = topUp.String()
+		keyTemplate.TopUpNum = topUpNum
+	case isChangeRewardAddress:
+		keyTemplate.RewardAddress = sp.pubkeyConverter.SilentEncode(topics[0], log)
+	case isMergeOperation:
+		delegationContract := sp.pubkeyConverter.SilentEncode(topics[0], log)
+		keyTemplate.Owner = delegationContract
+		keyTemplate.Delegation = delegationContract
+	}
+
+	stakedKeys := make([]*data.StakedKey, 0, len(topics)-1)
+	for _, blsKey := range topics[1:] {
+		stakedKey := *keyTemplate
+		stakedKey.BLSKey = hex.EncodeToString(blsKey)
+		stakedKeys = append(stakedKeys, &stakedKey)
+	}
+
+	return argOutputProcessEvent{
+		stakedKeys: stakedKeys,
+		processed:  true,
+	}
+}
+
+// mergeStakedKey will apply on the existing staked key the fields changed by a later event of the same block
+func mergeStakedKey(existing *data.StakedKey, stakedKey *data.StakedKey) {
+	if stakedKey.Owner != "" {
+		existing.Owner = stakedKey.Owner
+	}
+	if stakedKey.Status != "" {
+		existing.Status = stakedKey.Status
+	}
+	if stakedKey.TopUp != "" {
+		existing.TopUp = stakedKey.TopUp
+		existing.TopUpNum = stakedKey.TopUpNum
+	}
+	if stakedKey.Delegation != "" {
+		existing.Delegation = stakedKey.Delegation
+	}
+	if stakedKey.RewardAddress != "" {
+		existing.RewardAddress = stakedKey.RewardAddress
+	}
+	existing.Timestamp = stakedKey.Timestamp
+}
@@ -0,0 +1,174 @@
+package logsevents
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-es-indexer-go/data"
+	"github.com/multiversx/mx-chain-es-indexer-go/mock"
+	"github.com/multiversx/mx-chain-es-indexer-go/process/elasticproc/converters"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStakingProcessor_ProcessEvent(t *testing.T) {
+	t.Parallel()
+
+	event := &transaction.Event{
+		Address:    []byte("owner"),
+		Identifier: []byte(stakeFunc),
+		Topics:     [][]byte{big.NewInt(1000000000).Bytes(), []byte("key1"), []byte("key2")},
+	}
+	args := &argsProcessEvent{
+		timestamp:   1234,
+		event:       event,
+		logAddress:  validatorSCAddress,
+		selfShardID: core.MetachainShardId,
+	}
+
+	balanceConverter, _ := converters.NewBalanceConverter(10)
+	stakingProcessor := newStakingProcessor(&mock.PubkeyConverterMock{}, balanceConverter)
+
+	res := stakingProcessor.processEvent(args)
+	require.True(t, res.processed)
+	require.Equal(t, []*data.StakedKey{
+		{
+			BLSKey:    "6b657931",
+			Owner:     "6f776e6572",
+			Status:    StakedStatus,
+			TopUp:     "1000000000",
+			TopUpNum:  0.1,
+			ShardID:   core.MetachainShardId,
+			Timestamp: 1234,
+		},
+		{
+			BLSKey:    "6b657932",
+			Owner:     "6f776e6572",
+			Status:    StakedStatus,
+			TopUp:     "1000000000",
+			TopUpNum:  0.1,
+			ShardID:   core.MetachainShardId,
+			Timestamp: 1234,
+		},
+	}, res.stakedKeys)
+}
+
+func TestStakingProcessor_ProcessEventUnJailOnSovereignShard(t *testing.T) {
+	t.Parallel()
+
+	event := &transaction.Event{
+		Address:    []byte("owner"),
+		Identifier: []byte(unJailFunc),
+		Topics:     [][]byte{big.NewInt(0).Bytes(), []byte("key1")},
+	}
+	args := &argsProcessEvent{
+		timestamp:   1234,
+		event:       event,
+		logAddress:  validatorSCAddress,
+		selfShardID: 0,
+	}
+
+	balanceConverter, _ := converters.NewBalanceConverter(10)
+	stakingProcessor := newStakingProcessor(&mock.PubkeyConverterMock{}, balanceConverter)
+
+	res := stakingProcessor.processEvent(args)
+	require.True(t, res.processed)
+	require.Equal(t, []*data.StakedKey{
+		{
+			BLSKey:    "6b657931",
+			Owner:     "6f776e6572",
+			Status:    UnJailedStatus,
+			TopUp:     "0",
+			Timestamp: 1234,
+		},
+	}, res.stakedKeys)
+}
+
+func TestStakingProcessor_ProcessEventIgnoredEvents(t *testing.T) {
+	t.Parallel()
+
+	balanceConverter, _ := converters.NewBalanceConverter(10)
+	stakingProcessor := newStakingProcessor(&mock.PubkeyConverterMock{}, balanceConverter)
+
+	event := &transaction.Event{
+		Address:    []byte("owner"),
+		Identifier: []byte(unStakeFunc),
+		Topics:     [][]byte{big.NewInt(0).Bytes(), []byte("key1")},
+	}
+
+	res := stakingProcessor.processEvent(&argsProcessEvent{
+		event:       event,
+		logAddress:  []byte("contract"),
+		selfShardID: core.MetachainShardId,
+	})
+	require.False(t, res.processed)
+
+	event.Topics = [][]byte{big.NewInt(0).Bytes()}
+	res = stakingProcessor.processEvent(&argsProcessEvent{
+		event:       event,
+		logAddress:  validatorSCAddress,
+		selfShardID: core.MetachainShardId,
+	})
+	require.True(t, res.processed)
+	require.Nil(t, res.stakedKeys)
+}
+
+func TestLogsAndEventsProcessor_ExtractDataFromLogsStakedKeys(t *testing.T) {
+	t.Parallel()
+
+	logsAndEvents := []*outport.LogData{
+		{
+			TxHash: "h1",
+			Log: &transaction.Log{
+				Address: validatorSCAddress,
+				Events: []*transaction.Event{
+					{
+						Address:    []byte("owner"),
+						Identifier: []byte(stakeFunc),
+						Topics:     [][]byte{big.NewInt(1000000000).Bytes(), []byte("key1")},
+					},
+					{
+						Address:    []byte("owner"),
+						Identifier: []byte(changeRewardAddressFunc),
+						Topics:     [][]byte{[]byte("reward"), []byte("key1")},
+					},
+				},
+			},
+		},
+		{
+			TxHash: "h2",
+			Log: &transaction.Log{
+				Address: delegationManagerSCAddress,
+				Events: []*transaction.Event{
+					{
+						Address:    []byte("owner"),
+						Identifier: []byte(mergeValidatorToDelegationSameOwnerFunc),
+						Topics:     [][]byte{[]byte("delegation"), []byte("key1")},
+					},
+				},
+			},
+		},
+	}
+
+	args := createMockArgs()
+	balanceConverter, _ := converters.NewBalanceConverter(10)
+	args.BalanceConverter = balanceConverter
+	proc, _ := NewLogsAndEventsProcessor(args)
+
+	resLogs := proc.ExtractDataFromLogs(logsAndEvents, &data.PreparedResults{}, 1000, core.MetachainShardId, 3)
+	require.Equal(t, map[string]*data.StakedKey{
+		"6b657931": {
+			BLSKey:        "6b657931",
+			Owner:         "64656c65676174696f6e",
+			Status:        StakedStatus,
+			TopUp:         "1000000000",
+			TopUpNum:      0.1,
+			Delegation:    "64656c65676174696f6e",
+			RewardAddress: "726577617264",
+			ShardID:       core.MetachainShardId,
+			Timestamp:     1000,
+		},
+	}, resLogs.StakedKeys)
+}
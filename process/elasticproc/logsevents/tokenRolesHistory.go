@@ -0,0 +1,62 @@
+package logsevents
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/multiversx/mx-chain-es-indexer-go/data"
+	"github.com/multiversx/mx-chain-es-indexer-go/process/elasticproc/converters"
+	"github.com/multiversx/mx-chain-es-indexer-go/process/elasticproc/tokeninfo"
+)
+
+// prepareTokenRolesHistory converts the roles changes of a block in documents for the token roles history. The
+// order of a change in the block is kept, so the changes with the same timestamp can be replayed in order
+func prepareTokenRolesHistory(rolesChanges []*tokeninfo.RoleChange, timestamp uint64, shardID uint32) []*data.TokenRole {
+	tokenRoles := make([]*data.TokenRole, 0, len(rolesChanges))
+	for order, roleChange := range rolesChanges {
+		action := data.RoleUnsetAction
+		if roleChange.Set {
+			action = data.RoleSetAction
+		}
+
+		tokenRoles = append(tokenRoles, &data.TokenRole{
+			ID:        fmt.Sprintf(eventIDFormat, roleChange.TxHash, shardID, order),
+			Token:     roleChange.Token,
+			Address:   roleChange.Address,
+			Role:      roleChange.Role,
+			Action:    action,
+			TxHash:    roleChange.TxHash,
+			ShardID:   shardID,
+			Order:     order,
+			Timestamp: time.Duration(timestamp),
+		})
+	}
+
+	return tokenRoles
+}
+
+// SerializeTokenRolesHistory will serialize the provided token roles changes in a way that Elasticsearch expects a bulk request
+func (lep *logsAndEventsProcessor) SerializeTokenRolesHistory(tokenRoles []*data.TokenRole, buffSlice *data.BufferSlice, index string) error {
+	for _, tokenRole := range tokenRoles {
+		meta := []byte(fmt.Sprintf(`{ "index" : { "_index":"%s", "_id" : "%s" } }%s`, index, converters.JsonEscape(tokenRole.ID), "\n"))
+		serializedData, err := json.Marshal(tokenRole)
+		if err != nil {
+			return err
+		}
+
+		err = buffSlice.PutData(meta, serializedData)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// PrepareTokenRolesHistoryQuery will prepare the query that returns the roles changes of a token up to the provided
+// timestamp, in the order they were executed
+func (lep *logsAndEventsProcessor) PrepareTokenRolesHistoryQuery(token string, timestamp uint64) []byte {
+	return []byte(fmt.Sprintf(`{"query": {"bool": {"must": [{"match": {"token": "%s"}},{"range": {"timestamp": {"lte": %d}}}]}},"sort": [{"timestamp": {"order": "asc"}},{"order": {"order": "asc"}}]}`,
+		converters.JsonEscape(token), timestamp))
+}
@@ -0,0 +1,77 @@
+package logsevents
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-es-indexer-go/data"
+	"github.com/multiversx/mx-chain-es-indexer-go/process/elasticproc/tokeninfo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrepareTokenRolesHistory(t *testing.T) {
+	t.Parallel()
+
+	tokenRolesAndProperties := tokeninfo.NewTokenRolesAndProperties()
+	tokenRolesAndProperties.AddRole("TKN-abcd", "alice", core.ESDTRoleLocalMint, true, "h1")
+	tokenRolesAndProperties.AddRole("TKN-abcd", "alice", core.ESDTRoleLocalMint, false, "h2")
+
+	tokenRoles := prepareTokenRolesHistory(tokenRolesAndProperties.GetRolesChanges(), 1000, 1)
+	require.Equal(t, []*data.TokenRole{
+		{
+			ID:        "h1-1-0",
+			Token:     "TKN-abcd",
+			Address:   "alice",
+			Role:      core.ESDTRoleLocalMint,
+			Action:    data.RoleSetAction,
+			TxHash:    "h1",
+			ShardID:   1,
+			Order:     0,
+			Timestamp: 1000,
+		},
+		{
+			ID:        "h2-1-1",
+			Token:     "TKN-abcd",
+			Address:   "alice",
+			Role:      core.ESDTRoleLocalMint,
+			Action:    data.RoleUnsetAction,
+			TxHash:    "h2",
+			ShardID:   1,
+			Order:     1,
+			Timestamp: 1000,
+		},
+	}, tokenRoles)
+}
+
+func TestLogsAndEventsProcessor_SerializeTokenRolesHistory(t *testing.T) {
+	t.Parallel()
+
+	tokenRoles := []*data.TokenRole{
+		{
+			ID:        "h1-1-0",
+			Token:     "TKN-abcd",
+			Address:   "alice",
+			Role:      core.ESDTRoleLocalMint,
+			Action:    data.RoleSetAction,
+			TxHash:    "h1",
+			ShardID:   1,
+			Timestamp: 1000,
+		},
+	}
+
+	buffSlice := data.NewBufferSlice(data.DefaultMaxBulkSize)
+	err := (&logsAndEventsProcessor{}).SerializeTokenRolesHistory(tokenRoles, buffSlice, "tokenroles")
+	require.Nil(t, err)
+
+	expectedRes := `{ "index" : { "_index":"tokenroles", "_id" : "h1-1-0" } }
+{"token":"TKN-abcd","address":"alice","role":"ESDTRoleLocalMint","action":"set","txHash":"h1","shardID":1,"order":0,"timestamp":1000}
+`
+	require.Equal(t, expectedRes, buffSlice.Buffers()[0].String())
+}
+
+func TestLogsAndEventsProcessor_PrepareTokenRolesHistoryQuery(t *testing.T) {
+	t.Parallel()
+
+	query := (&logsAndEventsProcessor{}).PrepareTokenRolesHistoryQuery("TKN-abcd", 1000)
+	require.Equal(t, `{"query": {"bool": {"must": [{"match": {"token": "TKN-abcd"}},{"range": {"timestamp": {"lte": 1000}}}]}},"sort": [{"timestamp": {"order": "asc"}},{"order": {"order": "asc"}}]}`, string(query))
+}
@@ -0,0 +1,139 @@
+package logsevents
+
+import (
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/multiversx/mx-chain-es-indexer-go/data"
+	"github.com/multiversx/mx-chain-es-indexer-go/process/dataindexer"
+	"github.com/multiversx/mx-chain-es-indexer-go/process/elasticproc/converters"
+)
+
+type supplyChange struct {
+	token         string
+	identifier    string
+	nonce         uint64
+	initialSupply *big.Int
+	minted        *big.Int
+	burned        *big.Int
+	wiped         *big.Int
+	hasQuantity   bool
+}
+
+type tokensSupplyChanges struct {
+	changes map[string]*supplyChange
+}
+
+func newTokensSupplyChanges() *tokensSupplyChanges {
+	return &tokensSupplyChanges{
+		changes: make(map[string]*supplyChange),
+	}
+}
+
+func (tsc *tokensSupplyChanges) addInitialSupply(token string, value *big.Int) {
+	change := tsc.getOrCreate(token, 0)
+	change.initialSupply.Add(change.initialSupply, value)
+}
+
+// addMinted will add the minted value both on the token and, for a token nonce, on the nonce
+func (tsc *tokensSupplyChanges) addMinted(token string, nonce uint64, value *big.Int) {
+	for _, change := range tsc.getTokenAndNonceChanges(token, nonce) {
+		change.minted.Add(change.minted, value)
+	}
+}
+
+// addBurned will add the burned value both on the token and, for a token nonce, on the nonce
+func (tsc *tokensSupplyChanges) addBurned(token string, nonce uint64, value *big.Int) {
+	for _, change := range tsc.getTokenAndNonceChanges(token, nonce) {
+		change.burned.Add(change.burned, value)
+	}
+}
+
+// addWiped will add the wiped value both on the token and, for a token nonce, on the nonce
+func (tsc *tokensSupplyChanges) addWiped(token string, nonce uint64, value *big.Int) {
+	for _, change := range tsc.getTokenAndNonceChanges(token, nonce) {
+		change.wiped.Add(change.wiped, value)
+	}
+}
+
+// markQuantity will mark that the token nonce holds more than one unit, so it is not a non-fungible token
+func (tsc *tokensSupplyChanges) markQuantity(token string, nonce uint64) {
+	tsc.getOrCreate(token, nonce).hasQuantity = true
+}
+
+func (tsc *tokensSupplyChanges) getTokenAndNonceChanges(token string, nonce uint64) []*supplyChange {
+	changes := []*supplyChange{tsc.getOrCreate(token, 0)}
+	if nonce != 0 {
+		changes = append(changes, tsc.getOrCreate(token, nonce))
+	}
+
+	return changes
+}
+
+func (tsc *tokensSupplyChanges) getOrCreate(token string, nonce uint64) *supplyChange {
+	key := token
+	identifier := converters.ComputeTokenIdentifier(token, nonce)
+	if identifier != "" {
+		key = identifier
+	}
+
+	change, found := tsc.changes[key]
+	if found {
+		return change
+	}
+
+	change = &supplyChange{
+		token:         token,
+		identifier:    identifier,
+		nonce:         nonce,
+		initialSupply: big.NewInt(0),
+		minted:        big.NewInt(0),
+		burned:        big.NewInt(0),
+		wiped:         big.NewInt(0),
+	}
+	tsc.changes[key] = change
+
+	return change
+}
+
+// getAll will return all the supply changes, sorted by key, converted in the structure that is stored in the database
+func (tsc *tokensSupplyChanges) getAll(timestamp uint64, shardID uint32, balanceConverter dataindexer.BalanceConverter) []*data.TokenSupplyChange {
+	keys := make([]string, 0, len(tsc.changes))
+	for key := range tsc.changes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	dbChanges := make([]*data.TokenSupplyChange, 0, len(keys))
+	for _, key := range keys {
+		change := tsc.changes[key]
+		dbChanges = append(dbChanges, &data.TokenSupplyChange{
+			Token:            change.token,
+			Identifier:       change.identifier,
+			Nonce:            change.nonce,
+			InitialSupply:    change.initialSupply.String(),
+			InitialSupplyNum: convertSupplyValueToFloat(balanceConverter, change.initialSupply),
+			Minted:           change.minted.String(),
+			MintedNum:        convertSupplyValueToFloat(balanceConverter, change.minted),
+			Burned:           change.burned.String(),
+			BurnedNum:        convertSupplyValueToFloat(balanceConverter, change.burned),
+			Wiped:            change.wiped.String(),
+			WipedNum:         convertSupplyValueToFloat(balanceConverter, change.wiped),
+			ShardID:          shardID,
+			Timestamp:        time.Duration(timestamp),
+			HasQuantity:      change.hasQuantity,
+		})
+	}
+
+	return dbChanges
+}
+
+func convertSupplyValueToFloat(balanceConverter dataindexer.BalanceConverter, value *big.Int) float64 {
+	valueNum, err := balanceConverter.ConvertBigValueToFloat(value)
+	if err != nil {
+		log.Warn("tokensSupplyChanges: cannot convert supply value to float", "value", value.String(), "error", err)
+	}
+
+	return valueNum
+}
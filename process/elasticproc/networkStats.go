@@ -0,0 +1,143 @@
+package elasticproc
+
+import (
+	"context"
+
+	coreData "github.com/multiversx/mx-chain-core-go/data"
+
+	"github.com/multiversx/mx-chain-es-indexer-go/core/request"
+	"github.com/multiversx/mx-chain-es-indexer-go/data"
+	elasticIndexer "github.com/multiversx/mx-chain-es-indexer-go/process/dataindexer"
+)
+
+func (ei *elasticProcessor) indexStats(
+	preparedResults *data.PreparedResults,
+	transfersList []*data.Transfer,
+	header coreData.HeaderHandler,
+	buffSlice *data.BufferSlice,
+) error {
+	if !ei.isIndexEnabled(elasticIndexer.StatsIndex) {
+		return nil
+	}
+
+	statsChanges := ei.statsProc.PrepareStatsChanges(preparedResults, transfersList, header)
+
+	return ei.statsProc.SerializeStatsChanges(statsChanges, buffSlice, elasticIndexer.StatsIndex)
+}
+
+// finalizeDayStats computes the accounts counters of the previous day when the first block of a day is indexed. After a
+// restart they are computed again, which is harmless because they are overwritten
+func (ei *elasticProcessor) finalizeDayStats(header coreData.HeaderHandler, buffSlice *data.BufferSlice) error {
+	if !ei.isIndexEnabled(elasticIndexer.StatsIndex) {
+		return nil
+	}
+
+	if !ei.isNewDay(header) {
+		return nil
+	}
+
+	dayStart := ei.statsProc.GetDayStart(header.GetTimeStamp())
+	previousDayStart := ei.statsProc.GetDayStart(dayStart - 1)
+	counters, err := ei.getStatsAccountsCounters(header.GetShardID(), previousDayStart, dayStart)
+	if err != nil {
+		return err
+	}
+
+	return ei.statsProc.SerializeFinalizedDayStats(previousDayStart, header.GetShardID(), header.GetTimeStamp(), counters, buffSlice, elasticIndexer.StatsIndex)
+}
+
+// isNewDay returns true for the first block of a day indexed by the current process
+func (ei *elasticProcessor) isNewDay(header coreData.HeaderHandler) bool {
+	return ei.statsProc.GetDayStart(header.GetTimeStamp()) != ei.dayStart
+}
+
+// setDayStart has to be called after the block was indexed, so that the work done when a day ends is done again if the
+// block is retried
+func (ei *elasticProcessor) setDayStart(header coreData.HeaderHandler) {
+	ei.dayStart = ei.statsProc.GetDayStart(header.GetTimeStamp())
+}
+
+// finalizeEpochStats marks as final the statistics of the previous epoch when the epoch start block is indexed
+func (ei *elasticProcessor) finalizeEpochStats(header coreData.HeaderHandler, buffSlice *data.BufferSlice) error {
+	shouldFinalize := ei.isIndexEnabled(elasticIndexer.StatsIndex) && header.IsStartOfEpochBlock() && header.GetEpoch() > 0
+	if !shouldFinalize {
+		return nil
+	}
+
+	epoch := header.GetEpoch() - 1
+	startTimestamp, err := ei.getEpochStatsStartTimestamp(epoch, header.GetShardID())
+	if err != nil {
+		return err
+	}
+
+	counters := &data.StatsAccountsCounters{}
+	if startTimestamp > 0 {
+		counters, err = ei.getStatsAccountsCounters(header.GetShardID(), startTimestamp, header.GetTimeStamp())
+		if err != nil {
+			return err
+		}
+	}
+
+	return ei.statsProc.SerializeFinalizedEpochStats(epoch, header.GetShardID(), header.GetTimeStamp(), counters, buffSlice, elasticIndexer.StatsIndex)
+}
+
+// getEpochStatsStartTimestamp returns 0 if the epoch had no statistics document
+func (ei *elasticProcessor) getEpochStatsStartTimestamp(epoch uint32, shardID uint32) (uint64, error) {
+	ctxWithValue := context.WithValue(context.Background(), request.ContextKey, request.ExtendTopicWithShardID(request.GetTopic, shardID))
+	responseStats := &data.ResponseStats{}
+	err := ei.elasticClient.DoMultiGet(ctxWithValue, []string{ei.statsProc.GetEpochStatsID(epoch, shardID)}, elasticIndexer.StatsIndex, true, responseStats)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, doc := range responseStats.Docs {
+		if doc.Found {
+			return doc.Source.StartTimestamp, nil
+		}
+	}
+
+	return 0, nil
+}
+
+// getStatsAccountsCounters counts the distinct senders and receivers from the transactions index and the new accounts
+// from the accounts index, a counter is zero if its index is disabled
+func (ei *elasticProcessor) getStatsAccountsCounters(shardID uint32, startTimestamp uint64, endTimestamp uint64) (*data.StatsAccountsCounters, error) {
+	ctxWithValue := context.WithValue(context.Background(), request.ContextKey, request.ExtendTopicWithShardID(request.GetTopic, shardID))
+	counters := &data.StatsAccountsCounters{}
+
+	if ei.isIndexEnabled(elasticIndexer.TransactionsIndex) {
+		responseAddresses := &data.ResponseDistinctAddresses{}
+		query := ei.statsProc.PrepareDistinctAddressesQuery(shardID, startTimestamp, endTimestamp)
+		err := ei.elasticClient.DoSearchRequest(ctxWithValue, elasticIndexer.TransactionsIndex, query, responseAddresses)
+		if err != nil {
+			return nil, err
+		}
+
+		counters.DistinctSenders = responseAddresses.Aggregations.Senders.Addresses.Value
+		counters.DistinctReceivers = responseAddresses.Aggregations.Receivers.Addresses.Value
+	}
+
+	if ei.isIndexEnabled(elasticIndexer.AccountsIndex) {
+		responseAccounts := &data.ResponseHitsCount{}
+		query := ei.statsProc.PrepareNewAccountsQuery(shardID, startTimestamp, endTimestamp)
+		err := ei.elasticClient.DoSearchRequest(ctxWithValue, elasticIndexer.AccountsIndex, query, responseAccounts)
+		if err != nil {
+			return nil, err
+		}
+
+		counters.NewAccounts = responseAccounts.Hits.Total.Value
+	}
+
+	return counters, nil
+}
+
+func (ei *elasticProcessor) revertStats(header coreData.HeaderHandler) error {
+	if !ei.isIndexEnabled(elasticIndexer.StatsIndex) {
+		return nil
+	}
+
+	ctxWithValue := context.WithValue(context.Background(), request.ContextKey, request.ExtendTopicWithShardID(request.UpdateTopic, header.GetShardID()))
+	query := ei.statsProc.PrepareStatsQueryInCaseOfRevert(header.GetTimeStamp(), header.GetShardID())
+
+	return ei.elasticClient.UpdateByQuery(ctxWithValue, elasticIndexer.StatsIndex, query)
+}
@@ -0,0 +1,187 @@
+package networkstats
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-es-indexer-go/data"
+	"github.com/multiversx/mx-chain-es-indexer-go/process/elasticproc/converters"
+)
+
+// statsFields holds the counters, serialized as a painless parameter, that are changed by every block
+const statsFields = `["txsCount","scrsCount","gasUsed","esdtTransfersCount"]`
+
+// SerializeStatsChanges will serialize the provided statistics changes in a way that Elasticsearch expects a bulk request
+func (sp *statsProcessor) SerializeStatsChanges(statsChanges []*data.StatsChange, buffSlice *data.BufferSlice, index string) error {
+	for _, statsChange := range statsChanges {
+		meta, serializedData, err := prepareSerializedStatsChange(statsChange, index)
+		if err != nil {
+			return err
+		}
+
+		err = buffSlice.PutData(meta, serializedData)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func prepareSerializedStatsChange(statsChange *data.StatsChange, index string) ([]byte, []byte, error) {
+	meta := []byte(fmt.Sprintf(`{ "update" : { "_index":"%s", "_id" : "%s" } }%s`, index, converters.JsonEscape(statsChange.ID), "\n"))
+
+	serializedChange, err := json.Marshal(statsChange)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	stats := newStatsDocument(statsChange.Type, statsChange.Day, statsChange.Epoch, statsChange.ShardID)
+	stats.StartTimestamp = statsChange.Timestamp
+	serializedStats, err := json.Marshal(stats)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	codeToExecute := `
+		if ('create' == ctx.op) {
+			ctx._source = params.stats;
+		}
+` + converters.ApplyBlockChangeScript("statsChanges", `
+		for (String field : params.fields) {
+			long total = ctx._source.containsKey(field) ? ctx._source[field] : 0;
+			ctx._source[field] = total + params.change[field];
+		}
+		ctx._source.fees = new BigInteger(ctx._source.fees).add(new BigInteger(params.change.fees)).toString();
+		ctx._source.feesNum = ctx._source.feesNum + params.change.feesNum;
+		if (!ctx._source.containsKey('timestamp') || ctx._source.timestamp < params.change.timestamp) {
+			ctx._source.timestamp = params.change.timestamp;
+		}
+`)
+	serializedDataStr := fmt.Sprintf(`{"scripted_upsert": true, "script": {`+
+		`"source": "%s",`+
+		`"lang": "painless",`+
+		`"params": { "stats": %s, "change": %s, "fields": %s, "maxChanges": %d }},`+
+		`"upsert": {}}`,
+		converters.FormatPainlessSource(codeToExecute), string(serializedStats), string(serializedChange), statsFields, converters.MaxBlockChangesInDocument,
+	)
+
+	return meta, []byte(serializedDataStr), nil
+}
+
+// SerializeFinalizedDayStats will serialize the update that marks as final the statistics of the day that ended and sets
+// its accounts counters. A day without statistics document had no activity, so the document is not created
+func (sp *statsProcessor) SerializeFinalizedDayStats(
+	dayStart uint64,
+	shardID uint32,
+	timestamp uint64,
+	counters *data.StatsAccountsCounters,
+	buffSlice *data.BufferSlice,
+	index string,
+) error {
+	id := computeDayStatsID(formatDay(dayStart), shardID)
+
+	return serializeFinalizedStats(id, nil, timestamp, counters, buffSlice, index)
+}
+
+// SerializeFinalizedEpochStats will serialize the update that marks as final the statistics of the epoch that ended and
+// sets its accounts counters
+func (sp *statsProcessor) SerializeFinalizedEpochStats(
+	epoch uint32,
+	shardID uint32,
+	timestamp uint64,
+	counters *data.StatsAccountsCounters,
+	buffSlice *data.BufferSlice,
+	index string,
+) error {
+	stats := newStatsDocument(EpochStatsType, "", epoch, shardID)
+
+	return serializeFinalizedStats(sp.GetEpochStatsID(epoch, shardID), stats, timestamp, counters, buffSlice, index)
+}
+
+// serializeFinalizedStats will not create the document if the provided statistics are nil
+func serializeFinalizedStats(
+	id string,
+	stats *data.Stats,
+	timestamp uint64,
+	counters *data.StatsAccountsCounters,
+	buffSlice *data.BufferSlice,
+	index string,
+) error {
+	meta := []byte(fmt.Sprintf(`{ "update" : { "_index":"%s", "_id" : "%s" } }%s`, index, converters.JsonEscape(id), "\n"))
+
+	serializedStats, err := json.Marshal(stats)
+	if err != nil {
+		return err
+	}
+
+	serializedCounters, err := json.Marshal(counters)
+	if err != nil {
+		return err
+	}
+
+	codeToExecute := `
+		if ('create' == ctx.op) {
+			if (params.stats == null) {
+				ctx.op = 'noop';
+				return;
+			}
+			ctx._source = params.stats;
+		}
+		ctx._source.finalized = true;
+		ctx._source.endTimestamp = params.timestamp;
+		for (def counter : params.counters.entrySet()) {
+			ctx._source[counter.getKey()] = counter.getValue();
+		}
+`
+	serializedDataStr := fmt.Sprintf(`{"scripted_upsert": true, "script": {`+
+		`"source": "%s",`+
+		`"lang": "painless",`+
+		`"params": { "stats": %s, "timestamp": %d, "counters": %s }},`+
+		`"upsert": {}}`,
+		converters.FormatPainlessSource(codeToExecute), string(serializedStats), timestamp, string(serializedCounters),
+	)
+
+	return buffSlice.PutData(meta, []byte(serializedDataStr))
+}
+
+// PrepareStatsQueryInCaseOfRevert will prepare the query that subtracts the statistics changes of a reverted block
+func (sp *statsProcessor) PrepareStatsQueryInCaseOfRevert(timestamp uint64, shardID uint32) *bytes.Buffer {
+	codeToExecute := converters.RevertBlockChangeScript("statsChanges", `
+			for (String field : params.fields) {
+				ctx._source[field] = ctx._source[field] - change[field];
+			}
+			ctx._source.fees = new BigInteger(ctx._source.fees).subtract(new BigInteger(change.fees)).toString();
+			ctx._source.feesNum = ctx._source.feesNum - change.feesNum;
+`)
+
+	query := fmt.Sprintf(`
+	{
+	  "query": {
+		"bool": {
+		  "must": [
+			{"match": {"statsChanges.timestamp": "%d"}},
+			{"match": {"statsChanges.shardID": %d}}
+		  ]
+		}
+	  },
+	  "script": {
+		"source": "%s",
+		"lang": "painless",
+		"params": {"timestamp": %d, "shardID": %d, "fields": %s}
+	  }
+	}`, timestamp, shardID, converters.FormatPainlessSource(codeToExecute), timestamp, shardID, statsFields)
+
+	return bytes.NewBuffer([]byte(query))
+}
+
+func newStatsDocument(statsType string, day string, epoch uint32, shardID uint32) *data.Stats {
+	return &data.Stats{
+		Type:    statsType,
+		Day:     day,
+		Epoch:   epoch,
+		ShardID: shardID,
+		Fees:    "0",
+	}
+}
@@ -0,0 +1,67 @@
+package networkstats
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/multiversx/mx-chain-es-indexer-go/data"
+)
+
+func TestStatsProcessor_SerializeStatsChanges(t *testing.T) {
+	t.Parallel()
+
+	statsChanges := []*data.StatsChange{
+		{
+			ID:        "epoch-7-1",
+			Type:      EpochStatsType,
+			Epoch:     7,
+			TxsCount:  2,
+			Fees:      "15",
+			FeesNum:   0.5,
+			ShardID:   1,
+			Timestamp: 100,
+		},
+	}
+
+	buffSlice := data.NewBufferSlice(data.DefaultMaxBulkSize)
+	err := createStatsProcessor().SerializeStatsChanges(statsChanges, buffSlice, "stats")
+	require.Nil(t, err)
+	require.Equal(t, `{ "update" : { "_index":"stats", "_id" : "epoch-7-1" } }
+{"scripted_upsert": true, "script": {"source": "if ('create' == ctx.op) {ctx._source = params.stats;}if (!ctx._source.containsKey('statsChanges')) {ctx._source.statsChanges = [];}for (def change : ctx._source.statsChanges) {if (change.timestamp == params.change.timestamp && change.shardID == params.change.shardID) {ctx.op = 'noop';return;}}Map blockChange = new HashMap(params.change);for (String field : params.fields) {long total = ctx._source.containsKey(field) ? ctx._source[field] : 0;ctx._source[field] = total + params.change[field];}ctx._source.fees = new BigInteger(ctx._source.fees).add(new BigInteger(params.change.fees)).toString();ctx._source.feesNum = ctx._source.feesNum + params.change.feesNum;if (!ctx._source.containsKey('timestamp') || ctx._source.timestamp < params.change.timestamp) {ctx._source.timestamp = params.change.timestamp;}ctx._source.statsChanges.add(blockChange);if (ctx._source.statsChanges.length > params.maxChanges) {ctx._source.statsChanges.remove(0);}","lang": "painless","params": { "stats": {"type":"epoch","epoch":7,"shardID":1,"fees":"0","feesNum":0,"startTimestamp":100}, "change": {"txsCount":2,"scrsCount":0,"fees":"15","feesNum":0.5,"gasUsed":0,"esdtTransfersCount":0,"shardID":1,"timestamp":100}, "fields": ["txsCount","scrsCount","gasUsed","esdtTransfersCount"], "maxChanges": 100 }},"upsert": {}}
+`, buffSlice.Buffers()[0].String())
+}
+
+func TestStatsProcessor_SerializeFinalizedEpochStats(t *testing.T) {
+	t.Parallel()
+
+	counters := &data.StatsAccountsCounters{DistinctSenders: 3, DistinctReceivers: 5, NewAccounts: 2}
+	buffSlice := data.NewBufferSlice(data.DefaultMaxBulkSize)
+	err := createStatsProcessor().SerializeFinalizedEpochStats(6, 1, 100, counters, buffSlice, "stats")
+	require.Nil(t, err)
+	require.Equal(t, `{ "update" : { "_index":"stats", "_id" : "epoch-6-1" } }
+{"scripted_upsert": true, "script": {"source": "if ('create' == ctx.op) {if (params.stats == null) {ctx.op = 'noop';return;}ctx._source = params.stats;}ctx._source.finalized = true;ctx._source.endTimestamp = params.timestamp;for (def counter : params.counters.entrySet()) {ctx._source[counter.getKey()] = counter.getValue();}","lang": "painless","params": { "stats": {"type":"epoch","epoch":6,"shardID":1,"fees":"0","feesNum":0}, "timestamp": 100, "counters": {"distinctSenders":3,"distinctReceivers":5,"newAccounts":2} }},"upsert": {}}
+`, buffSlice.Buffers()[0].String())
+}
+
+func TestStatsProcessor_SerializeFinalizedDayStats(t *testing.T) {
+	t.Parallel()
+
+	counters := &data.StatsAccountsCounters{DistinctSenders: 3}
+	buffSlice := data.NewBufferSlice(data.DefaultMaxBulkSize)
+	err := createStatsProcessor().SerializeFinalizedDayStats(1792195200, 1, 1792281606, counters, buffSlice, "stats")
+	require.Nil(t, err)
+
+	bulk := buffSlice.Buffers()[0].String()
+	require.Contains(t, bulk, `{ "update" : { "_index":"stats", "_id" : "day-2026-10-17-1" } }`)
+	require.Contains(t, bulk, `"params": { "stats": null, "timestamp": 1792281606, "counters": {"distinctSenders":3,"distinctReceivers":0,"newAccounts":0} }`)
+}
+
+func TestStatsProcessor_PrepareStatsQueryInCaseOfRevert(t *testing.T) {
+	t.Parallel()
+
+	query := createStatsProcessor().PrepareStatsQueryInCaseOfRevert(100, 1).String()
+	require.Contains(t, query, `{"match": {"statsChanges.timestamp": "100"}}`)
+	require.Contains(t, query, `{"match": {"statsChanges.shardID": 1}}`)
+	require.Contains(t, query, `"params": {"timestamp": 100, "shardID": 1, "fields": ["txsCount","scrsCount","gasUsed","esdtTransfersCount"]}`)
+}
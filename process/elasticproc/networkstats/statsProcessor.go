@@ -0,0 +1,197 @@
+package networkstats
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	coreData "github.com/multiversx/mx-chain-core-go/data"
+	logger "github.com/multiversx/mx-chain-logger-go"
+
+	"github.com/multiversx/mx-chain-es-indexer-go/data"
+	"github.com/multiversx/mx-chain-es-indexer-go/process/dataindexer"
+)
+
+const (
+	// DayStatsType is the type of the statistics documents computed for a day
+	DayStatsType = "day"
+	// EpochStatsType is the type of the statistics documents computed for an epoch
+	EpochStatsType = "epoch"
+
+	dayFormat    = "2006-01-02"
+	secondsInDay = 24 * 60 * 60
+	egldToken    = "EGLD"
+
+	// cardinalityPrecisionThreshold is the highest precision threshold of the cardinality aggregation
+	cardinalityPrecisionThreshold = 40000
+)
+
+var log = logger.GetOrCreate("indexer/process/networkstats")
+
+type statsProcessor struct {
+	balanceConverter dataindexer.BalanceConverter
+}
+
+// NewStatsProcessor will create a new instance of statsProcessor
+func NewStatsProcessor(balanceConverter dataindexer.BalanceConverter) (*statsProcessor, error) {
+	if check.IfNil(balanceConverter) {
+		return nil, dataindexer.ErrNilBalanceConverter
+	}
+
+	return &statsProcessor{
+		balanceConverter: balanceConverter,
+	}, nil
+}
+
+// PrepareStatsChanges will compute the changes of the day and epoch statistics of the current shard from the provided block data.
+// The accounts counters are not changed by the blocks, they are computed when the day or the epoch ends
+func (sp *statsProcessor) PrepareStatsChanges(
+	preparedResults *data.PreparedResults,
+	transfersList []*data.Transfer,
+	header coreData.HeaderHandler,
+) []*data.StatsChange {
+	if check.IfNil(header) {
+		return nil
+	}
+
+	shardID := header.GetShardID()
+	timestamp := header.GetTimeStamp()
+	day := formatDay(timestamp)
+
+	dayChange := &data.StatsChange{
+		ID:        computeDayStatsID(day, shardID),
+		Type:      DayStatsType,
+		Day:       day,
+		Epoch:     header.GetEpoch(),
+		ShardID:   shardID,
+		Timestamp: timestamp,
+	}
+	sp.addTransactionsAndSCRs(dayChange, preparedResults)
+	dayChange.ESDTTransfersCount = countESDTTransfers(transfersList)
+	if isEmptyStatsChange(dayChange) {
+		return nil
+	}
+
+	epochChange := *dayChange
+	epochChange.ID = sp.GetEpochStatsID(header.GetEpoch(), shardID)
+	epochChange.Type = EpochStatsType
+	epochChange.Day = ""
+
+	return []*data.StatsChange{dayChange, &epochChange}
+}
+
+// GetDayStart returns the timestamp of the start of the day of the provided timestamp
+func (sp *statsProcessor) GetDayStart(timestamp uint64) uint64 {
+	return timestamp - timestamp%secondsInDay
+}
+
+// GetEpochStatsID returns the identifier of the statistics document of the provided epoch
+func (sp *statsProcessor) GetEpochStatsID(epoch uint32, shardID uint32) string {
+	return fmt.Sprintf("%s-%d-%d", EpochStatsType, epoch, shardID)
+}
+
+// PrepareDistinctAddressesQuery will prepare the query that counts the distinct senders and receivers of the transactions
+// of the provided shard and interval. The counts are exact up to the precision threshold and approximate above it
+func (sp *statsProcessor) PrepareDistinctAddressesQuery(shardID uint32, startTimestamp uint64, endTimestamp uint64) []byte {
+	query := fmt.Sprintf(`{
+		"size": 0,
+		"query": {"range": {"timestamp": {"gte": %d, "lt": %d}}},
+		"aggs": {
+			"senders": {
+				"filter": {"term": {"senderShard": %d}},
+				"aggs": {"addresses": {"cardinality": {"field": "sender", "precision_threshold": %d}}}
+			},
+			"receivers": {
+				"filter": {"term": {"receiverShard": %d}},
+				"aggs": {"addresses": {"cardinality": {"field": "receiver", "precision_threshold": %d}}}
+			}
+		}
+	}`, startTimestamp, endTimestamp, shardID, cardinalityPrecisionThreshold, shardID, cardinalityPrecisionThreshold)
+
+	return []byte(query)
+}
+
+// PrepareNewAccountsQuery will prepare the query that counts the accounts of the provided shard first seen in the provided interval
+func (sp *statsProcessor) PrepareNewAccountsQuery(shardID uint32, startTimestamp uint64, endTimestamp uint64) []byte {
+	query := fmt.Sprintf(`{
+		"size": 0,
+		"track_total_hits": true,
+		"query": {
+			"bool": {
+				"filter": [
+					{"term": {"shardID": %d}},
+					{"range": {"firstSeen": {"gte": %d, "lt": %d}}}
+				]
+			}
+		}
+	}`, shardID, startTimestamp, endTimestamp)
+
+	return []byte(query)
+}
+
+func formatDay(timestamp uint64) string {
+	return time.Unix(int64(timestamp), 0).UTC().Format(dayFormat)
+}
+
+func computeDayStatsID(day string, shardID uint32) string {
+	return fmt.Sprintf("%s-%s-%d", DayStatsType, day, shardID)
+}
+
+func (sp *statsProcessor) addTransactionsAndSCRs(statsChange *data.StatsChange, preparedResults *data.PreparedResults) {
+	fees := big.NewInt(0)
+	if preparedResults != nil {
+		for _, tx := range preparedResults.Transactions {
+			if tx.SenderShard != statsChange.ShardID {
+				continue
+			}
+
+			statsChange.TxsCount++
+			statsChange.GasUsed += tx.GasUsed
+
+			fee, ok := big.NewInt(0).SetString(tx.Fee, 10)
+			if ok {
+				fees.Add(fees, fee)
+			}
+		}
+
+		for _, scr := range preparedResults.ScResults {
+			if scr.SenderShard == statsChange.ShardID {
+				statsChange.ScrsCount++
+			}
+		}
+	}
+
+	feesNum, err := sp.balanceConverter.ConvertBigValueToFloat(fees)
+	if err != nil {
+		log.Warn("statsProcessor.addTransactionsAndSCRs cannot compute fees as num", "fees", fees, "error", err)
+	}
+
+	statsChange.Fees = fees.String()
+	statsChange.FeesNum = feesNum
+}
+
+func countESDTTransfers(transfersList []*data.Transfer) uint64 {
+	count := uint64(0)
+	for _, transfer := range transfersList {
+		// an incoming cross shard leg was already counted in the sender's shard
+		if transfer.Token == egldToken || transfer.IsIncoming {
+			continue
+		}
+
+		count++
+	}
+
+	return count
+}
+
+func isEmptyStatsChange(statsChange *data.StatsChange) bool {
+	return statsChange.TxsCount == 0 &&
+		statsChange.ScrsCount == 0 &&
+		statsChange.ESDTTransfersCount == 0
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sp *statsProcessor) IsInterfaceNil() bool {
+	return sp == nil
+}
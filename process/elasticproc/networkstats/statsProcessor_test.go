@@ -0,0 +1,127 @@
+package networkstats
+
+import (
+	"testing"
+
+	dataBlock "github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multiversx/mx-chain-es-indexer-go/data"
+	"github.com/multiversx/mx-chain-es-indexer-go/process/dataindexer"
+	"github.com/multiversx/mx-chain-es-indexer-go/process/elasticproc/converters"
+	"github.com/multiversx/mx-chain-es-indexer-go/process/elasticproc/transfers"
+)
+
+func createStatsProcessor() *statsProcessor {
+	balanceConverter, _ := converters.NewBalanceConverter(18)
+	sp, _ := NewStatsProcessor(balanceConverter)
+
+	return sp
+}
+
+func TestNewStatsProcessor(t *testing.T) {
+	t.Parallel()
+
+	sp, err := NewStatsProcessor(nil)
+	require.Nil(t, sp)
+	require.Equal(t, dataindexer.ErrNilBalanceConverter, err)
+
+	sp = createStatsProcessor()
+	require.NotNil(t, sp)
+	require.False(t, sp.IsInterfaceNil())
+}
+
+func TestStatsProcessor_PrepareStatsChanges(t *testing.T) {
+	t.Parallel()
+
+	sp := createStatsProcessor()
+
+	// the block is created on 2026-10-18 10:00:00 UTC
+	timestamp := uint64(1792317600)
+	header := &dataBlock.Header{TimeStamp: timestamp, Epoch: 7, ShardID: 1}
+
+	preparedResults := &data.PreparedResults{
+		Transactions: []*data.Transaction{
+			{Sender: "a1", SenderShard: 1, ReceiverShard: 1, Fee: "1000000000000000000", GasUsed: 50000},
+			{Sender: "a2", SenderShard: 1, ReceiverShard: 0, Fee: "500000000000000000", GasUsed: 70000},
+			{Sender: "a3", SenderShard: 0, ReceiverShard: 1, Fee: "1", GasUsed: 1},
+		},
+		ScResults: []*data.ScResult{
+			{SenderShard: 1, ReceiverShard: 1},
+			{SenderShard: 0, ReceiverShard: 1},
+		},
+	}
+	transfersList := []*data.Transfer{
+		{Token: "EGLD", Direction: transfers.IntraShardDirection},
+		{Token: "TKN-abcd", Direction: transfers.IntraShardDirection},
+		{Token: "TKN-abcd", Direction: transfers.CrossShardDirection},
+		{Token: "TKN-abcd", Direction: transfers.CrossShardDirection, IsIncoming: true},
+	}
+	statsChanges := sp.PrepareStatsChanges(preparedResults, transfersList, header)
+	require.Equal(t, []*data.StatsChange{
+		{
+			ID:                 "day-2026-10-18-1",
+			Type:               DayStatsType,
+			Day:                "2026-10-18",
+			Epoch:              7,
+			TxsCount:           2,
+			ScrsCount:          1,
+			Fees:               "1500000000000000000",
+			FeesNum:            1.5,
+			GasUsed:            120000,
+			ESDTTransfersCount: 2,
+			ShardID:            1,
+			Timestamp:          timestamp,
+		},
+		{
+			ID:                 "epoch-7-1",
+			Type:               EpochStatsType,
+			Epoch:              7,
+			TxsCount:           2,
+			ScrsCount:          1,
+			Fees:               "1500000000000000000",
+			FeesNum:            1.5,
+			GasUsed:            120000,
+			ESDTTransfersCount: 2,
+			ShardID:            1,
+			Timestamp:          timestamp,
+		},
+	}, statsChanges)
+}
+
+func TestStatsProcessor_PrepareStatsChangesOfEmptyBlock(t *testing.T) {
+	t.Parallel()
+
+	sp := createStatsProcessor()
+	header := &dataBlock.Header{TimeStamp: 1792317600, Epoch: 7, ShardID: 1}
+
+	statsChanges := sp.PrepareStatsChanges(&data.PreparedResults{}, nil, header)
+	require.Nil(t, statsChanges)
+
+	preparedResults := &data.PreparedResults{
+		Transactions: []*data.Transaction{{SenderShard: 1, Fee: "10"}},
+	}
+	statsChanges = sp.PrepareStatsChanges(preparedResults, nil, header)
+	require.Len(t, statsChanges, 2)
+	require.Equal(t, uint64(1), statsChanges[0].TxsCount)
+	require.Equal(t, uint64(1), statsChanges[1].TxsCount)
+}
+
+func TestStatsProcessor_AccountsCountersQueries(t *testing.T) {
+	t.Parallel()
+
+	sp := createStatsProcessor()
+	require.Equal(t, uint64(1792281600), sp.GetDayStart(1792317600))
+	require.Equal(t, "epoch-7-1", sp.GetEpochStatsID(7, 1))
+
+	query := string(sp.PrepareDistinctAddressesQuery(1, 1792195200, 1792281600))
+	require.Contains(t, query, `"query": {"range": {"timestamp": {"gte": 1792195200, "lt": 1792281600}}}`)
+	require.Contains(t, query, `"filter": {"term": {"senderShard": 1}}`)
+	require.Contains(t, query, `"aggs": {"addresses": {"cardinality": {"field": "sender", "precision_threshold": 40000}}}`)
+	require.Contains(t, query, `"filter": {"term": {"receiverShard": 1}}`)
+	require.Contains(t, query, `"aggs": {"addresses": {"cardinality": {"field": "receiver", "precision_threshold": 40000}}}`)
+
+	query = string(sp.PrepareNewAccountsQuery(1, 1792195200, 1792281600))
+	require.Contains(t, query, `{"term": {"shardID": 1}}`)
+	require.Contains(t, query, `{"range": {"firstSeen": {"gte": 1792195200, "lt": 1792281600}}}`)
+}
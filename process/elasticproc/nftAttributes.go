@@ -0,0 +1,49 @@
+package elasticproc
+
+import (
+	"strings"
+
+	"github.com/multiversx/mx-chain-es-indexer-go/data"
+)
+
+func (ei *elasticProcessor) decodeTokensAttributes(tokens []*data.TokenInfo) {
+	for _, token := range tokens {
+		if token.Data == nil {
+			continue
+		}
+
+		token.Attributes = ei.nftAttributesDecoder.Decode(token.Token, token.Data.Attributes)
+	}
+}
+
+func (ei *elasticProcessor) decodeAccountsESDTAttributes(accountsESDTMap map[string]*data.AccountInfo) {
+	for _, accountESDT := range accountsESDTMap {
+		if accountESDT.Data == nil {
+			continue
+		}
+
+		accountESDT.Attributes = ei.nftAttributesDecoder.Decode(accountESDT.TokenName, accountESDT.Data.Attributes)
+	}
+}
+
+func (ei *elasticProcessor) decodeNFTsDataUpdatesAttributes(updates []*data.NFTDataUpdate) {
+	for _, update := range updates {
+		collection := extractCollection(update.Identifier)
+		switch {
+		case update.NewMetaData != nil:
+			update.NewDecodedAttributes = ei.nftAttributesDecoder.Decode(collection, update.NewMetaData.Attributes)
+		case len(update.NewAttributes) > 0:
+			update.NewDecodedAttributes = ei.nftAttributesDecoder.Decode(collection, update.NewAttributes)
+		}
+	}
+}
+
+// extractCollection returns the collection of an NFT identifier, e.g. "COLL-abcdef-01" -> "COLL-abcdef"
+func extractCollection(identifier string) string {
+	lastSeparatorIndex := strings.LastIndex(identifier, "-")
+	if lastSeparatorIndex < 0 {
+		return identifier
+	}
+
+	return identifier[:lastSeparatorIndex]
+}
@@ -0,0 +1,144 @@
+package nftattributes
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/multiversx/mx-chain-es-indexer-go/data"
+)
+
+const (
+	// AutoDecoder will try all the known decoders, in order, and will keep the first result
+	AutoDecoder = "auto"
+	// JSONDecoder will decode the attributes as a JSON object or array
+	JSONDecoder = "json"
+	// Base64JSONDecoder will decode the attributes as a base64 encoded JSON object or array
+	Base64JSONDecoder = "base64-json"
+	// KeyValueDecoder will decode the attributes as a list of key-value pairs, e.g. "color:red;size=10"
+	KeyValueDecoder = "key-value"
+	// NoDecoder disables the attributes decoding
+	NoDecoder = "none"
+
+	defaultMaxFields      = 32
+	defaultMaxKeyLength   = 64
+	defaultMaxValueLength = 256
+)
+
+// ArgsAttributesDecoder holds all the components needed to create a new instance of attributesDecoder
+type ArgsAttributesDecoder struct {
+	MaxFields          int
+	MaxKeyLength       int
+	MaxValueLength     int
+	CollectionDecoders map[string]string
+}
+
+type attributesDecoder struct {
+	maxFields          int
+	maxKeyLength       int
+	maxValueLength     int
+	decoders           map[string][]decoder
+	collectionDecoders map[string][]decoder
+}
+
+// NewAttributesDecoder will create a new instance of attributesDecoder
+func NewAttributesDecoder(args ArgsAttributesDecoder) (*attributesDecoder, error) {
+	jsonDec := &jsonDecoder{}
+	base64JSONDec := &base64JSONDecoder{jsonDec: jsonDec}
+	keyValueDec := &keyValueDecoder{}
+
+	ad := &attributesDecoder{
+		maxFields:      valueOrDefault(args.MaxFields, defaultMaxFields),
+		maxKeyLength:   valueOrDefault(args.MaxKeyLength, defaultMaxKeyLength),
+		maxValueLength: valueOrDefault(args.MaxValueLength, defaultMaxValueLength),
+		decoders: map[string][]decoder{
+			AutoDecoder:       {jsonDec, base64JSONDec, keyValueDec},
+			JSONDecoder:       {jsonDec},
+			Base64JSONDecoder: {base64JSONDec},
+			KeyValueDecoder:   {keyValueDec},
+			NoDecoder:         {},
+		},
+		collectionDecoders: make(map[string][]decoder),
+	}
+
+	for collection, decoderName := range args.CollectionDecoders {
+		if collection == "" {
+			return nil, errEmptyCollection
+		}
+
+		decoders, found := ad.decoders[strings.ToLower(decoderName)]
+		if !found {
+			return nil, fmt.Errorf("%w: %s for collection %s", errUnknownDecoder, decoderName, collection)
+		}
+
+		ad.collectionDecoders[collection] = decoders
+	}
+
+	return ad, nil
+}
+
+// Decode will decode the provided NFT attributes using the decoders configured for the collection. It returns nil if
+// the attributes could not be decoded
+func (ad *attributesDecoder) Decode(collection string, attributes []byte) []*data.NFTAttribute {
+	if len(attributes) == 0 {
+		return nil
+	}
+
+	decoders, found := ad.collectionDecoders[collection]
+	if !found {
+		decoders = ad.decoders[AutoDecoder]
+	}
+
+	for _, dec := range decoders {
+		decoded, ok := dec.decode(attributes)
+		if ok {
+			return ad.applyLimits(decoded)
+		}
+	}
+
+	return nil
+}
+
+func (ad *attributesDecoder) applyLimits(decoded []*attribute) []*data.NFTAttribute {
+	numFields := len(decoded)
+	if numFields > ad.maxFields {
+		numFields = ad.maxFields
+	}
+
+	nftAttributes := make([]*data.NFTAttribute, 0, numFields)
+	for _, attr := range decoded[:numFields] {
+		nftAttributes = append(nftAttributes, &data.NFTAttribute{
+			Key:      truncate(attr.key, ad.maxKeyLength),
+			Value:    truncate(attr.value, ad.maxValueLength),
+			ValueNum: attr.num,
+		})
+	}
+
+	return nftAttributes
+}
+
+func truncate(value string, maxLength int) string {
+	if len(value) <= maxLength {
+		return value
+	}
+
+	// do not split a multi-byte character
+	for maxLength > 0 && !utf8.RuneStart(value[maxLength]) {
+		maxLength--
+	}
+
+	return value[:maxLength]
+}
+
+func valueOrDefault(value int, defaultValue int) int {
+	if value <= 0 {
+		return defaultValue
+	}
+
+	return value
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ad *attributesDecoder) IsInterfaceNil() bool {
+	return ad == nil
+}
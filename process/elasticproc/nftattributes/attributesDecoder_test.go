@@ -0,0 +1,149 @@
+package nftattributes
+
+import (
+	"encoding/base64"
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-es-indexer-go/data"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgs() ArgsAttributesDecoder {
+	return ArgsAttributesDecoder{
+		MaxFields:      10,
+		MaxKeyLength:   20,
+		MaxValueLength: 30,
+	}
+}
+
+func floatPtr(value float64) *float64 {
+	return &value
+}
+
+func TestNewAttributesDecoder(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unknown decoder should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.CollectionDecoders = map[string]string{"COLL-abcdef": "xml"}
+		ad, err := NewAttributesDecoder(args)
+		require.Nil(t, ad)
+		require.True(t, errors.Is(err, errUnknownDecoder))
+	})
+
+	t.Run("empty collection should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.CollectionDecoders = map[string]string{"": JSONDecoder}
+		ad, err := NewAttributesDecoder(args)
+		require.Nil(t, ad)
+		require.Equal(t, errEmptyCollection, err)
+	})
+
+	t.Run("zero limits should use defaults", func(t *testing.T) {
+		t.Parallel()
+
+		ad, err := NewAttributesDecoder(ArgsAttributesDecoder{})
+		require.Nil(t, err)
+		require.False(t, ad.IsInterfaceNil())
+		require.Equal(t, defaultMaxFields, ad.maxFields)
+		require.Equal(t, defaultMaxKeyLength, ad.maxKeyLength)
+		require.Equal(t, defaultMaxValueLength, ad.maxValueLength)
+	})
+}
+
+func TestAttributesDecoder_Decode(t *testing.T) {
+	t.Parallel()
+
+	ad, _ := NewAttributesDecoder(createMockArgs())
+
+	t.Run("empty attributes", func(t *testing.T) {
+		t.Parallel()
+
+		require.Nil(t, ad.Decode("COLL-abcdef", nil))
+	})
+
+	t.Run("json object", func(t *testing.T) {
+		t.Parallel()
+
+		res := ad.Decode("COLL-abcdef", []byte(`{"level": 5, "color": "red", "stats": {"speed": "fast"}, "rare": true}`))
+		require.Equal(t, []*data.NFTAttribute{
+			{Key: "color", Value: "red"},
+			{Key: "level", Value: "5", ValueNum: floatPtr(5)},
+			{Key: "rare", Value: "true"},
+			{Key: "stats.speed", Value: "fast"},
+		}, res)
+	})
+
+	t.Run("json metadata with traits", func(t *testing.T) {
+		t.Parallel()
+
+		res := ad.Decode("COLL-abcdef", []byte(`{"description": "nft", "attributes": [{"trait_type": "Background", "value": "Blue"}, {"trait_type": "Power", "value": 7.5}]}`))
+		require.Equal(t, []*data.NFTAttribute{
+			{Key: "Background", Value: "Blue"},
+			{Key: "Power", Value: "7.5", ValueNum: floatPtr(7.5)},
+		}, res)
+	})
+
+	t.Run("base64 json", func(t *testing.T) {
+		t.Parallel()
+
+		encoded := base64.StdEncoding.EncodeToString([]byte(`[{"trait_type": "Hat", "value": "Cap"}]`))
+		res := ad.Decode("COLL-abcdef", []byte(encoded))
+		require.Equal(t, []*data.NFTAttribute{{Key: "Hat", Value: "Cap"}}, res)
+	})
+
+	t.Run("key-value", func(t *testing.T) {
+		t.Parallel()
+
+		res := ad.Decode("COLL-abcdef", []byte("tags:a,b;metadata:ipfsCID/1.json&size=10"))
+		require.Equal(t, []*data.NFTAttribute{
+			{Key: "tags", Value: "a,b"},
+			{Key: "metadata", Value: "ipfsCID/1.json"},
+			{Key: "size", Value: "10", ValueNum: floatPtr(10)},
+		}, res)
+	})
+
+	t.Run("unknown format", func(t *testing.T) {
+		t.Parallel()
+
+		require.Nil(t, ad.Decode("COLL-abcdef", []byte("https://ipfs.io/ipfs/cid")))
+		require.Nil(t, ad.Decode("COLL-abcdef", []byte{0xff, 0xfe, 0x00}))
+	})
+
+	t.Run("limits are applied", func(t *testing.T) {
+		t.Parallel()
+
+		limitedDecoder, _ := NewAttributesDecoder(ArgsAttributesDecoder{
+			MaxFields:      2,
+			MaxKeyLength:   3,
+			MaxValueLength: 4,
+		})
+		res := limitedDecoder.Decode("COLL-abcdef", []byte(`{"aaaaa": "bbbbbb", "c": "dăăă", "e": "f"}`))
+		require.Equal(t, []*data.NFTAttribute{
+			{Key: "aaa", Value: "bbbb"},
+			{Key: "c", Value: "dă"},
+		}, res)
+	})
+}
+
+func TestAttributesDecoder_DecodeWithCollectionOverride(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.CollectionDecoders = map[string]string{
+		"KV-abcdef":   KeyValueDecoder,
+		"NONE-abcdef": NoDecoder,
+	}
+	ad, _ := NewAttributesDecoder(args)
+
+	attributes := []byte(`{"color": "red"}`)
+	require.Nil(t, ad.Decode("KV-abcdef", attributes))
+	require.Nil(t, ad.Decode("NONE-abcdef", attributes))
+	require.Equal(t, []*data.NFTAttribute{{Key: "color", Value: "red"}}, ad.Decode("OTHER-abcdef", attributes))
+	require.Equal(t, []*data.NFTAttribute{{Key: "color", Value: "red"}}, ad.Decode("KV-abcdef", []byte("color=red")))
+}
@@ -0,0 +1,214 @@
+package nftattributes
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	maxDepth            = 3
+	keyValueSeparators  = ";&"
+	keyValueAssignments = ":="
+)
+
+// attribute is a decoded trait, before the limits are applied
+type attribute struct {
+	key   string
+	value string
+	num   *float64
+}
+
+type decoder interface {
+	decode(attributes []byte) ([]*attribute, bool)
+}
+
+type jsonDecoder struct{}
+
+func (jd *jsonDecoder) decode(attributes []byte) ([]*attribute, bool) {
+	if !isJSONPayload(attributes) {
+		return nil, false
+	}
+
+	jsonDec := json.NewDecoder(bytes.NewReader(attributes))
+	jsonDec.UseNumber()
+
+	var payload interface{}
+	err := jsonDec.Decode(&payload)
+	if err != nil {
+		return nil, false
+	}
+
+	// metadata standards keep the traits in an "attributes" array
+	object, isObject := payload.(map[string]interface{})
+	if isObject {
+		traits, hasTraits := object["attributes"].([]interface{})
+		if hasTraits {
+			payload = traits
+		}
+	}
+
+	decoded := make([]*attribute, 0)
+	flatten("", payload, 0, &decoded)
+
+	return decoded, len(decoded) > 0
+}
+
+func isJSONPayload(attributes []byte) bool {
+	trimmed := bytes.TrimSpace(attributes)
+
+	return len(trimmed) > 0 && (trimmed[0] == '{' || trimmed[0] == '[')
+}
+
+func flatten(key string, value interface{}, depth int, decoded *[]*attribute) {
+	switch typedValue := value.(type) {
+	case map[string]interface{}:
+		if depth >= maxDepth {
+			return
+		}
+		trait, isTrait := extractTrait(typedValue)
+		if isTrait {
+			*decoded = append(*decoded, trait)
+			return
+		}
+
+		keys := make([]string, 0, len(typedValue))
+		for k := range typedValue {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		for _, k := range keys {
+			flatten(joinKeys(key, k), typedValue[k], depth+1, decoded)
+		}
+	case []interface{}:
+		if depth >= maxDepth {
+			return
+		}
+		for _, element := range typedValue {
+			flatten(key, element, depth+1, decoded)
+		}
+	default:
+		attr, ok := newAttributeFromScalar(key, typedValue)
+		if ok {
+			*decoded = append(*decoded, attr)
+		}
+	}
+}
+
+// extractTrait handles the {"trait_type": "...", "value": ...} objects
+func extractTrait(object map[string]interface{}) (*attribute, bool) {
+	value, hasValue := object["value"]
+	if !hasValue {
+		return nil, false
+	}
+
+	for _, keyField := range []string{"trait_type", "key", "name"} {
+		key, ok := object[keyField].(string)
+		if ok && key != "" {
+			return newAttributeFromScalar(key, value)
+		}
+	}
+
+	return nil, false
+}
+
+func newAttributeFromScalar(key string, value interface{}) (*attribute, bool) {
+	if key == "" {
+		return nil, false
+	}
+
+	switch typedValue := value.(type) {
+	case string:
+		return newAttribute(key, typedValue), true
+	case json.Number:
+		return newAttribute(key, typedValue.String()), true
+	case bool:
+		return &attribute{key: key, value: strconv.FormatBool(typedValue)}, true
+	default:
+		return nil, false
+	}
+}
+
+func newAttribute(key string, value string) *attribute {
+	attr := &attribute{
+		key:   key,
+		value: value,
+	}
+
+	num, err := strconv.ParseFloat(value, 64)
+	if err == nil {
+		attr.num = &num
+	}
+
+	return attr
+}
+
+func joinKeys(prefix string, key string) string {
+	if prefix == "" {
+		return key
+	}
+
+	return prefix + "." + key
+}
+
+type base64JSONDecoder struct {
+	jsonDec *jsonDecoder
+}
+
+func (bd *base64JSONDecoder) decode(attributes []byte) ([]*attribute, bool) {
+	trimmed := strings.TrimSpace(string(attributes))
+	if len(trimmed) == 0 {
+		return nil, false
+	}
+
+	for _, encoding := range []*base64.Encoding{base64.StdEncoding, base64.RawStdEncoding, base64.URLEncoding, base64.RawURLEncoding} {
+		decodedBytes, err := encoding.DecodeString(trimmed)
+		if err != nil {
+			continue
+		}
+
+		return bd.jsonDec.decode(decodedBytes)
+	}
+
+	return nil, false
+}
+
+type keyValueDecoder struct{}
+
+func (kvd *keyValueDecoder) decode(attributes []byte) ([]*attribute, bool) {
+	if !utf8.Valid(attributes) || isJSONPayload(attributes) {
+		return nil, false
+	}
+
+	segments := strings.FieldsFunc(string(attributes), func(r rune) bool {
+		return strings.ContainsRune(keyValueSeparators, r)
+	})
+
+	decoded := make([]*attribute, 0, len(segments))
+	for _, segment := range segments {
+		if strings.TrimSpace(segment) == "" {
+			continue
+		}
+
+		assignmentIndex := strings.IndexAny(segment, keyValueAssignments)
+		if assignmentIndex <= 0 {
+			return nil, false
+		}
+
+		key := strings.TrimSpace(segment[:assignmentIndex])
+		value := strings.TrimSpace(segment[assignmentIndex+1:])
+		// a link is not a key-value pair, e.g. "https://..."
+		if key == "" || strings.HasPrefix(value, "//") {
+			return nil, false
+		}
+
+		decoded = append(decoded, newAttribute(key, value))
+	}
+
+	return decoded, len(decoded) > 0
+}
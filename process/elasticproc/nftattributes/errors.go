@@ -0,0 +1,7 @@
+package nftattributes
+
+import "errors"
+
+var errUnknownDecoder = errors.New("unknown nft attributes decoder")
+
+var errEmptyCollection = errors.New("empty collection in nft attributes decoder overrides")
@@ -0,0 +1,64 @@
+package elasticproc
+
+import (
+	"context"
+	"encoding/hex"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	coreData "github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-es-indexer-go/core/request"
+	"github.com/multiversx/mx-chain-es-indexer-go/data"
+	elasticIndexer "github.com/multiversx/mx-chain-es-indexer-go/process/dataindexer"
+)
+
+func (ei *elasticProcessor) indexNotarizedBlocks(obh *outport.OutportBlockWithHeader, buffSlice *data.BufferSlice) error {
+	if obh.Header.GetShardID() != core.MetachainShardId || len(obh.NotarizedHeadersHashes) == 0 {
+		return nil
+	}
+
+	metaBlockHash := hex.EncodeToString(obh.BlockData.HeaderHash)
+
+	return ei.blockProc.SerializeNotarizedBlocks(obh.NotarizedHeadersHashes, metaBlockHash, obh.Header.GetRound(), buffSlice, elasticIndexer.BlockIndex)
+}
+
+// linkNotarizingMetaBlock links the provided shard block with the metachain block that notarized it, if the metachain
+// block was indexed first
+func (ei *elasticProcessor) linkNotarizingMetaBlock(elasticBlock *data.Block) error {
+	metaBlockHash, metaBlockRound, err := ei.getNotarizingMetaBlock(elasticBlock.Hash, elasticBlock.ShardID)
+	if err != nil || metaBlockHash == "" {
+		return err
+	}
+
+	elasticBlock.NotarizedByMetaHash = metaBlockHash
+	elasticBlock.NotarizedAtRound = metaBlockRound
+	elasticBlock.Notarized = true
+
+	return nil
+}
+
+func (ei *elasticProcessor) revertNotarizedBlocks(header coreData.HeaderHandler, encodedHeaderHash string) error {
+	if !ei.isIndexEnabled(elasticIndexer.BlockIndex) || header.GetShardID() != core.MetachainShardId {
+		return nil
+	}
+
+	ctxWithValue := context.WithValue(context.Background(), request.ContextKey, request.ExtendTopicWithShardID(request.UpdateTopic, header.GetShardID()))
+	query := ei.blockProc.PrepareNotarizedBlocksQueryInCaseOfRevert(encodedHeaderHash)
+
+	return ei.elasticClient.UpdateByQuery(ctxWithValue, elasticIndexer.BlockIndex, query)
+}
+
+// SaveFinalizedBlock will mark as final the block with the provided hash
+func (ei *elasticProcessor) SaveFinalizedBlock(finalizedBlock *outport.FinalizedBlock) error {
+	if !ei.isIndexEnabled(elasticIndexer.BlockIndex) || len(finalizedBlock.HeaderHash) == 0 {
+		return nil
+	}
+
+	buffSlice := data.NewBufferSlice(ei.getBulkRequestMaxSize())
+	err := ei.blockProc.SerializeFinalizedBlock(hex.EncodeToString(finalizedBlock.HeaderHash), buffSlice, elasticIndexer.BlockIndex)
+	if err != nil {
+		return err
+	}
+
+	return ei.doBulkRequests(elasticIndexer.BlockIndex, buffSlice.Buffers(), finalizedBlock.ShardID)
+}
@@ -0,0 +1,85 @@
+package elasticproc
+
+import (
+	"context"
+
+	coreData "github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/outport"
+
+	"github.com/multiversx/mx-chain-es-indexer-go/core/request"
+	"github.com/multiversx/mx-chain-es-indexer-go/data"
+	elasticIndexer "github.com/multiversx/mx-chain-es-indexer-go/process/dataindexer"
+)
+
+func (ei *elasticProcessor) indexRelayerStats(
+	preparedResults *data.PreparedResults,
+	txHashStatusInfo map[string]*outport.StatusInfo,
+	header coreData.HeaderHandler,
+	buffSlice *data.BufferSlice,
+) error {
+	if !ei.isIndexEnabled(elasticIndexer.RelayersIndex) {
+		return nil
+	}
+
+	changes := ei.relayerStatsProc.PrepareRelayerStatsChanges(preparedResults, txHashStatusInfo, header.GetTimeStamp(), header.GetShardID())
+	if len(changes) == 0 {
+		return nil
+	}
+
+	existingUsers, err := ei.getExistingRelayerUsers(ei.relayerStatsProc.GetRelayerUsersIDs(changes), header.GetTimeStamp(), header.GetShardID())
+	if err != nil {
+		return err
+	}
+
+	ei.relayerStatsProc.AddUniqueUsers(changes, existingUsers)
+
+	err = ei.relayerStatsProc.SerializeRelayerUsers(changes, buffSlice, elasticIndexer.RelayerUsersIndex)
+	if err != nil {
+		return err
+	}
+
+	return ei.relayerStatsProc.SerializeRelayerStatsChanges(changes, buffSlice, elasticIndexer.RelayersIndex)
+}
+
+// getExistingRelayerUsers returns the users sponsored by the relayers before the provided block, the users first seen in
+// the block are not returned even if the block was already indexed
+func (ei *elasticProcessor) getExistingRelayerUsers(ids []string, timestamp uint64, shardID uint32) (map[string]struct{}, error) {
+	existingUsers := make(map[string]struct{})
+	if len(ids) == 0 {
+		return existingUsers, nil
+	}
+
+	ctxWithValue := context.WithValue(context.Background(), request.ContextKey, request.ExtendTopicWithShardID(request.GetTopic, shardID))
+	responseUsers := &data.ResponseRelayerUsers{}
+	err := ei.elasticClient.DoMultiGet(ctxWithValue, ids, elasticIndexer.RelayerUsersIndex, true, responseUsers)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, user := range responseUsers.Docs {
+		seenInBlock := user.Source.Timestamp == timestamp && user.Source.ShardID == shardID
+		if user.Found && !seenInBlock {
+			existingUsers[user.ID] = struct{}{}
+		}
+	}
+
+	return existingUsers, nil
+}
+
+func (ei *elasticProcessor) revertRelayerStats(header coreData.HeaderHandler) error {
+	if !ei.isIndexEnabled(elasticIndexer.RelayersIndex) {
+		return nil
+	}
+
+	ctxWithValue := context.WithValue(context.Background(), request.ContextKey, request.ExtendTopicWithShardID(request.UpdateTopic, header.GetShardID()))
+	query := ei.relayerStatsProc.PrepareRelayerStatsQueryInCaseOfRevert(header.GetTimeStamp(), header.GetShardID())
+	err := ei.elasticClient.UpdateByQuery(ctxWithValue, elasticIndexer.RelayersIndex, query)
+	if err != nil {
+		return err
+	}
+
+	ctxWithValue = context.WithValue(context.Background(), request.ContextKey, request.ExtendTopicWithShardID(request.RemoveTopic, header.GetShardID()))
+	query = ei.relayerStatsProc.PrepareRelayerUsersQueryInCaseOfRevert(header.GetTimeStamp(), header.GetShardID())
+
+	return ei.elasticClient.DoQueryRemove(ctxWithValue, elasticIndexer.RelayerUsersIndex, query)
+}
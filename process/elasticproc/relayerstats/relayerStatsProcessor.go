@@ -0,0 +1,171 @@
+package relayerstats
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	logger "github.com/multiversx/mx-chain-logger-go"
+
+	"github.com/multiversx/mx-chain-es-indexer-go/data"
+	"github.com/multiversx/mx-chain-es-indexer-go/process/dataindexer"
+)
+
+const dayFormat = "2006-01-02"
+
+var log = logger.GetOrCreate("indexer/process/relayerstats")
+
+type relayerStatsProcessor struct {
+	balanceConverter dataindexer.BalanceConverter
+}
+
+// NewRelayerStatsProcessor will create a new instance of relayerStatsProcessor
+func NewRelayerStatsProcessor(balanceConverter dataindexer.BalanceConverter) (*relayerStatsProcessor, error) {
+	if check.IfNil(balanceConverter) {
+		return nil, dataindexer.ErrNilBalanceConverter
+	}
+
+	return &relayerStatsProcessor{
+		balanceConverter: balanceConverter,
+	}, nil
+}
+
+// PrepareRelayerStatsChanges will aggregate the relayed v3 transactions per relayer and day. A transaction is counted only
+// in the shard of its sender, where the relayer pays the fee, so that the cross-shard transactions are not counted twice.
+// The cross-shard transactions that fail in the shard of their receiver are counted there only as failed transactions
+func (rsp *relayerStatsProcessor) PrepareRelayerStatsChanges(
+	preparedResults *data.PreparedResults,
+	txHashStatusInfo map[string]*outport.StatusInfo,
+	timestamp uint64,
+	shardID uint32,
+) []*data.RelayerStatsChange {
+	if preparedResults == nil {
+		return nil
+	}
+
+	day := time.Unix(int64(timestamp), 0).UTC().Format(dayFormat)
+	changes := make(map[string]*data.RelayerStatsChange)
+	fees := make(map[string]*big.Int)
+	users := make(map[string]map[string]struct{})
+	for _, tx := range preparedResults.Transactions {
+		if tx.RelayerShard == nil {
+			continue
+		}
+
+		isSenderShard := tx.SenderShard == shardID
+		failed := isFailed(tx.Hash, tx.Status, txHashStatusInfo)
+		failedAtDestination := !isSenderShard && tx.ReceiverShard == shardID && failed
+		if !isSenderShard && !failedAtDestination {
+			continue
+		}
+
+		change, found := changes[tx.RelayedAddr]
+		if !found {
+			change = &data.RelayerStatsChange{
+				ID:        ComputeRelayerStatsID(tx.RelayedAddr, day),
+				Relayer:   tx.RelayedAddr,
+				Day:       day,
+				ShardID:   shardID,
+				Timestamp: timestamp,
+			}
+			changes[tx.RelayedAddr] = change
+			fees[tx.RelayedAddr] = big.NewInt(0)
+			users[tx.RelayedAddr] = make(map[string]struct{})
+		}
+
+		if failed {
+			change.FailedTransactions++
+		}
+		if failedAtDestination {
+			continue
+		}
+
+		change.TransactionsCount++
+		change.GasUsed += tx.GasUsed
+
+		fee, ok := big.NewInt(0).SetString(tx.RelayerFee, 10)
+		if ok {
+			fees[tx.RelayedAddr].Add(fees[tx.RelayedAddr], fee)
+		}
+
+		_, userFound := users[tx.RelayedAddr][tx.Sender]
+		if !userFound {
+			users[tx.RelayedAddr][tx.Sender] = struct{}{}
+			change.Users = append(change.Users, tx.Sender)
+		}
+	}
+
+	result := make([]*data.RelayerStatsChange, 0, len(changes))
+	for relayer, change := range changes {
+		feeNum, err := rsp.balanceConverter.ConvertBigValueToFloat(fees[relayer])
+		if err != nil {
+			log.Warn("relayerStatsProcessor.PrepareRelayerStatsChanges cannot compute fee as num", "fee", fees[relayer], "relayer", relayer, "error", err)
+		}
+
+		change.Fee = fees[relayer].String()
+		change.FeeNum = feeNum
+		result = append(result, change)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].ID < result[j].ID
+	})
+
+	return result
+}
+
+// GetRelayerUsersIDs returns the ids of the documents that mark the users of the provided relayer statistics changes
+func (rsp *relayerStatsProcessor) GetRelayerUsersIDs(changes []*data.RelayerStatsChange) []string {
+	ids := make([]string, 0)
+	for _, change := range changes {
+		for _, user := range change.Users {
+			ids = append(ids, ComputeRelayerUserID(change.Relayer, change.Day, user))
+		}
+	}
+
+	return ids
+}
+
+// AddUniqueUsers will count for every change the users that were not sponsored by the relayer earlier in the same day
+func (rsp *relayerStatsProcessor) AddUniqueUsers(changes []*data.RelayerStatsChange, existingUsers map[string]struct{}) {
+	for _, change := range changes {
+		newUsers := make([]string, 0, len(change.Users))
+		for _, user := range change.Users {
+			_, exists := existingUsers[ComputeRelayerUserID(change.Relayer, change.Day, user)]
+			if !exists {
+				newUsers = append(newUsers, user)
+			}
+		}
+
+		change.Users = newUsers
+		change.UniqueUsers = uint64(len(newUsers))
+	}
+}
+
+// ComputeRelayerStatsID returns the id of the daily statistics document of a relayer
+func ComputeRelayerStatsID(relayer string, day string) string {
+	return fmt.Sprintf("%s-%s", relayer, day)
+}
+
+// ComputeRelayerUserID returns the id of the document that marks an address as sponsored by a relayer in a day
+func ComputeRelayerUserID(relayer string, day string, user string) string {
+	return fmt.Sprintf("%s-%s-%s", relayer, day, user)
+}
+
+func isFailed(hash string, status string, txHashStatusInfo map[string]*outport.StatusInfo) bool {
+	statusInfo, found := txHashStatusInfo[hash]
+	if found && statusInfo.Status != "" {
+		status = statusInfo.Status
+	}
+
+	return status == transaction.TxStatusFail.String() || status == transaction.TxStatusInvalid.String()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rsp *relayerStatsProcessor) IsInterfaceNil() bool {
+	return rsp == nil
+}
@@ -0,0 +1,145 @@
+package relayerstats
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multiversx/mx-chain-es-indexer-go/data"
+	"github.com/multiversx/mx-chain-es-indexer-go/process/dataindexer"
+	"github.com/multiversx/mx-chain-es-indexer-go/process/elasticproc/converters"
+)
+
+const (
+	relayerAddr  = "aaaa"
+	relayerAddr2 = "bbbb"
+	userAddr     = "cccc"
+	userAddr2    = "dddd"
+)
+
+func createRelayerStatsProcessor() *relayerStatsProcessor {
+	balanceConverter, _ := converters.NewBalanceConverter(18)
+	rsp, _ := NewRelayerStatsProcessor(balanceConverter)
+
+	return rsp
+}
+
+func TestNewRelayerStatsProcessor(t *testing.T) {
+	t.Parallel()
+
+	rsp, err := NewRelayerStatsProcessor(nil)
+	require.Nil(t, rsp)
+	require.Equal(t, dataindexer.ErrNilBalanceConverter, err)
+
+	rsp = createRelayerStatsProcessor()
+	require.NotNil(t, rsp)
+	require.False(t, rsp.IsInterfaceNil())
+}
+
+func TestRelayerStatsProcessor_PrepareRelayerStatsChanges(t *testing.T) {
+	t.Parallel()
+
+	// the block is created on 2026-10-18 10:00:00 UTC
+	timestamp := uint64(1792317600)
+	day := "2026-10-18"
+	shardOne := uint32(1)
+	shardTwo := uint32(2)
+	preparedResults := &data.PreparedResults{
+		Transactions: []*data.Transaction{
+			{Hash: "h1", Sender: userAddr, SenderShard: 1, RelayedAddr: relayerAddr, RelayerShard: &shardOne, RelayerFee: "100", GasUsed: 10, Status: transaction.TxStatusSuccess.String()},
+			{Hash: "h2", Sender: userAddr, SenderShard: 1, RelayedAddr: relayerAddr, RelayerShard: &shardOne, RelayerFee: "50", GasUsed: 5, Status: transaction.TxStatusSuccess.String()},
+			{Hash: "h3", Sender: userAddr2, SenderShard: 1, RelayedAddr: relayerAddr, RelayerShard: &shardOne, RelayerFee: "20", GasUsed: 2, Status: transaction.TxStatusInvalid.String()},
+			{Hash: "h4", Sender: userAddr2, SenderShard: 1, RelayedAddr: relayerAddr2, RelayerShard: &shardOne, RelayerFee: "30", GasUsed: 3},
+			{Hash: "h5", Sender: userAddr, SenderShard: 2, RelayedAddr: relayerAddr, RelayerShard: &shardTwo, RelayerFee: "40", GasUsed: 4},
+			{Hash: "h6", Sender: userAddr, SenderShard: 1, Fee: "40", GasUsed: 4},
+		},
+	}
+	statusInfo := map[string]*outport.StatusInfo{
+		"h2": {Status: transaction.TxStatusFail.String()},
+	}
+
+	changes := createRelayerStatsProcessor().PrepareRelayerStatsChanges(preparedResults, statusInfo, timestamp, 1)
+	require.Equal(t, []*data.RelayerStatsChange{
+		{
+			ID:                 relayerAddr + "-" + day,
+			Relayer:            relayerAddr,
+			Day:                day,
+			Users:              []string{userAddr, userAddr2},
+			TransactionsCount:  3,
+			FailedTransactions: 2,
+			GasUsed:            17,
+			Fee:                "170",
+			FeeNum:             1.7e-16,
+			ShardID:            1,
+			Timestamp:          timestamp,
+		},
+		{
+			ID:                relayerAddr2 + "-" + day,
+			Relayer:           relayerAddr2,
+			Day:               day,
+			Users:             []string{userAddr2},
+			TransactionsCount: 1,
+			GasUsed:           3,
+			Fee:               "30",
+			FeeNum:            3e-17,
+			ShardID:           1,
+			Timestamp:         timestamp,
+		},
+	}, changes)
+}
+
+func TestRelayerStatsProcessor_PrepareRelayerStatsChangesShouldCountTheFailuresAtDestination(t *testing.T) {
+	t.Parallel()
+
+	// the block is created on 2026-10-18 10:00:00 UTC
+	timestamp := uint64(1792317600)
+	day := "2026-10-18"
+	shardTwo := uint32(2)
+	preparedResults := &data.PreparedResults{
+		Transactions: []*data.Transaction{
+			{Hash: "h1", Sender: userAddr, SenderShard: 2, ReceiverShard: 1, RelayedAddr: relayerAddr, RelayerShard: &shardTwo, RelayerFee: "100", GasUsed: 10},
+			{Hash: "h2", Sender: userAddr2, SenderShard: 2, ReceiverShard: 1, RelayedAddr: relayerAddr, RelayerShard: &shardTwo, RelayerFee: "50", GasUsed: 5, Status: transaction.TxStatusSuccess.String()},
+			{Hash: "h3", Sender: userAddr2, SenderShard: 2, ReceiverShard: 0, RelayedAddr: relayerAddr, RelayerShard: &shardTwo, RelayerFee: "50", GasUsed: 5, Status: transaction.TxStatusFail.String()},
+		},
+	}
+	statusInfo := map[string]*outport.StatusInfo{
+		"h1": {Status: transaction.TxStatusFail.String()},
+	}
+
+	changes := createRelayerStatsProcessor().PrepareRelayerStatsChanges(preparedResults, statusInfo, timestamp, 1)
+	require.Equal(t, []*data.RelayerStatsChange{
+		{
+			ID:                 relayerAddr + "-" + day,
+			Relayer:            relayerAddr,
+			Day:                day,
+			FailedTransactions: 1,
+			Fee:                "0",
+			ShardID:            1,
+			Timestamp:          timestamp,
+		},
+	}, changes)
+}
+
+func TestRelayerStatsProcessor_AddUniqueUsers(t *testing.T) {
+	t.Parallel()
+
+	day := "2026-10-18"
+	rsp := createRelayerStatsProcessor()
+	changes := []*data.RelayerStatsChange{
+		{Relayer: relayerAddr, Day: day, Users: []string{userAddr, userAddr2}},
+	}
+
+	ids := rsp.GetRelayerUsersIDs(changes)
+	require.Equal(t, []string{
+		relayerAddr + "-" + day + "-" + userAddr,
+		relayerAddr + "-" + day + "-" + userAddr2,
+	}, ids)
+
+	rsp.AddUniqueUsers(changes, map[string]struct{}{
+		relayerAddr + "-" + day + "-" + userAddr: {},
+	})
+	require.Equal(t, []string{userAddr2}, changes[0].Users)
+	require.Equal(t, uint64(1), changes[0].UniqueUsers)
+}
@@ -0,0 +1,171 @@
+package relayerstats
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-es-indexer-go/data"
+	"github.com/multiversx/mx-chain-es-indexer-go/process/elasticproc/converters"
+)
+
+// relayerStatsFields holds the counters, serialized as a painless parameter, that are changed by the relayed transactions
+const relayerStatsFields = `["transactionsCount","failedTransactions","uniqueUsers","gasUsed"]`
+
+// SerializeRelayerUsers will serialize the first relayed transactions of the day of the users of the provided relayer
+// statistics changes in a way that Elasticsearch expects a bulk request
+func (rsp *relayerStatsProcessor) SerializeRelayerUsers(changes []*data.RelayerStatsChange, buffSlice *data.BufferSlice, index string) error {
+	for _, change := range changes {
+		for _, user := range change.Users {
+			meta, serializedData, err := prepareSerializedRelayerUser(change, user, index)
+			if err != nil {
+				return err
+			}
+
+			err = buffSlice.PutData(meta, serializedData)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// SerializeRelayerStatsChanges will serialize the provided relayer statistics changes in a way that Elasticsearch expects
+// a bulk request
+func (rsp *relayerStatsProcessor) SerializeRelayerStatsChanges(changes []*data.RelayerStatsChange, buffSlice *data.BufferSlice, index string) error {
+	for _, change := range changes {
+		meta, serializedData, err := prepareSerializedRelayerStatsChange(change, index)
+		if err != nil {
+			return err
+		}
+
+		err = buffSlice.PutData(meta, serializedData)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func prepareSerializedRelayerUser(change *data.RelayerStatsChange, user string, index string) ([]byte, []byte, error) {
+	id := ComputeRelayerUserID(change.Relayer, change.Day, user)
+	meta := []byte(fmt.Sprintf(`{ "update" : { "_index":"%s", "_id" : "%s" } }%s`, index, converters.JsonEscape(id), "\n"))
+
+	serializedUser, err := json.Marshal(&data.RelayerUser{
+		Relayer:   change.Relayer,
+		Day:       change.Day,
+		User:      user,
+		ShardID:   change.ShardID,
+		Timestamp: change.Timestamp,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// the first relayed transaction of the day has to be kept, otherwise reverting a later block would remove the user
+	codeToExecute := `
+		if ('create' == ctx.op) {
+			ctx._source = params.user;
+		} else {
+			ctx.op = 'noop';
+		}
+`
+	serializedDataStr := fmt.Sprintf(`{"scripted_upsert": true, "script": {`+
+		`"source": "%s",`+
+		`"lang": "painless",`+
+		`"params": { "user": %s }},`+
+		`"upsert": {}}`,
+		converters.FormatPainlessSource(codeToExecute), string(serializedUser),
+	)
+
+	return meta, []byte(serializedDataStr), nil
+}
+
+func prepareSerializedRelayerStatsChange(change *data.RelayerStatsChange, index string) ([]byte, []byte, error) {
+	meta := []byte(fmt.Sprintf(`{ "update" : { "_index":"%s", "_id" : "%s" } }%s`, index, converters.JsonEscape(change.ID), "\n"))
+
+	serializedChange, err := json.Marshal(change)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	serializedStats, err := json.Marshal(&data.RelayerStats{
+		Relayer: change.Relayer,
+		Day:     change.Day,
+		ShardID: change.ShardID,
+		Fee:     "0",
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	codeToExecute := `
+		if ('create' == ctx.op) {
+			ctx._source = params.stats;
+		}
+` + converters.ApplyBlockChangeScript("relayerChanges", `
+		for (String field : params.fields) {
+			long total = ctx._source.containsKey(field) ? ctx._source[field] : 0;
+			ctx._source[field] = total + params.change[field];
+		}
+		ctx._source.fee = new BigInteger(ctx._source.fee).add(new BigInteger(params.change.fee)).toString();
+		ctx._source.feeNum = ctx._source.feeNum + params.change.feeNum;
+		ctx._source.lastRelayed = params.change.timestamp;
+`)
+	serializedDataStr := fmt.Sprintf(`{"scripted_upsert": true, "script": {`+
+		`"source": "%s",`+
+		`"lang": "painless",`+
+		`"params": { "stats": %s, "change": %s, "fields": %s, "maxChanges": %d }},`+
+		`"upsert": {}}`,
+		converters.FormatPainlessSource(codeToExecute), string(serializedStats), string(serializedChange), relayerStatsFields, converters.MaxBlockChangesInDocument,
+	)
+
+	return meta, []byte(serializedDataStr), nil
+}
+
+// PrepareRelayerStatsQueryInCaseOfRevert will prepare the query that subtracts the relayer statistics changes of a reverted block
+func (rsp *relayerStatsProcessor) PrepareRelayerStatsQueryInCaseOfRevert(timestamp uint64, shardID uint32) *bytes.Buffer {
+	codeToExecute := converters.RevertBlockChangeScript("relayerChanges", `
+			for (String field : params.fields) {
+				ctx._source[field] = ctx._source[field] - change[field];
+			}
+			ctx._source.fee = new BigInteger(ctx._source.fee).subtract(new BigInteger(change.fee)).toString();
+			ctx._source.feeNum = ctx._source.feeNum - change.feeNum;
+`) + `
+	if (ctx._source.relayerChanges.length == 0) {
+		ctx.op = 'delete';
+		return;
+	}
+	ctx._source.lastRelayed = ctx._source.relayerChanges[ctx._source.relayerChanges.length - 1].timestamp;
+`
+
+	query := fmt.Sprintf(`
+	{
+	  "query": {
+		"bool": {
+		  "must": [
+			{"match": {"relayerChanges.timestamp": "%d"}},
+			{"match": {"relayerChanges.shardID": %d}}
+		  ]
+		}
+	  },
+	  "script": {
+		"source": "%s",
+		"lang": "painless",
+		"params": {"timestamp": %d, "shardID": %d, "fields": %s}
+	  }
+	}`, timestamp, shardID, converters.FormatPainlessSource(codeToExecute), timestamp, shardID, relayerStatsFields)
+
+	return bytes.NewBuffer([]byte(query))
+}
+
+// PrepareRelayerUsersQueryInCaseOfRevert will prepare the query that removes the users first seen in a reverted block
+func (rsp *relayerStatsProcessor) PrepareRelayerUsersQueryInCaseOfRevert(timestamp uint64, shardID uint32) *bytes.Buffer {
+	query := fmt.Sprintf(`{"query": {"bool": {"must": [{"match": {"shardID": {"query": %d,"operator": "AND"}}},{"match": {"timestamp": {"query": "%d","operator": "AND"}}}]}}}`,
+		shardID, timestamp)
+
+	return bytes.NewBuffer([]byte(query))
+}
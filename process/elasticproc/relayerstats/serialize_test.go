@@ -0,0 +1,64 @@
+package relayerstats
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/multiversx/mx-chain-es-indexer-go/data"
+)
+
+func createRelayerStatsChanges() []*data.RelayerStatsChange {
+	return []*data.RelayerStatsChange{
+		{
+			ID:                "relayer-2026-10-18",
+			Relayer:           "relayer",
+			Day:               "2026-10-18",
+			Users:             []string{"alice"},
+			TransactionsCount: 2,
+			UniqueUsers:       1,
+			GasUsed:           100,
+			Fee:               "10",
+			FeeNum:            0.1,
+			ShardID:           1,
+			Timestamp:         100,
+		},
+	}
+}
+
+func TestRelayerStatsProcessor_SerializeRelayerUsers(t *testing.T) {
+	t.Parallel()
+
+	buffSlice := data.NewBufferSlice(data.DefaultMaxBulkSize)
+	err := createRelayerStatsProcessor().SerializeRelayerUsers(createRelayerStatsChanges(), buffSlice, "relayerusers")
+	require.Nil(t, err)
+	require.Equal(t, `{ "update" : { "_index":"relayerusers", "_id" : "relayer-2026-10-18-alice" } }
+{"scripted_upsert": true, "script": {"source": "if ('create' == ctx.op) {ctx._source = params.user;} else {ctx.op = 'noop';}","lang": "painless","params": { "user": {"relayer":"relayer","day":"2026-10-18","user":"alice","shardID":1,"timestamp":100} }},"upsert": {}}
+`, buffSlice.Buffers()[0].String())
+}
+
+func TestRelayerStatsProcessor_SerializeRelayerStatsChanges(t *testing.T) {
+	t.Parallel()
+
+	buffSlice := data.NewBufferSlice(data.DefaultMaxBulkSize)
+	err := createRelayerStatsProcessor().SerializeRelayerStatsChanges(createRelayerStatsChanges(), buffSlice, "relayers")
+	require.Nil(t, err)
+
+	bulk := buffSlice.Buffers()[0].String()
+	require.NotContains(t, bulk, "alice")
+	require.Contains(t, bulk, `{ "update" : { "_index":"relayers", "_id" : "relayer-2026-10-18" } }`)
+	require.Contains(t, bulk, `"params": { "stats": {"relayer":"relayer","day":"2026-10-18","shardID":1,"fee":"0","feeNum":0}, "change": {"transactionsCount":2,"failedTransactions":0,"uniqueUsers":1,"gasUsed":100,"fee":"10","feeNum":0.1,"shardID":1,"timestamp":100}, "fields": ["transactionsCount","failedTransactions","uniqueUsers","gasUsed"], "maxChanges": 100 }}`)
+}
+
+func TestRelayerStatsProcessor_PrepareQueriesInCaseOfRevert(t *testing.T) {
+	t.Parallel()
+
+	rsp := createRelayerStatsProcessor()
+
+	query := rsp.PrepareRelayerStatsQueryInCaseOfRevert(100, 1).String()
+	require.Contains(t, query, `{"match": {"relayerChanges.timestamp": "100"}}`)
+	require.Contains(t, query, `"params": {"timestamp": 100, "shardID": 1, "fields": ["transactionsCount","failedTransactions","uniqueUsers","gasUsed"]}`)
+
+	query = rsp.PrepareRelayerUsersQueryInCaseOfRevert(100, 1).String()
+	require.Equal(t, `{"query": {"bool": {"must": [{"match": {"shardID": {"query": 1,"operator": "AND"}}},{"match": {"timestamp": {"query": "100","operator": "AND"}}}]}}}`, query)
+}
@@ -0,0 +1,150 @@
+package elasticproc
+
+import (
+	"encoding/hex"
+	"math/big"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	coreData "github.com/multiversx/mx-chain-core-go/data"
+
+	"github.com/multiversx/mx-chain-es-indexer-go/data"
+	"github.com/multiversx/mx-chain-es-indexer-go/process/elasticproc/converters"
+)
+
+const numTopicsPerTransferredToken = 3
+
+// tokenEventsIdentifiers holds the identifiers of the events whose first topics are the token and the nonce. The value
+// is true for the transfer events, which hold a (token, nonce, value) group of topics for every transferred token
+var tokenEventsIdentifiers = map[string]bool{
+	core.BuiltInFunctionESDTTransfer:            true,
+	core.BuiltInFunctionESDTNFTTransfer:         true,
+	core.BuiltInFunctionMultiESDTNFTTransfer:    true,
+	core.BuiltInFunctionESDTLocalMint:           false,
+	core.BuiltInFunctionESDTLocalBurn:           false,
+	core.BuiltInFunctionESDTNFTCreate:           false,
+	core.BuiltInFunctionESDTNFTBurn:             false,
+	core.BuiltInFunctionESDTNFTAddQuantity:      false,
+	core.BuiltInFunctionESDTNFTUpdateAttributes: false,
+	core.BuiltInFunctionESDTNFTAddURI:           false,
+	core.BuiltInFunctionESDTWipe:                false,
+	core.BuiltInFunctionESDTFreeze:              false,
+	core.BuiltInFunctionESDTUnFreeze:            false,
+}
+
+func createBlockStreamEvent(block *data.Block) *data.StreamEvent {
+	return &data.StreamEvent{
+		Type:      data.StreamEventBlock,
+		ShardID:   block.ShardID,
+		Hash:      block.Hash,
+		Timestamp: block.Timestamp,
+		Data:      block,
+	}
+}
+
+func createRevertStreamEvent(header coreData.HeaderHandler, headerHash string) *data.StreamEvent {
+	return &data.StreamEvent{
+		Type:      data.StreamEventRevert,
+		ShardID:   header.GetShardID(),
+		Hash:      headerHash,
+		Timestamp: time.Duration(header.GetTimeStamp()),
+		Data: &data.StreamRevertedBlock{
+			Nonce: header.GetNonce(),
+			Round: header.GetRound(),
+			Epoch: header.GetEpoch(),
+		},
+	}
+}
+
+func createTransactionsStreamEvents(preparedResults *data.PreparedResults, logEvents []*data.LogEvent, shardID uint32) []*data.StreamEvent {
+	identifiersByTxHash := make(map[string][]string)
+	for _, logEvent := range logEvents {
+		identifiersByTxHash[logEvent.TxHash] = appendIfMissing(identifiersByTxHash[logEvent.TxHash], logEvent.Identifier)
+		if logEvent.OriginalTxHash != "" && logEvent.OriginalTxHash != logEvent.TxHash {
+			identifiersByTxHash[logEvent.OriginalTxHash] = appendIfMissing(identifiersByTxHash[logEvent.OriginalTxHash], logEvent.Identifier)
+		}
+	}
+
+	events := make([]*data.StreamEvent, 0, len(preparedResults.Transactions)+len(preparedResults.ScResults)+len(logEvents))
+	for _, tx := range preparedResults.Transactions {
+		events = append(events, &data.StreamEvent{
+			Type:        data.StreamEventTransaction,
+			ShardID:     shardID,
+			Hash:        tx.Hash,
+			Timestamp:   tx.Timestamp,
+			Addresses:   []string{tx.Sender, tx.Receiver},
+			Tokens:      tx.Tokens,
+			Identifiers: identifiersByTxHash[tx.Hash],
+			Data:        tx,
+		})
+	}
+
+	for _, scr := range preparedResults.ScResults {
+		events = append(events, &data.StreamEvent{
+			Type:        data.StreamEventScResult,
+			ShardID:     shardID,
+			Hash:        scr.Hash,
+			Timestamp:   scr.Timestamp,
+			Addresses:   []string{scr.Sender, scr.Receiver},
+			Tokens:      scr.Tokens,
+			Identifiers: identifiersByTxHash[scr.Hash],
+			Data:        scr,
+		})
+	}
+
+	for _, logEvent := range logEvents {
+		events = append(events, &data.StreamEvent{
+			Type:        data.StreamEventLogEvent,
+			ShardID:     logEvent.ShardID,
+			Hash:        logEvent.TxHash,
+			Timestamp:   logEvent.Timestamp,
+			Addresses:   []string{logEvent.Address},
+			Tokens:      getLogEventTokens(logEvent),
+			Identifiers: []string{logEvent.Identifier},
+			Data:        logEvent,
+		})
+	}
+
+	return events
+}
+
+func getLogEventTokens(logEvent *data.LogEvent) []string {
+	isTransfer, isTokenEvent := tokenEventsIdentifiers[logEvent.Identifier]
+	if !isTokenEvent || len(logEvent.Topics) < 2 {
+		return nil
+	}
+
+	tokens := make([]string, 0)
+	for i := 0; i+1 < len(logEvent.Topics); i += numTopicsPerTransferredToken {
+		token := getTokenFromTopics(logEvent.Topics[i], logEvent.Topics[i+1])
+		if token != "" {
+			tokens = appendIfMissing(tokens, token)
+		}
+		if !isTransfer || i+numTopicsPerTransferredToken+2 >= len(logEvent.Topics) {
+			break
+		}
+	}
+
+	return tokens
+}
+
+func getTokenFromTopics(hexToken string, hexNonce string) string {
+	token, _ := hex.DecodeString(hexToken)
+	nonceBytes, _ := hex.DecodeString(hexNonce)
+	nonce := big.NewInt(0).SetBytes(nonceBytes).Uint64()
+	if nonce == 0 {
+		return string(token)
+	}
+
+	return converters.ComputeTokenIdentifier(string(token), nonce)
+}
+
+func appendIfMissing(values []string, value string) []string {
+	for _, v := range values {
+		if v == value {
+			return values
+		}
+	}
+
+	return append(values, value)
+}
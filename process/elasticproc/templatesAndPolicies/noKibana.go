@@ -28,6 +28,7 @@ func (tr *templatesAndPolicyReaderNoKibana) GetElasticTemplatesAndPolicies() (ma
 	indexTemplates[indexer.RoundsIndex] = noKibana.Rounds.ToBuffer()
 	indexTemplates[indexer.ValidatorsIndex] = noKibana.Validators.ToBuffer()
 	indexTemplates[indexer.AccountsIndex] = noKibana.Accounts.ToBuffer()
+	indexTemplates[indexer.AccountsActivityIndex] = noKibana.AccountsActivity.ToBuffer()
 	indexTemplates[indexer.AccountsHistoryIndex] = noKibana.AccountsHistory.ToBuffer()
 	indexTemplates[indexer.AccountsESDTIndex] = noKibana.AccountsESDT.ToBuffer()
 	indexTemplates[indexer.AccountsESDTHistoryIndex] = noKibana.AccountsESDTHistory.ToBuffer()
@@ -43,6 +44,18 @@ func (tr *templatesAndPolicyReaderNoKibana) GetElasticTemplatesAndPolicies() (ma
 	indexTemplates[indexer.ESDTsIndex] = noKibana.ESDTs.ToBuffer()
 	indexTemplates[indexer.ValuesIndex] = noKibana.Values.ToBuffer()
 	indexTemplates[indexer.EventsIndex] = noKibana.Events.ToBuffer()
+	indexTemplates[indexer.TransfersIndex] = noKibana.Transfers.ToBuffer()
+	indexTemplates[indexer.StatsIndex] = noKibana.Stats.ToBuffer()
+	indexTemplates[indexer.ContractStatsIndex] = noKibana.ContractStats.ToBuffer()
+	indexTemplates[indexer.ContractCallersIndex] = noKibana.ContractCallers.ToBuffer()
+	indexTemplates[indexer.StakedKeysIndex] = noKibana.StakedKeys.ToBuffer()
+	indexTemplates[indexer.ProvidersIndex] = noKibana.Providers.ToBuffer()
+	indexTemplates[indexer.UndelegationsIndex] = noKibana.Undelegations.ToBuffer()
+	indexTemplates[indexer.TokenRolesIndex] = noKibana.TokenRoles.ToBuffer()
+	indexTemplates[indexer.RelayersIndex] = noKibana.Relayers.ToBuffer()
+	indexTemplates[indexer.RelayerUsersIndex] = noKibana.RelayerUsers.ToBuffer()
+	indexTemplates[indexer.BridgeTransfersIndex] = noKibana.BridgeTransfers.ToBuffer()
+	indexTemplates[indexer.TokensSyncReportsIndex] = noKibana.TokensSyncReports.ToBuffer()
 
 	return indexTemplates, indexPolicies, nil
 }
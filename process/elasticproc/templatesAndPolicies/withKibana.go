@@ -39,6 +39,7 @@ func getTemplatesKibana() map[string]*bytes.Buffer {
 	indexTemplates[indexer.RoundsIndex] = withKibana.Rounds.ToBuffer()
 	indexTemplates[indexer.ValidatorsIndex] = withKibana.Validators.ToBuffer()
 	indexTemplates[indexer.AccountsIndex] = withKibana.Accounts.ToBuffer()
+	indexTemplates[indexer.AccountsActivityIndex] = withKibana.AccountsActivity.ToBuffer()
 	indexTemplates[indexer.AccountsHistoryIndex] = withKibana.AccountsHistory.ToBuffer()
 	indexTemplates[indexer.AccountsESDTIndex] = withKibana.AccountsESDT.ToBuffer()
 	indexTemplates[indexer.AccountsESDTHistoryIndex] = withKibana.AccountsESDTHistory.ToBuffer()
@@ -52,6 +53,18 @@ func getTemplatesKibana() map[string]*bytes.Buffer {
 	indexTemplates[indexer.DelegatorsIndex] = withKibana.Delegators.ToBuffer()
 	indexTemplates[indexer.OperationsIndex] = withKibana.Operations.ToBuffer()
 	indexTemplates[indexer.ESDTsIndex] = withKibana.ESDTs.ToBuffer()
+	indexTemplates[indexer.TransfersIndex] = withKibana.Transfers.ToBuffer()
+	indexTemplates[indexer.StatsIndex] = withKibana.Stats.ToBuffer()
+	indexTemplates[indexer.ContractStatsIndex] = withKibana.ContractStats.ToBuffer()
+	indexTemplates[indexer.ContractCallersIndex] = withKibana.ContractCallers.ToBuffer()
+	indexTemplates[indexer.StakedKeysIndex] = withKibana.StakedKeys.ToBuffer()
+	indexTemplates[indexer.ProvidersIndex] = withKibana.Providers.ToBuffer()
+	indexTemplates[indexer.UndelegationsIndex] = withKibana.Undelegations.ToBuffer()
+	indexTemplates[indexer.TokenRolesIndex] = withKibana.TokenRoles.ToBuffer()
+	indexTemplates[indexer.RelayersIndex] = withKibana.Relayers.ToBuffer()
+	indexTemplates[indexer.RelayerUsersIndex] = withKibana.RelayerUsers.ToBuffer()
+	indexTemplates[indexer.BridgeTransfersIndex] = withKibana.BridgeTransfers.ToBuffer()
+	indexTemplates[indexer.TokensSyncReportsIndex] = withKibana.TokensSyncReports.ToBuffer()
 
 	return indexTemplates
 }
@@ -0,0 +1,90 @@
+package elasticproc
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+
+	coreData "github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-es-indexer-go/core/request"
+	"github.com/multiversx/mx-chain-es-indexer-go/data"
+	elasticIndexer "github.com/multiversx/mx-chain-es-indexer-go/process/dataindexer"
+)
+
+func (ei *elasticProcessor) indexTokenRolesHistory(tokenRoles []*data.TokenRole, buffSlice *data.BufferSlice) error {
+	if len(tokenRoles) == 0 || !ei.isIndexEnabled(elasticIndexer.TokenRolesIndex) {
+		return nil
+	}
+
+	return ei.logsAndEventsProc.SerializeTokenRolesHistory(tokenRoles, buffSlice, elasticIndexer.TokenRolesIndex)
+}
+
+func (ei *elasticProcessor) revertTokenRolesHistory(header coreData.HeaderHandler) error {
+	if !ei.isIndexEnabled(elasticIndexer.TokenRolesIndex) {
+		return nil
+	}
+
+	return ei.removeFromIndexByTimestampAndShardID(header.GetTimeStamp(), header.GetShardID(), elasticIndexer.TokenRolesIndex)
+}
+
+// GetTokenRolesAtTimestamp returns the addresses that had each role of the provided token at the provided timestamp,
+// by replaying the roles changes indexed up to that timestamp
+func (ei *elasticProcessor) GetTokenRolesAtTimestamp(token string, timestamp uint64) (map[string][]string, error) {
+	rolesAddresses := make(map[string]map[string]struct{})
+	handlerFunc := func(responseBytes []byte) error {
+		responseScroll := &data.ResponseScroll{}
+		err := json.Unmarshal(responseBytes, responseScroll)
+		if err != nil {
+			return err
+		}
+
+		for _, hit := range responseScroll.Hits.Hits {
+			tokenRole := &data.TokenRole{}
+			err = json.Unmarshal(hit.Source, tokenRole)
+			if err != nil {
+				return err
+			}
+
+			applyTokenRoleChange(rolesAddresses, tokenRole)
+		}
+
+		return nil
+	}
+
+	ctxWithValue := context.WithValue(context.Background(), request.ContextKey, request.ScrollTopic)
+	query := ei.logsAndEventsProc.PrepareTokenRolesHistoryQuery(token, timestamp)
+	err := ei.elasticClient.DoScrollRequest(ctxWithValue, elasticIndexer.TokenRolesIndex, query, true, handlerFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	roles := make(map[string][]string, len(rolesAddresses))
+	for role, addresses := range rolesAddresses {
+		if len(addresses) == 0 {
+			continue
+		}
+
+		roles[role] = make([]string, 0, len(addresses))
+		for address := range addresses {
+			roles[role] = append(roles[role], address)
+		}
+		sort.Strings(roles[role])
+	}
+
+	return roles, nil
+}
+
+func applyTokenRoleChange(rolesAddresses map[string]map[string]struct{}, tokenRole *data.TokenRole) {
+	addresses, found := rolesAddresses[tokenRole.Role]
+	if !found {
+		addresses = make(map[string]struct{})
+		rolesAddresses[tokenRole.Role] = addresses
+	}
+
+	if tokenRole.Action == data.RoleSetAction {
+		addresses[tokenRole.Address] = struct{}{}
+		return
+	}
+
+	delete(addresses, tokenRole.Address)
+}
@@ -5,6 +5,13 @@ type RoleData struct {
 	Token   string
 	Address string
 	Set     bool
+	TxHash  string
+}
+
+// RoleChange is the structure that will keep information about a role set or unset, in the order of execution
+type RoleChange struct {
+	*RoleData
+	Role string
 }
 
 // PropertiesData is the structure that will keep information about a token and properties
@@ -16,6 +23,7 @@ type PropertiesData struct {
 // TokenRolesAndProperties is the structure that will keep information about tokens properties and roles
 type TokenRolesAndProperties struct {
 	rolesData       map[string][]*RoleData
+	rolesChanges    []*RoleChange
 	tokenProperties []*PropertiesData
 }
 
@@ -24,17 +32,23 @@ type TokenRolesAndProperties struct {
 func NewTokenRolesAndProperties() *TokenRolesAndProperties {
 	return &TokenRolesAndProperties{
 		rolesData:       make(map[string][]*RoleData),
+		rolesChanges:    make([]*RoleChange, 0),
 		tokenProperties: make([]*PropertiesData, 0),
 	}
 }
 
 // AddRole will add role for the provided address
-func (tap *TokenRolesAndProperties) AddRole(token string, address string, role string, set bool) {
+func (tap *TokenRolesAndProperties) AddRole(token string, address string, role string, set bool, txHash string) {
 	rData := &RoleData{
 		Set:     set,
 		Address: address,
 		Token:   token,
+		TxHash:  txHash,
 	}
+	tap.rolesChanges = append(tap.rolesChanges, &RoleChange{
+		RoleData: rData,
+		Role:     role,
+	})
 
 	_, found := tap.rolesData[role]
 	if found {
@@ -50,6 +64,11 @@ func (tap *TokenRolesAndProperties) GetRoles() map[string][]*RoleData {
 	return tap.rolesData
 }
 
+// GetRolesChanges will return all the roles set or unset, in the order they were added
+func (tap *TokenRolesAndProperties) GetRolesChanges() []*RoleChange {
+	return tap.rolesChanges
+}
+
 // AddProperties will add token and the provided properties
 func (tap *TokenRolesAndProperties) AddProperties(token string, properties map[string]bool) {
 	tap.tokenProperties = append(tap.tokenProperties, &PropertiesData{
@@ -12,8 +12,9 @@ func TestTokenRolesAndPropertiesAddRole(t *testing.T) {
 
 	tokenRolesAndProp := NewTokenRolesAndProperties()
 
-	tokenRolesAndProp.AddRole("MY-abcd", "addr-1", core.ESDTRoleNFTBurn, true)
-	tokenRolesAndProp.AddRole("MY-abcd", "addr-2", core.ESDTRoleNFTBurn, true)
+	tokenRolesAndProp.AddRole("MY-abcd", "addr-1", core.ESDTRoleNFTBurn, true, "h1")
+	tokenRolesAndProp.AddRole("MY-abcd", "addr-2", core.ESDTRoleNFTBurn, true, "h2")
+	tokenRolesAndProp.AddRole("MY-abcd", "addr-1", core.ESDTRoleNFTCreate, false, "h3")
 
 	expected := map[string][]*RoleData{
 		core.ESDTRoleNFTBurn: {
@@ -21,15 +22,32 @@ func TestTokenRolesAndPropertiesAddRole(t *testing.T) {
 				Token:   "MY-abcd",
 				Address: "addr-1",
 				Set:     true,
+				TxHash:  "h1",
 			},
 			{
 				Token:   "MY-abcd",
 				Address: "addr-2",
 				Set:     true,
+				TxHash:  "h2",
+			},
+		},
+		core.ESDTRoleNFTCreate: {
+			{
+				Token:   "MY-abcd",
+				Address: "addr-1",
+				Set:     false,
+				TxHash:  "h3",
 			},
 		},
 	}
 	require.Equal(t, expected, tokenRolesAndProp.GetRoles())
+
+	changes := tokenRolesAndProp.GetRolesChanges()
+	require.Len(t, changes, 3)
+	require.Equal(t, core.ESDTRoleNFTBurn, changes[0].Role)
+	require.Equal(t, "addr-2", changes[1].Address)
+	require.Equal(t, core.ESDTRoleNFTCreate, changes[2].Role)
+	require.False(t, changes[2].Set)
 }
 
 func TestTokenAndROlesPropertiesAddProperties(t *testing.T) {
@@ -0,0 +1,374 @@
+package tokens
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/multiversx/mx-chain-es-indexer-go/core/request"
+	"github.com/multiversx/mx-chain-es-indexer-go/data"
+	indexerdata "github.com/multiversx/mx-chain-es-indexer-go/process/dataindexer"
+	"github.com/multiversx/mx-chain-es-indexer-go/process/elasticproc"
+	"github.com/multiversx/mx-chain-es-indexer-go/process/elasticproc/converters"
+)
+
+const (
+	// tokensSyncCheckpointKey is the key of the document from the values index that holds the last synchronized token
+	tokensSyncCheckpointKey = "mainChainTokensSyncCheckpoint"
+	// maxTokensPerSync is the number of tokens refreshed in a synchronization, the next one continues from the checkpoint
+	maxTokensPerSync = 500
+)
+
+// localTokenFields are the fields of a token that are computed by the sovereign chain, they are never taken from the main
+// chain. The roles are granted to sovereign addresses and the attributes are decoded with the sovereign decoders
+var localTokenFields = map[string]struct{}{
+	"burned": {}, "burnedNum": {}, "initialSupply": {}, "initialSupplyNum": {}, "minted": {}, "mintedNum": {},
+	"supply": {}, "supplyNum": {}, "wiped": {}, "wipedNum": {}, "supplyChanges": {}, "roles": {}, "attributes": {},
+}
+
+var errLastTokensSyncReportFound = errors.New("last tokens sync report found")
+
+// SyncCrossChainTokens will serialize the refreshed properties of the cross-chain tokens from the main chain tokens
+// source, for the provided indices. The tokens are refreshed in batches, the last refreshed token being kept as a
+// checkpoint in the values index, so a synchronization continues where the previous one stopped. The report of the
+// synchronization is serialized in the tokens sync reports index
+func (sit *sovereignIndexTokensHandler) SyncCrossChainTokens(elasticClient elasticproc.DatabaseClientHandler, indexes []string, buffSlice *data.BufferSlice) (*data.TokensSyncReport, error) {
+	if !sit.mainChainTokensSource.IsEnabled() {
+		return nil, indexerdata.ErrTokensSyncNotAvailable
+	}
+
+	report := &data.TokensSyncReport{
+		StartTimestamp: time.Now().Unix(),
+	}
+
+	checkpoint, err := getTokensSyncCheckpoint(elasticClient)
+	if err != nil {
+		return nil, err
+	}
+
+	tokensIDs, err := sit.getCrossChainTokensIDs(elasticClient)
+	if err != nil {
+		return nil, err
+	}
+
+	batch := getTokensBatchAfterCheckpoint(tokensIDs, checkpoint)
+	if len(batch) > 0 {
+		err = sit.syncTokensBatch(elasticClient, batch, indexes, report, buffSlice)
+		if err != nil {
+			return nil, err
+		}
+
+		report.Checkpoint = batch[len(batch)-1]
+	}
+	if len(batch) < maxTokensPerSync {
+		report.Checkpoint = ""
+		report.FullCycleCompleted = true
+	}
+
+	err = serializeTokensSyncCheckpoint(report.Checkpoint, buffSlice)
+	if err != nil {
+		return nil, err
+	}
+
+	report.EndTimestamp = time.Now().Unix()
+	err = serializeTokensSyncReport(report, buffSlice)
+	if err != nil {
+		return nil, err
+	}
+
+	logTokensSyncReport(report)
+
+	return report, nil
+}
+
+// GetLastCrossChainTokensSyncReport returns the last report stored in the tokens sync reports index, nil if there is none
+func (sit *sovereignIndexTokensHandler) GetLastCrossChainTokensSyncReport(elasticClient elasticproc.DatabaseClientHandler) (*data.TokensSyncReport, error) {
+	var lastReport *data.TokensSyncReport
+	handlerFunc := func(responseBytes []byte) error {
+		responseScroll := &data.ResponseScroll{}
+		err := json.Unmarshal(responseBytes, responseScroll)
+		if err != nil {
+			return err
+		}
+
+		for _, hit := range responseScroll.Hits.Hits {
+			lastReport = &data.TokensSyncReport{}
+			err = json.Unmarshal(hit.Source, lastReport)
+			if err != nil {
+				return err
+			}
+
+			return errLastTokensSyncReportFound
+		}
+
+		return nil
+	}
+
+	ctxWithValue := context.WithValue(context.Background(), request.ContextKey, request.ScrollTopic)
+	query := []byte(`{"query": {"match_all": {}},"sort": [{"startTimestamp": {"order": "desc"}}]}`)
+	err := elasticClient.DoScrollRequest(ctxWithValue, indexerdata.TokensSyncReportsIndex, query, true, handlerFunc)
+	if err != nil && !errors.Is(err, errLastTokensSyncReportFound) {
+		return nil, err
+	}
+
+	return lastReport, nil
+}
+
+func getTokensSyncCheckpoint(elasticClient elasticproc.DatabaseClientHandler) (string, error) {
+	response := &data.ResponseRawDocs{}
+	ctxWithValue := context.WithValue(context.Background(), request.ContextKey, request.GetTopic)
+	err := elasticClient.DoMultiGet(ctxWithValue, []string{tokensSyncCheckpointKey}, indexerdata.ValuesIndex, true, response)
+	if err != nil {
+		return "", err
+	}
+
+	for _, doc := range response.Docs {
+		if !doc.Found {
+			continue
+		}
+
+		checkpoint := ""
+		err = json.Unmarshal(doc.Source["value"], &checkpoint)
+		return checkpoint, err
+	}
+
+	return "", nil
+}
+
+func (sit *sovereignIndexTokensHandler) getCrossChainTokensIDs(elasticClient elasticproc.DatabaseClientHandler) ([]string, error) {
+	tokensIDs := make([]string, 0)
+	handlerFunc := func(responseBytes []byte) error {
+		responseScroll := &data.ResponseScroll{}
+		err := json.Unmarshal(responseBytes, responseScroll)
+		if err != nil {
+			return err
+		}
+
+		for _, hit := range responseScroll.Hits.Hits {
+			tokensIDs = append(tokensIDs, hit.ID)
+		}
+
+		return nil
+	}
+
+	ctxWithValue := context.WithValue(context.Background(), request.ContextKey, request.ScrollTopic)
+	// only the tokens and the collections are synchronized, the NFTs documents hold an identifier
+	query := []byte(fmt.Sprintf(`{"query": {"bool": {"must_not": [{"prefix": {"token": "%s-"}},{"exists": {"field": "identifier"}}]}}}`, converters.JsonEscape(sit.esdtPrefix)))
+	err := elasticClient.DoScrollRequest(ctxWithValue, indexerdata.TokensIndex, query, false, handlerFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Strings(tokensIDs)
+
+	return tokensIDs, nil
+}
+
+func getTokensBatchAfterCheckpoint(sortedTokensIDs []string, checkpoint string) []string {
+	startIdx := sort.Search(len(sortedTokensIDs), func(i int) bool {
+		return sortedTokensIDs[i] > checkpoint
+	})
+
+	endIdx := startIdx + maxTokensPerSync
+	if endIdx > len(sortedTokensIDs) {
+		endIdx = len(sortedTokensIDs)
+	}
+
+	return sortedTokensIDs[startIdx:endIdx]
+}
+
+func (sit *sovereignIndexTokensHandler) syncTokensBatch(
+	elasticClient elasticproc.DatabaseClientHandler,
+	batch []string,
+	indexes []string,
+	report *data.TokensSyncReport,
+	buffSlice *data.BufferSlice,
+) error {
+	localTokens := &data.ResponseRawDocs{}
+	ctxWithValue := context.WithValue(context.Background(), request.ContextKey, request.GetTopic)
+	err := elasticClient.DoMultiGet(ctxWithValue, batch, indexerdata.TokensIndex, true, localTokens)
+	if err != nil {
+		return err
+	}
+
+	mainChainTokens, err := sit.mainChainTokensSource.GetTokens(batch)
+	if err != nil {
+		return err
+	}
+
+	for _, localToken := range localTokens.Docs {
+		if !localToken.Found {
+			continue
+		}
+
+		report.CheckedTokens++
+		mainChainToken, found := mainChainTokens[localToken.ID]
+		if !found {
+			report.MissingTokens = append(report.MissingTokens, localToken.ID)
+			continue
+		}
+
+		changedFields, removedFields := compareTokens(localToken.Source, mainChainToken, sit.mainChainTokensSource.HasAllTokenFields())
+		if len(changedFields) == 0 && len(removedFields) == 0 {
+			continue
+		}
+
+		divergentFields := append(changedFields, removedFields...)
+		sort.Strings(divergentFields)
+		report.Divergences = append(report.Divergences, &data.TokenDivergence{
+			Token:  localToken.ID,
+			Fields: divergentFields,
+		})
+		report.UpdatedTokens++
+
+		err = serializeSyncedToken(localToken.ID, mainChainToken, removedFields, indexes, buffSlice)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// compareTokens returns the main chain fields that are different in the local token and the local fields that do not exist
+// anymore on the main chain. The removed fields are computed only if the main chain token holds all the indexed fields
+func compareTokens(localToken map[string]json.RawMessage, mainChainToken map[string]json.RawMessage, withRemovedFields bool) ([]string, []string) {
+	changedFields := make([]string, 0)
+	for field, mainChainValue := range mainChainToken {
+		if isLocalTokenField(field) {
+			continue
+		}
+
+		localValue, found := localToken[field]
+		if !found || !equalJSONValues(localValue, mainChainValue) {
+			changedFields = append(changedFields, field)
+		}
+	}
+
+	removedFields := make([]string, 0)
+	if !withRemovedFields {
+		return changedFields, removedFields
+	}
+	for field := range localToken {
+		_, found := mainChainToken[field]
+		if !found && !isLocalTokenField(field) {
+			removedFields = append(removedFields, field)
+		}
+	}
+
+	return changedFields, removedFields
+}
+
+func isLocalTokenField(field string) bool {
+	_, isLocal := localTokenFields[field]
+	return isLocal
+}
+
+func equalJSONValues(first json.RawMessage, second json.RawMessage) bool {
+	var firstValue, secondValue interface{}
+	errFirst := json.Unmarshal(first, &firstValue)
+	errSecond := json.Unmarshal(second, &secondValue)
+	if errFirst != nil || errSecond != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(firstValue, secondValue)
+}
+
+func serializeSyncedToken(
+	tokenID string,
+	mainChainToken map[string]json.RawMessage,
+	removedFields []string,
+	indexes []string,
+	buffSlice *data.BufferSlice,
+) error {
+	syncedToken := make(map[string]json.RawMessage, len(mainChainToken))
+	for field, value := range mainChainToken {
+		if !isLocalTokenField(field) {
+			syncedToken[field] = value
+		}
+	}
+
+	serializedToken, err := json.Marshal(syncedToken)
+	if err != nil {
+		return err
+	}
+	serializedRemovedFields, err := json.Marshal(removedFields)
+	if err != nil {
+		return err
+	}
+
+	for _, index := range indexes {
+		err = putSyncedToken(tokenID, index, serializedToken, serializedRemovedFields, buffSlice)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func putSyncedToken(tokenID string, index string, serializedToken []byte, serializedRemovedFields []byte, buffSlice *data.BufferSlice) error {
+	meta := []byte(fmt.Sprintf(`{ "update" : { "_index":"%s", "_id" : "%s" } }%s`, index, converters.JsonEscape(tokenID), "\n"))
+
+	codeToExecute := `
+		if ('create' == ctx.op) {
+			ctx._source = params.token;
+			return;
+		}
+		for (entry in params.token.entrySet()) {
+			ctx._source[entry.getKey()] = entry.getValue();
+		}
+		for (String field : params.removed) {
+			ctx._source.remove(field);
+		}
+`
+	serializedDataStr := fmt.Sprintf(`{"scripted_upsert": true, "script": {`+
+		`"source": "%s",`+
+		`"lang": "painless",`+
+		`"params": { "token": %s, "removed": %s }},`+
+		`"upsert": {}}`,
+		converters.FormatPainlessSource(codeToExecute), string(serializedToken), string(serializedRemovedFields),
+	)
+
+	return buffSlice.PutData(meta, []byte(serializedDataStr))
+}
+
+func serializeTokensSyncCheckpoint(checkpoint string, buffSlice *data.BufferSlice) error {
+	meta := []byte(fmt.Sprintf(`{ "index" : { "_index":"%s", "_id" : "%s" } }%s`, indexerdata.ValuesIndex, tokensSyncCheckpointKey, "\n"))
+	serializedData, err := json.Marshal(&data.KeyValueObj{
+		Key:   tokensSyncCheckpointKey,
+		Value: checkpoint,
+	})
+	if err != nil {
+		return err
+	}
+
+	return buffSlice.PutData(meta, serializedData)
+}
+
+func serializeTokensSyncReport(report *data.TokensSyncReport, buffSlice *data.BufferSlice) error {
+	meta := []byte(fmt.Sprintf(`{ "index" : { "_index":"%s", "_id" : "%d" } }%s`, indexerdata.TokensSyncReportsIndex, report.StartTimestamp, "\n"))
+	serializedData, err := json.Marshal(report)
+	if err != nil {
+		return err
+	}
+
+	return buffSlice.PutData(meta, serializedData)
+}
+
+func logTokensSyncReport(report *data.TokensSyncReport) {
+	for _, divergence := range report.Divergences {
+		log.Info("cross-chain token diverged from the main chain", "token", divergence.Token, "fields", divergence.Fields)
+	}
+	for _, token := range report.MissingTokens {
+		log.Warn("cross-chain token not found on the main chain", "token", token)
+	}
+
+	log.Info("cross-chain tokens synchronized with the main chain", "checked", report.CheckedTokens,
+		"updated", report.UpdatedTokens, "missing", len(report.MissingTokens), "checkpoint", report.Checkpoint)
+}
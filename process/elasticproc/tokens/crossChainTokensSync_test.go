@@ -0,0 +1,175 @@
+package tokens
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/multiversx/mx-chain-es-indexer-go/client"
+	"github.com/multiversx/mx-chain-es-indexer-go/client/disabled"
+	"github.com/multiversx/mx-chain-es-indexer-go/data"
+	"github.com/multiversx/mx-chain-es-indexer-go/mock"
+	indexerdata "github.com/multiversx/mx-chain-es-indexer-go/process/dataindexer"
+)
+
+func TestGetTokensBatchAfterCheckpoint(t *testing.T) {
+	t.Parallel()
+
+	tokensIDs := []string{"AAA-0001", "BBB-0001", "CCC-0001"}
+	require.Equal(t, tokensIDs, getTokensBatchAfterCheckpoint(tokensIDs, ""))
+	require.Equal(t, []string{"CCC-0001"}, getTokensBatchAfterCheckpoint(tokensIDs, "BBB-0001"))
+	require.Empty(t, getTokensBatchAfterCheckpoint(tokensIDs, "CCC-0001"))
+
+	manyTokens := make([]string, maxTokensPerSync+10)
+	for idx := range manyTokens {
+		manyTokens[idx] = fmt.Sprintf("TKN-%04d", idx)
+	}
+	require.Len(t, getTokensBatchAfterCheckpoint(manyTokens, ""), maxTokensPerSync)
+}
+
+func TestCompareTokens(t *testing.T) {
+	t.Parallel()
+
+	localToken := map[string]json.RawMessage{
+		"name":          json.RawMessage(`"Token"`),
+		"paused":        json.RawMessage(`false`),
+		"roles":         json.RawMessage(`{"ESDTRoleLocalMint":["erd1"]}`),
+		"attributes":    json.RawMessage(`[{"key":"color","value":"red"}]`),
+		"frozen":        json.RawMessage(`true`),
+		"supply":        json.RawMessage(`"100"`),
+		"ownersHistory": json.RawMessage(`[{"address":"erd1","timestamp":10}]`),
+	}
+	mainChainToken := map[string]json.RawMessage{
+		"name":          json.RawMessage(`"Token"`),
+		"paused":        json.RawMessage(`true`),
+		"roles":         json.RawMessage(`{"ESDTRoleLocalMint":["erd2"]}`),
+		"supply":        json.RawMessage(`"5000"`),
+		"ownersHistory": json.RawMessage(`[{"timestamp":10,"address":"erd1"}]`),
+	}
+
+	changedFields, removedFields := compareTokens(localToken, mainChainToken, true)
+	require.Equal(t, []string{"paused"}, changedFields)
+	require.Equal(t, []string{"frozen"}, removedFields)
+
+	changedFields, removedFields = compareTokens(localToken, mainChainToken, false)
+	require.Equal(t, []string{"paused"}, changedFields)
+	require.Empty(t, removedFields)
+}
+
+func TestSovereignIndexTokensHandler_SyncCrossChainTokensNotAvailable(t *testing.T) {
+	t.Parallel()
+
+	mainChainTokensSource, _ := NewElasticMainChainTokensSource(disabled.NewDisabledElasticClient())
+	sith, _ := NewSovereignIndexTokensHandler(mainChainTokensSource, prefix)
+	report, err := sith.SyncCrossChainTokens(&mock.DatabaseWriterStub{}, []string{indexerdata.TokensIndex}, data.NewBufferSlice(data.DefaultMaxBulkSize))
+	require.Nil(t, report)
+	require.Equal(t, indexerdata.ErrTokensSyncNotAvailable, err)
+}
+
+func TestSovereignIndexTokensHandler_SyncCrossChainTokens(t *testing.T) {
+	t.Parallel()
+
+	mainChainClient, _ := client.NewMainChainElasticClient(&mock.DatabaseWriterStub{
+		DoMultiGetCalled: func(ids []string, index string, withSource bool, response interface{}) error {
+			require.Equal(t, indexerdata.TokensIndex, index)
+			return json.Unmarshal([]byte(`{"docs":[
+				{"found":true,"_id":"AAA-0001","_source":{"name":"AAA","type":"FungibleESDT","paused":true,"supply":"1"}},
+				{"found":true,"_id":"BBB-0001","_source":{"name":"BBB","type":"FungibleESDT"}}
+			]}`), response)
+		},
+	}, true)
+
+	localClient := &mock.DatabaseWriterStub{
+		DoMultiGetCalled: func(ids []string, index string, withSource bool, response interface{}) error {
+			if index == indexerdata.ValuesIndex {
+				return json.Unmarshal([]byte(`{"docs":[{"found":true,"_id":"mainChainTokensSyncCheckpoint","_source":{"key":"mainChainTokensSyncCheckpoint","value":"AAA-0000"}}]}`), response)
+			}
+
+			require.Equal(t, []string{"AAA-0001", "BBB-0001", "CCC-0001"}, ids)
+			return json.Unmarshal([]byte(`{"docs":[
+				{"found":true,"_id":"AAA-0001","_source":{"name":"AAA","type":"FungibleESDT","paused":false,"supply":"100"}},
+				{"found":true,"_id":"BBB-0001","_source":{"name":"BBB","type":"FungibleESDT"}},
+				{"found":true,"_id":"CCC-0001","_source":{"name":"CCC","type":"FungibleESDT"}}
+			]}`), response)
+		},
+		DoScrollRequestCalled: func(index string, body []byte, withSource bool, handlerFunc func(responseBytes []byte) error) error {
+			require.Equal(t, indexerdata.TokensIndex, index)
+			require.Equal(t, `{"query": {"bool": {"must_not": [{"prefix": {"token": "sov-"}},{"exists": {"field": "identifier"}}]}}}`, string(body))
+			return handlerFunc([]byte(`{"hits":{"hits":[{"_id":"CCC-0001"},{"_id":"AAA-0000"},{"_id":"BBB-0001"},{"_id":"AAA-0001"}]}}`))
+		},
+		DoBulkRequestCalled: func(buff *bytes.Buffer, index string) error {
+			require.Fail(t, "the synchronization should only be serialized")
+			return nil
+		},
+	}
+
+	mainChainTokensSource, _ := NewElasticMainChainTokensSource(mainChainClient)
+	sith, _ := NewSovereignIndexTokensHandler(mainChainTokensSource, prefix)
+	buffSlice := data.NewBufferSlice(data.DefaultMaxBulkSize)
+	report, err := sith.SyncCrossChainTokens(localClient, []string{indexerdata.TokensIndex, indexerdata.ESDTsIndex}, buffSlice)
+	require.Nil(t, err)
+	require.Equal(t, 3, report.CheckedTokens)
+	require.Equal(t, 1, report.UpdatedTokens)
+	require.Equal(t, []string{"CCC-0001"}, report.MissingTokens)
+	require.Equal(t, []*data.TokenDivergence{{Token: "AAA-0001", Fields: []string{"paused"}}}, report.Divergences)
+	require.True(t, report.FullCycleCompleted)
+	require.Empty(t, report.Checkpoint)
+
+	bulk := buffSlice.Buffers()[0].String()
+	require.Contains(t, bulk, `{ "update" : { "_index":"tokens", "_id" : "AAA-0001" } }`)
+	require.Contains(t, bulk, `{ "update" : { "_index":"esdts", "_id" : "AAA-0001" } }`)
+	require.Contains(t, bulk, `"params": { "token": {"name":"AAA","paused":true,"type":"FungibleESDT"}, "removed": [] }}`)
+	require.NotContains(t, bulk, `"_id" : "BBB-0001"`)
+	require.Contains(t, bulk, `{ "index" : { "_index":"values", "_id" : "mainChainTokensSyncCheckpoint" } }
+{"key":"mainChainTokensSyncCheckpoint","value":""}`)
+
+	serializedReport, _ := json.Marshal(report)
+	require.Contains(t, bulk, fmt.Sprintf(`{ "index" : { "_index":"tokenssyncreports", "_id" : "%d" } }
+%s`, report.StartTimestamp, serializedReport))
+}
+
+func TestSovereignIndexTokensHandler_GetLastCrossChainTokensSyncReport(t *testing.T) {
+	t.Parallel()
+
+	mainChainTokensSource, _ := NewElasticMainChainTokensSource(disabled.NewDisabledElasticClient())
+	sith, _ := NewSovereignIndexTokensHandler(mainChainTokensSource, prefix)
+
+	report, err := sith.GetLastCrossChainTokensSyncReport(&mock.DatabaseWriterStub{
+		DoScrollRequestCalled: func(index string, body []byte, withSource bool, handlerFunc func(responseBytes []byte) error) error {
+			return handlerFunc([]byte(`{"hits":{"hits":[]}}`))
+		},
+	})
+	require.Nil(t, err)
+	require.Nil(t, report)
+
+	report, err = sith.GetLastCrossChainTokensSyncReport(&mock.DatabaseWriterStub{
+		DoScrollRequestCalled: func(index string, body []byte, withSource bool, handlerFunc func(responseBytes []byte) error) error {
+			require.Equal(t, indexerdata.TokensSyncReportsIndex, index)
+			require.Equal(t, `{"query": {"match_all": {}},"sort": [{"startTimestamp": {"order": "desc"}}]}`, string(body))
+			return handlerFunc([]byte(`{"hits":{"hits":[
+				{"_id":"200","_source":{"startTimestamp":200,"checkedTokens":5,"divergences":[{"token":"AAA-0001","fields":["paused"]}],"checkpoint":"","fullCycleCompleted":true}},
+				{"_id":"100","_source":{"startTimestamp":100,"checkedTokens":500,"checkpoint":"ZZZ-0001"}}
+			]}}`))
+		},
+	})
+	require.Nil(t, err)
+	require.Equal(t, &data.TokensSyncReport{
+		StartTimestamp:     200,
+		CheckedTokens:      5,
+		Divergences:        []*data.TokenDivergence{{Token: "AAA-0001", Fields: []string{"paused"}}},
+		FullCycleCompleted: true,
+	}, report)
+
+	expectedErr := errors.New("local error")
+	report, err = sith.GetLastCrossChainTokensSyncReport(&mock.DatabaseWriterStub{
+		DoScrollRequestCalled: func(index string, body []byte, withSource bool, handlerFunc func(responseBytes []byte) error) error {
+			return expectedErr
+		},
+	})
+	require.Nil(t, report)
+	require.Equal(t, expectedErr, err)
+}
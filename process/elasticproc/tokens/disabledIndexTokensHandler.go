@@ -2,6 +2,7 @@ package tokens
 
 import (
 	"github.com/multiversx/mx-chain-es-indexer-go/data"
+	"github.com/multiversx/mx-chain-es-indexer-go/process/dataindexer"
 	"github.com/multiversx/mx-chain-es-indexer-go/process/elasticproc"
 )
 
@@ -17,6 +18,16 @@ func (dit *disabledTndexTokensHandler) IndexCrossChainTokens(_ elasticproc.Datab
 	return nil
 }
 
+// SyncCrossChainTokens returns an error as there are no cross-chain tokens to synchronize
+func (dit *disabledTndexTokensHandler) SyncCrossChainTokens(_ elasticproc.DatabaseClientHandler, _ []string, _ *data.BufferSlice) (*data.TokensSyncReport, error) {
+	return nil, dataindexer.ErrTokensSyncNotAvailable
+}
+
+// GetLastCrossChainTokensSyncReport returns nil as there are no cross-chain tokens to synchronize
+func (dit *disabledTndexTokensHandler) GetLastCrossChainTokensSyncReport(_ elasticproc.DatabaseClientHandler) (*data.TokensSyncReport, error) {
+	return nil, nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (dit *disabledTndexTokensHandler) IsInterfaceNil() bool {
 	return dit == nil
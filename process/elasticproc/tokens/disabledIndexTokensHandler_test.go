@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/multiversx/mx-chain-es-indexer-go/process/dataindexer"
 )
 
 func TestNewIndexTokensHandler(t *testing.T) {
@@ -20,3 +22,16 @@ func TestIndexTokensHandler_IndexCrossChainTokens(t *testing.T) {
 	err := ith.IndexCrossChainTokens(nil, nil, nil)
 	require.NoError(t, err)
 }
+
+func TestIndexTokensHandler_SyncCrossChainTokens(t *testing.T) {
+	t.Parallel()
+
+	ith := NewDisabledIndexTokensHandler()
+	report, err := ith.SyncCrossChainTokens(nil, nil, nil)
+	require.Nil(t, report)
+	require.Equal(t, dataindexer.ErrTokensSyncNotAvailable, err)
+
+	report, err = ith.GetLastCrossChainTokensSyncReport(nil)
+	require.Nil(t, report)
+	require.Nil(t, err)
+}
@@ -0,0 +1,62 @@
+package tokens
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+
+	"github.com/multiversx/mx-chain-es-indexer-go/core/request"
+	"github.com/multiversx/mx-chain-es-indexer-go/data"
+	indexerdata "github.com/multiversx/mx-chain-es-indexer-go/process/dataindexer"
+	"github.com/multiversx/mx-chain-es-indexer-go/process/elasticproc"
+)
+
+type elasticMainChainTokensSource struct {
+	mainChainElasticClient elasticproc.MainChainDatabaseClientHandler
+}
+
+// NewElasticMainChainTokensSource creates a new main chain tokens source that reads the tokens index of the main chain cluster
+func NewElasticMainChainTokensSource(mainChainElasticClient elasticproc.MainChainDatabaseClientHandler) (*elasticMainChainTokensSource, error) {
+	if check.IfNil(mainChainElasticClient) {
+		return nil, indexerdata.ErrNilDatabaseClient
+	}
+
+	return &elasticMainChainTokensSource{
+		mainChainElasticClient: mainChainElasticClient,
+	}, nil
+}
+
+// GetTokens returns the documents of the provided tokens found in the main chain tokens index
+func (emts *elasticMainChainTokensSource) GetTokens(identifiers []string) (map[string]map[string]json.RawMessage, error) {
+	responseTokens := &data.ResponseRawDocs{}
+	ctxWithValue := context.WithValue(context.Background(), request.ContextKey, request.GetTopic)
+	err := emts.mainChainElasticClient.DoMultiGet(ctxWithValue, identifiers, indexerdata.TokensIndex, true, responseTokens)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens := make(map[string]map[string]json.RawMessage, len(responseTokens.Docs))
+	for _, doc := range responseTokens.Docs {
+		if doc.Found {
+			tokens[doc.ID] = doc.Source
+		}
+	}
+
+	return tokens, nil
+}
+
+// HasAllTokenFields returns true because the documents are the ones indexed on the main chain
+func (emts *elasticMainChainTokensSource) HasAllTokenFields() bool {
+	return true
+}
+
+// IsEnabled returns true if the main chain cluster is enabled
+func (emts *elasticMainChainTokensSource) IsEnabled() bool {
+	return emts.mainChainElasticClient.IsEnabled()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (emts *elasticMainChainTokensSource) IsInterfaceNil() bool {
+	return emts == nil
+}
@@ -0,0 +1,46 @@
+package tokens
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/multiversx/mx-chain-es-indexer-go/client"
+	"github.com/multiversx/mx-chain-es-indexer-go/mock"
+	indexerdata "github.com/multiversx/mx-chain-es-indexer-go/process/dataindexer"
+)
+
+func TestNewElasticMainChainTokensSource(t *testing.T) {
+	t.Parallel()
+
+	emts, err := NewElasticMainChainTokensSource(nil)
+	require.Nil(t, emts)
+	require.Equal(t, indexerdata.ErrNilDatabaseClient, err)
+
+	mainChainClient, _ := client.NewMainChainElasticClient(&mock.DatabaseWriterStub{}, true)
+	emts, err = NewElasticMainChainTokensSource(mainChainClient)
+	require.Nil(t, err)
+	require.False(t, emts.IsInterfaceNil())
+	require.True(t, emts.IsEnabled())
+	require.True(t, emts.HasAllTokenFields())
+}
+
+func TestElasticMainChainTokensSource_GetTokens(t *testing.T) {
+	t.Parallel()
+
+	mainChainClient, _ := client.NewMainChainElasticClient(&mock.DatabaseWriterStub{
+		DoMultiGetCalled: func(ids []string, index string, withSource bool, response interface{}) error {
+			require.Equal(t, []string{"AAA-0001", "BBB-0001"}, ids)
+			require.Equal(t, indexerdata.TokensIndex, index)
+			return json.Unmarshal([]byte(`{"docs":[{"found":true,"_id":"AAA-0001","_source":{"name":"AAA"}},{"found":false,"_id":"BBB-0001"}]}`), response)
+		},
+	}, true)
+
+	emts, _ := NewElasticMainChainTokensSource(mainChainClient)
+	tokens, err := emts.GetTokens([]string{"AAA-0001", "BBB-0001"})
+	require.Nil(t, err)
+	require.Equal(t, map[string]map[string]json.RawMessage{
+		"AAA-0001": {"name": json.RawMessage(`"AAA"`)},
+	}, tokens)
+}
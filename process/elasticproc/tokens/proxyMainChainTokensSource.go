@@ -0,0 +1,366 @@
+package tokens
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/alteredAccount"
+	"github.com/multiversx/mx-chain-core-go/data/esdt"
+
+	"github.com/multiversx/mx-chain-es-indexer-go/data"
+	indexerdata "github.com/multiversx/mx-chain-es-indexer-go/process/dataindexer"
+	"github.com/multiversx/mx-chain-es-indexer-go/process/elasticproc/converters"
+)
+
+const (
+	// ElasticTokensSourceType is the type of the main chain tokens source that reads the main chain Elasticsearch cluster
+	ElasticTokensSourceType = "elastic"
+	// ProxyTokensSourceType is the type of the main chain tokens source that reads a main chain gateway
+	ProxyTokensSourceType = "proxy"
+
+	vmValuesQueryPath     = "/vm-values/query"
+	nftTokenDataPath      = "/address/%s/nft/%s/nonce/%d"
+	getTokenPropertiesFn  = "getTokenProperties"
+	vmQueryReturnCodeOk   = "ok"
+	defaultRequestTimeout = 10 * time.Second
+
+	// getTokenProperties returns the name, the type, the owner, the minted and the burnt values of a token, followed by
+	// the properties in the "Name-value" format
+	numTokenPropertiesValues = 5
+)
+
+// ArgsProxyMainChainTokensSource holds the arguments needed for creating a new proxy main chain tokens source
+type ArgsProxyMainChainTokensSource struct {
+	ProxyURL string
+	// PubKeyConverter has to encode the addresses of the main chain
+	PubKeyConverter core.PubkeyConverter
+	RequestTimeout  time.Duration
+	// CacheExpiry is the duration for which a token is not requested again, 0 disables the cache
+	CacheExpiry  time.Duration
+	CacheMaxSize int
+}
+
+type proxyMainChainTokensSource struct {
+	proxyURL             string
+	pubKeyConverter      core.PubkeyConverter
+	esdtSCAddress        string
+	systemAccountAddress string
+	httpClient           *http.Client
+	cache                *tokensCache
+}
+
+type proxyResponse struct {
+	Data  json.RawMessage `json:"data"`
+	Error string          `json:"error"`
+	Code  string          `json:"code"`
+}
+
+type vmQueryRequest struct {
+	ScAddress string   `json:"scAddress"`
+	FuncName  string   `json:"funcName"`
+	Args      []string `json:"args"`
+}
+
+type vmQueryResponseData struct {
+	Data struct {
+		ReturnData    [][]byte `json:"returnData"`
+		ReturnCode    string   `json:"returnCode"`
+		ReturnMessage string   `json:"returnMessage"`
+	} `json:"data"`
+}
+
+type nftTokenDataResponseData struct {
+	TokenData struct {
+		TokenIdentifier string   `json:"tokenIdentifier"`
+		Name            string   `json:"name"`
+		Nonce           uint64   `json:"nonce"`
+		Creator         string   `json:"creator"`
+		Royalties       string   `json:"royalties"`
+		Hash            []byte   `json:"hash"`
+		URIs            [][]byte `json:"uris"`
+		Attributes      []byte   `json:"attributes"`
+	} `json:"tokenData"`
+}
+
+// NewProxyMainChainTokensSource creates a new main chain tokens source that reads the properties of the tokens from a
+// main chain gateway. The ESDT properties are read from the ESDT system smart contract, while the NFT properties are
+// read from the metadata kept in the system account
+func NewProxyMainChainTokensSource(args ArgsProxyMainChainTokensSource) (*proxyMainChainTokensSource, error) {
+	if args.ProxyURL == "" {
+		return nil, indexerdata.ErrNoProxyUrlProvided
+	}
+	if check.IfNil(args.PubKeyConverter) {
+		return nil, indexerdata.ErrNilPubkeyConverter
+	}
+
+	esdtSCAddress, err := args.PubKeyConverter.Encode(core.ESDTSCAddress)
+	if err != nil {
+		return nil, err
+	}
+	systemAccountAddress, err := args.PubKeyConverter.Encode(core.SystemAccountAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	requestTimeout := args.RequestTimeout
+	if requestTimeout == 0 {
+		requestTimeout = defaultRequestTimeout
+	}
+
+	return &proxyMainChainTokensSource{
+		proxyURL:             strings.TrimSuffix(args.ProxyURL, "/"),
+		pubKeyConverter:      args.PubKeyConverter,
+		esdtSCAddress:        esdtSCAddress,
+		systemAccountAddress: systemAccountAddress,
+		httpClient:           &http.Client{Timeout: requestTimeout},
+		cache:                newTokensCache(args.CacheExpiry, args.CacheMaxSize),
+	}, nil
+}
+
+// GetTokens returns the properties of the provided tokens that exist on the main chain, in the format of the tokens index
+func (pmts *proxyMainChainTokensSource) GetTokens(identifiers []string) (map[string]map[string]json.RawMessage, error) {
+	tokens := make(map[string]map[string]json.RawMessage, len(identifiers))
+	for _, identifier := range identifiers {
+		token, err := pmts.getToken(identifier)
+		if err != nil {
+			return nil, err
+		}
+		if token != nil {
+			tokens[identifier] = token
+		}
+	}
+
+	return tokens, nil
+}
+
+func (pmts *proxyMainChainTokensSource) getToken(identifier string) (map[string]json.RawMessage, error) {
+	token, found := pmts.cache.get(identifier)
+	if found {
+		return token, nil
+	}
+
+	_, hasPrefix := esdt.IsValidPrefixedToken(identifier)
+	collection := getTokenCollection(hasPrefix, identifier)
+	if collection != "" {
+		nft, err := pmts.fetchNFT(identifier, collection)
+		if err != nil || nft == nil {
+			return nil, err
+		}
+
+		return pmts.cacheToken(identifier, nft)
+	}
+
+	esdtToken, err := pmts.fetchESDT(identifier)
+	if err != nil || esdtToken == nil {
+		return nil, err
+	}
+
+	return pmts.cacheToken(identifier, esdtToken)
+}
+
+func (pmts *proxyMainChainTokensSource) cacheToken(identifier string, tokenInfo interface{}) (map[string]json.RawMessage, error) {
+	serializedToken, err := json.Marshal(tokenInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	token := make(map[string]json.RawMessage)
+	err = json.Unmarshal(serializedToken, &token)
+	if err != nil {
+		return nil, err
+	}
+
+	pmts.cache.put(identifier, token)
+
+	return token, nil
+}
+
+// proxyTokenInfo extends the token info with the fields that are updated by separate events on the main chain
+type proxyTokenInfo struct {
+	data.TokenInfo
+	Paused bool `json:"paused"`
+}
+
+func (pmts *proxyMainChainTokensSource) fetchESDT(token string) (*proxyTokenInfo, error) {
+	queryRequest := &vmQueryRequest{
+		ScAddress: pmts.esdtSCAddress,
+		FuncName:  getTokenPropertiesFn,
+		Args:      []string{hex.EncodeToString([]byte(token))},
+	}
+	serializedRequest, err := json.Marshal(queryRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	queryResponse := &vmQueryResponseData{}
+	err = pmts.doRequest(http.MethodPost, vmValuesQueryPath, serializedRequest, queryResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	returnData := queryResponse.Data.ReturnData
+	if queryResponse.Data.ReturnCode != vmQueryReturnCodeOk || len(returnData) < numTokenPropertiesValues {
+		log.Debug("token not found on the main chain", "token", token, "message", queryResponse.Data.ReturnMessage)
+		return nil, nil
+	}
+
+	tokenInfo := &proxyTokenInfo{
+		TokenInfo: data.TokenInfo{
+			Name:         string(returnData[0]),
+			Ticker:       token,
+			Token:        token,
+			CurrentOwner: pmts.pubKeyConverter.SilentEncode(returnData[2], log),
+			Type:         string(returnData[1]),
+			Properties:   &data.TokenProperties{},
+		},
+	}
+	if separatorIdx := strings.LastIndex(token, "-"); separatorIdx > 0 {
+		tokenInfo.Ticker = token[:separatorIdx]
+	}
+
+	for _, value := range returnData[numTokenPropertiesValues:] {
+		setTokenProperty(tokenInfo, string(value))
+	}
+
+	return tokenInfo, nil
+}
+
+func setTokenProperty(tokenInfo *proxyTokenInfo, property string) {
+	separatorIdx := strings.Index(property, "-")
+	if separatorIdx < 0 {
+		return
+	}
+
+	name, value := property[:separatorIdx], property[separatorIdx+1:]
+	boolValue := value == "true"
+	switch name {
+	case "NumDecimals":
+		tokenInfo.NumDecimals, _ = strconv.ParseUint(value, 10, 64)
+	case "IsPaused":
+		tokenInfo.Paused = boolValue
+	case "CanUpgrade":
+		tokenInfo.Properties.Upgradable = boolValue
+	case "CanMint":
+		tokenInfo.Properties.Mintable = boolValue
+	case "CanBurn":
+		tokenInfo.Properties.Burnable = boolValue
+	case "CanChangeOwner":
+		tokenInfo.Properties.CanChangeOwner = boolValue
+	case "CanPause":
+		tokenInfo.Properties.CanPause = boolValue
+	case "CanFreeze":
+		tokenInfo.Properties.CanFreeze = boolValue
+	case "CanWipe":
+		tokenInfo.Properties.CanWipe = boolValue
+	case "CanAddSpecialRoles":
+		tokenInfo.Properties.CanAddSpecialRoles = boolValue
+	case "CanTransferNFTCreateRole":
+		tokenInfo.Properties.CanTransferNFTCreateRole = boolValue
+	case "CanCreateMultiShard":
+		tokenInfo.Properties.CanCreateMultiShard = boolValue
+	}
+}
+
+func (pmts *proxyMainChainTokensSource) fetchNFT(identifier string, collection string) (*data.TokenInfo, error) {
+	nonceHex := identifier[strings.LastIndex(identifier, "-")+1:]
+	nonce, err := strconv.ParseUint(nonceHex, 16, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	nftResponse := &nftTokenDataResponseData{}
+	path := fmt.Sprintf(nftTokenDataPath, pmts.systemAccountAddress, collection, nonce)
+	err = pmts.doRequest(http.MethodGet, path, nil, nftResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenData := nftResponse.TokenData
+	if tokenData.Name == "" && tokenData.Creator == "" {
+		log.Debug("token not found on the main chain", "token", identifier)
+		return nil, nil
+	}
+
+	royalties, _ := strconv.ParseUint(tokenData.Royalties, 10, 32)
+	tokenInfo := &data.TokenInfo{
+		Identifier: identifier,
+		Token:      collection,
+		Nonce:      nonce,
+		Data: converters.PrepareTokenMetaData(&alteredAccount.TokenMetaData{
+			Nonce:      nonce,
+			Name:       tokenData.Name,
+			Creator:    tokenData.Creator,
+			Royalties:  uint32(royalties),
+			Hash:       tokenData.Hash,
+			URIs:       tokenData.URIs,
+			Attributes: tokenData.Attributes,
+		}),
+	}
+
+	// the type of the NFT is the type of its collection
+	collectionToken, err := pmts.getToken(collection)
+	if err != nil {
+		return nil, err
+	}
+	if collectionToken != nil {
+		_ = json.Unmarshal(collectionToken["type"], &tokenInfo.Type)
+	}
+
+	return tokenInfo, nil
+}
+
+func (pmts *proxyMainChainTokensSource) doRequest(method string, path string, body []byte, responseData interface{}) error {
+	request, err := http.NewRequest(method, pmts.proxyURL+path, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := pmts.httpClient.Do(request)
+	if err != nil {
+		return fmt.Errorf("%w: %s", indexerdata.ErrProxyRequestFailed, err.Error())
+	}
+	defer func() {
+		_ = response.Body.Close()
+	}()
+
+	responseBytes, err := io.ReadAll(response.Body)
+	if err != nil {
+		return err
+	}
+
+	proxyResp := &proxyResponse{}
+	err = json.Unmarshal(responseBytes, proxyResp)
+	if err != nil {
+		return fmt.Errorf("%w: status %d, %s", indexerdata.ErrProxyRequestFailed, response.StatusCode, err.Error())
+	}
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("%w: status %d, %s", indexerdata.ErrProxyRequestFailed, response.StatusCode, proxyResp.Error)
+	}
+
+	return json.Unmarshal(proxyResp.Data, responseData)
+}
+
+// HasAllTokenFields returns false because the gateway does not expose all the fields indexed on the main chain
+func (pmts *proxyMainChainTokensSource) HasAllTokenFields() bool {
+	return false
+}
+
+// IsEnabled returns true
+func (pmts *proxyMainChainTokensSource) IsEnabled() bool {
+	return true
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pmts *proxyMainChainTokensSource) IsInterfaceNil() bool {
+	return pmts == nil
+}
@@ -0,0 +1,181 @@
+package tokens
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/pubkeyConverter"
+	"github.com/stretchr/testify/require"
+
+	indexerdata "github.com/multiversx/mx-chain-es-indexer-go/process/dataindexer"
+)
+
+const (
+	esdtSCAddressBech32        = "erd1qqqqqqqqqqqqqqqpqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqzllls8a5w6u"
+	systemAccountAddressBech32 = "erd1lllllllllllllllllllllllllllllllllllllllllllllllllllsckry7t"
+	ownerBech32                = "erd1qyqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqquwsrdr"
+)
+
+func writeProxyResponse(w http.ResponseWriter, statusCode int, responseData interface{}, errMessage string) {
+	serializedData, _ := json.Marshal(responseData)
+	serializedResponse, _ := json.Marshal(&proxyResponse{
+		Data:  serializedData,
+		Error: errMessage,
+		Code:  "successful",
+	})
+
+	w.WriteHeader(statusCode)
+	_, _ = w.Write(serializedResponse)
+}
+
+func createProxyStandIn(t *testing.T, numRequests *uint32) *httptest.Server {
+	// the ESDT system smart contract returns the owner as raw address bytes
+	owner := string(append([]byte{1}, make([]byte, 31)...))
+	tokensProperties := map[string][]string{
+		"TKN-123456": {"Token", "FungibleESDT", owner, "1000", "0", "NumDecimals-18", "IsPaused-true", "CanUpgrade-true",
+			"CanMint-true", "CanBurn-false", "CanChangeOwner-true", "CanPause-true", "CanFreeze-false", "CanWipe-false",
+			"CanAddSpecialRoles-true", "CanTransferNFTCreateRole-false", "NFTCreateStopped-false", "NumWiped-0"},
+		"NFT-abcdef": {"Collection", "NonFungibleESDT", owner, "0", "0", "NumDecimals-0", "IsPaused-false"},
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(vmValuesQueryPath, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddUint32(numRequests, 1)
+		require.Equal(t, http.MethodPost, r.Method)
+
+		queryRequest := &vmQueryRequest{}
+		require.Nil(t, json.NewDecoder(r.Body).Decode(queryRequest))
+		require.Equal(t, esdtSCAddressBech32, queryRequest.ScAddress)
+		require.Equal(t, getTokenPropertiesFn, queryRequest.FuncName)
+
+		token, _ := hex.DecodeString(queryRequest.Args[0])
+		queryResponse := &vmQueryResponseData{}
+		properties, found := tokensProperties[string(token)]
+		if !found {
+			queryResponse.Data.ReturnCode = "user error"
+			queryResponse.Data.ReturnMessage = "no ticker with given name"
+			writeProxyResponse(w, http.StatusOK, queryResponse, "")
+			return
+		}
+
+		queryResponse.Data.ReturnCode = vmQueryReturnCodeOk
+		for _, property := range properties {
+			queryResponse.Data.ReturnData = append(queryResponse.Data.ReturnData, []byte(property))
+		}
+		writeProxyResponse(w, http.StatusOK, queryResponse, "")
+	})
+	mux.HandleFunc("/address/"+systemAccountAddressBech32+"/nft/NFT-abcdef/nonce/1", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddUint32(numRequests, 1)
+		require.Equal(t, http.MethodGet, r.Method)
+
+		nftResponse := &nftTokenDataResponseData{}
+		nftResponse.TokenData.TokenIdentifier = "NFT-abcdef-01"
+		nftResponse.TokenData.Name = "Nft"
+		nftResponse.TokenData.Nonce = 1
+		nftResponse.TokenData.Creator = "erd1creator"
+		nftResponse.TokenData.Royalties = "500"
+		nftResponse.TokenData.URIs = [][]byte{[]byte("uri")}
+		nftResponse.TokenData.Attributes = []byte("tags:a,b")
+		writeProxyResponse(w, http.StatusOK, nftResponse, "")
+	})
+	mux.HandleFunc("/address/"+systemAccountAddressBech32+"/nft/NFT-abcdef/nonce/2", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddUint32(numRequests, 1)
+		writeProxyResponse(w, http.StatusOK, &nftTokenDataResponseData{}, "")
+	})
+
+	return httptest.NewServer(mux)
+}
+
+func createArgsProxyMainChainTokensSource(proxyURL string) ArgsProxyMainChainTokensSource {
+	converter, _ := pubkeyConverter.NewBech32PubkeyConverter(32, "erd")
+	return ArgsProxyMainChainTokensSource{
+		ProxyURL:        proxyURL,
+		PubKeyConverter: converter,
+		RequestTimeout:  time.Second,
+		CacheExpiry:     time.Minute,
+		CacheMaxSize:    100,
+	}
+}
+
+func TestNewProxyMainChainTokensSource(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no proxy url, should error", func(t *testing.T) {
+		args := createArgsProxyMainChainTokensSource("")
+		pmts, err := NewProxyMainChainTokensSource(args)
+		require.Nil(t, pmts)
+		require.Equal(t, indexerdata.ErrNoProxyUrlProvided, err)
+	})
+	t.Run("nil pub key converter, should error", func(t *testing.T) {
+		args := createArgsProxyMainChainTokensSource("http://localhost:8079")
+		args.PubKeyConverter = nil
+		pmts, err := NewProxyMainChainTokensSource(args)
+		require.Nil(t, pmts)
+		require.Equal(t, indexerdata.ErrNilPubkeyConverter, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		pmts, err := NewProxyMainChainTokensSource(createArgsProxyMainChainTokensSource("http://localhost:8079/"))
+		require.Nil(t, err)
+		require.False(t, pmts.IsInterfaceNil())
+		require.True(t, pmts.IsEnabled())
+		require.False(t, pmts.HasAllTokenFields())
+		require.Equal(t, "http://localhost:8079", pmts.proxyURL)
+		require.Equal(t, esdtSCAddressBech32, pmts.esdtSCAddress)
+		require.Equal(t, systemAccountAddressBech32, pmts.systemAccountAddress)
+	})
+}
+
+func TestProxyMainChainTokensSource_GetTokens(t *testing.T) {
+	t.Parallel()
+
+	numRequests := uint32(0)
+	proxyStandIn := createProxyStandIn(t, &numRequests)
+	defer proxyStandIn.Close()
+
+	pmts, _ := NewProxyMainChainTokensSource(createArgsProxyMainChainTokensSource(proxyStandIn.URL))
+	identifiers := []string{"TKN-123456", "NFT-abcdef-01", "NFT-abcdef-02", "MISSING-abcdef"}
+	tokens, err := pmts.GetTokens(identifiers)
+	require.Nil(t, err)
+	require.Len(t, tokens, 2)
+
+	serializedToken, _ := json.Marshal(tokens["TKN-123456"])
+	require.JSONEq(t, `{"name":"Token","ticker":"TKN","token":"TKN-123456","currentOwner":"`+ownerBech32+`","numDecimals":18,
+		"type":"FungibleESDT","paused":true,"properties":{"canMint":true,"canBurn":false,"canUpgrade":true,
+		"canTransferNFTCreateRole":false,"canAddSpecialRoles":true,"canPause":true,"canFreeze":false,"canWipe":false,
+		"canChangeOwner":true,"canCreateMultiShard":false}}`, string(serializedToken))
+
+	serializedNFT, _ := json.Marshal(tokens["NFT-abcdef-01"])
+	require.JSONEq(t, `{"identifier":"NFT-abcdef-01","token":"NFT-abcdef","nonce":1,"numDecimals":0,"type":"NonFungibleESDT",
+		"data":{"name":"Nft","creator":"erd1creator","royalties":500,"uris":["dXJp"],"tags":["a","b"],"attributes":"dGFnczphLGI=",
+		"nonEmptyURIs":true,"whiteListedStorage":false}}`, string(serializedNFT))
+
+	// the collection is requested only once, the second NFT is not found and the missing token does not exist
+	require.Equal(t, uint32(5), atomic.LoadUint32(&numRequests))
+
+	// the found tokens are served from the cache
+	tokens, err = pmts.GetTokens([]string{"TKN-123456", "NFT-abcdef-01"})
+	require.Nil(t, err)
+	require.Len(t, tokens, 2)
+	require.Equal(t, uint32(5), atomic.LoadUint32(&numRequests))
+}
+
+func TestProxyMainChainTokensSource_GetTokensRequestFailed(t *testing.T) {
+	t.Parallel()
+
+	proxyStandIn := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeProxyResponse(w, http.StatusInternalServerError, nil, "internal error")
+	}))
+	defer proxyStandIn.Close()
+
+	pmts, _ := NewProxyMainChainTokensSource(createArgsProxyMainChainTokensSource(proxyStandIn.URL))
+	tokens, err := pmts.GetTokens([]string{"TKN-123456"})
+	require.Nil(t, tokens)
+	require.True(t, errors.Is(err, indexerdata.ErrProxyRequestFailed))
+	require.Contains(t, err.Error(), "internal error")
+}
@@ -7,7 +7,9 @@ import (
 	"strings"
 
 	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/data/esdt"
+	logger "github.com/multiversx/mx-chain-logger-go"
 
 	"github.com/multiversx/mx-chain-es-indexer-go/data"
 	indexerdata "github.com/multiversx/mx-chain-es-indexer-go/process/dataindexer"
@@ -15,22 +17,28 @@ import (
 	"github.com/multiversx/mx-chain-es-indexer-go/process/elasticproc/converters"
 )
 
+var log = logger.GetOrCreate("indexer/process/tokens")
+
 type sovereignIndexTokensHandler struct {
-	mainChainElasticClient elasticproc.MainChainDatabaseClientHandler
-	esdtPrefix             string
+	mainChainTokensSource elasticproc.MainChainTokensSource
+	esdtPrefix            string
 }
 
 // NewSovereignIndexTokensHandler creates a new sovereign index tokens handler
-func NewSovereignIndexTokensHandler(mainChainElasticClient elasticproc.MainChainDatabaseClientHandler, esdtPrefix string) (*sovereignIndexTokensHandler, error) {
+func NewSovereignIndexTokensHandler(mainChainTokensSource elasticproc.MainChainTokensSource, esdtPrefix string) (*sovereignIndexTokensHandler, error) {
+	if check.IfNil(mainChainTokensSource) {
+		return nil, indexerdata.ErrNilMainChainTokensSource
+	}
+
 	return &sovereignIndexTokensHandler{
-		mainChainElasticClient: mainChainElasticClient,
-		esdtPrefix:             esdtPrefix,
+		mainChainTokensSource: mainChainTokensSource,
+		esdtPrefix:            esdtPrefix,
 	}, nil
 }
 
 // IndexCrossChainTokens will index the new tokens properties
 func (sit *sovereignIndexTokensHandler) IndexCrossChainTokens(elasticClient elasticproc.DatabaseClientHandler, scrs []*data.ScResult, buffSlice *data.BufferSlice) error {
-	if !sit.mainChainElasticClient.IsEnabled() {
+	if !sit.mainChainTokensSource.IsEnabled() {
 		return nil
 	}
 
@@ -43,14 +51,12 @@ func (sit *sovereignIndexTokensHandler) IndexCrossChainTokens(elasticClient elas
 		return nil
 	}
 
-	// get tokens from main chain elastic db
-	mainChainTokens := &data.ResponseTokenInfo{}
-	err = sit.mainChainElasticClient.DoMultiGet(context.Background(), newTokens, indexerdata.TokensIndex, true, mainChainTokens)
+	mainChainTokens, err := sit.mainChainTokensSource.GetTokens(newTokens)
 	if err != nil {
 		return err
 	}
 
-	return sit.serializeNewTokens(mainChainTokens.Docs, buffSlice)
+	return sit.serializeNewTokens(newTokens, mainChainTokens, buffSlice)
 }
 
 func (sit *sovereignIndexTokensHandler) getNewTokensFromSCRs(elasticClient elasticproc.DatabaseClientHandler, scrs []*data.ScResult) ([]string, error) {
@@ -107,9 +113,17 @@ func getTokenCollection(hasPrefix bool, tokenIdentifier string) string {
 	return ""
 }
 
-func (sit *sovereignIndexTokensHandler) serializeNewTokens(responseTokensInfo []data.ResponseTokenInfoDB, buffSlice *data.BufferSlice) error {
-	for _, responseToken := range responseTokensInfo {
-		token, identifier := formatToken(responseToken)
+func (sit *sovereignIndexTokensHandler) serializeNewTokens(newTokens []string, mainChainTokens map[string]map[string]json.RawMessage, buffSlice *data.BufferSlice) error {
+	for _, newToken := range newTokens {
+		mainChainToken, found := mainChainTokens[newToken]
+		if !found {
+			continue
+		}
+
+		token, identifier, err := formatToken(mainChainToken)
+		if err != nil {
+			return err
+		}
 
 		meta := []byte(fmt.Sprintf(`{ "index" : { "_index":"%s", "_id" : "%s" } }%s`, indexerdata.TokensIndex, converters.JsonEscape(identifier), "\n"))
 		serializedTokenData, err := json.Marshal(token)
@@ -126,15 +140,25 @@ func (sit *sovereignIndexTokensHandler) serializeNewTokens(responseTokensInfo []
 	return nil
 }
 
-func formatToken(token data.ResponseTokenInfoDB) (data.TokenInfo, string) {
-	token.Source.OwnersHistory = nil
-	token.Source.Properties = nil
+func formatToken(mainChainToken map[string]json.RawMessage) (data.TokenInfo, string, error) {
+	token := data.TokenInfo{}
+	serializedToken, err := json.Marshal(mainChainToken)
+	if err != nil {
+		return token, "", err
+	}
+	err = json.Unmarshal(serializedToken, &token)
+	if err != nil {
+		return token, "", err
+	}
+
+	token.OwnersHistory = nil
+	token.Properties = nil
 
-	identifier := token.Source.Identifier // for NFTs
+	identifier := token.Identifier // for NFTs
 	if identifier == "" {
-		identifier = token.Source.Token // for tokens/collections
+		identifier = token.Token // for tokens/collections
 	}
-	return token.Source, identifier
+	return token, identifier, nil
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
@@ -1,14 +1,17 @@
 package tokens
 
 import (
+	"encoding/json"
 	"fmt"
 	"testing"
 
+	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/stretchr/testify/require"
 
 	"github.com/multiversx/mx-chain-es-indexer-go/client/disabled"
 	"github.com/multiversx/mx-chain-es-indexer-go/data"
 	"github.com/multiversx/mx-chain-es-indexer-go/mock"
+	indexerdata "github.com/multiversx/mx-chain-es-indexer-go/process/dataindexer"
 )
 
 const (
@@ -18,22 +21,30 @@ const (
 func TestSovereignNewIndexTokensHandler(t *testing.T) {
 	t.Parallel()
 
+	t.Run("nil main chain tokens source, should error", func(t *testing.T) {
+		sith, err := NewSovereignIndexTokensHandler(nil, prefix)
+		require.Nil(t, sith)
+		require.Equal(t, indexerdata.ErrNilMainChainTokensSource, err)
+	})
 	t.Run("valid disabled config, should work", func(t *testing.T) {
-		sith, err := NewSovereignIndexTokensHandler(disabled.NewDisabledElasticClient(), prefix)
+		mainChainTokensSource, _ := NewElasticMainChainTokensSource(disabled.NewDisabledElasticClient())
+		sith, err := NewSovereignIndexTokensHandler(mainChainTokensSource, prefix)
 		require.NoError(t, err)
-		require.Equal(t, "*disabled.elasticClient", fmt.Sprintf("%T", sith.mainChainElasticClient))
+		require.Equal(t, "*tokens.elasticMainChainTokensSource", fmt.Sprintf("%T", sith.mainChainTokensSource))
+		require.False(t, sith.mainChainTokensSource.IsEnabled())
 	})
 	t.Run("valid config, should work", func(t *testing.T) {
-		sith, err := NewSovereignIndexTokensHandler(&mock.DatabaseWriterStub{}, prefix)
+		sith, err := NewSovereignIndexTokensHandler(&mock.MainChainTokensSourceStub{}, prefix)
 		require.NoError(t, err)
-		require.Equal(t, "*mock.DatabaseWriterStub", fmt.Sprintf("%T", sith.mainChainElasticClient))
+		require.Equal(t, "*mock.MainChainTokensSourceStub", fmt.Sprintf("%T", sith.mainChainTokensSource))
 	})
 }
 
 func TestSovereignIndexTokensHandler_IndexCrossChainTokens(t *testing.T) {
 	t.Parallel()
 
-	sith, err := NewSovereignIndexTokensHandler(disabled.NewDisabledElasticClient(), prefix)
+	mainChainTokensSource, _ := NewElasticMainChainTokensSource(disabled.NewDisabledElasticClient())
+	sith, err := NewSovereignIndexTokensHandler(mainChainTokensSource, prefix)
 	require.NoError(t, err)
 	require.NotNil(t, sith)
 
@@ -43,3 +54,35 @@ func TestSovereignIndexTokensHandler_IndexCrossChainTokens(t *testing.T) {
 
 	// actual indexing is tested in TestCrossChainTokensIndexingFromMainChain
 }
+
+func TestSovereignIndexTokensHandler_IndexCrossChainTokensFromTokensSource(t *testing.T) {
+	t.Parallel()
+
+	mainChainTokensSource := &mock.MainChainTokensSourceStub{
+		GetTokensCalled: func(identifiers []string) (map[string]map[string]json.RawMessage, error) {
+			require.Equal(t, []string{"TKN-123456", "NFT-abcdef-01", "NFT-abcdef"}, identifiers)
+			return map[string]map[string]json.RawMessage{
+				"TKN-123456": {"name": json.RawMessage(`"Token"`), "token": json.RawMessage(`"TKN-123456"`), "type": json.RawMessage(`"FungibleESDT"`),
+					"properties": json.RawMessage(`{"canMint":true}`)},
+				"NFT-abcdef": {"name": json.RawMessage(`"Collection"`), "token": json.RawMessage(`"NFT-abcdef"`), "type": json.RawMessage(`"NonFungibleESDT"`)},
+			}, nil
+		},
+	}
+	elasticClient := &mock.DatabaseWriterStub{
+		DoMultiGetCalled: func(ids []string, index string, withSource bool, response interface{}) error {
+			return json.Unmarshal([]byte(`{"docs":[{"_id":"TKN-123456","found":false},{"_id":"NFT-abcdef-01","found":false},{"_id":"NFT-abcdef","found":false}]}`), response)
+		},
+	}
+
+	sith, _ := NewSovereignIndexTokensHandler(mainChainTokensSource, prefix)
+	buffSlice := data.NewBufferSlice(data.DefaultMaxBulkSize)
+	scrs := []*data.ScResult{{SenderShard: core.MainChainShardId, Tokens: []string{"TKN-123456", "NFT-abcdef-01"}}}
+	err := sith.IndexCrossChainTokens(elasticClient, scrs, buffSlice)
+	require.NoError(t, err)
+
+	require.Equal(t, `{ "index" : { "_index":"tokens", "_id" : "TKN-123456" } }
+{"name":"Token","token":"TKN-123456","numDecimals":0,"type":"FungibleESDT"}
+{ "index" : { "_index":"tokens", "_id" : "NFT-abcdef" } }
+{"name":"Collection","token":"NFT-abcdef","numDecimals":0,"type":"NonFungibleESDT"}
+`, buffSlice.Buffers()[0].String())
+}
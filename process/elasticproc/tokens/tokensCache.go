@@ -0,0 +1,87 @@
+package tokens
+
+import (
+	"encoding/json"
+	"sync"
+	"time"
+)
+
+type cachedToken struct {
+	token     map[string]json.RawMessage
+	expiresAt time.Time
+}
+
+// tokensCache holds the tokens read from the main chain for a limited time, so the same token is not requested for
+// every cross-chain transfer
+type tokensCache struct {
+	mut      sync.Mutex
+	tokens   map[string]*cachedToken
+	expiry   time.Duration
+	maxSize  int
+	timeFunc func() time.Time
+}
+
+func newTokensCache(expiry time.Duration, maxSize int) *tokensCache {
+	return &tokensCache{
+		tokens:   make(map[string]*cachedToken),
+		expiry:   expiry,
+		maxSize:  maxSize,
+		timeFunc: time.Now,
+	}
+}
+
+func (tc *tokensCache) get(identifier string) (map[string]json.RawMessage, bool) {
+	tc.mut.Lock()
+	defer tc.mut.Unlock()
+
+	cached, found := tc.tokens[identifier]
+	if !found {
+		return nil, false
+	}
+	if !tc.timeFunc().Before(cached.expiresAt) {
+		delete(tc.tokens, identifier)
+		return nil, false
+	}
+
+	return cached.token, true
+}
+
+func (tc *tokensCache) put(identifier string, token map[string]json.RawMessage) {
+	if tc.expiry == 0 || tc.maxSize == 0 {
+		return
+	}
+
+	tc.mut.Lock()
+	defer tc.mut.Unlock()
+
+	now := tc.timeFunc()
+	_, exists := tc.tokens[identifier]
+	if !exists && len(tc.tokens) >= tc.maxSize {
+		tc.evict(now)
+	}
+
+	tc.tokens[identifier] = &cachedToken{
+		token:     token,
+		expiresAt: now.Add(tc.expiry),
+	}
+}
+
+// evict removes the expired tokens and, if the cache is still full, the token that expires first
+func (tc *tokensCache) evict(now time.Time) {
+	oldestIdentifier := ""
+	var oldestExpiresAt time.Time
+	for identifier, cached := range tc.tokens {
+		if !now.Before(cached.expiresAt) {
+			delete(tc.tokens, identifier)
+			continue
+		}
+		if oldestIdentifier == "" || cached.expiresAt.Before(oldestExpiresAt) {
+			oldestIdentifier = identifier
+			oldestExpiresAt = cached.expiresAt
+		}
+	}
+
+	if len(tc.tokens) >= tc.maxSize {
+		delete(tc.tokens, oldestIdentifier)
+	}
+}
@@ -0,0 +1,48 @@
+package tokens
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokensCache(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1000, 0)
+	cache := newTokensCache(10*time.Second, 2)
+	cache.timeFunc = func() time.Time {
+		return now
+	}
+
+	token := map[string]json.RawMessage{"name": json.RawMessage(`"Token"`)}
+	cache.put("AAA-0001", token)
+	now = now.Add(time.Second)
+	cache.put("BBB-0001", token)
+
+	cachedToken, found := cache.get("AAA-0001")
+	require.True(t, found)
+	require.Equal(t, token, cachedToken)
+
+	// the cache is full, the token that expires first is evicted
+	now = now.Add(time.Second)
+	cache.put("CCC-0001", token)
+	_, found = cache.get("AAA-0001")
+	require.False(t, found)
+	_, found = cache.get("BBB-0001")
+	require.True(t, found)
+
+	// the tokens expire
+	now = now.Add(9 * time.Second)
+	_, found = cache.get("BBB-0001")
+	require.False(t, found)
+	_, found = cache.get("CCC-0001")
+	require.True(t, found)
+
+	disabledCache := newTokensCache(0, 10)
+	disabledCache.put("AAA-0001", token)
+	_, found = disabledCache.get("AAA-0001")
+	require.False(t, found)
+}
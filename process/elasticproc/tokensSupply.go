@@ -0,0 +1,58 @@
+package elasticproc
+
+import (
+	"context"
+
+	coreData "github.com/multiversx/mx-chain-core-go/data"
+
+	"github.com/multiversx/mx-chain-es-indexer-go/core/request"
+	"github.com/multiversx/mx-chain-es-indexer-go/data"
+	elasticIndexer "github.com/multiversx/mx-chain-es-indexer-go/process/dataindexer"
+)
+
+func (ei *elasticProcessor) indexTokensSupply(supplyChanges []*data.TokenSupplyChange, buffSlice *data.BufferSlice) error {
+	if len(supplyChanges) == 0 {
+		return nil
+	}
+
+	if ei.isIndexEnabled(elasticIndexer.ESDTsIndex) {
+		err := ei.logsAndEventsProc.SerializeTokensSupplyChanges(getTokensSupplyChangesWithoutNonces(supplyChanges), buffSlice, elasticIndexer.ESDTsIndex)
+		if err != nil {
+			return err
+		}
+	}
+
+	if !ei.isIndexEnabled(elasticIndexer.TokensIndex) {
+		return nil
+	}
+
+	return ei.logsAndEventsProc.SerializeTokensSupplyChanges(supplyChanges, buffSlice, elasticIndexer.TokensIndex)
+}
+
+func getTokensSupplyChangesWithoutNonces(supplyChanges []*data.TokenSupplyChange) []*data.TokenSupplyChange {
+	tokensSupplyChanges := make([]*data.TokenSupplyChange, 0, len(supplyChanges))
+	for _, supplyChange := range supplyChanges {
+		if supplyChange.Nonce == 0 {
+			tokensSupplyChanges = append(tokensSupplyChanges, supplyChange)
+		}
+	}
+
+	return tokensSupplyChanges
+}
+
+func (ei *elasticProcessor) revertTokensSupply(header coreData.HeaderHandler) error {
+	ctxWithValue := context.WithValue(context.Background(), request.ContextKey, request.ExtendTopicWithShardID(request.UpdateTopic, header.GetShardID()))
+	for _, index := range []string{elasticIndexer.TokensIndex, elasticIndexer.ESDTsIndex} {
+		if !ei.isIndexEnabled(index) {
+			continue
+		}
+
+		query := ei.logsAndEventsProc.PrepareTokensSupplyQueryInCaseOfRevert(header.GetTimeStamp(), header.GetShardID())
+		err := ei.elasticClient.UpdateByQuery(ctxWithValue, index, query)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
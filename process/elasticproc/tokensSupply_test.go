@@ -0,0 +1,67 @@
+package elasticproc
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	dataBlock "github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multiversx/mx-chain-es-indexer-go/data"
+	"github.com/multiversx/mx-chain-es-indexer-go/mock"
+	"github.com/multiversx/mx-chain-es-indexer-go/process/dataindexer"
+)
+
+func TestElasticProcessor_IndexTokensSupply(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockElasticProcessorArgs()
+	arguments.EnabledIndexes = map[string]struct{}{dataindexer.TokensIndex: {}, dataindexer.ESDTsIndex: {}}
+	dbWriter := &mock.DatabaseWriterStub{
+		DoMultiGetCalled: func(ids []string, index string, withSource bool, response interface{}) error {
+			require.Fail(t, "the tokens should not be fetched")
+			return nil
+		},
+	}
+	elasticProc := newElasticsearchProcessor(dbWriter, arguments)
+
+	supplyChanges := []*data.TokenSupplyChange{
+		{Token: "NFT-abcd"},
+		{Token: "NFT-abcd", Identifier: "NFT-abcd-01", Nonce: 1},
+		{Token: "SFT-abcd"},
+		{Token: "SFT-abcd", Identifier: "SFT-abcd-01", Nonce: 1, HasQuantity: true},
+		{Token: "TKN-abcd"},
+	}
+
+	buffSlice := data.NewBufferSlice(data.DefaultMaxBulkSize)
+	err := elasticProc.indexTokensSupply(supplyChanges, buffSlice)
+	require.Nil(t, err)
+
+	serialized := buffSlice.Buffers()[0].String()
+	require.Equal(t, 3, strings.Count(serialized, `"_index":"esdts"`))
+	require.Equal(t, 5, strings.Count(serialized, `"_index":"tokens"`))
+	require.Contains(t, serialized, `{ "update" : { "_index":"tokens", "_id" : "SFT-abcd-01" } }`)
+	require.Contains(t, serialized, `{ "update" : { "_index":"tokens", "_id" : "NFT-abcd-01" } }`)
+	require.Equal(t, 1, strings.Count(serialized, `"hasQuantity": false`))
+}
+
+func TestElasticProcessor_RevertTokensSupply(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockElasticProcessorArgs()
+	arguments.EnabledIndexes = map[string]struct{}{dataindexer.TokensIndex: {}, dataindexer.ESDTsIndex: {}}
+	updatedIndices := make([]string, 0)
+	dbWriter := &mock.DatabaseWriterStub{
+		UpdateByQueryCalled: func(index string, buff *bytes.Buffer) error {
+			updatedIndices = append(updatedIndices, index)
+			require.Contains(t, buff.String(), `{"match": {"supplyChanges.timestamp": "1000"}}`)
+			return nil
+		},
+	}
+	elasticProc := newElasticsearchProcessor(dbWriter, arguments)
+
+	err := elasticProc.revertTokensSupply(&dataBlock.Header{TimeStamp: 1000, ShardID: 1})
+	require.Nil(t, err)
+	require.Equal(t, []string{dataindexer.TokensIndex, dataindexer.ESDTsIndex}, updatedIndices)
+}
@@ -0,0 +1,43 @@
+package elasticproc
+
+import (
+	"github.com/multiversx/mx-chain-core-go/core"
+
+	"github.com/multiversx/mx-chain-es-indexer-go/data"
+	elasticIndexer "github.com/multiversx/mx-chain-es-indexer-go/process/dataindexer"
+)
+
+// PrepareMainChainTokensSync will serialize the refreshed cross-chain tokens from the main chain for the enabled tokens
+// indices, together with the report of the synchronization. Nothing is written until SaveMainChainTokensSync is called
+func (ei *elasticProcessor) PrepareMainChainTokensSync() (*data.TokensSyncReport, *data.BufferSlice, error) {
+	if !ei.isIndexEnabled(elasticIndexer.TokensIndex) {
+		return nil, nil, elasticIndexer.ErrTokensSyncNotAvailable
+	}
+
+	indexes := []string{elasticIndexer.TokensIndex}
+	if ei.isIndexEnabled(elasticIndexer.ESDTsIndex) {
+		indexes = append(indexes, elasticIndexer.ESDTsIndex)
+	}
+
+	buffSlice := data.NewBufferSlice(ei.getBulkRequestMaxSize())
+	report, err := ei.indexTokensHandler.SyncCrossChainTokens(ei.elasticClient, indexes, buffSlice)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return report, buffSlice, nil
+}
+
+// SaveMainChainTokensSync will write the synchronization prepared by PrepareMainChainTokensSync
+func (ei *elasticProcessor) SaveMainChainTokensSync(buffSlice *data.BufferSlice) error {
+	return ei.doBulkRequests("", buffSlice.Buffers(), core.SovereignChainShardId)
+}
+
+// GetLastMainChainTokensSyncReport returns the report of the last synchronization of the cross-chain tokens, nil if there was none
+func (ei *elasticProcessor) GetLastMainChainTokensSyncReport() (*data.TokensSyncReport, error) {
+	if !ei.isIndexEnabled(elasticIndexer.TokensIndex) {
+		return nil, nil
+	}
+
+	return ei.indexTokensHandler.GetLastCrossChainTokensSyncReport(ei.elasticClient)
+}
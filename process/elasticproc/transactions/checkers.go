@@ -42,6 +42,9 @@ func checkTxsProcessorArg(args *ArgsTransactionProcessor) error {
 	if check.IfNil(args.RewardTxData) {
 		return ErrNilRewardTxDataHandler
 	}
+	if check.IfNil(args.CallArgsDecoder) {
+		return ErrNilCallArgsDecoder
+	}
 
 	return nil
 }
@@ -110,3 +113,7 @@ func isRelayedTx(tx *data.Transaction) bool {
 func isCrossShardOnSourceShard(tx *data.Transaction, selfShardID uint32) bool {
 	return tx.SenderShard != tx.ReceiverShard && tx.SenderShard == selfShardID
 }
+
+func isCrossShardOnDestinationShard(tx *data.Transaction, selfShardID uint32) bool {
+	return tx.SenderShard != tx.ReceiverShard && tx.ReceiverShard == selfShardID
+}
@@ -27,6 +27,7 @@ func createMockArgs() *ArgsTransactionProcessor {
 		BalanceConverter:       bc,
 		TxHashExtractor:        &mock.TxHashExtractorMock{},
 		RewardTxData:           &mock.RewardTxDataMock{},
+		CallArgsDecoder:        &mock.ABIDecoderStub{},
 	}
 }
 
@@ -92,6 +93,15 @@ func TestNewTransactionsProcessor(t *testing.T) {
 			},
 			exErr: ErrNilRewardTxDataHandler,
 		},
+		{
+			name: "NilCallArgsDecoder",
+			args: func() *ArgsTransactionProcessor {
+				args := createMockArgs()
+				args.CallArgsDecoder = nil
+				return args
+			},
+			exErr: ErrNilCallArgsDecoder,
+		},
 	}
 
 	for _, tt := range tests {
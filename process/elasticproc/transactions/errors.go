@@ -9,3 +9,6 @@ var ErrNilTxHashExtractor = errors.New("nil tx hash extractor")
 
 // ErrNilRewardTxDataHandler signals that a nil rewards tx data handler has been provided
 var ErrNilRewardTxDataHandler = errors.New("nil reward tx data handler")
+
+// ErrNilCallArgsDecoder signals that a nil call arguments decoder has been provided
+var ErrNilCallArgsDecoder = errors.New("nil call arguments decoder")
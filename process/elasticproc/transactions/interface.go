@@ -4,6 +4,8 @@ import (
 	coreData "github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/data/outport"
 	datafield "github.com/multiversx/mx-chain-vm-common-go/parsers/dataField"
+
+	"github.com/multiversx/mx-chain-es-indexer-go/data"
 )
 
 // DataFieldParser defines what a data field parser should be able to do
@@ -11,6 +13,12 @@ type DataFieldParser interface {
 	Parse(dataField []byte, sender, receiver []byte, numOfShards uint32) *datafield.ResponseParseData
 }
 
+// CallArgsDecoder defines what a smart contract call arguments decoder should be able to do
+type CallArgsDecoder interface {
+	DecodeCallArguments(sender []byte, receiver []byte, dataField []byte) []*data.DecodedArg
+	IsInterfaceNil() bool
+}
+
 type feeInfoHandler interface {
 	GetFeeInfo() *outport.FeeInfo
 }
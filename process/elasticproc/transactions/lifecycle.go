@@ -0,0 +1,201 @@
+package transactions
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-es-indexer-go/data"
+	"github.com/multiversx/mx-chain-es-indexer-go/process/elasticproc/converters"
+)
+
+// SerializeTransactionsLifecycle will serialize the lifecycle leg executed in the provided block for every cross-shard transaction.
+// The notarized block hash is the metachain block that already notarized the provided shard block, if any
+func (tdp *txsDatabaseProcessor) SerializeTransactionsLifecycle(
+	transactions []*data.Transaction,
+	headerHash string,
+	notarizedBlockHash string,
+	timestamp uint64,
+	selfShardID uint32,
+	buffSlice *data.BufferSlice,
+	index string,
+) error {
+	for _, tx := range transactions {
+		lifecycle, ok := prepareLifecycleLeg(tx, headerHash, notarizedBlockHash, timestamp, selfShardID)
+		if !ok {
+			continue
+		}
+
+		meta, serializedData, err := prepareSerializedDataForLifecycle(tx.Hash, lifecycle, index)
+		if err != nil {
+			return err
+		}
+
+		err = buffSlice.PutData(meta, serializedData)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func prepareLifecycleLeg(tx *data.Transaction, headerHash string, notarizedBlockHash string, timestamp uint64, selfShardID uint32) (*data.TxLifecycle, bool) {
+	isCrossShard := tx.SenderShard != tx.ReceiverShard
+	if !isCrossShard || tx.Status == transaction.TxStatusInvalid.String() {
+		return nil, false
+	}
+
+	// blocks from metachain are notarized by themselves
+	if selfShardID == core.MetachainShardId {
+		notarizedBlockHash = headerHash
+	}
+
+	switch selfShardID {
+	case tx.SenderShard:
+		return &data.TxLifecycle{
+			SourceBlockHash:          headerHash,
+			SourceTimestamp:          time.Duration(timestamp),
+			SourceNotarizedBlockHash: notarizedBlockHash,
+		}, true
+	case tx.ReceiverShard:
+		return &data.TxLifecycle{
+			DestinationBlockHash:          headerHash,
+			DestinationTimestamp:          time.Duration(timestamp),
+			DestinationNotarizedBlockHash: notarizedBlockHash,
+		}, true
+	default:
+		return nil, false
+	}
+}
+
+func prepareSerializedDataForLifecycle(txHash string, lifecycle *data.TxLifecycle, index string) ([]byte, []byte, error) {
+	meta := []byte(fmt.Sprintf(`{"update":{ "_index":"%s","_id":"%s"}}%s`, index, converters.JsonEscape(txHash), "\n"))
+	marshaledLifecycle, err := json.Marshal(lifecycle)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	codeToExecute := `
+		if ('create' == ctx.op) {
+			ctx.op = 'noop';
+			return;
+		}
+		if (!ctx._source.containsKey('lifecycle') || ctx._source.lifecycle == null) {
+			ctx._source.lifecycle = new HashMap();
+		}
+		def lifecycle = ctx._source.lifecycle;
+		for (def entry : params.lifecycle.entrySet()) {
+			lifecycle[entry.getKey()] = entry.getValue();
+		}
+		if (lifecycle.containsKey('sourceTimestamp') && lifecycle.containsKey('destinationTimestamp')) {
+			lifecycle.completionLatency = lifecycle.destinationTimestamp - lifecycle.sourceTimestamp;
+		}
+`
+	serializedData := []byte(fmt.Sprintf(`{"scripted_upsert": true, "script": {"source": "%s","lang": "painless","params": {"lifecycle": %s}},"upsert": {}}`,
+		converters.FormatPainlessSource(codeToExecute), string(marshaledLifecycle)))
+
+	return meta, serializedData, nil
+}
+
+// SerializeNotarizedTransactions will serialize the updates that link the provided cross-shard transactions, executed in the
+// notarized shard blocks, with the metachain block that notarized them
+func (tdp *txsDatabaseProcessor) SerializeNotarizedTransactions(
+	txsHashes []string,
+	notarizedBlocksHashes []string,
+	metaBlockHash string,
+	buffSlice *data.BufferSlice,
+	index string,
+) error {
+	serializedHashes, err := json.Marshal(notarizedBlocksHashes)
+	if err != nil {
+		return err
+	}
+
+	codeToExecute := `
+		if ('create' == ctx.op) {
+			ctx.op = 'noop';
+			return;
+		}
+		def lifecycle = ctx._source.lifecycle;
+		if (lifecycle == null) {
+			ctx.op = 'noop';
+			return;
+		}
+		boolean changed = false;
+		if (lifecycle.containsKey('sourceBlockHash') && params.hashes.contains(lifecycle.sourceBlockHash)) {
+			lifecycle.sourceNotarizedBlockHash = params.metaBlockHash;
+			changed = true;
+		}
+		if (lifecycle.containsKey('destinationBlockHash') && params.hashes.contains(lifecycle.destinationBlockHash)) {
+			lifecycle.destinationNotarizedBlockHash = params.metaBlockHash;
+			changed = true;
+		}
+		if (!changed) {
+			ctx.op = 'noop';
+		}
+`
+	for _, txHash := range txsHashes {
+		meta := []byte(fmt.Sprintf(`{"update":{ "_index":"%s","_id":"%s"}}%s`, index, converters.JsonEscape(txHash), "\n"))
+		serializedData := []byte(fmt.Sprintf(`{"scripted_upsert": true, "script": {"source": "%s","lang": "painless","params": {"hashes": %s, "metaBlockHash": "%s"}},"upsert": {}}`,
+			converters.FormatPainlessSource(codeToExecute), serializedHashes, converters.JsonEscape(metaBlockHash)))
+
+		err = buffSlice.PutData(meta, serializedData)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// PrepareTransactionsLifecycleQueryInCaseOfRevert will prepare the update by query request that removes from the
+// transactions lifecycle all the information related to a reverted block
+func (tdp *txsDatabaseProcessor) PrepareTransactionsLifecycleQueryInCaseOfRevert(blockHash string) *bytes.Buffer {
+	codeToExecute := `
+		def lifecycle = ctx._source.lifecycle;
+		if (lifecycle == null) {
+			ctx.op = 'noop';
+			return;
+		}
+		if (lifecycle.sourceBlockHash == params.blockHash) {
+			lifecycle.remove('sourceBlockHash');
+			lifecycle.remove('sourceTimestamp');
+			lifecycle.remove('sourceNotarizedBlockHash');
+		}
+		if (lifecycle.destinationBlockHash == params.blockHash) {
+			lifecycle.remove('destinationBlockHash');
+			lifecycle.remove('destinationTimestamp');
+			lifecycle.remove('destinationNotarizedBlockHash');
+		}
+		if (lifecycle.sourceNotarizedBlockHash == params.blockHash) {
+			lifecycle.remove('sourceNotarizedBlockHash');
+		}
+		if (lifecycle.destinationNotarizedBlockHash == params.blockHash) {
+			lifecycle.remove('destinationNotarizedBlockHash');
+		}
+		if (!lifecycle.containsKey('sourceTimestamp') || !lifecycle.containsKey('destinationTimestamp')) {
+			lifecycle.remove('completionLatency');
+		}
+`
+	escapedHash := converters.JsonEscape(blockHash)
+	query := fmt.Sprintf(`{"query": {"bool": {"should": [`+
+		`{"match": {"lifecycle.sourceBlockHash": "%s"}},{"match": {"lifecycle.destinationBlockHash": "%s"}},`+
+		`{"match": {"lifecycle.sourceNotarizedBlockHash": "%s"}},{"match": {"lifecycle.destinationNotarizedBlockHash": "%s"}}],"minimum_should_match": 1}},`+
+		`"script": {"source": "%s","lang": "painless","params": {"blockHash": "%s"}}}`,
+		escapedHash, escapedHash, escapedHash, escapedHash, converters.FormatPainlessSource(codeToExecute), escapedHash)
+
+	return bytes.NewBuffer([]byte(query))
+}
+
+// PrepareStuckTransactionsQuery will prepare the query that returns the cross-shard transactions which are still pending and
+// were executed on the source shard before the provided timestamp. Only the cross-shard transactions have a lifecycle, so
+// the range on the source timestamp of the lifecycle also filters out the intra-shard transactions
+func (tdp *txsDatabaseProcessor) PrepareStuckTransactionsQuery(maxTimestamp uint64) []byte {
+	return []byte(fmt.Sprintf(`{"query": {"bool": {"filter": [{"term": {"status": "%s"}},{"range": {"lifecycle.sourceTimestamp": {"lte": %d}}}]}},`+
+		`"sort": [{"timestamp": {"order": "asc"}}]}`,
+		transaction.TxStatusPending.String(), maxTimestamp))
+}
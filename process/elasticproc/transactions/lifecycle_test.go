@@ -0,0 +1,112 @@
+package transactions
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-es-indexer-go/data"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTxsDatabaseProcessor_SerializeTransactionsLifecycle(t *testing.T) {
+	t.Parallel()
+
+	txs := []*data.Transaction{
+		{Hash: "intraShard", SenderShard: 0, ReceiverShard: 0},
+		{Hash: "source", SenderShard: 0, ReceiverShard: 1},
+		{Hash: "destination", SenderShard: 2, ReceiverShard: 0},
+		{Hash: "invalid", SenderShard: 0, ReceiverShard: 1, Status: transaction.TxStatusInvalid.String()},
+	}
+
+	buffSlice := data.NewBufferSlice(data.DefaultMaxBulkSize)
+	err := (&txsDatabaseProcessor{}).SerializeTransactionsLifecycle(txs, "blockHash", "", 6000, 0, buffSlice, "transactions")
+	require.Nil(t, err)
+
+	expectedBuff := `{"update":{ "_index":"transactions","_id":"source"}}
+{"scripted_upsert": true, "script": {"source": "if ('create' == ctx.op) {ctx.op = 'noop';return;}if (!ctx._source.containsKey('lifecycle') || ctx._source.lifecycle == null) {ctx._source.lifecycle = new HashMap();}def lifecycle = ctx._source.lifecycle;for (def entry : params.lifecycle.entrySet()) {lifecycle[entry.getKey()] = entry.getValue();}if (lifecycle.containsKey('sourceTimestamp') && lifecycle.containsKey('destinationTimestamp')) {lifecycle.completionLatency = lifecycle.destinationTimestamp - lifecycle.sourceTimestamp;}","lang": "painless","params": {"lifecycle": {"sourceBlockHash":"blockHash","sourceTimestamp":6000}}},"upsert": {}}
+{"update":{ "_index":"transactions","_id":"destination"}}
+{"scripted_upsert": true, "script": {"source": "if ('create' == ctx.op) {ctx.op = 'noop';return;}if (!ctx._source.containsKey('lifecycle') || ctx._source.lifecycle == null) {ctx._source.lifecycle = new HashMap();}def lifecycle = ctx._source.lifecycle;for (def entry : params.lifecycle.entrySet()) {lifecycle[entry.getKey()] = entry.getValue();}if (lifecycle.containsKey('sourceTimestamp') && lifecycle.containsKey('destinationTimestamp')) {lifecycle.completionLatency = lifecycle.destinationTimestamp - lifecycle.sourceTimestamp;}","lang": "painless","params": {"lifecycle": {"destinationBlockHash":"blockHash","destinationTimestamp":6000}}},"upsert": {}}
+`
+	require.Equal(t, expectedBuff, buffSlice.Buffers()[0].String())
+}
+
+func TestPrepareLifecycleLeg(t *testing.T) {
+	t.Parallel()
+
+	t.Run("metachain legs are notarized by their own block", func(t *testing.T) {
+		t.Parallel()
+
+		tx := &data.Transaction{SenderShard: core.MetachainShardId, ReceiverShard: 1}
+		lifecycle, ok := prepareLifecycleLeg(tx, "metaHash", "", 100, core.MetachainShardId)
+		require.True(t, ok)
+		require.Equal(t, &data.TxLifecycle{
+			SourceBlockHash:          "metaHash",
+			SourceTimestamp:          100,
+			SourceNotarizedBlockHash: "metaHash",
+		}, lifecycle)
+	})
+
+	t.Run("shard legs are not notarized yet", func(t *testing.T) {
+		t.Parallel()
+
+		tx := &data.Transaction{SenderShard: core.MetachainShardId, ReceiverShard: 1}
+		lifecycle, ok := prepareLifecycleLeg(tx, "shardHash", "", 106, 1)
+		require.True(t, ok)
+		require.Equal(t, &data.TxLifecycle{
+			DestinationBlockHash: "shardHash",
+			DestinationTimestamp: 106,
+		}, lifecycle)
+	})
+
+	t.Run("shard legs are linked with the metachain block indexed before them", func(t *testing.T) {
+		t.Parallel()
+
+		tx := &data.Transaction{SenderShard: 1, ReceiverShard: 2}
+		lifecycle, ok := prepareLifecycleLeg(tx, "shardHash", "metaHash", 106, 1)
+		require.True(t, ok)
+		require.Equal(t, &data.TxLifecycle{
+			SourceBlockHash:          "shardHash",
+			SourceTimestamp:          106,
+			SourceNotarizedBlockHash: "metaHash",
+		}, lifecycle)
+	})
+
+	t.Run("transaction not executed in self shard", func(t *testing.T) {
+		t.Parallel()
+
+		tx := &data.Transaction{SenderShard: 0, ReceiverShard: 1}
+		_, ok := prepareLifecycleLeg(tx, "hash", "", 100, 2)
+		require.False(t, ok)
+	})
+}
+
+func TestTxsDatabaseProcessor_SerializeNotarizedTransactions(t *testing.T) {
+	t.Parallel()
+
+	buffSlice := data.NewBufferSlice(data.DefaultMaxBulkSize)
+	err := (&txsDatabaseProcessor{}).SerializeNotarizedTransactions([]string{"tx1"}, []string{"h1", "h2"}, "metaHash", buffSlice, "transactions")
+	require.Nil(t, err)
+
+	res := buffSlice.Buffers()[0].String()
+	require.True(t, strings.HasPrefix(res, `{"update":{ "_index":"transactions","_id":"tx1"}}
+{"scripted_upsert": true, "script": {"source": "if ('create' == ctx.op) {ctx.op = 'noop';return;}`))
+	require.Contains(t, res, `"params": {"hashes": ["h1","h2"], "metaBlockHash": "metaHash"}`)
+}
+
+func TestTxsDatabaseProcessor_PrepareTransactionsLifecycleQueryInCaseOfRevert(t *testing.T) {
+	t.Parallel()
+
+	query := (&txsDatabaseProcessor{}).PrepareTransactionsLifecycleQueryInCaseOfRevert("blockHash")
+	require.Contains(t, query.String(), `{"match": {"lifecycle.sourceBlockHash": "blockHash"}},{"match": {"lifecycle.destinationBlockHash": "blockHash"}}`)
+	require.Contains(t, query.String(), `"params": {"blockHash": "blockHash"}`)
+}
+
+func TestTxsDatabaseProcessor_PrepareStuckTransactionsQuery(t *testing.T) {
+	t.Parallel()
+
+	query := (&txsDatabaseProcessor{}).PrepareStuckTransactionsQuery(1000)
+	require.Equal(t, `{"query": {"bool": {"filter": [{"term": {"status": "pending"}},{"range": {"lifecycle.sourceTimestamp": {"lte": 1000}}}]}},`+
+		`"sort": [{"timestamp": {"order": "asc"}}]}`, string(query))
+}
@@ -81,6 +81,10 @@ func (tdp *txsDatabaseProcessor) SerializeTransactionsFeeData(txHashRefund map[s
  				if (ctx._source.gasUsed > params.gasRefunded) {
  					ctx._source.gasUsed -= params.gasRefunded;	
  				}
+				if (ctx._source.containsKey('relayerFee')) {
+					ctx._source.relayerFee = ctx._source.fee;
+					ctx._source.relayerFeeNum = ctx._source.feeNum;
+				}
  			}
  `
 		} else {
@@ -91,6 +95,10 @@ func (tdp *txsDatabaseProcessor) SerializeTransactionsFeeData(txHashRefund map[s
 				ctx._source.fee = params.fee;
 				ctx._source.feeNum = params.feeNum;
 				ctx._source.gasUsed = params.gasUsed;
+				if (ctx._source.containsKey('relayerFee')) {
+					ctx._source.relayerFee = params.fee;
+					ctx._source.relayerFeeNum = params.feeNum;
+				}
 			}
 `
 		}
@@ -227,7 +235,11 @@ func prepareSerializedDataForATransaction(
 		return metaData, prepareSerializedDataForESDTTransferOnDestination(marshaledTx), nil
 	}
 
-	// transaction is intra-shard, invalid or cross-shard destination me
+	if isCrossShardOnDestinationShard(tx, selfShardID) {
+		return metaData, prepareSerializedDataForCrossShardTxOnDestination(marshaledTx), nil
+	}
+
+	// transaction is intra-shard or invalid
 	meta := []byte(fmt.Sprintf(`{ "index" : { "_index":"%s", "_id" : "%s" } }%s`, index, converters.JsonEscape(tx.Hash), "\n"))
 
 	return meta, marshaledTx, nil
@@ -241,10 +253,30 @@ func prepareSerializedDataForESDTTransferOnDestination(marshaledTx []byte) []byt
 			def gasUsed = ctx._source.gasUsed;
 			def fee = ctx._source.fee;
 			def feeNum = ctx._source.feeNum;
+			def lifecycle = ctx._source.lifecycle;
 			ctx._source = params.tx;
 			ctx._source.gasUsed = gasUsed;
 			ctx._source.fee = fee;
 			ctx._source.feeNum = feeNum;
+			if (lifecycle != null) {
+				ctx._source.lifecycle = lifecycle;
+			}
+		}
+`
+	return []byte(fmt.Sprintf(`{"scripted_upsert": true, "script":{"source":"%s","lang": "painless","params":{"tx": %s}},"upsert":{}}`,
+		converters.FormatPainlessSource(codeToExecute), string(marshaledTx)))
+}
+
+func prepareSerializedDataForCrossShardTxOnDestination(marshaledTx []byte) []byte {
+	codeToExecute := `
+		if ('create' == ctx.op) {
+			ctx._source = params.tx;
+		} else {
+			def lifecycle = ctx._source.lifecycle;
+			ctx._source = params.tx;
+			if (lifecycle != null) {
+				ctx._source.lifecycle = lifecycle;
+			}
 		}
 `
 	return []byte(fmt.Sprintf(`{"scripted_upsert": true, "script":{"source":"%s","lang": "painless","params":{"tx": %s}},"upsert":{}}`,
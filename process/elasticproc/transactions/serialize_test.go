@@ -123,8 +123,8 @@ func TestSerializeTransactionsCrossShardTxDestination(t *testing.T) {
 	}}, map[string]*outport.StatusInfo{}, 0, buffSlice, "transactions")
 	require.Nil(t, err)
 
-	expectedBuff := `{ "index" : { "_index":"transactions", "_id" : "txHash" } }
-{"uuid":"","miniBlockHash":"","nonce":0,"round":0,"value":"","valueNum":0,"receiver":"","sender":"","receiverShard":0,"senderShard":1,"gasPrice":0,"gasLimit":0,"gasUsed":0,"fee":"","feeNum":0,"data":null,"signature":"","timestamp":0,"status":"","searchOrder":0,"version":1,"epoch":0}
+	expectedBuff := `{"update":{ "_index":"transactions", "_id":"txHash"}}
+{"scripted_upsert": true, "script":{"source":"if ('create' == ctx.op) {ctx._source = params.tx;} else {def lifecycle = ctx._source.lifecycle;ctx._source = params.tx;if (lifecycle != null) {ctx._source.lifecycle = lifecycle;}}","lang": "painless","params":{"tx": {"uuid":"","miniBlockHash":"","nonce":0,"round":0,"value":"","valueNum":0,"receiver":"","sender":"","receiverShard":0,"senderShard":1,"gasPrice":0,"gasLimit":0,"gasUsed":0,"fee":"","feeNum":0,"data":null,"signature":"","timestamp":0,"status":"","searchOrder":0,"version":1,"epoch":0}}},"upsert":{}}
 `
 	require.Equal(t, expectedBuff, buffSlice.Buffers()[0].String())
 }
@@ -146,7 +146,7 @@ func TestTxsDatabaseProcessor_SerializeTransactionWithRefund(t *testing.T) {
 	require.Nil(t, err)
 
 	expectedBuff := `{"update":{ "_index":"transactions","_id":"txHash"}}
-{"scripted_upsert": true, "script": {"source": "if ('create' == ctx.op) {ctx.op = 'noop'} else {ctx._source.fee = params.fee;ctx._source.feeNum = params.feeNum;ctx._source.gasUsed = params.gasUsed;}","lang": "painless","params": {"fee": "100000", "gasUsed": 5000, "feeNum": 5e-15, "gasRefunded": 0}},"upsert": {}}
+{"scripted_upsert": true, "script": {"source": "if ('create' == ctx.op) {ctx.op = 'noop'} else {ctx._source.fee = params.fee;ctx._source.feeNum = params.feeNum;ctx._source.gasUsed = params.gasUsed;if (ctx._source.containsKey('relayerFee')) {ctx._source.relayerFee = params.fee;ctx._source.relayerFeeNum = params.feeNum;}}","lang": "painless","params": {"fee": "100000", "gasUsed": 5000, "feeNum": 5e-15, "gasRefunded": 0}},"upsert": {}}
 `
 	require.Equal(t, expectedBuff, buffSlice.Buffers()[0].String())
 }
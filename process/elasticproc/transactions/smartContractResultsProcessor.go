@@ -17,11 +17,13 @@ import (
 )
 
 type smartContractResultsProcessor struct {
-	pubKeyConverter  core.PubkeyConverter
-	hasher           hashing.Hasher
-	marshalizer      marshal.Marshalizer
-	dataFieldParser  DataFieldParser
-	balanceConverter dataindexer.BalanceConverter
+	pubKeyConverter      core.PubkeyConverter
+	hasher               hashing.Hasher
+	marshalizer          marshal.Marshalizer
+	dataFieldParser      DataFieldParser
+	balanceConverter     dataindexer.BalanceConverter
+	callArgsDecoder      CallArgsDecoder
+	storeRawHexAddresses bool
 }
 
 func newSmartContractResultsProcessor(
@@ -30,13 +32,17 @@ func newSmartContractResultsProcessor(
 	hasher hashing.Hasher,
 	dataFieldParser DataFieldParser,
 	balanceConverter dataindexer.BalanceConverter,
+	callArgsDecoder CallArgsDecoder,
+	storeRawHexAddresses bool,
 ) *smartContractResultsProcessor {
 	return &smartContractResultsProcessor{
-		pubKeyConverter:  pubKeyConverter,
-		marshalizer:      marshalzier,
-		hasher:           hasher,
-		dataFieldParser:  dataFieldParser,
-		balanceConverter: balanceConverter,
+		pubKeyConverter:      pubKeyConverter,
+		marshalizer:          marshalzier,
+		hasher:               hasher,
+		dataFieldParser:      dataFieldParser,
+		balanceConverter:     balanceConverter,
+		callArgsDecoder:      callArgsDecoder,
+		storeRawHexAddresses: storeRawHexAddresses,
 	}
 }
 
@@ -154,6 +160,11 @@ func (proc *smartContractResultsProcessor) prepareSmartContractResult(
 		esdtValues = res.ESDTValues
 	}
 
+	var decodedArgs []*indexerData.DecodedArg
+	if res.Function != "" {
+		decodedArgs = proc.callArgsDecoder.DecodeCallArguments(scr.SndAddr, scr.RcvAddr, scr.Data)
+	}
+
 	feeInfo := getFeeInfo(scrInfo)
 	return &indexerData.ScResult{
 		Hash:               scrHashHex,
@@ -165,6 +176,8 @@ func (proc *smartContractResultsProcessor) prepareSmartContractResult(
 		ValueNum:           valueNum,
 		Sender:             senderAddr,
 		Receiver:           receiverAddr,
+		SenderHex:          rawHexAddress(scr.SndAddr, proc.storeRawHexAddresses),
+		ReceiverHex:        rawHexAddress(scr.RcvAddr, proc.storeRawHexAddresses),
 		RelayerAddr:        relayerAddr,
 		RelayedValue:       relayedValue,
 		Code:               string(scr.Code),
@@ -180,6 +193,7 @@ func (proc *smartContractResultsProcessor) prepareSmartContractResult(
 		ReceiverShard:      receiverShard,
 		Operation:          res.Operation,
 		Function:           converters.TruncateFieldIfExceedsMaxLength(res.Function),
+		DecodedArgs:        decodedArgs,
 		ESDTValues:         esdtValues,
 		ESDTValuesNum:      esdtValuesNum,
 		Tokens:             converters.TruncateSliceElementsIfExceedsMaxLength(res.Tokens),
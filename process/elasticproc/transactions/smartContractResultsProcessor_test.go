@@ -32,7 +32,7 @@ func TestPrepareSmartContractResult(t *testing.T) {
 	parser := createDataFieldParserMock()
 	pubKeyConverter := &mock.PubkeyConverterMock{}
 	ap, _ := converters.NewBalanceConverter(18)
-	scrsProc := newSmartContractResultsProcessor(pubKeyConverter, &mock.MarshalizerMock{}, &mock.HasherMock{}, parser, ap)
+	scrsProc := newSmartContractResultsProcessor(pubKeyConverter, &mock.MarshalizerMock{}, &mock.HasherMock{}, parser, ap, &mock.ABIDecoderStub{}, false)
 
 	nonce := uint64(10)
 	txHash := []byte("txHash")
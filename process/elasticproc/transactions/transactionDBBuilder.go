@@ -21,6 +21,8 @@ type dbTransactionBuilder struct {
 	dataFieldParser        DataFieldParser
 	balanceConverter       dataindexer.BalanceConverter
 	rewardTxData           RewardTxDataHandler
+	callArgsDecoder        CallArgsDecoder
+	storeRawHexAddresses   bool
 }
 
 func newTransactionDBBuilder(
@@ -28,12 +30,16 @@ func newTransactionDBBuilder(
 	dataFieldParser DataFieldParser,
 	balanceConverter dataindexer.BalanceConverter,
 	rewardTxData RewardTxDataHandler,
+	callArgsDecoder CallArgsDecoder,
+	storeRawHexAddresses bool,
 ) *dbTransactionBuilder {
 	return &dbTransactionBuilder{
 		addressPubkeyConverter: addressPubkeyConverter,
 		dataFieldParser:        dataFieldParser,
 		balanceConverter:       balanceConverter,
 		rewardTxData:           rewardTxData,
+		callArgsDecoder:        callArgsDecoder,
+		storeRawHexAddresses:   storeRawHexAddresses,
 	}
 }
 
@@ -102,6 +108,8 @@ func (dtb *dbTransactionBuilder) prepareTransaction(
 		Value:             tx.Value.String(),
 		Receiver:          receiverAddr,
 		Sender:            senderAddr,
+		ReceiverHex:       rawHexAddress(tx.RcvAddr, dtb.storeRawHexAddresses),
+		SenderHex:         rawHexAddress(tx.SndAddr, dtb.storeRawHexAddresses),
 		ValueNum:          valueNum,
 		ReceiverShard:     receiverShardID,
 		SenderShard:       mb.SenderShardID,
@@ -141,10 +149,24 @@ func (dtb *dbTransactionBuilder) prepareTransaction(
 	eTx.Tokens = converters.TruncateSliceElementsIfExceedsMaxLength(res.Tokens)
 	eTx.ReceiversShardIDs = res.ReceiversShardID
 	eTx.IsRelayed = res.IsRelayed || isRelayedV3
+	if isRelayedV3 {
+		setRelayedV3FeeInfo(eTx, tx.RelayerAddr, numOfShards)
+	}
+	if res.Function != "" {
+		eTx.DecodedArgs = dtb.callArgsDecoder.DecodeCallArguments(tx.SndAddr, tx.RcvAddr, tx.Data)
+	}
 
 	return eTx
 }
 
+// setRelayedV3FeeInfo sets the relayer centric fields of a relayed v3 transaction, the whole fee being paid by the relayer
+func setRelayedV3FeeInfo(eTx *data.Transaction, relayerAddr []byte, numOfShards uint32) {
+	relayerShard := sharding.ComputeShardID(relayerAddr, numOfShards)
+	eTx.RelayerShard = &relayerShard
+	eTx.RelayerFee = eTx.Fee
+	eTx.RelayerFeeNum = eTx.FeeNum
+}
+
 func (dtb *dbTransactionBuilder) prepareRewardTransaction(
 	rTxInfo *outport.RewardInfo,
 	txHash []byte,
@@ -170,6 +192,7 @@ func (dtb *dbTransactionBuilder) prepareRewardTransaction(
 		Value:          rTx.Value.String(),
 		ValueNum:       valueNum,
 		Receiver:       receiverAddr,
+		ReceiverHex:    rawHexAddress(rTx.RcvAddr, dtb.storeRawHexAddresses),
 		Sender:         dtb.rewardTxData.GetSender(),
 		ReceiverShard:  mb.ReceiverShardID,
 		SenderShard:    mb.SenderShardID,
@@ -202,3 +225,13 @@ func (dtb *dbTransactionBuilder) prepareReceipt(
 		Timestamp: time.Duration(header.GetTimeStamp()),
 	}
 }
+
+// rawHexAddress returns the hex encoding of the provided address if the raw addresses should be stored next to the
+// ones encoded with the configured converter
+func rawHexAddress(address []byte, storeRawHexAddresses bool) string {
+	if !storeRawHexAddresses || len(address) == 0 {
+		return ""
+	}
+
+	return hex.EncodeToString(address)
+}
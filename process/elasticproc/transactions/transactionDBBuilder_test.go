@@ -1,11 +1,13 @@
 package transactions
 
 import (
+	"bytes"
 	"encoding/hex"
 	"math/big"
 	"testing"
 	"time"
 
+	"github.com/multiversx/mx-chain-core-go/core/sharding"
 	"github.com/multiversx/mx-chain-core-go/data/block"
 	"github.com/multiversx/mx-chain-core-go/data/outport"
 	"github.com/multiversx/mx-chain-core-go/data/rewardTx"
@@ -24,6 +26,7 @@ func createCommonProcessor() dbTransactionBuilder {
 		dataFieldParser:        createDataFieldParserMock(),
 		balanceConverter:       ap,
 		rewardTxData:           &mock.RewardTxDataMock{},
+		callArgsDecoder:        &mock.ABIDecoderStub{},
 	}
 }
 
@@ -103,6 +106,68 @@ func TestGetMoveBalanceTransaction(t *testing.T) {
 	require.Equal(t, expectedTx, dbTx)
 }
 
+func TestGetMoveBalanceTransactionWithRawHexAddresses(t *testing.T) {
+	t.Parallel()
+
+	cp := createCommonProcessor()
+	cp.storeRawHexAddresses = true
+
+	tx := &transaction.Transaction{
+		Value:   big.NewInt(1000),
+		RcvAddr: []byte("receiver"),
+		SndAddr: []byte("sender"),
+	}
+	txInfo := &outport.TxInfo{
+		Transaction: tx,
+		FeeInfo: &outport.FeeInfo{
+			Fee:            big.NewInt(100),
+			InitialPaidFee: big.NewInt(100),
+		},
+	}
+
+	dbTx := cp.prepareTransaction(txInfo, []byte("txHash"), []byte("mbHash"), &block.MiniBlock{}, &block.Header{}, "Success", 3)
+	require.Equal(t, hex.EncodeToString(tx.SndAddr), dbTx.SenderHex)
+	require.Equal(t, hex.EncodeToString(tx.RcvAddr), dbTx.ReceiverHex)
+
+	cp.storeRawHexAddresses = false
+	dbTx = cp.prepareTransaction(txInfo, []byte("txHash"), []byte("mbHash"), &block.MiniBlock{}, &block.Header{}, "Success", 3)
+	require.Empty(t, dbTx.SenderHex)
+	require.Empty(t, dbTx.ReceiverHex)
+}
+
+func TestGetScCallTransactionWithDecodedArgs(t *testing.T) {
+	t.Parallel()
+
+	decodedArgs := []*data.DecodedArg{{Name: "amount", Type: "BigUint", Value: "10"}}
+	tx := &transaction.Transaction{
+		Value:   big.NewInt(0),
+		RcvAddr: append(make([]byte, 10), bytes.Repeat([]byte{1}, 22)...),
+		SndAddr: bytes.Repeat([]byte{2}, 32),
+		Data:    []byte("claim@0a"),
+	}
+	txInfo := &outport.TxInfo{
+		Transaction: tx,
+		FeeInfo: &outport.FeeInfo{
+			Fee:            big.NewInt(100),
+			InitialPaidFee: big.NewInt(100),
+		},
+	}
+
+	cp := createCommonProcessor()
+	cp.callArgsDecoder = &mock.ABIDecoderStub{
+		DecodeCallArgumentsCalled: func(sender []byte, receiver []byte, dataField []byte) []*data.DecodedArg {
+			require.Equal(t, tx.SndAddr, sender)
+			require.Equal(t, tx.RcvAddr, receiver)
+			require.Equal(t, tx.Data, dataField)
+			return decodedArgs
+		},
+	}
+
+	dbTx := cp.prepareTransaction(txInfo, []byte("txHash"), []byte("mbHash"), &block.MiniBlock{}, &block.Header{}, "Success", 3)
+	require.Equal(t, "claim", dbTx.Function)
+	require.Equal(t, decodedArgs, dbTx.DecodedArgs)
+}
+
 func TestGetTransactionByType_RewardTx(t *testing.T) {
 	t.Parallel()
 
@@ -218,6 +283,37 @@ func TestRelayedV3Transaction(t *testing.T) {
 	require.Equal(t, expectedTx, dbTx)
 }
 
+func TestRelayedV3TransactionRelayerFields(t *testing.T) {
+	t.Parallel()
+
+	cp := createCommonProcessor()
+	tx := &transaction.Transaction{
+		Nonce:            1,
+		Value:            big.NewInt(1000),
+		RcvAddr:          []byte("receiver"),
+		SndAddr:          []byte("sender"),
+		Signature:        []byte("signature"),
+		RelayerAddr:      []byte("relay1"),
+		RelayerSignature: []byte("relaySign"),
+	}
+	txInfo := &outport.TxInfo{
+		Transaction: tx,
+		FeeInfo: &outport.FeeInfo{
+			GasUsed:        500,
+			Fee:            big.NewInt(100),
+			InitialPaidFee: big.NewInt(100),
+		},
+	}
+	mb := &block.MiniBlock{TxHashes: [][]byte{[]byte("txHash")}, Type: block.TxBlock}
+
+	dbTx := cp.prepareTransaction(txInfo, []byte("txHash"), []byte("mbHash"), mb, &block.Header{}, transaction.TxStatusSuccess.String(), 3)
+	require.True(t, dbTx.IsRelayed)
+	require.NotNil(t, dbTx.RelayerShard)
+	require.Equal(t, sharding.ComputeShardID(tx.RelayerAddr, 3), *dbTx.RelayerShard)
+	require.Equal(t, "100", dbTx.RelayerFee)
+	require.Equal(t, 1e-16, dbTx.RelayerFeeNum)
+}
+
 func TestGetMoveBalanceTransactionInvalid(t *testing.T) {
 	t.Parallel()
 
@@ -29,6 +29,8 @@ type ArgsTransactionProcessor struct {
 	BalanceConverter       dataindexer.BalanceConverter
 	TxHashExtractor        TxHashExtractor
 	RewardTxData           RewardTxDataHandler
+	CallArgsDecoder        CallArgsDecoder
+	StoreRawHexAddresses   bool
 }
 
 type txsDatabaseProcessor struct {
@@ -54,9 +56,9 @@ func NewTransactionsProcessor(args *ArgsTransactionProcessor) (*txsDatabaseProce
 		return nil, err
 	}
 
-	txBuilder := newTransactionDBBuilder(args.AddressPubkeyConverter, operationsDataParser, args.BalanceConverter, args.RewardTxData)
+	txBuilder := newTransactionDBBuilder(args.AddressPubkeyConverter, operationsDataParser, args.BalanceConverter, args.RewardTxData, args.CallArgsDecoder, args.StoreRawHexAddresses)
 	txsDBGrouper := newTxsGrouper(txBuilder, args.Hasher, args.Marshalizer, args.TxHashExtractor)
-	scrProc := newSmartContractResultsProcessor(args.AddressPubkeyConverter, args.Marshalizer, args.Hasher, operationsDataParser, args.BalanceConverter)
+	scrProc := newSmartContractResultsProcessor(args.AddressPubkeyConverter, args.Marshalizer, args.Hasher, operationsDataParser, args.BalanceConverter, args.CallArgsDecoder, args.StoreRawHexAddresses)
 	scrsDataToTxs := newScrsDataToTransactions(args.BalanceConverter)
 
 	return &txsDatabaseProcessor{
@@ -30,6 +30,7 @@ func createMockArgsTxsDBProc() *ArgsTransactionProcessor {
 		BalanceConverter:       ap,
 		TxHashExtractor:        NewTxHashExtractor(),
 		RewardTxData:           &mock.RewardTxDataMock{},
+		CallArgsDecoder:        &mock.ABIDecoderStub{},
 	}
 	return args
 }
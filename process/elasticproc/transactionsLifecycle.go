@@ -0,0 +1,212 @@
+package elasticproc
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	coreData "github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-es-indexer-go/core/request"
+	"github.com/multiversx/mx-chain-es-indexer-go/data"
+	elasticIndexer "github.com/multiversx/mx-chain-es-indexer-go/process/dataindexer"
+)
+
+const maxStuckTransactions = 1000
+
+// notarizingMetaBlockInfo holds the metachain block that notarized a shard block, empty if it was not indexed yet
+type notarizingMetaBlockInfo struct {
+	blockHash      string
+	metaBlockHash  string
+	metaBlockRound uint64
+}
+
+var errMaxStuckTransactionsReached = errors.New("max number of stuck transactions reached")
+
+// indexTransactionsLifecycle indexes the lifecycle leg of the cross-shard transactions executed in the provided block. If
+// the metachain block that notarizes the block is not indexed yet, the hashes of the transactions are kept in the block
+// document, so that they are linked when the metachain block is indexed
+func (ei *elasticProcessor) indexTransactionsLifecycle(txs []*data.Transaction, headerHash []byte, header coreData.HeaderHandler, buffSlice *data.BufferSlice) error {
+	if !ei.isIndexEnabled(elasticIndexer.TransactionsIndex) {
+		return nil
+	}
+
+	crossShardTxsHashes := getCrossShardTxsHashes(txs)
+	if len(crossShardTxsHashes) == 0 {
+		return nil
+	}
+
+	encodedHeaderHash := hex.EncodeToString(headerHash)
+	notarizedBlockHash, _, err := ei.getNotarizingMetaBlock(encodedHeaderHash, header.GetShardID())
+	if err != nil {
+		return err
+	}
+
+	err = ei.transactionsProc.SerializeTransactionsLifecycle(txs, encodedHeaderHash, notarizedBlockHash, header.GetTimeStamp(), header.GetShardID(), buffSlice, elasticIndexer.TransactionsIndex)
+	if err != nil {
+		return err
+	}
+
+	shouldKeepHashes := notarizedBlockHash == "" && header.GetShardID() != core.MetachainShardId && ei.isIndexEnabled(elasticIndexer.BlockIndex)
+	if !shouldKeepHashes {
+		return nil
+	}
+
+	return ei.blockProc.SerializeCrossShardTransactions(encodedHeaderHash, crossShardTxsHashes, buffSlice, elasticIndexer.BlockIndex)
+}
+
+func getCrossShardTxsHashes(txs []*data.Transaction) []string {
+	txsHashes := make([]string, 0)
+	for _, tx := range txs {
+		if tx.SenderShard != tx.ReceiverShard {
+			txsHashes = append(txsHashes, tx.Hash)
+		}
+	}
+
+	return txsHashes
+}
+
+// getNotarizingMetaBlock returns the hash and the round of the metachain block that notarized the provided shard block,
+// if the metachain block was indexed before the shard block. The result is kept for the block being indexed, so that the
+// block and its transactions are linked with the same metachain block by a single search
+func (ei *elasticProcessor) getNotarizingMetaBlock(blockHash string, shardID uint32) (string, uint64, error) {
+	if !ei.isIndexEnabled(elasticIndexer.BlockIndex) || shardID == core.MetachainShardId {
+		return "", 0, nil
+	}
+
+	cached := ei.notarizingMetaBlock
+	if cached != nil && cached.blockHash == blockHash {
+		return cached.metaBlockHash, cached.metaBlockRound, nil
+	}
+
+	ctxWithValue := context.WithValue(context.Background(), request.ContextKey, request.ExtendTopicWithShardID(request.GetTopic, shardID))
+	query := ei.blockProc.PrepareNotarizingMetaBlockQuery(blockHash)
+	responseSearch := &data.ResponseScroll{}
+	err := ei.elasticClient.DoSearchRequest(ctxWithValue, elasticIndexer.BlockIndex, query, responseSearch)
+	if err != nil {
+		return "", 0, err
+	}
+
+	notarizing := &notarizingMetaBlockInfo{blockHash: blockHash}
+	if len(responseSearch.Hits.Hits) > 0 {
+		metaBlock := &data.Block{}
+		err = json.Unmarshal(responseSearch.Hits.Hits[0].Source, metaBlock)
+		if err != nil {
+			return "", 0, err
+		}
+
+		notarizing.metaBlockHash = responseSearch.Hits.Hits[0].ID
+		notarizing.metaBlockRound = metaBlock.Round
+	}
+	ei.notarizingMetaBlock = notarizing
+
+	return notarizing.metaBlockHash, notarizing.metaBlockRound, nil
+}
+
+// indexNotarizedTransactions links the cross-shard transactions of the notarized shard blocks, which were indexed before
+// the metachain block, with the metachain block. Their hashes are read from the documents of the shard blocks. The
+// transactions of the shard blocks indexed later are linked when their lifecycle is indexed
+func (ei *elasticProcessor) indexNotarizedTransactions(notarizedHeadersHashes []string, headerHash []byte, header coreData.HeaderHandler, buffSlice *data.BufferSlice) error {
+	shouldIndex := ei.isIndexEnabled(elasticIndexer.TransactionsIndex) && ei.isIndexEnabled(elasticIndexer.BlockIndex)
+	if !shouldIndex || header.GetShardID() != core.MetachainShardId || len(notarizedHeadersHashes) == 0 {
+		return nil
+	}
+
+	ctxWithValue := context.WithValue(context.Background(), request.ContextKey, request.ExtendTopicWithShardID(request.GetTopic, header.GetShardID()))
+	responseBlocks := &data.ResponseBlocks{}
+	err := ei.elasticClient.DoMultiGet(ctxWithValue, notarizedHeadersHashes, elasticIndexer.BlockIndex, true, responseBlocks)
+	if err != nil {
+		return err
+	}
+
+	txsHashes := make([]string, 0)
+	for _, block := range responseBlocks.Docs {
+		if block.Found {
+			txsHashes = append(txsHashes, block.Source.CrossShardTxsHashes...)
+		}
+	}
+	if len(txsHashes) == 0 {
+		return nil
+	}
+
+	return ei.transactionsProc.SerializeNotarizedTransactions(txsHashes, notarizedHeadersHashes, hex.EncodeToString(headerHash), buffSlice, elasticIndexer.TransactionsIndex)
+}
+
+func (ei *elasticProcessor) revertTransactionsLifecycle(header coreData.HeaderHandler) error {
+	if !ei.isIndexEnabled(elasticIndexer.TransactionsIndex) {
+		return nil
+	}
+
+	headerHash, err := ei.blockProc.ComputeHeaderHash(header)
+	if err != nil {
+		return err
+	}
+
+	ctxWithValue := context.WithValue(context.Background(), request.ContextKey, request.ExtendTopicWithShardID(request.UpdateTopic, header.GetShardID()))
+	query := ei.transactionsProc.PrepareTransactionsLifecycleQueryInCaseOfRevert(hex.EncodeToString(headerHash))
+
+	return ei.elasticClient.UpdateByQuery(ctxWithValue, elasticIndexer.TransactionsIndex, query)
+}
+
+// GetStuckTransactions returns the transactions that are pending for more than the provided age, oldest first
+func (ei *elasticProcessor) GetStuckTransactions(maxAgeInSec uint64) ([]*data.StuckTransaction, error) {
+	now := uint64(time.Now().Unix())
+	maxTimestamp := uint64(0)
+	if now > maxAgeInSec {
+		maxTimestamp = now - maxAgeInSec
+	}
+
+	stuckTxs := make([]*data.StuckTransaction, 0)
+	handlerFunc := func(responseBytes []byte) error {
+		responseScroll := &data.ResponseScroll{}
+		err := json.Unmarshal(responseBytes, responseScroll)
+		if err != nil {
+			return err
+		}
+
+		for _, hit := range responseScroll.Hits.Hits {
+			tx := &data.Transaction{}
+			err = json.Unmarshal(hit.Source, tx)
+			if err != nil {
+				return err
+			}
+
+			stuckTxs = append(stuckTxs, &data.StuckTransaction{
+				Hash:          hit.ID,
+				Sender:        tx.Sender,
+				Receiver:      tx.Receiver,
+				SenderShard:   tx.SenderShard,
+				ReceiverShard: tx.ReceiverShard,
+				Timestamp:     tx.Timestamp,
+				PendingFor:    computePendingFor(now, uint64(tx.Timestamp)),
+				Lifecycle:     tx.Lifecycle,
+			})
+			if len(stuckTxs) >= maxStuckTransactions {
+				return errMaxStuckTransactionsReached
+			}
+		}
+
+		return nil
+	}
+
+	ctxWithValue := context.WithValue(context.Background(), request.ContextKey, request.ScrollTopic)
+	query := ei.transactionsProc.PrepareStuckTransactionsQuery(maxTimestamp)
+	err := ei.elasticClient.DoScrollRequest(ctxWithValue, elasticIndexer.TransactionsIndex, query, true, handlerFunc)
+	if err != nil && !errors.Is(err, errMaxStuckTransactionsReached) {
+		return nil, err
+	}
+
+	return stuckTxs, nil
+}
+
+// computePendingFor returns 0 for the transactions with a timestamp in the future, since the clocks of the nodes and of
+// the indexer can drift
+func computePendingFor(now uint64, timestamp uint64) uint64 {
+	if now < timestamp {
+		return 0
+	}
+
+	return now - timestamp
+}
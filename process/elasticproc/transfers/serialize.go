@@ -0,0 +1,95 @@
+package transfers
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+
+	"github.com/multiversx/mx-chain-es-indexer-go/data"
+	"github.com/multiversx/mx-chain-es-indexer-go/process/elasticproc/converters"
+)
+
+// SerializeTransfers will serialize the provided transfers in a way that Elasticsearch expects a bulk request.
+// There is one document for every leg: the receiver's shard of a cross shard leg only completes the document written by
+// the sender's shard, while the sender's shard keeps the status of a leg that was already completed
+func (tp *transfersProcessor) SerializeTransfers(transfers []*data.Transfer, buffSlice *data.BufferSlice, index string) error {
+	for _, transfer := range transfers {
+		meta, serializedData, err := prepareSerializedTransfer(transfer, index)
+		if err != nil {
+			return err
+		}
+
+		err = buffSlice.PutData(meta, serializedData)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func prepareSerializedTransfer(transfer *data.Transfer, index string) ([]byte, []byte, error) {
+	meta := []byte(fmt.Sprintf(`{ "update" : { "_index":"%s", "_id" : "%s" } }%s`, index, converters.JsonEscape(transfer.ID), "\n"))
+	serializedTransfer, err := json.Marshal(transfer)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	codeToExecute := `
+		if ('create' == ctx.op) {
+			ctx._source = params.transfer;
+			return;
+		}
+		if (params.isIncoming) {
+			ctx._source.status = params.transfer.status;
+			ctx._source.completedTimestamp = params.transfer.completedTimestamp;
+			return;
+		}
+		def status = ctx._source.status;
+		def completedTimestamp = ctx._source.completedTimestamp;
+		ctx._source = params.transfer;
+		if (completedTimestamp != null) {
+			ctx._source.status = status;
+			ctx._source.completedTimestamp = completedTimestamp;
+		}
+`
+	serializedDataStr := fmt.Sprintf(`{"scripted_upsert": true, "script": {`+
+		`"source": "%s",`+
+		`"lang": "painless",`+
+		`"params": { "transfer": %s, "isIncoming": %t }},`+
+		`"upsert": {}}`,
+		converters.FormatPainlessSource(codeToExecute), string(serializedTransfer), transfer.IsIncoming,
+	)
+
+	return meta, []byte(serializedDataStr), nil
+}
+
+// PrepareTransfersQueryInCaseOfRevert will prepare the query that marks again as pending the cross shard legs completed
+// by a reverted block of the receiver's shard
+func (tp *transfersProcessor) PrepareTransfersQueryInCaseOfRevert(timestamp uint64, shardID uint32) *bytes.Buffer {
+	codeToExecute := `
+		ctx._source.status = params.status;
+		ctx._source.remove('completedTimestamp');
+`
+
+	query := fmt.Sprintf(`
+	{
+	  "query": {
+		"bool": {
+		  "must": [
+			{"match": {"completedTimestamp": "%d"}},
+			{"match": {"receiverShard": %d}}
+		  ]
+		}
+	  },
+	  "script": {
+		"source": "%s",
+		"lang": "painless",
+		"params": {"status": "%s"}
+	  }
+	}`, timestamp, shardID, converters.FormatPainlessSource(codeToExecute), transaction.TxStatusPending.String())
+
+	return bytes.NewBuffer([]byte(query))
+}
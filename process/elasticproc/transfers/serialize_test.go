@@ -0,0 +1,64 @@
+package transfers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/multiversx/mx-chain-es-indexer-go/data"
+)
+
+func TestTransfersProcessor_SerializeTransfers(t *testing.T) {
+	t.Parallel()
+
+	tp := createTransfersProcessor()
+
+	transfers := []*data.Transfer{
+		{
+			ID:        "h1",
+			TxHash:    "h1",
+			Sender:    "s1",
+			Receiver:  "r1",
+			Token:     egldToken,
+			Amount:    "1000",
+			Direction: IntraShardDirection,
+			Status:    "success",
+			Timestamp: 100,
+		},
+		{
+			ID:                 "h2",
+			TxHash:             "h2",
+			Sender:             "s2",
+			Receiver:           "r2",
+			SenderShard:        1,
+			Token:              egldToken,
+			Amount:             "500",
+			Direction:          CrossShardDirection,
+			Status:             "fail",
+			Timestamp:          106,
+			CompletedTimestamp: 106,
+			IsIncoming:         true,
+		},
+	}
+
+	buffSlice := data.NewBufferSlice(data.DefaultMaxBulkSize)
+	err := tp.SerializeTransfers(transfers, buffSlice, "transfers")
+	require.Nil(t, err)
+	require.Equal(t, `{ "update" : { "_index":"transfers", "_id" : "h1" } }
+{"scripted_upsert": true, "script": {"source": "if ('create' == ctx.op) {ctx._source = params.transfer;return;}if (params.isIncoming) {ctx._source.status = params.transfer.status;ctx._source.completedTimestamp = params.transfer.completedTimestamp;return;}def status = ctx._source.status;def completedTimestamp = ctx._source.completedTimestamp;ctx._source = params.transfer;if (completedTimestamp != null) {ctx._source.status = status;ctx._source.completedTimestamp = completedTimestamp;}","lang": "painless","params": { "transfer": {"uuid":"","txHash":"h1","sender":"s1","receiver":"r1","senderShard":0,"receiverShard":0,"token":"EGLD","amount":"1000","amountNum":0,"direction":"intraShard","status":"success","shardID":0,"timestamp":100}, "isIncoming": false }},"upsert": {}}
+{ "update" : { "_index":"transfers", "_id" : "h2" } }
+{"scripted_upsert": true, "script": {"source": "if ('create' == ctx.op) {ctx._source = params.transfer;return;}if (params.isIncoming) {ctx._source.status = params.transfer.status;ctx._source.completedTimestamp = params.transfer.completedTimestamp;return;}def status = ctx._source.status;def completedTimestamp = ctx._source.completedTimestamp;ctx._source = params.transfer;if (completedTimestamp != null) {ctx._source.status = status;ctx._source.completedTimestamp = completedTimestamp;}","lang": "painless","params": { "transfer": {"uuid":"","txHash":"h2","sender":"s2","receiver":"r2","senderShard":1,"receiverShard":0,"token":"EGLD","amount":"500","amountNum":0,"direction":"crossShard","status":"fail","shardID":0,"timestamp":106,"completedTimestamp":106}, "isIncoming": true }},"upsert": {}}
+`, buffSlice.Buffers()[0].String())
+}
+
+func TestTransfersProcessor_PrepareTransfersQueryInCaseOfRevert(t *testing.T) {
+	t.Parallel()
+
+	tp := createTransfersProcessor()
+
+	query := tp.PrepareTransfersQueryInCaseOfRevert(106, 1).String()
+	require.Contains(t, query, `{"match": {"completedTimestamp": "106"}}`)
+	require.Contains(t, query, `{"match": {"receiverShard": 1}}`)
+	require.Contains(t, query, `"params": {"status": "pending"}`)
+	require.Contains(t, query, `ctx._source.remove('completedTimestamp');`)
+}
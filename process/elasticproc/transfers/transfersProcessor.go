@@ -0,0 +1,329 @@
+package transfers
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/core/sharding"
+	"github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	logger "github.com/multiversx/mx-chain-logger-go"
+
+	"github.com/multiversx/mx-chain-es-indexer-go/data"
+	"github.com/multiversx/mx-chain-es-indexer-go/process/dataindexer"
+	"github.com/multiversx/mx-chain-es-indexer-go/process/elasticproc/converters"
+)
+
+const (
+	// IntraShardDirection is the direction of a transfer leg whose sender and receiver are in the same shard
+	IntraShardDirection = "intraShard"
+	// CrossShardDirection is the direction of a transfer leg whose sender and receiver are in different shards. The leg is
+	// written by the sender's shard as pending and completed by the receiver's shard
+	CrossShardDirection = "crossShard"
+
+	egldToken                   = "EGLD"
+	egldTokenInMultiTransfer    = "EGLD-000000"
+	numTopicsPerTransferredItem = 3
+	minNumTopicsForTransfer     = numTopicsPerTransferredItem + 1
+	legHashLen                  = 8
+	okDataField                 = "@6f6b"
+)
+
+var log = logger.GetOrCreate("indexer/process/transfers")
+
+var transferIdentifiers = map[string]struct{}{
+	core.BuiltInFunctionESDTTransfer:         {},
+	core.BuiltInFunctionESDTNFTTransfer:      {},
+	core.BuiltInFunctionMultiESDTNFTTransfer: {},
+}
+
+type transfersProcessor struct {
+	pubKeyConverter  core.PubkeyConverter
+	balanceConverter dataindexer.BalanceConverter
+}
+
+// NewTransfersProcessor will create a new instance of transfersProcessor
+func NewTransfersProcessor(pubKeyConverter core.PubkeyConverter, balanceConverter dataindexer.BalanceConverter) (*transfersProcessor, error) {
+	if check.IfNil(pubKeyConverter) {
+		return nil, dataindexer.ErrNilPubkeyConverter
+	}
+	if check.IfNil(balanceConverter) {
+		return nil, dataindexer.ErrNilBalanceConverter
+	}
+
+	return &transfersProcessor{
+		pubKeyConverter:  pubKeyConverter,
+		balanceConverter: balanceConverter,
+	}, nil
+}
+
+// ExtractTransfers will create a transfer leg for every value movement found in the provided transactions, smart contract
+// results and transfer events. EGLD legs are taken from the transactions and smart contract results values, while the ESDT
+// legs are taken from the events because the data field of a transaction does not guarantee that the transfer was executed
+func (tp *transfersProcessor) ExtractTransfers(
+	preparedResults *data.PreparedResults,
+	logsResults *data.PreparedLogsResults,
+	timestamp uint64,
+	selfShardID uint32,
+	numOfShards uint32,
+) []*data.Transfer {
+	transfers := make([]*data.Transfer, 0)
+	if preparedResults != nil {
+		var txHashStatusInfo map[string]*outport.StatusInfo
+		if logsResults != nil {
+			txHashStatusInfo = logsResults.TxHashStatusInfo
+		}
+
+		transfers = append(transfers, tp.extractFromTransactions(preparedResults.Transactions, txHashStatusInfo, timestamp, selfShardID)...)
+		transfers = append(transfers, tp.extractFromScResults(preparedResults.ScResults, timestamp, selfShardID)...)
+	}
+	if logsResults != nil {
+		transfers = append(transfers, tp.extractFromEvents(logsResults.DBEvents, timestamp, selfShardID, numOfShards)...)
+	}
+
+	return transfers
+}
+
+func (tp *transfersProcessor) extractFromTransactions(
+	txs []*data.Transaction,
+	txHashStatusInfo map[string]*outport.StatusInfo,
+	timestamp uint64,
+	selfShardID uint32,
+) []*data.Transfer {
+	transfers := make([]*data.Transfer, 0)
+	for _, tx := range txs {
+		if !isPositiveValue(tx.Value) {
+			continue
+		}
+
+		// a failed cross shard transaction is kept in the receiver's shard to mark its pending leg as failed
+		isIncoming := isIncomingLeg(tx.SenderShard, tx.ReceiverShard, selfShardID)
+		isSuccessful := isExecutedWithSuccess(tx, txHashStatusInfo)
+		if !isSuccessful && !isIncoming {
+			continue
+		}
+
+		transfer := &data.Transfer{
+			UUID:          converters.GenerateBase64UUID(),
+			ID:            tx.Hash,
+			TxHash:        tx.Hash,
+			Sender:        tx.Sender,
+			Receiver:      tx.Receiver,
+			SenderShard:   tx.SenderShard,
+			ReceiverShard: tx.ReceiverShard,
+			Token:         egldToken,
+			Amount:        tx.Value,
+			AmountNum:     tx.ValueNum,
+		}
+		setLegStatus(transfer, isSuccessful, timestamp, selfShardID)
+		transfers = append(transfers, transfer)
+	}
+
+	return transfers
+}
+
+func (tp *transfersProcessor) extractFromScResults(scrs []*data.ScResult, timestamp uint64, selfShardID uint32) []*data.Transfer {
+	transfers := make([]*data.Transfer, 0)
+	for _, scr := range scrs {
+		if !isPositiveValue(scr.Value) || isGasRefund(scr) {
+			continue
+		}
+
+		transfer := &data.Transfer{
+			UUID:          converters.GenerateBase64UUID(),
+			ID:            scr.Hash,
+			TxHash:        scr.OriginalTxHash,
+			ScrHash:       scr.Hash,
+			Sender:        scr.Sender,
+			Receiver:      scr.Receiver,
+			SenderShard:   scr.SenderShard,
+			ReceiverShard: scr.ReceiverShard,
+			Token:         egldToken,
+			Amount:        scr.Value,
+			AmountNum:     scr.ValueNum,
+		}
+		setLegStatus(transfer, true, timestamp, selfShardID)
+		transfers = append(transfers, transfer)
+	}
+
+	return transfers
+}
+
+func (tp *transfersProcessor) extractFromEvents(events []*data.LogEvent, timestamp uint64, selfShardID uint32, numOfShards uint32) []*data.Transfer {
+	transfers := make([]*data.Transfer, 0)
+	legsOccurrences := make(map[string]int)
+	for _, event := range events {
+		_, isTransfer := transferIdentifiers[event.Identifier]
+		if !isTransfer {
+			continue
+		}
+
+		eventTransfers := tp.extractFromEvent(event, timestamp, selfShardID, numOfShards)
+		for _, transfer := range eventTransfers {
+			setEventLegID(transfer, legsOccurrences)
+		}
+		transfers = append(transfers, eventTransfers...)
+	}
+
+	return transfers
+}
+
+// setEventLegID computes the same identifier for a leg in the sender's and in the receiver's shard. The event is emitted
+// in both shards, but for different transactions or smart contract results of the same original transaction, so the
+// identifier is built from the original transaction hash and the leg fields. Identical legs of the same original
+// transaction are told apart by their order in the block
+func setEventLegID(transfer *data.Transfer, legsOccurrences map[string]int) {
+	legKey := strings.Join([]string{transfer.TxHash, transfer.Sender, transfer.Receiver, transfer.Identifier, transfer.Amount}, "-")
+	legHash := sha256.Sum256([]byte(legKey))
+	occurrence := legsOccurrences[legKey]
+	legsOccurrences[legKey]++
+
+	transfer.ID = fmt.Sprintf("%s-%s-%d", transfer.TxHash, hex.EncodeToString(legHash[:legHashLen]), occurrence)
+}
+
+// extractFromEvent parses the topics of a transfer event: (token, nonce, value) for every transferred item, followed by the receiver
+func (tp *transfersProcessor) extractFromEvent(event *data.LogEvent, timestamp uint64, selfShardID uint32, numOfShards uint32) []*data.Transfer {
+	topics := event.Topics
+	isWellFormatted := len(topics) >= minNumTopicsForTransfer && (len(topics)-1)%numTopicsPerTransferredItem == 0
+	if !isWellFormatted {
+		log.Warn("transfersProcessor.extractFromEvent: unexpected number of topics", "txHash", event.TxHash, "identifier", event.Identifier, "num topics", len(topics))
+		return nil
+	}
+
+	receiverBytes, err := hex.DecodeString(topics[len(topics)-1])
+	if err != nil {
+		log.Warn("transfersProcessor.extractFromEvent: cannot decode receiver", "txHash", event.TxHash, "error", err)
+		return nil
+	}
+
+	senderShard := selfShardID
+	senderBytes, err := tp.pubKeyConverter.Decode(event.Address)
+	if err == nil {
+		senderShard = sharding.ComputeShardID(senderBytes, numOfShards)
+	}
+	receiverShard := sharding.ComputeShardID(receiverBytes, numOfShards)
+
+	txHash, scrHash := event.TxHash, ""
+	if event.OriginalTxHash != "" {
+		txHash, scrHash = event.OriginalTxHash, event.TxHash
+	}
+
+	receiver := tp.pubKeyConverter.SilentEncode(receiverBytes, log)
+	transfers := make([]*data.Transfer, 0, len(topics)/numTopicsPerTransferredItem)
+	for idx := 0; idx+numTopicsPerTransferredItem < len(topics); idx += numTopicsPerTransferredItem {
+		token, nonce, amount, errDecode := decodeTransferredItem(topics[idx], topics[idx+1], topics[idx+2])
+		if errDecode != nil {
+			log.Warn("transfersProcessor.extractFromEvent: cannot decode transferred item", "txHash", event.TxHash, "error", errDecode)
+			continue
+		}
+		if amount.Sign() <= 0 {
+			continue
+		}
+
+		amountNum, errConvert := tp.balanceConverter.ConvertBigValueToFloat(amount)
+		if errConvert != nil {
+			log.Warn("transfersProcessor.extractFromEvent: cannot compute amount as number", "amount", amount, "error", errConvert)
+		}
+
+		transfer := &data.Transfer{
+			UUID:          converters.GenerateBase64UUID(),
+			TxHash:        txHash,
+			ScrHash:       scrHash,
+			Sender:        event.Address,
+			Receiver:      receiver,
+			SenderShard:   senderShard,
+			ReceiverShard: receiverShard,
+			Token:         token,
+			Identifier:    converters.ComputeTokenIdentifier(token, nonce),
+			Nonce:         nonce,
+			Amount:        amount.String(),
+			AmountNum:     amountNum,
+		}
+		setLegStatus(transfer, true, timestamp, selfShardID)
+		transfers = append(transfers, transfer)
+	}
+
+	return transfers
+}
+
+func decodeTransferredItem(tokenHex string, nonceHex string, amountHex string) (string, uint64, *big.Int, error) {
+	tokenBytes, err := hex.DecodeString(tokenHex)
+	if err != nil {
+		return "", 0, nil, err
+	}
+	nonceBytes, err := hex.DecodeString(nonceHex)
+	if err != nil {
+		return "", 0, nil, err
+	}
+	amountBytes, err := hex.DecodeString(amountHex)
+	if err != nil {
+		return "", 0, nil, err
+	}
+
+	token := string(tokenBytes)
+	if token == egldTokenInMultiTransfer {
+		token = egldToken
+	}
+
+	return token, big.NewInt(0).SetBytes(nonceBytes).Uint64(), big.NewInt(0).SetBytes(amountBytes), nil
+}
+
+func isExecutedWithSuccess(tx *data.Transaction, txHashStatusInfo map[string]*outport.StatusInfo) bool {
+	status := tx.Status
+	statusInfo, found := txHashStatusInfo[tx.Hash]
+	if found && statusInfo.Status != "" {
+		status = statusInfo.Status
+	}
+
+	return status != transaction.TxStatusFail.String() && status != transaction.TxStatusInvalid.String()
+}
+
+func isPositiveValue(value string) bool {
+	valueBig, ok := big.NewInt(0).SetString(value, 10)
+
+	return ok && valueBig.Sign() > 0
+}
+
+// isGasRefund returns true for the smart contract results that give back the unused gas, they are fees and not transfers
+func isGasRefund(scr *data.ScResult) bool {
+	return scr.ReturnMessage == core.GasRefundForRelayerMessage || string(scr.Data) == okDataField
+}
+
+func isIncomingLeg(senderShard uint32, receiverShard uint32, selfShardID uint32) bool {
+	return senderShard != receiverShard && receiverShard == selfShardID
+}
+
+// setLegStatus fills the fields that depend on the shard that indexes the leg. A cross shard leg is pending in the
+// sender's shard and is completed, with the execution status, by the receiver's shard
+func setLegStatus(transfer *data.Transfer, isSuccessful bool, timestamp uint64, selfShardID uint32) {
+	transfer.ShardID = selfShardID
+	transfer.Timestamp = time.Duration(timestamp)
+	transfer.Direction = IntraShardDirection
+	transfer.Status = transaction.TxStatusSuccess.String()
+	if transfer.SenderShard == transfer.ReceiverShard {
+		return
+	}
+
+	transfer.Direction = CrossShardDirection
+	transfer.IsIncoming = isIncomingLeg(transfer.SenderShard, transfer.ReceiverShard, selfShardID)
+	if !transfer.IsIncoming {
+		transfer.Status = transaction.TxStatusPending.String()
+		return
+	}
+
+	transfer.CompletedTimestamp = time.Duration(timestamp)
+	if !isSuccessful {
+		transfer.Status = transaction.TxStatusFail.String()
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tp *transfersProcessor) IsInterfaceNil() bool {
+	return tp == nil
+}
@@ -0,0 +1,219 @@
+package transfers
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/stretchr/testify/require"
+
+	"github.com/multiversx/mx-chain-es-indexer-go/data"
+	"github.com/multiversx/mx-chain-es-indexer-go/mock"
+	"github.com/multiversx/mx-chain-es-indexer-go/process/dataindexer"
+	"github.com/multiversx/mx-chain-es-indexer-go/process/elasticproc/converters"
+)
+
+func createTransfersProcessor() *transfersProcessor {
+	balanceConverter, _ := converters.NewBalanceConverter(18)
+	tp, _ := NewTransfersProcessor(&mock.PubkeyConverterMock{}, balanceConverter)
+
+	return tp
+}
+
+func addressInShard(shardID byte) []byte {
+	return append(bytes.Repeat([]byte{1}, 31), shardID)
+}
+
+func TestNewTransfersProcessor(t *testing.T) {
+	t.Parallel()
+
+	balanceConverter, _ := converters.NewBalanceConverter(18)
+
+	tp, err := NewTransfersProcessor(nil, balanceConverter)
+	require.Nil(t, tp)
+	require.Equal(t, dataindexer.ErrNilPubkeyConverter, err)
+
+	tp, err = NewTransfersProcessor(&mock.PubkeyConverterMock{}, nil)
+	require.Nil(t, tp)
+	require.Equal(t, dataindexer.ErrNilBalanceConverter, err)
+
+	tp, err = NewTransfersProcessor(&mock.PubkeyConverterMock{}, balanceConverter)
+	require.Nil(t, err)
+	require.False(t, tp.IsInterfaceNil())
+}
+
+func TestTransfersProcessor_ExtractTransfersFromTransactionsAndScResults(t *testing.T) {
+	t.Parallel()
+
+	tp := createTransfersProcessor()
+
+	preparedResults := &data.PreparedResults{
+		Transactions: []*data.Transaction{
+			{Hash: "h1", Sender: "s1", Receiver: "r1", Value: "1000", ValueNum: 1, Status: transaction.TxStatusSuccess.String()},
+			{Hash: "h2", Sender: "s2", Receiver: "r2", Value: "0", Status: transaction.TxStatusSuccess.String()},
+			{Hash: "h3", Sender: "s3", Receiver: "r3", Value: "1000", Status: transaction.TxStatusFail.String()},
+			{Hash: "h4", Sender: "s4", Receiver: "r4", Value: "1000", Status: transaction.TxStatusSuccess.String()},
+			{Hash: "h5", Sender: "s5", Receiver: "r5", Value: "500", SenderShard: 0, ReceiverShard: 1, Status: transaction.TxStatusPending.String()},
+			{Hash: "h6", Sender: "s6", Receiver: "r6", Value: "300", SenderShard: 1, ReceiverShard: 0, Status: transaction.TxStatusFail.String()},
+		},
+		ScResults: []*data.ScResult{
+			{Hash: "scr1", OriginalTxHash: "h0", Sender: "s6", Receiver: "r6", Value: "20", SenderShard: 1, ReceiverShard: 0},
+			{Hash: "scr2", OriginalTxHash: "h0", Sender: "s7", Receiver: "r7", Value: "0"},
+			{Hash: "scr3", OriginalTxHash: "h0", Sender: "s8", Receiver: "r8", Value: "10", ReturnMessage: core.GasRefundForRelayerMessage},
+			{Hash: "scr4", OriginalTxHash: "h0", Sender: "s9", Receiver: "r9", Value: "10", Data: []byte("@6f6b")},
+		},
+	}
+	logsResults := &data.PreparedLogsResults{
+		TxHashStatusInfo: map[string]*outport.StatusInfo{
+			"h4": {Status: transaction.TxStatusFail.String()},
+		},
+	}
+
+	transfers := tp.ExtractTransfers(preparedResults, logsResults, 100, 0, 3)
+	require.Len(t, transfers, 4)
+
+	require.Equal(t, "h1", transfers[0].ID)
+	require.Equal(t, "h1", transfers[0].TxHash)
+	require.Equal(t, "s1", transfers[0].Sender)
+	require.Equal(t, "r1", transfers[0].Receiver)
+	require.Equal(t, egldToken, transfers[0].Token)
+	require.Equal(t, "1000", transfers[0].Amount)
+	require.Equal(t, float64(1), transfers[0].AmountNum)
+	require.Equal(t, IntraShardDirection, transfers[0].Direction)
+	require.Equal(t, transaction.TxStatusSuccess.String(), transfers[0].Status)
+	require.Zero(t, transfers[0].CompletedTimestamp)
+
+	require.Equal(t, "h5", transfers[1].ID)
+	require.Equal(t, CrossShardDirection, transfers[1].Direction)
+	require.Equal(t, transaction.TxStatusPending.String(), transfers[1].Status)
+	require.False(t, transfers[1].IsIncoming)
+	require.Zero(t, transfers[1].CompletedTimestamp)
+
+	// the failed cross shard transaction marks its leg as failed in the receiver's shard
+	require.Equal(t, "h6", transfers[2].ID)
+	require.Equal(t, CrossShardDirection, transfers[2].Direction)
+	require.Equal(t, transaction.TxStatusFail.String(), transfers[2].Status)
+	require.True(t, transfers[2].IsIncoming)
+	require.Equal(t, time.Duration(100), transfers[2].CompletedTimestamp)
+
+	require.Equal(t, "scr1", transfers[3].ID)
+	require.Equal(t, "h0", transfers[3].TxHash)
+	require.Equal(t, "scr1", transfers[3].ScrHash)
+	require.Equal(t, CrossShardDirection, transfers[3].Direction)
+	require.Equal(t, transaction.TxStatusSuccess.String(), transfers[3].Status)
+	require.True(t, transfers[3].IsIncoming)
+	require.Equal(t, time.Duration(100), transfers[3].CompletedTimestamp)
+}
+
+func TestTransfersProcessor_ExtractTransfersFromEvents(t *testing.T) {
+	t.Parallel()
+
+	tp := createTransfersProcessor()
+
+	sender := hex.EncodeToString(addressInShard(0))
+	receiver := hex.EncodeToString(addressInShard(1))
+	events := []*data.LogEvent{
+		{
+			TxHash:     "h1",
+			Address:    sender,
+			Identifier: core.BuiltInFunctionMultiESDTNFTTransfer,
+			Topics: []string{
+				hex.EncodeToString([]byte("NFT-abcd")), hex.EncodeToString(big.NewInt(2).Bytes()), hex.EncodeToString(big.NewInt(1).Bytes()),
+				hex.EncodeToString([]byte(egldTokenInMultiTransfer)), "", hex.EncodeToString(big.NewInt(5000).Bytes()),
+				receiver,
+			},
+			Order: 1,
+		},
+		{
+			TxHash:         "scr1",
+			OriginalTxHash: "h2",
+			Address:        receiver,
+			Identifier:     core.BuiltInFunctionESDTTransfer,
+			Topics:         []string{hex.EncodeToString([]byte("TKN-abcd")), "", hex.EncodeToString(big.NewInt(7).Bytes()), sender},
+		},
+		{
+			TxHash:     "h3",
+			Address:    sender,
+			Identifier: core.BuiltInFunctionESDTTransfer,
+			Topics:     []string{hex.EncodeToString([]byte("TKN-abcd")), ""},
+		},
+		{
+			TxHash:     "h4",
+			Address:    sender,
+			Identifier: "writeLog",
+			Topics:     []string{sender},
+		},
+	}
+
+	transfers := tp.ExtractTransfers(nil, &data.PreparedLogsResults{DBEvents: events}, 100, 0, 3)
+	require.Len(t, transfers, 3)
+
+	require.True(t, strings.HasPrefix(transfers[0].ID, "h1-"))
+	require.True(t, strings.HasSuffix(transfers[0].ID, "-0"))
+	require.Equal(t, "h1", transfers[0].TxHash)
+	require.Equal(t, "", transfers[0].ScrHash)
+	require.Equal(t, sender, transfers[0].Sender)
+	require.Equal(t, receiver, transfers[0].Receiver)
+	require.Equal(t, uint32(0), transfers[0].SenderShard)
+	require.Equal(t, uint32(1), transfers[0].ReceiverShard)
+	require.Equal(t, "NFT-abcd", transfers[0].Token)
+	require.Equal(t, "NFT-abcd-02", transfers[0].Identifier)
+	require.Equal(t, uint64(2), transfers[0].Nonce)
+	require.Equal(t, "1", transfers[0].Amount)
+	require.Equal(t, CrossShardDirection, transfers[0].Direction)
+	require.Equal(t, transaction.TxStatusPending.String(), transfers[0].Status)
+
+	require.True(t, strings.HasPrefix(transfers[1].ID, "h1-"))
+	require.NotEqual(t, transfers[0].ID, transfers[1].ID)
+	require.Equal(t, egldToken, transfers[1].Token)
+	require.Equal(t, "5000", transfers[1].Amount)
+
+	require.True(t, strings.HasPrefix(transfers[2].ID, "h2-"))
+	require.Equal(t, "h2", transfers[2].TxHash)
+	require.Equal(t, "scr1", transfers[2].ScrHash)
+	require.Equal(t, "TKN-abcd", transfers[2].Token)
+	require.Equal(t, "7", transfers[2].Amount)
+	require.Equal(t, CrossShardDirection, transfers[2].Direction)
+	require.Equal(t, transaction.TxStatusSuccess.String(), transfers[2].Status)
+	require.True(t, transfers[2].IsIncoming)
+}
+
+func TestTransfersProcessor_ExtractTransfersFromEventsShouldComputeTheSameLegInBothShards(t *testing.T) {
+	t.Parallel()
+
+	tp := createTransfersProcessor()
+
+	sender := hex.EncodeToString(addressInShard(0))
+	receiver := hex.EncodeToString(addressInShard(1))
+	topics := []string{hex.EncodeToString([]byte("TKN-abcd")), "", hex.EncodeToString(big.NewInt(7).Bytes()), receiver}
+	senderShardEvents := []*data.LogEvent{
+		{TxHash: "h1", Address: sender, Identifier: core.BuiltInFunctionESDTTransfer, Topics: topics},
+		{TxHash: "h1", Address: sender, Identifier: core.BuiltInFunctionESDTTransfer, Topics: topics},
+	}
+	receiverShardEvents := []*data.LogEvent{
+		{TxHash: "scr1", OriginalTxHash: "h1", Address: sender, Identifier: core.BuiltInFunctionESDTTransfer, Topics: topics},
+		{TxHash: "scr2", OriginalTxHash: "h1", Address: sender, Identifier: core.BuiltInFunctionESDTTransfer, Topics: topics},
+	}
+
+	outgoing := tp.ExtractTransfers(nil, &data.PreparedLogsResults{DBEvents: senderShardEvents}, 100, 0, 3)
+	incoming := tp.ExtractTransfers(nil, &data.PreparedLogsResults{DBEvents: receiverShardEvents}, 106, 1, 3)
+	require.Len(t, outgoing, 2)
+	require.Len(t, incoming, 2)
+
+	// identical legs of the same transaction are distinct documents
+	require.NotEqual(t, outgoing[0].ID, outgoing[1].ID)
+	for idx := range outgoing {
+		require.Equal(t, outgoing[idx].ID, incoming[idx].ID)
+		require.Equal(t, transaction.TxStatusPending.String(), outgoing[idx].Status)
+		require.False(t, outgoing[idx].IsIncoming)
+		require.Equal(t, transaction.TxStatusSuccess.String(), incoming[idx].Status)
+		require.True(t, incoming[idx].IsIncoming)
+		require.Equal(t, time.Duration(106), incoming[idx].CompletedTimestamp)
+	}
+}
@@ -0,0 +1,84 @@
+package elasticproc
+
+import (
+	"context"
+	"encoding/json"
+
+	coreData "github.com/multiversx/mx-chain-core-go/data"
+
+	"github.com/multiversx/mx-chain-es-indexer-go/core/request"
+	"github.com/multiversx/mx-chain-es-indexer-go/data"
+	elasticIndexer "github.com/multiversx/mx-chain-es-indexer-go/process/dataindexer"
+)
+
+func (ei *elasticProcessor) indexUndelegations(delegatorsOperations []*data.Delegator, header coreData.HeaderHandler, buffSlice *data.BufferSlice) error {
+	if !ei.isIndexEnabled(elasticIndexer.UndelegationsIndex) {
+		return nil
+	}
+
+	undelegations := ei.undelegationsProc.PrepareUndelegations(delegatorsOperations, header.GetEpoch())
+	withdrawnIDs := ei.undelegationsProc.GetWithdrawnUndelegationsIDs(delegatorsOperations)
+
+	return ei.undelegationsProc.SerializeUndelegations(undelegations, withdrawnIDs, header.GetTimeStamp(), buffSlice, elasticIndexer.UndelegationsIndex)
+}
+
+// indexWithdrawableUndelegations marks as withdrawable the funds whose unbond period ended when the epoch start block is indexed
+func (ei *elasticProcessor) indexWithdrawableUndelegations(header coreData.HeaderHandler, buffSlice *data.BufferSlice) error {
+	if !ei.shouldUpdateUndelegationsAtEpochStart(header) {
+		return nil
+	}
+
+	ids := make([]string, 0)
+	handlerFunc := func(responseBytes []byte) error {
+		responseScroll := &data.ResponseScroll{}
+		err := json.Unmarshal(responseBytes, responseScroll)
+		if err != nil {
+			return err
+		}
+
+		for _, hit := range responseScroll.Hits.Hits {
+			ids = append(ids, hit.ID)
+		}
+
+		return nil
+	}
+
+	ctxWithValue := context.WithValue(context.Background(), request.ContextKey, request.ExtendTopicWithShardID(request.ScrollTopic, header.GetShardID()))
+	query := ei.undelegationsProc.PrepareWithdrawableQuery(header.GetEpoch())
+	err := ei.elasticClient.DoScrollRequest(ctxWithValue, elasticIndexer.UndelegationsIndex, query, false, handlerFunc)
+	if err != nil {
+		return err
+	}
+
+	return ei.undelegationsProc.SerializeWithdrawableUndelegations(ids, header.GetEpoch(), buffSlice, elasticIndexer.UndelegationsIndex)
+}
+
+func (ei *elasticProcessor) revertUndelegations(header coreData.HeaderHandler) error {
+	if !ei.isIndexEnabled(elasticIndexer.UndelegationsIndex) {
+		return nil
+	}
+
+	ctxWithValue := context.WithValue(context.Background(), request.ContextKey, request.ExtendTopicWithShardID(request.UpdateTopic, header.GetShardID()))
+	if ei.shouldUpdateUndelegationsAtEpochStart(header) {
+		query := ei.undelegationsProc.PrepareWithdrawableQueryInCaseOfRevert(header.GetEpoch())
+		err := ei.elasticClient.UpdateByQuery(ctxWithValue, elasticIndexer.UndelegationsIndex, query)
+		if err != nil {
+			return err
+		}
+	}
+
+	query := ei.undelegationsProc.PrepareWithdrawnQueryInCaseOfRevert(header.GetTimeStamp(), header.GetEpoch())
+	err := ei.elasticClient.UpdateByQuery(ctxWithValue, elasticIndexer.UndelegationsIndex, query)
+	if err != nil {
+		return err
+	}
+
+	ctxWithValue = context.WithValue(context.Background(), request.ContextKey, request.ExtendTopicWithShardID(request.RemoveTopic, header.GetShardID()))
+	query = ei.undelegationsProc.PrepareCreatedQueryInCaseOfRevert(header.GetTimeStamp())
+
+	return ei.elasticClient.DoQueryRemove(ctxWithValue, elasticIndexer.UndelegationsIndex, query)
+}
+
+func (ei *elasticProcessor) shouldUpdateUndelegationsAtEpochStart(header coreData.HeaderHandler) bool {
+	return ei.isIndexEnabled(elasticIndexer.UndelegationsIndex) && header.IsStartOfEpochBlock()
+}
@@ -0,0 +1,175 @@
+package undelegations
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-es-indexer-go/data"
+	"github.com/multiversx/mx-chain-es-indexer-go/process/elasticproc/converters"
+)
+
+// SerializeUndelegations will serialize the new unDelegate funds and the withdrawn ones in a way that Elasticsearch expects a bulk request
+func (up *undelegationsProcessor) SerializeUndelegations(
+	undelegations []*data.Undelegation,
+	withdrawnIDs []string,
+	timestamp uint64,
+	buffSlice *data.BufferSlice,
+	index string,
+) error {
+	for _, undelegation := range undelegations {
+		meta := []byte(fmt.Sprintf(`{ "update" : { "_index":"%s", "_id" : "%s" } }%s`, index, converters.JsonEscape(undelegation.ID), "\n"))
+		serializedUndelegation, err := json.Marshal(undelegation)
+		if err != nil {
+			return err
+		}
+
+		// the fund is only created, so a retried block does not overwrite the status set by a later block
+		codeToExecute := `
+			if ('create' == ctx.op) {
+				ctx._source = params.undelegation;
+			} else {
+				ctx.op = 'noop';
+			}
+`
+		serializedDataStr := fmt.Sprintf(`{"scripted_upsert": true, "script": {`+
+			`"source": "%s",`+
+			`"lang": "painless",`+
+			`"params": { "undelegation": %s }},`+
+			`"upsert": {}}`,
+			converters.FormatPainlessSource(codeToExecute), string(serializedUndelegation),
+		)
+		err = buffSlice.PutData(meta, []byte(serializedDataStr))
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, id := range withdrawnIDs {
+		meta := []byte(fmt.Sprintf(`{ "update" : { "_index":"%s", "_id" : "%s" } }%s`, index, converters.JsonEscape(id), "\n"))
+
+		// the funds created before the index was enabled are not known, so they are not created
+		codeToExecute := `
+			if ('create' == ctx.op) {
+				ctx.op = 'noop';
+			} else {
+				ctx._source.status = params.status;
+				ctx._source.withdrawTimestamp = params.timestamp;
+			}
+`
+		serializedDataStr := fmt.Sprintf(`{"scripted_upsert": true, "script": {`+
+			`"source": "%s",`+
+			`"lang": "painless",`+
+			`"params": { "status": "%s", "timestamp": %d }},`+
+			`"upsert": {}}`,
+			converters.FormatPainlessSource(codeToExecute), WithdrawnStatus, timestamp,
+		)
+
+		err := buffSlice.PutData(meta, []byte(serializedDataStr))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// PrepareWithdrawableQuery will prepare the query that returns the pending funds whose unbond period ended in the provided epoch
+func (up *undelegationsProcessor) PrepareWithdrawableQuery(epoch uint32) []byte {
+	return []byte(fmt.Sprintf(`{"query": {"bool": {"must": [{"match": {"status": "%s"}},{"range": {"unlockEpoch": {"lte": %d}}}]}}}`,
+		PendingStatus, epoch))
+}
+
+// SerializeWithdrawableUndelegations will serialize the funds that become withdrawable in the provided epoch in a way that
+// Elasticsearch expects a bulk request
+func (up *undelegationsProcessor) SerializeWithdrawableUndelegations(ids []string, epoch uint32, buffSlice *data.BufferSlice, index string) error {
+	for _, id := range ids {
+		meta := []byte(fmt.Sprintf(`{ "update" : { "_index":"%s", "_id" : "%s" } }%s`, index, converters.JsonEscape(id), "\n"))
+		codeToExecute := `
+			if ('create' == ctx.op || ctx._source.status != params.pendingStatus) {
+				ctx.op = 'noop';
+			} else {
+				ctx._source.status = params.status;
+				ctx._source.withdrawableEpoch = params.epoch;
+			}
+`
+		serializedDataStr := fmt.Sprintf(`{"scripted_upsert": true, "script": {`+
+			`"source": "%s",`+
+			`"lang": "painless",`+
+			`"params": { "pendingStatus": "%s", "status": "%s", "epoch": %d }},`+
+			`"upsert": {}}`,
+			converters.FormatPainlessSource(codeToExecute), PendingStatus, WithdrawableStatus, epoch,
+		)
+
+		err := buffSlice.PutData(meta, []byte(serializedDataStr))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// PrepareWithdrawableQueryInCaseOfRevert will prepare the query that marks again as pending the funds that became withdrawable
+// in the epoch started by a reverted block
+func (up *undelegationsProcessor) PrepareWithdrawableQueryInCaseOfRevert(epoch uint32) *bytes.Buffer {
+	codeToExecute := `
+	ctx._source.status = params.status;
+	ctx._source.remove('withdrawableEpoch');
+`
+
+	query := fmt.Sprintf(`
+	{
+	  "query": {
+		"bool": {
+		  "must": [
+			{"match": {"status": "%s"}},
+			{"match": {"withdrawableEpoch": %d}}
+		  ]
+		}
+	  },
+	  "script": {
+		"source": "%s",
+		"lang": "painless",
+		"params": {"status": "%s"}
+	  }
+	}`, WithdrawableStatus, epoch, converters.FormatPainlessSource(codeToExecute), PendingStatus)
+
+	return bytes.NewBuffer([]byte(query))
+}
+
+// PrepareWithdrawnQueryInCaseOfRevert will prepare the query that restores the status of the funds withdrawn in a reverted block
+func (up *undelegationsProcessor) PrepareWithdrawnQueryInCaseOfRevert(timestamp uint64, epoch uint32) *bytes.Buffer {
+	codeToExecute := `
+	ctx._source.status = ctx._source.unlockEpoch <= params.epoch ? params.withdrawableStatus : params.pendingStatus;
+	ctx._source.remove('withdrawTimestamp');
+`
+
+	query := fmt.Sprintf(`
+	{
+	  "query": {
+		"match": {
+		  "withdrawTimestamp": "%d"
+		}
+	  },
+	  "script": {
+		"source": "%s",
+		"lang": "painless",
+		"params": {"epoch": %d, "withdrawableStatus": "%s", "pendingStatus": "%s"}
+	  }
+	}`, timestamp, converters.FormatPainlessSource(codeToExecute), epoch, WithdrawableStatus, PendingStatus)
+
+	return bytes.NewBuffer([]byte(query))
+}
+
+// PrepareCreatedQueryInCaseOfRevert will prepare the query that removes the funds created in a reverted block
+func (up *undelegationsProcessor) PrepareCreatedQueryInCaseOfRevert(timestamp uint64) *bytes.Buffer {
+	query := fmt.Sprintf(`{"query": {"match": {"timestamp": {"query": "%d","operator": "AND"}}}}`, timestamp)
+
+	return bytes.NewBuffer([]byte(query))
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (up *undelegationsProcessor) IsInterfaceNil() bool {
+	return up == nil
+}
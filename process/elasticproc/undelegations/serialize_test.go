@@ -0,0 +1,72 @@
+package undelegations
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/multiversx/mx-chain-es-indexer-go/data"
+)
+
+func TestUndelegationsProcessor_SerializeUndelegations(t *testing.T) {
+	t.Parallel()
+
+	undelegations := []*data.Undelegation{
+		{
+			ID:          "contract-01",
+			Delegator:   "addr",
+			Contract:    "contract",
+			FundID:      "01",
+			Value:       "1000",
+			Status:      PendingStatus,
+			Epoch:       5,
+			UnlockEpoch: 15,
+			Timestamp:   1234,
+		},
+	}
+
+	buffSlice := data.NewBufferSlice(data.DefaultMaxBulkSize)
+	err := NewUndelegationsProcessor(10).SerializeUndelegations(undelegations, []string{"contract-02"}, 1234, buffSlice, "undelegations")
+	require.Nil(t, err)
+
+	expectedRes := `{ "update" : { "_index":"undelegations", "_id" : "contract-01" } }
+{"scripted_upsert": true, "script": {"source": "if ('create' == ctx.op) {ctx._source = params.undelegation;} else {ctx.op = 'noop';}","lang": "painless","params": { "undelegation": {"delegator":"addr","contract":"contract","fundID":"01","value":"1000","valueNum":0,"status":"pending","epoch":5,"unlockEpoch":15,"timestamp":1234} }},"upsert": {}}
+{ "update" : { "_index":"undelegations", "_id" : "contract-02" } }
+{"scripted_upsert": true, "script": {"source": "if ('create' == ctx.op) {ctx.op = 'noop';} else {ctx._source.status = params.status;ctx._source.withdrawTimestamp = params.timestamp;}","lang": "painless","params": { "status": "withdrawn", "timestamp": 1234 }},"upsert": {}}
+`
+	require.Equal(t, expectedRes, buffSlice.Buffers()[0].String())
+}
+
+func TestUndelegationsProcessor_SerializeWithdrawableUndelegations(t *testing.T) {
+	t.Parallel()
+
+	buffSlice := data.NewBufferSlice(data.DefaultMaxBulkSize)
+	err := NewUndelegationsProcessor(10).SerializeWithdrawableUndelegations([]string{"contract-01"}, 15, buffSlice, "undelegations")
+	require.Nil(t, err)
+
+	expectedRes := `{ "update" : { "_index":"undelegations", "_id" : "contract-01" } }
+{"scripted_upsert": true, "script": {"source": "if ('create' == ctx.op || ctx._source.status != params.pendingStatus) {ctx.op = 'noop';} else {ctx._source.status = params.status;ctx._source.withdrawableEpoch = params.epoch;}","lang": "painless","params": { "pendingStatus": "pending", "status": "withdrawable", "epoch": 15 }},"upsert": {}}
+`
+	require.Equal(t, expectedRes, buffSlice.Buffers()[0].String())
+}
+
+func TestUndelegationsProcessor_PrepareQueries(t *testing.T) {
+	t.Parallel()
+
+	up := NewUndelegationsProcessor(10)
+
+	query := string(up.PrepareWithdrawableQuery(15))
+	require.Equal(t, `{"query": {"bool": {"must": [{"match": {"status": "pending"}},{"range": {"unlockEpoch": {"lte": 15}}}]}}}`, query)
+
+	query = up.PrepareWithdrawableQueryInCaseOfRevert(15).String()
+	require.Contains(t, query, `{"match": {"status": "withdrawable"}}`)
+	require.Contains(t, query, `{"match": {"withdrawableEpoch": 15}}`)
+	require.Contains(t, query, `"params": {"status": "pending"}`)
+
+	query = up.PrepareWithdrawnQueryInCaseOfRevert(1234, 15).String()
+	require.Contains(t, query, `"withdrawTimestamp": "1234"`)
+	require.Contains(t, query, `"params": {"epoch": 15, "withdrawableStatus": "withdrawable", "pendingStatus": "pending"}`)
+
+	query = up.PrepareCreatedQueryInCaseOfRevert(1234).String()
+	require.Equal(t, `{"query": {"match": {"timestamp": {"query": "1234","operator": "AND"}}}}`, query)
+}
@@ -0,0 +1,75 @@
+package undelegations
+
+import (
+	"fmt"
+
+	"github.com/multiversx/mx-chain-es-indexer-go/data"
+)
+
+const (
+	// PendingStatus is the status of an unDelegate fund that is still in the unbond period
+	PendingStatus = "pending"
+	// WithdrawableStatus is the status of an unDelegate fund whose unbond period has ended
+	WithdrawableStatus = "withdrawable"
+	// WithdrawnStatus is the status of an unDelegate fund that was withdrawn
+	WithdrawnStatus = "withdrawn"
+)
+
+type undelegationsProcessor struct {
+	unBondPeriodInEpochs uint32
+}
+
+// NewUndelegationsProcessor will create a new instance of undelegationsProcessor
+func NewUndelegationsProcessor(unBondPeriodInEpochs uint32) *undelegationsProcessor {
+	return &undelegationsProcessor{
+		unBondPeriodInEpochs: unBondPeriodInEpochs,
+	}
+}
+
+// PrepareUndelegations will create the unDelegate funds of the provided delegators operations
+func (up *undelegationsProcessor) PrepareUndelegations(delegatorsOperations []*data.Delegator, epoch uint32) []*data.Undelegation {
+	undelegations := make([]*data.Undelegation, 0)
+	for _, delegator := range delegatorsOperations {
+		if delegator.UnDelegateInfo == nil {
+			continue
+		}
+
+		unlockEpoch := epoch + up.unBondPeriodInEpochs
+		status := PendingStatus
+		if unlockEpoch <= epoch {
+			status = WithdrawableStatus
+		}
+
+		undelegations = append(undelegations, &data.Undelegation{
+			ID:          ComputeUndelegationID(delegator.Contract, delegator.UnDelegateInfo.ID),
+			Delegator:   delegator.Address,
+			Contract:    delegator.Contract,
+			FundID:      delegator.UnDelegateInfo.ID,
+			Value:       delegator.UnDelegateInfo.Value,
+			ValueNum:    delegator.UnDelegateInfo.ValueNum,
+			Status:      status,
+			Epoch:       epoch,
+			UnlockEpoch: unlockEpoch,
+			Timestamp:   delegator.UnDelegateInfo.Timestamp,
+		})
+	}
+
+	return undelegations
+}
+
+// GetWithdrawnUndelegationsIDs returns the ids of the unDelegate funds withdrawn by the provided delegators operations
+func (up *undelegationsProcessor) GetWithdrawnUndelegationsIDs(delegatorsOperations []*data.Delegator) []string {
+	ids := make([]string, 0)
+	for _, delegator := range delegatorsOperations {
+		for _, fundID := range delegator.WithdrawFundIDs {
+			ids = append(ids, ComputeUndelegationID(delegator.Contract, fundID))
+		}
+	}
+
+	return ids
+}
+
+// ComputeUndelegationID returns the id of an unDelegate fund, the fund ids are unique only in a delegation contract
+func ComputeUndelegationID(contract string, fundID string) string {
+	return fmt.Sprintf("%s-%s", contract, fundID)
+}
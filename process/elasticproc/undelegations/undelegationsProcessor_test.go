@@ -0,0 +1,74 @@
+package undelegations
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/multiversx/mx-chain-es-indexer-go/data"
+)
+
+func TestUndelegationsProcessor_PrepareUndelegations(t *testing.T) {
+	t.Parallel()
+
+	delegatorsOperations := []*data.Delegator{
+		{
+			Address:  "addr",
+			Contract: "contract",
+			UnDelegateInfo: &data.UnDelegate{
+				ID:        "01",
+				Value:     "1000",
+				ValueNum:  0.1,
+				Timestamp: 1234,
+			},
+		},
+		{
+			Address:         "addr",
+			Contract:        "contract",
+			WithdrawFundIDs: []string{"02", "03"},
+		},
+		{
+			Address:  "addr2",
+			Contract: "contract",
+		},
+	}
+
+	up := NewUndelegationsProcessor(10)
+	require.False(t, up.IsInterfaceNil())
+
+	undelegations := up.PrepareUndelegations(delegatorsOperations, 5)
+	require.Equal(t, []*data.Undelegation{
+		{
+			ID:          "contract-01",
+			Delegator:   "addr",
+			Contract:    "contract",
+			FundID:      "01",
+			Value:       "1000",
+			ValueNum:    0.1,
+			Status:      PendingStatus,
+			Epoch:       5,
+			UnlockEpoch: 15,
+			Timestamp:   1234,
+		},
+	}, undelegations)
+
+	withdrawnIDs := up.GetWithdrawnUndelegationsIDs(delegatorsOperations)
+	require.Equal(t, []string{"contract-02", "contract-03"}, withdrawnIDs)
+}
+
+func TestUndelegationsProcessor_PrepareUndelegationsWithoutUnBondPeriod(t *testing.T) {
+	t.Parallel()
+
+	delegatorsOperations := []*data.Delegator{
+		{
+			Address:        "addr",
+			Contract:       "contract",
+			UnDelegateInfo: &data.UnDelegate{ID: "01", Value: "1000"},
+		},
+	}
+
+	undelegations := NewUndelegationsProcessor(0).PrepareUndelegations(delegatorsOperations, 5)
+	require.Len(t, undelegations, 1)
+	require.Equal(t, WithdrawableStatus, undelegations[0].Status)
+	require.Equal(t, uint32(5), undelegations[0].UnlockEpoch)
+}
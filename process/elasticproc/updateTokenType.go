@@ -64,7 +64,7 @@ func (ei *elasticProcessor) addTokenType(tokensData []*data.TokenInfo, index str
 				ids = append(ids, res.ID)
 			}
 
-			buffSlice := data.NewBufferSlice(ei.bulkRequestMaxSize)
+			buffSlice := data.NewBufferSlice(ei.getBulkRequestMaxSize())
 			err = ei.accountsProc.SerializeTypeForProvidedIDs(ids, td.Type, buffSlice, index)
 			if err != nil {
 				return err
@@ -3,6 +3,7 @@ package factory
 import (
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/elastic/go-elasticsearch/v7"
 	"github.com/multiversx/mx-chain-core-go/core"
@@ -19,7 +20,11 @@ import (
 	"github.com/multiversx/mx-chain-es-indexer-go/factory/runType"
 	"github.com/multiversx/mx-chain-es-indexer-go/process/dataindexer"
 	"github.com/multiversx/mx-chain-es-indexer-go/process/elasticproc"
+	"github.com/multiversx/mx-chain-es-indexer-go/process/elasticproc/abi"
 	"github.com/multiversx/mx-chain-es-indexer-go/process/elasticproc/factory"
+	"github.com/multiversx/mx-chain-es-indexer-go/process/elasticproc/nftattributes"
+	"github.com/multiversx/mx-chain-es-indexer-go/process/elasticproc/tokens"
+	"github.com/multiversx/mx-chain-es-indexer-go/stream"
 )
 
 var log = logger.GetOrCreate("indexer/factory")
@@ -30,11 +35,15 @@ type ArgsIndexerFactory struct {
 	Enabled                  bool
 	UseKibana                bool
 	ImportDB                 bool
+	StoreRawHexAddresses     bool
 	Sovereign                bool
 	ESDTPrefix               string
 	MainChainElastic         factory.ElasticConfig
+	MainChainTokensSource    factory.MainChainTokensSourceConfig
+	TokensSyncIntervalInSec  uint64
 	Denomination             int
 	BulkRequestMaxSize       int
+	UnBondPeriodInEpochs     uint32
 	Url                      string
 	UserName                 string
 	Password                 string
@@ -47,6 +56,10 @@ type ArgsIndexerFactory struct {
 	AddressPubkeyConverter   core.PubkeyConverter
 	ValidatorPubkeyConverter core.PubkeyConverter
 	StatusMetrics            indexerCore.StatusMetricsHandler
+	StreamPublisher          elasticproc.StreamPublisher
+	NFTAttributes            nftattributes.ArgsAttributesDecoder
+	ABI                      abi.ArgsABIDecoder
+	CallersRetentionInDays   uint64
 	RunTypeComponents        runType.RunTypeComponentsHandler
 }
 
@@ -58,7 +71,7 @@ func NewIndexer(args ArgsIndexerFactory) (dataindexer.Indexer, error) {
 	}
 
 	if args.Sovereign {
-		args.RunTypeComponents, err = createManagedRunTypeComponents(runType.NewSovereignRunTypeComponentsFactory(args.MainChainElastic, args.ESDTPrefix))
+		args.RunTypeComponents, err = createManagedRunTypeComponents(runType.NewSovereignRunTypeComponentsFactory(args.MainChainElastic, args.MainChainTokensSource, args.ESDTPrefix, args.AddressPubkeyConverter))
 	} else {
 		args.RunTypeComponents, err = createManagedRunTypeComponents(runType.NewRunTypeComponentsFactory())
 	}
@@ -77,14 +90,29 @@ func NewIndexer(args ArgsIndexerFactory) (dataindexer.Indexer, error) {
 	}
 
 	arguments := dataindexer.ArgDataIndexer{
-		HeaderMarshaller: args.HeaderMarshaller,
-		ElasticProcessor: elasticProcessor,
-		BlockContainer:   blockContainer,
+		HeaderMarshaller:   args.HeaderMarshaller,
+		ElasticProcessor:   elasticProcessor,
+		BlockContainer:     blockContainer,
+		StatusMetrics:      args.StatusMetrics,
+		TokensSyncInterval: computeTokensSyncInterval(args),
 	}
 
 	return dataindexer.NewDataIndexer(arguments)
 }
 
+// computeTokensSyncInterval returns the period of the cross-chain tokens synchronization, it runs only for a sovereign
+// chain with a main chain tokens source enabled
+func computeTokensSyncInterval(args ArgsIndexerFactory) time.Duration {
+	if !args.Sovereign {
+		return 0
+	}
+	if !args.MainChainElastic.Enabled && args.MainChainTokensSource.Type != tokens.ProxyTokensSourceType {
+		return 0
+	}
+
+	return time.Duration(args.TokensSyncIntervalInSec) * time.Second
+}
+
 func createManagedRunTypeComponents(factory runType.RunTypeComponentsCreator) (runType.RunTypeComponentsHandler, error) {
 	managedRunTypeComponents, err := runType.NewManagedRunTypeComponents(factory)
 	if err != nil {
@@ -115,16 +143,38 @@ func createElasticProcessor(args ArgsIndexerFactory) (dataindexer.ElasticProcess
 		Denomination:             args.Denomination,
 		EnabledIndexes:           args.EnabledIndexes,
 		BulkRequestMaxSize:       args.BulkRequestMaxSize,
+		UnBondPeriodInEpochs:     args.UnBondPeriodInEpochs,
 		ImportDB:                 args.ImportDB,
+		StoreRawHexAddresses:     args.StoreRawHexAddresses,
 		Version:                  args.Version,
 		TxHashExtractor:          args.RunTypeComponents.TxHashExtractorCreator(),
 		RewardTxData:             args.RunTypeComponents.RewardTxDataCreator(),
 		IndexTokensHandler:       args.RunTypeComponents.IndexTokensHandlerCreator(),
+		BridgeTransfersHandler:   args.RunTypeComponents.BridgeTransfersHandlerCreator(),
+		StreamPublisher:          createStreamPublisher(args),
+		NFTAttributes:            args.NFTAttributes,
+		ABI:                      createABIDecoderArgs(args),
+		CallersRetentionInDays:   args.CallersRetentionInDays,
 	}
 
 	return factory.CreateElasticProcessor(argsElasticProcFac)
 }
 
+func createABIDecoderArgs(args ArgsIndexerFactory) abi.ArgsABIDecoder {
+	argsABIDecoder := args.ABI
+	argsABIDecoder.StatusMetrics = args.StatusMetrics
+
+	return argsABIDecoder
+}
+
+func createStreamPublisher(args ArgsIndexerFactory) elasticproc.StreamPublisher {
+	if check.IfNil(args.StreamPublisher) {
+		return stream.NewDisabledPublisher()
+	}
+
+	return args.StreamPublisher
+}
+
 func createElasticClient(args ArgsIndexerFactory) (elasticproc.DatabaseClientHandler, error) {
 	argsEsClient := elasticsearch.Config{
 		Addresses:     []string{args.Url},
@@ -5,10 +5,12 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/multiversx/mx-chain-core-go/core/atomic"
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/data/outport"
 	"github.com/multiversx/mx-chain-core-go/marshal"
 	"github.com/multiversx/mx-chain-es-indexer-go/core"
+	"github.com/multiversx/mx-chain-es-indexer-go/data"
 	"github.com/multiversx/mx-chain-es-indexer-go/metrics"
 	"github.com/multiversx/mx-chain-es-indexer-go/process/dataindexer"
 	logger "github.com/multiversx/mx-chain-logger-go"
@@ -17,6 +19,7 @@ import (
 var (
 	log               = logger.GetOrCreate("process/wsindexer")
 	errNilDataIndexer = errors.New("nil data indexer")
+	errIndexingPaused = errors.New("indexing is paused")
 )
 
 // ArgsIndexer holds all the components needed to create a new instance of indexer
@@ -31,6 +34,7 @@ type indexer struct {
 	di            DataIndexer
 	statusMetrics core.StatusMetricsHandler
 	actions       map[string]func(marshalledData []byte) error
+	paused        atomic.Flag
 }
 
 // NewIndexer will create a new instance of *indexer
@@ -81,6 +85,12 @@ func (i *indexer) ProcessPayload(payload []byte, topic string, version uint32) e
 		return nil
 	}
 
+	// while paused, the payload is not acknowledged so the observer will resend it once the indexing is resumed
+	if i.paused.IsSet() && topic != outport.TopicSettings {
+		log.Trace("indexer.ProcessPayload: indexing is paused", "topic", topic)
+		return errIndexingPaused
+	}
+
 	shardID, err := i.getShardID(payload)
 	if err != nil {
 		log.Warn("indexer.ProcessPayload: cannot get shardID from payload", "error", err)
@@ -161,8 +171,14 @@ func (i *indexer) saveAccounts(marshalledData []byte) error {
 	return i.di.SaveAccounts(accounts)
 }
 
-func (i *indexer) finalizedBlock(_ []byte) error {
-	return nil
+func (i *indexer) finalizedBlock(marshalledData []byte) error {
+	finalizedBlock := &outport.FinalizedBlock{}
+	err := i.marshaller.Unmarshal(finalizedBlock, marshalledData)
+	if err != nil {
+		return err
+	}
+
+	return i.di.FinalizedBlock(finalizedBlock)
 }
 
 func (i *indexer) setSettings(marshalledData []byte) error {
@@ -175,6 +191,61 @@ func (i *indexer) setSettings(marshalledData []byte) error {
 	return i.di.SetCurrentSettings(settings)
 }
 
+// Pause will stop processing the received payloads until Resume is called
+func (i *indexer) Pause() {
+	i.paused.SetValue(true)
+}
+
+// Resume will continue processing the received payloads
+func (i *indexer) Resume() {
+	i.paused.SetValue(false)
+}
+
+// IsPaused returns true if the indexer does not process the received payloads
+func (i *indexer) IsPaused() bool {
+	return i.paused.IsSet()
+}
+
+// EnableIndex will enable writing in the provided index
+func (i *indexer) EnableIndex(index string) error {
+	return i.di.EnableIndex(index)
+}
+
+// DisableIndex will disable writing in the provided index
+func (i *indexer) DisableIndex(index string) error {
+	return i.di.DisableIndex(index)
+}
+
+// GetEnabledIndexes returns the indices that are currently enabled
+func (i *indexer) GetEnabledIndexes() []string {
+	return i.di.GetEnabledIndexes()
+}
+
+// UpdateSettings will schedule the provided settings to be applied before indexing the next block
+func (i *indexer) UpdateSettings(settings data.RuntimeSettings) {
+	i.di.UpdateSettings(settings)
+}
+
+// GetStuckTransactions returns the cross-shard transactions that are pending for more than the provided age
+func (i *indexer) GetStuckTransactions(maxAgeInSec uint64) ([]*data.StuckTransaction, error) {
+	return i.di.GetStuckTransactions(maxAgeInSec)
+}
+
+// GetTokenRolesAtTimestamp returns the addresses that had each role of the provided token at the provided timestamp
+func (i *indexer) GetTokenRolesAtTimestamp(token string, timestamp uint64) (map[string][]string, error) {
+	return i.di.GetTokenRolesAtTimestamp(token, timestamp)
+}
+
+// SyncMainChainTokens will refresh the cross-chain tokens from the main chain
+func (i *indexer) SyncMainChainTokens() (*data.TokensSyncReport, error) {
+	return i.di.SyncMainChainTokens()
+}
+
+// GetMainChainTokensSyncReport returns the report of the last synchronization of the cross-chain tokens
+func (i *indexer) GetMainChainTokensSyncReport() *data.TokensSyncReport {
+	return i.di.GetMainChainTokensSyncReport()
+}
+
 // Close will close the indexer
 func (i *indexer) Close() error {
 	return i.di.Close()
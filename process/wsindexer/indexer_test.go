@@ -0,0 +1,117 @@
+package wsindexer
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-es-indexer-go/core"
+	"github.com/multiversx/mx-chain-es-indexer-go/metrics"
+	"github.com/multiversx/mx-chain-es-indexer-go/mock"
+	"github.com/multiversx/mx-chain-es-indexer-go/process/dataindexer"
+	"github.com/stretchr/testify/require"
+)
+
+func createArgsIndexer() ArgsIndexer {
+	return ArgsIndexer{
+		Marshaller:    &marshal.GogoProtoMarshalizer{},
+		DataIndexer:   &mock.DataIndexerStub{},
+		StatusMetrics: metrics.NewStatusMetrics(),
+	}
+}
+
+func TestNewIndexer(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsIndexer()
+	args.Marshaller = nil
+	wi, err := NewIndexer(args)
+	require.Nil(t, wi)
+	require.Equal(t, dataindexer.ErrNilMarshalizer, err)
+
+	args = createArgsIndexer()
+	args.DataIndexer = nil
+	wi, err = NewIndexer(args)
+	require.Nil(t, wi)
+	require.Equal(t, errNilDataIndexer, err)
+
+	args = createArgsIndexer()
+	args.StatusMetrics = nil
+	wi, err = NewIndexer(args)
+	require.Nil(t, wi)
+	require.Equal(t, core.ErrNilMetricsHandler, err)
+
+	wi, err = NewIndexer(createArgsIndexer())
+	require.Nil(t, err)
+	require.False(t, wi.IsInterfaceNil())
+	require.False(t, wi.IsPaused())
+}
+
+func TestIndexer_ProcessPayloadWhilePausedShouldReturnErrorSoTheObserverResendsIt(t *testing.T) {
+	t.Parallel()
+
+	savedBlocks := 0
+	args := createArgsIndexer()
+	args.DataIndexer = &mock.DataIndexerStub{
+		SaveBlockCalled: func(outportBlock *outport.OutportBlock) error {
+			savedBlocks++
+			return nil
+		},
+	}
+	wi, _ := NewIndexer(args)
+
+	payload, err := args.Marshaller.Marshal(&outport.OutportBlock{ShardID: 1})
+	require.Nil(t, err)
+
+	wi.Pause()
+	require.True(t, wi.IsPaused())
+
+	err = wi.ProcessPayload(payload, outport.TopicSaveBlock, 1)
+	require.Equal(t, errIndexingPaused, err)
+	require.Zero(t, savedBlocks)
+
+	// the observer resends the payload that was not acknowledged, it is indexed once the indexing is resumed
+	err = wi.ProcessPayload(payload, outport.TopicSaveBlock, 1)
+	require.Equal(t, errIndexingPaused, err)
+	require.Zero(t, savedBlocks)
+
+	wi.Resume()
+	require.False(t, wi.IsPaused())
+
+	err = wi.ProcessPayload(payload, outport.TopicSaveBlock, 1)
+	require.Nil(t, err)
+	require.Equal(t, 1, savedBlocks)
+}
+
+func TestIndexer_ProcessPayloadWhilePausedShouldApplyTheSettings(t *testing.T) {
+	t.Parallel()
+
+	appliedSettings := 0
+	args := createArgsIndexer()
+	args.DataIndexer = &mock.DataIndexerStub{
+		SetCurrentSettingsCalled: func(settings outport.OutportConfig) error {
+			require.True(t, settings.IsInImportDBMode)
+			appliedSettings++
+			return nil
+		},
+	}
+	wi, _ := NewIndexer(args)
+
+	payload, err := args.Marshaller.Marshal(&outport.OutportConfig{IsInImportDBMode: true})
+	require.Nil(t, err)
+
+	wi.Pause()
+	err = wi.ProcessPayload(payload, outport.TopicSettings, 1)
+	require.Nil(t, err)
+	require.Equal(t, 1, appliedSettings)
+}
+
+func TestIndexer_ProcessPayloadWithUnknownTopicShouldBeIgnored(t *testing.T) {
+	t.Parallel()
+
+	wi, _ := NewIndexer(createArgsIndexer())
+	wi.Pause()
+
+	err := wi.ProcessPayload([]byte("payload"), "unknown", 1)
+	require.Nil(t, err)
+}
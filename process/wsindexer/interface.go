@@ -2,6 +2,8 @@ package wsindexer
 
 import (
 	"github.com/multiversx/mx-chain-core-go/data/outport"
+
+	"github.com/multiversx/mx-chain-es-indexer-go/data"
 )
 
 // WSClient defines what a websocket client should do
@@ -20,6 +22,14 @@ type DataIndexer interface {
 	SaveAccounts(accountsData *outport.Accounts) error
 	FinalizedBlock(finalizedBlock *outport.FinalizedBlock) error
 	SetCurrentSettings(settings outport.OutportConfig) error
+	EnableIndex(index string) error
+	DisableIndex(index string) error
+	GetEnabledIndexes() []string
+	UpdateSettings(settings data.RuntimeSettings)
+	GetStuckTransactions(maxAgeInSec uint64) ([]*data.StuckTransaction, error)
+	GetTokenRolesAtTimestamp(token string, timestamp uint64) (map[string][]string, error)
+	SyncMainChainTokens() (*data.TokensSyncReport, error)
+	GetMainChainTokensSyncReport() *data.TokensSyncReport
 	Close() error
 	IsInterfaceNil() bool
 }
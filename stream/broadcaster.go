@@ -0,0 +1,142 @@
+package stream
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/multiversx/mx-chain-es-indexer-go/data"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("stream")
+
+// ErrTooManySubscribers signals that the maximum number of subscribers has been reached
+var ErrTooManySubscribers = errors.New("too many subscribers")
+
+// ErrInvalidBufferSize signals that an invalid subscriber buffer size has been provided
+var ErrInvalidBufferSize = errors.New("invalid subscriber buffer size")
+
+// ArgsBroadcaster holds the arguments needed for creating a new broadcaster
+type ArgsBroadcaster struct {
+	MaxSubscribers       int
+	SubscriberBufferSize int
+}
+
+// Subscription is the handle received by a subscriber. The events are received on the Events channel,
+// which is closed when the subscription ends
+type Subscription struct {
+	ID     uint64
+	Events <-chan *data.StreamEvent
+}
+
+type subscriber struct {
+	filter Filter
+	events chan *data.StreamEvent
+}
+
+type broadcaster struct {
+	mut                  sync.RWMutex
+	subscribers          map[uint64]*subscriber
+	lastID               uint64
+	maxSubscribers       int
+	subscriberBufferSize int
+}
+
+// NewBroadcaster will create a new instance of broadcaster that pushes the published events to all the
+// subscribers whose filters match
+func NewBroadcaster(args ArgsBroadcaster) (*broadcaster, error) {
+	if args.SubscriberBufferSize < 1 {
+		return nil, ErrInvalidBufferSize
+	}
+
+	return &broadcaster{
+		subscribers:          make(map[uint64]*subscriber),
+		maxSubscribers:       args.MaxSubscribers,
+		subscriberBufferSize: args.SubscriberBufferSize,
+	}, nil
+}
+
+// Subscribe will register a new subscriber that receives the events matching the provided filter
+func (b *broadcaster) Subscribe(filter Filter) (*Subscription, error) {
+	b.mut.Lock()
+	defer b.mut.Unlock()
+
+	if b.maxSubscribers > 0 && len(b.subscribers) >= b.maxSubscribers {
+		return nil, ErrTooManySubscribers
+	}
+
+	b.lastID++
+	sub := &subscriber{
+		filter: filter,
+		events: make(chan *data.StreamEvent, b.subscriberBufferSize),
+	}
+	b.subscribers[b.lastID] = sub
+
+	return &Subscription{
+		ID:     b.lastID,
+		Events: sub.events,
+	}, nil
+}
+
+// Unsubscribe will remove the subscriber with the provided ID and will close its events channel
+func (b *broadcaster) Unsubscribe(id uint64) {
+	b.mut.Lock()
+	defer b.mut.Unlock()
+
+	b.removeUnprotected(id)
+}
+
+// Publish will push the provided events to the subscribers. A subscriber that cannot keep up with the events
+// is disconnected, so it does not silently miss events
+func (b *broadcaster) Publish(events []*data.StreamEvent) {
+	if len(events) == 0 {
+		return
+	}
+
+	b.mut.Lock()
+	defer b.mut.Unlock()
+
+	for id, sub := range b.subscribers {
+		for _, event := range events {
+			if !sub.filter.Matches(event) {
+				continue
+			}
+
+			select {
+			case sub.events <- event:
+			default:
+				log.Debug("broadcaster.Publish: subscriber is too slow, disconnecting", "id", id)
+				b.removeUnprotected(id)
+			}
+
+			if _, stillSubscribed := b.subscribers[id]; !stillSubscribed {
+				break
+			}
+		}
+	}
+}
+
+func (b *broadcaster) removeUnprotected(id uint64) {
+	sub, found := b.subscribers[id]
+	if !found {
+		return
+	}
+
+	close(sub.events)
+	delete(b.subscribers, id)
+}
+
+// Close will end all the subscriptions
+func (b *broadcaster) Close() {
+	b.mut.Lock()
+	defer b.mut.Unlock()
+
+	for id := range b.subscribers {
+		b.removeUnprotected(id)
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (b *broadcaster) IsInterfaceNil() bool {
+	return b == nil
+}
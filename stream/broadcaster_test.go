@@ -0,0 +1,101 @@
+package stream
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-es-indexer-go/data"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewBroadcaster(t *testing.T) {
+	t.Parallel()
+
+	b, err := NewBroadcaster(ArgsBroadcaster{SubscriberBufferSize: 0})
+	require.Nil(t, b)
+	require.Equal(t, ErrInvalidBufferSize, err)
+
+	b, err = NewBroadcaster(ArgsBroadcaster{SubscriberBufferSize: 1})
+	require.Nil(t, err)
+	require.False(t, b.IsInterfaceNil())
+}
+
+func TestBroadcaster_PublishShouldPushOnlyMatchingEvents(t *testing.T) {
+	t.Parallel()
+
+	b, _ := NewBroadcaster(ArgsBroadcaster{SubscriberBufferSize: 10})
+	allEvents, _ := b.Subscribe(Filter{})
+	onlyShardOne, _ := b.Subscribe(Filter{ShardIDs: []uint32{1}})
+
+	events := []*data.StreamEvent{
+		{Type: data.StreamEventBlock, ShardID: 0, Hash: "h0"},
+		{Type: data.StreamEventBlock, ShardID: 1, Hash: "h1"},
+	}
+	b.Publish(events)
+
+	require.Equal(t, events[0], <-allEvents.Events)
+	require.Equal(t, events[1], <-allEvents.Events)
+	require.Equal(t, events[1], <-onlyShardOne.Events)
+	require.Len(t, onlyShardOne.Events, 0)
+}
+
+func TestBroadcaster_MaxSubscribersAndUnsubscribe(t *testing.T) {
+	t.Parallel()
+
+	b, _ := NewBroadcaster(ArgsBroadcaster{MaxSubscribers: 1, SubscriberBufferSize: 1})
+	subscription, err := b.Subscribe(Filter{})
+	require.Nil(t, err)
+
+	_, err = b.Subscribe(Filter{})
+	require.Equal(t, ErrTooManySubscribers, err)
+
+	b.Unsubscribe(subscription.ID)
+	_, ok := <-subscription.Events
+	require.False(t, ok)
+
+	_, err = b.Subscribe(Filter{})
+	require.Nil(t, err)
+}
+
+func TestBroadcaster_SlowSubscriberShouldBeDisconnected(t *testing.T) {
+	t.Parallel()
+
+	b, _ := NewBroadcaster(ArgsBroadcaster{SubscriberBufferSize: 1})
+	subscription, _ := b.Subscribe(Filter{})
+
+	b.Publish([]*data.StreamEvent{{Hash: "h0"}, {Hash: "h1"}, {Hash: "h2"}})
+
+	event, ok := <-subscription.Events
+	require.True(t, ok)
+	require.Equal(t, "h0", event.Hash)
+	_, ok = <-subscription.Events
+	require.False(t, ok)
+
+	// unsubscribing an already removed subscriber should not panic
+	b.Unsubscribe(subscription.ID)
+}
+
+func TestFilter_Matches(t *testing.T) {
+	t.Parallel()
+
+	event := &data.StreamEvent{
+		Type:        data.StreamEventTransaction,
+		ShardID:     2,
+		Addresses:   []string{"alice", "bob"},
+		Tokens:      []string{"TKN-abcdef"},
+		Identifiers: []string{"ESDTTransfer"},
+	}
+
+	require.True(t, (&Filter{}).Matches(event))
+	require.True(t, (&Filter{
+		Types:       []string{data.StreamEventTransaction},
+		ShardIDs:    []uint32{1, 2},
+		Addresses:   []string{"bob"},
+		Tokens:      []string{"TKN-abcdef"},
+		Identifiers: []string{"ESDTTransfer", "transferValueOnly"},
+	}).Matches(event))
+	require.False(t, (&Filter{Types: []string{data.StreamEventBlock}}).Matches(event))
+	require.False(t, (&Filter{ShardIDs: []uint32{0}}).Matches(event))
+	require.False(t, (&Filter{Addresses: []string{"carol"}}).Matches(event))
+	require.False(t, (&Filter{Tokens: []string{"OTHER-123456"}}).Matches(event))
+	require.False(t, (&Filter{Identifiers: []string{"writeLog"}}).Matches(event))
+}
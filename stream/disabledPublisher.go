@@ -0,0 +1,19 @@
+package stream
+
+import "github.com/multiversx/mx-chain-es-indexer-go/data"
+
+type disabledPublisher struct{}
+
+// NewDisabledPublisher will create a new instance of disabledPublisher
+func NewDisabledPublisher() *disabledPublisher {
+	return &disabledPublisher{}
+}
+
+// Publish does nothing
+func (dp *disabledPublisher) Publish(_ []*data.StreamEvent) {
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dp *disabledPublisher) IsInterfaceNil() bool {
+	return dp == nil
+}
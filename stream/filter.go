@@ -0,0 +1,66 @@
+package stream
+
+import (
+	"github.com/multiversx/mx-chain-es-indexer-go/data"
+)
+
+// Filter holds the criteria that an event has to match in order to be pushed to a subscriber.
+// Empty criteria match all the events
+type Filter struct {
+	Types       []string
+	ShardIDs    []uint32
+	Addresses   []string
+	Tokens      []string
+	Identifiers []string
+}
+
+// Matches returns true if the provided event satisfies all the criteria of the filter
+func (f *Filter) Matches(event *data.StreamEvent) bool {
+	if len(f.Types) > 0 && !contains(f.Types, event.Type) {
+		return false
+	}
+	if len(f.ShardIDs) > 0 && !containsShard(f.ShardIDs, event.ShardID) {
+		return false
+	}
+	if len(f.Addresses) > 0 && !intersects(f.Addresses, event.Addresses) {
+		return false
+	}
+	if len(f.Tokens) > 0 && !intersects(f.Tokens, event.Tokens) {
+		return false
+	}
+	if len(f.Identifiers) > 0 && !intersects(f.Identifiers, event.Identifiers) {
+		return false
+	}
+
+	return true
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+func containsShard(shardIDs []uint32, shardID uint32) bool {
+	for _, s := range shardIDs {
+		if s == shardID {
+			return true
+		}
+	}
+
+	return false
+}
+
+func intersects(wanted []string, values []string) bool {
+	for _, value := range values {
+		if contains(wanted, value) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -31,6 +31,9 @@ var Accounts = Object{
 				"address": Object{
 					"type": "keyword",
 				},
+				"addressHex": Object{
+					"type": "keyword",
+				},
 				"balance": Object{
 					"type": "keyword",
 				},
@@ -56,6 +59,26 @@ var Accounts = Object{
 				"developerRewardsNum": Object{
 					"type": "double",
 				},
+				"firstSeen": Object{
+					"type":   "date",
+					"format": "epoch_second",
+				},
+				"lastActive": Object{
+					"type":   "date",
+					"format": "epoch_second",
+				},
+				"scrsCount": Object{
+					"type": "long",
+				},
+				"tokensCount": Object{
+					"type": "long",
+				},
+				"txsReceived": Object{
+					"type": "long",
+				},
+				"txsSent": Object{
+					"type": "long",
+				},
 			},
 		},
 	},
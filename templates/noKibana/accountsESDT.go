@@ -24,6 +24,20 @@ var AccountsESDT = Object{
 				"currentOwner": Object{
 					"type": "keyword",
 				},
+				"attributes": Object{
+					"type": "nested",
+					"properties": Object{
+						"key": Object{
+							"type": "keyword",
+						},
+						"value": Object{
+							"type": "keyword",
+						},
+						"valueNum": Object{
+							"type": "double",
+						},
+					},
+				},
 				"data": Object{
 					"type": "nested",
 					"properties": Object{
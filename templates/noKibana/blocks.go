@@ -24,6 +24,10 @@ var Blocks = Object{
 					"index": "false",
 					"type":  "keyword",
 				},
+				"crossShardTxsHashes": Object{
+					"index": "false",
+					"type":  "keyword",
+				},
 				"developerFees": Object{
 					"index": "false",
 					"type":  "keyword",
@@ -135,6 +139,9 @@ var Blocks = Object{
 						},
 					},
 				},
+				"finalized": Object{
+					"type": "boolean",
+				},
 				"gasPenalized": Object{
 					"type": "double",
 				},
@@ -169,9 +176,18 @@ var Blocks = Object{
 				"nonce": Object{
 					"type": "double",
 				},
+				"notarized": Object{
+					"type": "boolean",
+				},
 				"notarizedBlocksHashes": Object{
 					"type": "keyword",
 				},
+				"notarizedAtRound": Object{
+					"type": "double",
+				},
+				"notarizedByMetaHash": Object{
+					"type": "keyword",
+				},
 				"notarizedTxsCount": Object{
 					"index": "false",
 					"type":  "long",
@@ -12,6 +12,79 @@ var ESDTs = Object{
 		},
 		"mappings": Object{
 			"properties": Object{
+				"burned": Object{
+					"type": "keyword",
+				},
+				"burnedNum": Object{
+					"type": "double",
+				},
+				"initialSupply": Object{
+					"type": "keyword",
+				},
+				"initialSupplyNum": Object{
+					"type": "double",
+				},
+				"minted": Object{
+					"type": "keyword",
+				},
+				"mintedNum": Object{
+					"type": "double",
+				},
+				"supply": Object{
+					"type": "keyword",
+				},
+				"supplyNum": Object{
+					"type": "double",
+				},
+				"wiped": Object{
+					"type": "keyword",
+				},
+				"wipedNum": Object{
+					"type": "double",
+				},
+				"supplyChanges": Object{
+					"properties": Object{
+						"burned": Object{
+							"index": "false",
+							"type":  "keyword",
+						},
+						"burnedNum": Object{
+							"index": "false",
+							"type":  "double",
+						},
+						"initialSupply": Object{
+							"index": "false",
+							"type":  "keyword",
+						},
+						"initialSupplyNum": Object{
+							"index": "false",
+							"type":  "double",
+						},
+						"minted": Object{
+							"index": "false",
+							"type":  "keyword",
+						},
+						"mintedNum": Object{
+							"index": "false",
+							"type":  "double",
+						},
+						"wiped": Object{
+							"index": "false",
+							"type":  "keyword",
+						},
+						"wipedNum": Object{
+							"index": "false",
+							"type":  "double",
+						},
+						"shardID": Object{
+							"type": "long",
+						},
+						"timestamp": Object{
+							"type":   "date",
+							"format": "epoch_second",
+						},
+					},
+				},
 				"name": Object{
 					"type": "keyword",
 				},
@@ -40,6 +40,27 @@ var Events = Object{
 				"topics": Object{
 					"type": "text",
 				},
+				"decoded": Object{
+					"type": "nested",
+					"properties": Object{
+						"name": Object{
+							"type": "keyword",
+						},
+						"type": Object{
+							"type": "keyword",
+						},
+						"value": Object{
+							"type":         "keyword",
+							"ignore_above": 256,
+						},
+						"valueBool": Object{
+							"type": "boolean",
+						},
+						"valueNum": Object{
+							"type": "double",
+						},
+					},
+				},
 				"order": Object{
 					"type": "long",
 				},
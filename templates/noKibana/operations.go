@@ -34,6 +34,21 @@ var Operations = Object{
 				"data": Object{
 					"type": "text",
 				},
+				"decodedArgs": Object{
+					"type": "nested",
+					"properties": Object{
+						"name": Object{
+							"type": "keyword",
+						},
+						"type": Object{
+							"type": "keyword",
+						},
+						"value": Object{
+							"type":         "keyword",
+							"ignore_above": 256,
+						},
+					},
+				},
 				"esdtValues": Object{
 					"type": "keyword",
 				},
@@ -102,6 +117,9 @@ var Operations = Object{
 				"receiver": Object{
 					"type": "keyword",
 				},
+				"receiverHex": Object{
+					"type": "keyword",
+				},
 				"receiverShard": Object{
 					"type": "long",
 				},
@@ -130,6 +148,9 @@ var Operations = Object{
 				"sender": Object{
 					"type": "keyword",
 				},
+				"senderHex": Object{
+					"type": "keyword",
+				},
 				"senderShard": Object{
 					"type": "long",
 				},
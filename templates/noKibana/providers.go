@@ -0,0 +1,165 @@
+package noKibana
+
+// Providers will hold the configuration for the providers index
+var Providers = Object{
+	"index_patterns": Array{
+		"providers-*",
+	},
+	"template": Object{
+		"settings": Object{
+			"number_of_shards":   1,
+			"number_of_replicas": 0,
+		},
+		"mappings": Object{
+			"properties": Object{
+				"claimsCount": Object{
+					"type": "long",
+				},
+				"contract": Object{
+					"type": "keyword",
+				},
+				"delegationCapHistory": Object{
+					"properties": Object{
+						"maxDelegationCap": Object{
+							"index": "false",
+							"type":  "keyword",
+						},
+						"maxDelegationCapNum": Object{
+							"index": "false",
+							"type":  "double",
+						},
+						"timestamp": Object{
+							"type":   "date",
+							"format": "epoch_second",
+						},
+					},
+				},
+				"maxDelegationCap": Object{
+					"type": "keyword",
+				},
+				"maxDelegationCapNum": Object{
+					"type": "double",
+				},
+				"numDelegators": Object{
+					"type": "long",
+				},
+				"providerChanges": Object{
+					"properties": Object{
+						"claimsCount": Object{
+							"index": "false",
+							"type":  "long",
+						},
+						"delegationCapChanges": Object{
+							"properties": Object{
+								"maxDelegationCap": Object{
+									"index": "false",
+									"type":  "keyword",
+								},
+								"maxDelegationCapNum": Object{
+									"index": "false",
+									"type":  "double",
+								},
+								"timestamp": Object{
+									"index":  "false",
+									"type":   "date",
+									"format": "epoch_second",
+								},
+							},
+						},
+						"delegatorsDeleted": Object{
+							"index": "false",
+							"type":  "long",
+						},
+						"hasStakeInfo": Object{
+							"index": "false",
+							"type":  "boolean",
+						},
+						"numDelegators": Object{
+							"index": "false",
+							"type":  "long",
+						},
+						"prevNumDelegators": Object{
+							"index": "false",
+							"type":  "long",
+						},
+						"prevTotalActiveStake": Object{
+							"index": "false",
+							"type":  "keyword",
+						},
+						"prevTotalActiveStakeNum": Object{
+							"index": "false",
+							"type":  "double",
+						},
+						"rewardsClaimed": Object{
+							"index": "false",
+							"type":  "keyword",
+						},
+						"rewardsClaimedNum": Object{
+							"index": "false",
+							"type":  "double",
+						},
+						"serviceFeeChanges": Object{
+							"properties": Object{
+								"serviceFee": Object{
+									"index": "false",
+									"type":  "long",
+								},
+								"timestamp": Object{
+									"index":  "false",
+									"type":   "date",
+									"format": "epoch_second",
+								},
+							},
+						},
+						"shardID": Object{
+							"type": "long",
+						},
+						"timestamp": Object{
+							"type":   "date",
+							"format": "epoch_second",
+						},
+						"totalActiveStake": Object{
+							"index": "false",
+							"type":  "keyword",
+						},
+						"totalActiveStakeNum": Object{
+							"index": "false",
+							"type":  "double",
+						},
+					},
+				},
+				"rewardsClaimed": Object{
+					"type": "keyword",
+				},
+				"rewardsClaimedNum": Object{
+					"type": "double",
+				},
+				"serviceFee": Object{
+					"type": "long",
+				},
+				"serviceFeeHistory": Object{
+					"properties": Object{
+						"serviceFee": Object{
+							"index": "false",
+							"type":  "long",
+						},
+						"timestamp": Object{
+							"type":   "date",
+							"format": "epoch_second",
+						},
+					},
+				},
+				"timestamp": Object{
+					"type":   "date",
+					"format": "epoch_second",
+				},
+				"totalActiveStake": Object{
+					"type": "keyword",
+				},
+				"totalActiveStakeNum": Object{
+					"type": "double",
+				},
+			},
+		},
+	},
+}
@@ -0,0 +1,69 @@
+package noKibana
+
+// StakedKeys will hold the configuration for the stakedkeys index
+var StakedKeys = Object{
+	"index_patterns": Array{
+		"stakedkeys-*",
+	},
+	"template": Object{
+		"settings": Object{
+			"number_of_shards":   1,
+			"number_of_replicas": 0,
+		},
+		"mappings": Object{
+			"properties": Object{
+				"blsKey": Object{
+					"type": "keyword",
+				},
+				"delegation": Object{
+					"type": "keyword",
+				},
+				"keyChanges": Object{
+					"properties": Object{
+						"created": Object{
+							"index": "false",
+							"type":  "boolean",
+						},
+						"fields": Object{
+							"index": "false",
+							"type":  "keyword",
+						},
+						"previous": Object{
+							"type":    "object",
+							"enabled": false,
+						},
+						"shardID": Object{
+							"type": "long",
+						},
+						"timestamp": Object{
+							"type":   "date",
+							"format": "epoch_second",
+						},
+					},
+				},
+				"owner": Object{
+					"type": "keyword",
+				},
+				"rewardAddress": Object{
+					"type": "keyword",
+				},
+				"shardID": Object{
+					"type": "long",
+				},
+				"status": Object{
+					"type": "keyword",
+				},
+				"timestamp": Object{
+					"type":   "date",
+					"format": "epoch_second",
+				},
+				"topUp": Object{
+					"type": "keyword",
+				},
+				"topUpNum": Object{
+					"type": "double",
+				},
+			},
+		},
+	},
+}
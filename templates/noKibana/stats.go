@@ -0,0 +1,107 @@
+package noKibana
+
+// Stats will hold the configuration for the stats index
+var Stats = Object{
+	"index_patterns": Array{
+		"stats-*",
+	},
+	"template": Object{
+		"settings": Object{
+			"number_of_shards":   1,
+			"number_of_replicas": 0,
+		},
+		"mappings": Object{
+			"properties": Object{
+				"day": Object{
+					"type": "keyword",
+				},
+				"distinctReceivers": Object{
+					"type": "long",
+				},
+				"distinctSenders": Object{
+					"type": "long",
+				},
+				"endTimestamp": Object{
+					"type":   "date",
+					"format": "epoch_second",
+				},
+				"epoch": Object{
+					"type": "long",
+				},
+				"esdtTransfersCount": Object{
+					"type": "long",
+				},
+				"fees": Object{
+					"type": "keyword",
+				},
+				"feesNum": Object{
+					"type": "double",
+				},
+				"finalized": Object{
+					"type": "boolean",
+				},
+				"gasUsed": Object{
+					"type": "double",
+				},
+				"newAccounts": Object{
+					"type": "long",
+				},
+				"scrsCount": Object{
+					"type": "long",
+				},
+				"shardID": Object{
+					"type": "long",
+				},
+				"startTimestamp": Object{
+					"type":   "date",
+					"format": "epoch_second",
+				},
+				"statsChanges": Object{
+					"properties": Object{
+						"esdtTransfersCount": Object{
+							"index": "false",
+							"type":  "long",
+						},
+						"fees": Object{
+							"index": "false",
+							"type":  "keyword",
+						},
+						"feesNum": Object{
+							"index": "false",
+							"type":  "double",
+						},
+						"gasUsed": Object{
+							"index": "false",
+							"type":  "double",
+						},
+						"scrsCount": Object{
+							"index": "false",
+							"type":  "long",
+						},
+						"shardID": Object{
+							"type": "long",
+						},
+						"timestamp": Object{
+							"type":   "date",
+							"format": "epoch_second",
+						},
+						"txsCount": Object{
+							"index": "false",
+							"type":  "long",
+						},
+					},
+				},
+				"timestamp": Object{
+					"type":   "date",
+					"format": "epoch_second",
+				},
+				"txsCount": Object{
+					"type": "long",
+				},
+				"type": Object{
+					"type": "keyword",
+				},
+			},
+		},
+	},
+}
@@ -0,0 +1,43 @@
+package noKibana
+
+// TokenRoles will hold the configuration for the tokenroles index
+var TokenRoles = Object{
+	"index_patterns": Array{
+		"tokenroles-*",
+	},
+	"template": Object{
+		"settings": Object{
+			"number_of_shards":   3,
+			"number_of_replicas": 0,
+		},
+		"mappings": Object{
+			"properties": Object{
+				"action": Object{
+					"type": "keyword",
+				},
+				"address": Object{
+					"type": "keyword",
+				},
+				"order": Object{
+					"type": "long",
+				},
+				"role": Object{
+					"type": "keyword",
+				},
+				"shardID": Object{
+					"type": "long",
+				},
+				"timestamp": Object{
+					"type":   "date",
+					"format": "epoch_second",
+				},
+				"token": Object{
+					"type": "keyword",
+				},
+				"txHash": Object{
+					"type": "keyword",
+				},
+			},
+		},
+	},
+}
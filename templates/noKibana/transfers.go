@@ -0,0 +1,79 @@
+package noKibana
+
+// Transfers will hold the configuration for the transfers index
+var Transfers = Object{
+	"index_patterns": Array{
+		"transfers-*",
+	},
+	"template": Object{
+		"settings": Object{
+			"number_of_shards":   5,
+			"number_of_replicas": 0,
+			"index": Object{
+				"sort.field": Array{
+					"timestamp",
+				},
+				"sort.order": Array{
+					"desc",
+				},
+			},
+		},
+		"mappings": Object{
+			"properties": Object{
+				"amount": Object{
+					"type": "keyword",
+				},
+				"amountNum": Object{
+					"type": "double",
+				},
+				"completedTimestamp": Object{
+					"type":   "date",
+					"format": "epoch_second",
+				},
+				"direction": Object{
+					"type": "keyword",
+				},
+				"identifier": Object{
+					"type": "keyword",
+				},
+				"nonce": Object{
+					"type": "double",
+				},
+				"receiver": Object{
+					"type": "keyword",
+				},
+				"receiverShard": Object{
+					"type": "long",
+				},
+				"scrHash": Object{
+					"type": "keyword",
+				},
+				"sender": Object{
+					"type": "keyword",
+				},
+				"senderShard": Object{
+					"type": "long",
+				},
+				"shardID": Object{
+					"type": "long",
+				},
+				"status": Object{
+					"type": "keyword",
+				},
+				"timestamp": Object{
+					"type":   "date",
+					"format": "epoch_second",
+				},
+				"token": Object{
+					"type": "keyword",
+				},
+				"txHash": Object{
+					"type": "keyword",
+				},
+				"uuid": Object{
+					"type": "keyword",
+				},
+			},
+		},
+	},
+}
@@ -0,0 +1,53 @@
+package noKibana
+
+// Undelegations will hold the configuration for the undelegations index
+var Undelegations = Object{
+	"index_patterns": Array{
+		"undelegations-*",
+	},
+	"template": Object{
+		"settings": Object{
+			"number_of_shards":   3,
+			"number_of_replicas": 0,
+		},
+		"mappings": Object{
+			"properties": Object{
+				"contract": Object{
+					"type": "keyword",
+				},
+				"delegator": Object{
+					"type": "keyword",
+				},
+				"epoch": Object{
+					"type": "long",
+				},
+				"fundID": Object{
+					"type": "keyword",
+				},
+				"status": Object{
+					"type": "keyword",
+				},
+				"timestamp": Object{
+					"type":   "date",
+					"format": "epoch_second",
+				},
+				"unlockEpoch": Object{
+					"type": "long",
+				},
+				"value": Object{
+					"type": "keyword",
+				},
+				"valueNum": Object{
+					"type": "double",
+				},
+				"withdrawTimestamp": Object{
+					"type":   "date",
+					"format": "epoch_second",
+				},
+				"withdrawableEpoch": Object{
+					"type": "long",
+				},
+			},
+		},
+	},
+}
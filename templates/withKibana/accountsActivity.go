@@ -0,0 +1,41 @@
+package withKibana
+
+// AccountsActivity will hold the configuration for the accountsactivity index
+var AccountsActivity = Object{
+	"index_patterns": Array{
+		"accountsactivity-*",
+	},
+	"settings": Object{
+		"number_of_shards":   1,
+		"number_of_replicas": 0,
+	},
+	"mappings": Object{
+		"properties": Object{
+			"address": Object{
+				"type": "keyword",
+			},
+			"epoch": Object{
+				"type": "long",
+			},
+			"scrsCount": Object{
+				"type": "long",
+			},
+			"shardID": Object{
+				"type": "long",
+			},
+			"timestamp": Object{
+				"type":   "date",
+				"format": "epoch_second",
+			},
+			"tokensCount": Object{
+				"type": "long",
+			},
+			"txsReceived": Object{
+				"type": "long",
+			},
+			"txsSent": Object{
+				"type": "long",
+			},
+		},
+	},
+}
@@ -0,0 +1,63 @@
+package withKibana
+
+// BridgeTransfers will hold the configuration for the bridgetransfers index
+var BridgeTransfers = Object{
+	"index_patterns": Array{
+		"bridgetransfers-*",
+	},
+	"settings": Object{
+		"number_of_shards":   3,
+		"number_of_replicas": 0,
+	},
+	"mappings": Object{
+		"properties": Object{
+			"direction": Object{
+				"type": "keyword",
+			},
+			"esdtValues": Object{
+				"type": "keyword",
+			},
+			"mainChainTxHash": Object{
+				"type": "keyword",
+			},
+			"matchShardID": Object{
+				"type": "long",
+			},
+			"matchTimestamp": Object{
+				"type":   "date",
+				"format": "epoch_second",
+			},
+			"matched": Object{
+				"type": "boolean",
+			},
+			"nonce": Object{
+				"type": "long",
+			},
+			"opHash": Object{
+				"type": "keyword",
+			},
+			"receiver": Object{
+				"type": "keyword",
+			},
+			"sender": Object{
+				"type": "keyword",
+			},
+			"shardID": Object{
+				"type": "long",
+			},
+			"sovereignTxHash": Object{
+				"type": "keyword",
+			},
+			"status": Object{
+				"type": "keyword",
+			},
+			"timestamp": Object{
+				"type":   "date",
+				"format": "epoch_second",
+			},
+			"tokens": Object{
+				"type": "keyword",
+			},
+		},
+	},
+}
@@ -0,0 +1,32 @@
+package withKibana
+
+// ContractCallers will hold the configuration for the contractcallers index
+var ContractCallers = Object{
+	"index_patterns": Array{
+		"contractcallers-*",
+	},
+	"settings": Object{
+		"number_of_shards":   1,
+		"number_of_replicas": 0,
+	},
+	"mappings": Object{
+		"properties": Object{
+			"caller": Object{
+				"type": "keyword",
+			},
+			"contract": Object{
+				"type": "keyword",
+			},
+			"day": Object{
+				"type": "keyword",
+			},
+			"shardID": Object{
+				"type": "long",
+			},
+			"timestamp": Object{
+				"type":   "date",
+				"format": "epoch_second",
+			},
+		},
+	},
+}
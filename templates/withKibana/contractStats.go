@@ -0,0 +1,108 @@
+package withKibana
+
+// ContractStats will hold the configuration for the contractstats index
+var ContractStats = Object{
+	"index_patterns": Array{
+		"contractstats-*",
+	},
+	"settings": Object{
+		"number_of_shards":   1,
+		"number_of_replicas": 0,
+	},
+	"mappings": Object{
+		"properties": Object{
+			"callsCount": Object{
+				"type": "long",
+			},
+			"contract": Object{
+				"type": "keyword",
+			},
+			"contractChanges": Object{
+				"properties": Object{
+					"callsCount": Object{
+						"index": "false",
+						"type":  "long",
+					},
+					"failedCalls": Object{
+						"index": "false",
+						"type":  "long",
+					},
+					"functions": Object{
+						"properties": Object{
+							"calls": Object{
+								"index": "false",
+								"type":  "long",
+							},
+							"function": Object{
+								"index": "false",
+								"type":  "keyword",
+							},
+						},
+					},
+					"gasUsed": Object{
+						"index": "false",
+						"type":  "double",
+					},
+					"shardID": Object{
+						"type": "long",
+					},
+					"timestamp": Object{
+						"type":   "date",
+						"format": "epoch_second",
+					},
+					"uniqueCallers": Object{
+						"index": "false",
+						"type":  "long",
+					},
+					"value": Object{
+						"index": "false",
+						"type":  "keyword",
+					},
+					"valueNum": Object{
+						"index": "false",
+						"type":  "double",
+					},
+				},
+			},
+			"day": Object{
+				"type": "keyword",
+			},
+			"failedCalls": Object{
+				"type": "long",
+			},
+			"functions": Object{
+				"properties": Object{
+					"calls": Object{
+						"type": "long",
+					},
+					"function": Object{
+						"type": "keyword",
+					},
+				},
+			},
+			"gasUsed": Object{
+				"type": "double",
+			},
+			"lastCall": Object{
+				"type":   "date",
+				"format": "epoch_second",
+			},
+			"shardID": Object{
+				"type": "long",
+			},
+			"timestamp": Object{
+				"type":   "date",
+				"format": "epoch_second",
+			},
+			"uniqueCallers": Object{
+				"type": "long",
+			},
+			"value": Object{
+				"type": "keyword",
+			},
+			"valueNum": Object{
+				"type": "double",
+			},
+		},
+	},
+}
@@ -0,0 +1,32 @@
+package withKibana
+
+// RelayerUsers will hold the configuration for the relayerusers index
+var RelayerUsers = Object{
+	"index_patterns": Array{
+		"relayerusers-*",
+	},
+	"settings": Object{
+		"number_of_shards":   1,
+		"number_of_replicas": 0,
+	},
+	"mappings": Object{
+		"properties": Object{
+			"day": Object{
+				"type": "keyword",
+			},
+			"relayer": Object{
+				"type": "keyword",
+			},
+			"shardID": Object{
+				"type": "long",
+			},
+			"timestamp": Object{
+				"type":   "date",
+				"format": "epoch_second",
+			},
+			"user": Object{
+				"type": "keyword",
+			},
+		},
+	},
+}
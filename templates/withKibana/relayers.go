@@ -0,0 +1,86 @@
+package withKibana
+
+// Relayers will hold the configuration for the relayers index
+var Relayers = Object{
+	"index_patterns": Array{
+		"relayers-*",
+	},
+	"settings": Object{
+		"number_of_shards":   1,
+		"number_of_replicas": 0,
+	},
+	"mappings": Object{
+		"properties": Object{
+			"day": Object{
+				"type": "keyword",
+			},
+			"failedTransactions": Object{
+				"type": "long",
+			},
+			"fee": Object{
+				"type": "keyword",
+			},
+			"feeNum": Object{
+				"type": "double",
+			},
+			"gasUsed": Object{
+				"type": "double",
+			},
+			"lastRelayed": Object{
+				"type":   "date",
+				"format": "epoch_second",
+			},
+			"relayer": Object{
+				"type": "keyword",
+			},
+			"relayerChanges": Object{
+				"properties": Object{
+					"failedTransactions": Object{
+						"index": "false",
+						"type":  "long",
+					},
+					"fee": Object{
+						"index": "false",
+						"type":  "keyword",
+					},
+					"feeNum": Object{
+						"index": "false",
+						"type":  "double",
+					},
+					"gasUsed": Object{
+						"index": "false",
+						"type":  "double",
+					},
+					"shardID": Object{
+						"type": "long",
+					},
+					"timestamp": Object{
+						"type":   "date",
+						"format": "epoch_second",
+					},
+					"transactionsCount": Object{
+						"index": "false",
+						"type":  "long",
+					},
+					"uniqueUsers": Object{
+						"index": "false",
+						"type":  "long",
+					},
+				},
+			},
+			"shardID": Object{
+				"type": "long",
+			},
+			"timestamp": Object{
+				"type":   "date",
+				"format": "epoch_second",
+			},
+			"transactionsCount": Object{
+				"type": "long",
+			},
+			"uniqueUsers": Object{
+				"type": "long",
+			},
+		},
+	},
+}
@@ -30,6 +30,21 @@ var SCResults = Object{
 			"data": Object{
 				"type": "text",
 			},
+			"decodedArgs": Object{
+				"type": "nested",
+				"properties": Object{
+					"name": Object{
+						"type": "keyword",
+					},
+					"type": Object{
+						"type": "keyword",
+					},
+					"value": Object{
+						"type":         "keyword",
+						"ignore_above": 256,
+					},
+				},
+			},
 			"esdtValues": Object{
 				"type": "keyword",
 			},
@@ -71,6 +86,9 @@ var SCResults = Object{
 			"receiver": Object{
 				"type": "keyword",
 			},
+			"receiverHex": Object{
+				"type": "keyword",
+			},
 			"receiverShard": Object{
 				"type": "long",
 			},
@@ -93,6 +111,9 @@ var SCResults = Object{
 			"sender": Object{
 				"type": "keyword",
 			},
+			"senderHex": Object{
+				"type": "keyword",
+			},
 			"senderShard": Object{
 				"type": "long",
 			},
@@ -0,0 +1,49 @@
+package withKibana
+
+// TokensSyncReports will hold the configuration for the tokenssyncreports index
+var TokensSyncReports = Object{
+	"index_patterns": Array{
+		"tokenssyncreports-*",
+	},
+	"settings": Object{
+		"number_of_shards":   1,
+		"number_of_replicas": 0,
+	},
+	"mappings": Object{
+		"properties": Object{
+			"checkedTokens": Object{
+				"type": "long",
+			},
+			"checkpoint": Object{
+				"type": "keyword",
+			},
+			"divergences": Object{
+				"properties": Object{
+					"fields": Object{
+						"type": "keyword",
+					},
+					"token": Object{
+						"type": "keyword",
+					},
+				},
+			},
+			"endTimestamp": Object{
+				"type":   "date",
+				"format": "epoch_second",
+			},
+			"fullCycleCompleted": Object{
+				"type": "boolean",
+			},
+			"missingTokens": Object{
+				"type": "keyword",
+			},
+			"startTimestamp": Object{
+				"type":   "date",
+				"format": "epoch_second",
+			},
+			"updatedTokens": Object{
+				"type": "long",
+			},
+		},
+	},
+}
@@ -23,6 +23,21 @@ var Transactions = Object{
 			"data": Object{
 				"type": "text",
 			},
+			"decodedArgs": Object{
+				"type": "nested",
+				"properties": Object{
+					"name": Object{
+						"type": "keyword",
+					},
+					"type": Object{
+						"type": "keyword",
+					},
+					"value": Object{
+						"type":         "keyword",
+						"ignore_above": 256,
+					},
+				},
+			},
 			"esdtValues": Object{
 				"type": "keyword",
 			},
@@ -70,6 +85,33 @@ var Transactions = Object{
 			"isScCall": Object{
 				"type": "boolean",
 			},
+			"lifecycle": Object{
+				"properties": Object{
+					"completionLatency": Object{
+						"type": "long",
+					},
+					"destinationBlockHash": Object{
+						"type": "keyword",
+					},
+					"destinationNotarizedBlockHash": Object{
+						"type": "keyword",
+					},
+					"destinationTimestamp": Object{
+						"type":   "date",
+						"format": "epoch_second",
+					},
+					"sourceBlockHash": Object{
+						"type": "keyword",
+					},
+					"sourceNotarizedBlockHash": Object{
+						"type": "keyword",
+					},
+					"sourceTimestamp": Object{
+						"type":   "date",
+						"format": "epoch_second",
+					},
+				},
+			},
 			"miniBlockHash": Object{
 				"type": "keyword",
 			},
@@ -82,6 +124,9 @@ var Transactions = Object{
 			"receiver": Object{
 				"type": "keyword",
 			},
+			"receiverHex": Object{
+				"type": "keyword",
+			},
 			"receiverShard": Object{
 				"type": "long",
 			},
@@ -91,6 +136,19 @@ var Transactions = Object{
 			"receiversShardIDs": Object{
 				"type": "long",
 			},
+			"relayer": Object{
+				"type": "keyword",
+			},
+			"relayerFee": Object{
+				"index": "false",
+				"type":  "keyword",
+			},
+			"relayerFeeNum": Object{
+				"type": "double",
+			},
+			"relayerShard": Object{
+				"type": "long",
+			},
 			"round": Object{
 				"type": "double",
 			},
@@ -100,6 +158,9 @@ var Transactions = Object{
 			"sender": Object{
 				"type": "keyword",
 			},
+			"senderHex": Object{
+				"type": "keyword",
+			},
 			"senderShard": Object{
 				"type": "long",
 			},